@@ -0,0 +1,596 @@
+// Package ipc contains the Arrow IPC result encoders and decoders.
+//
+// Each table of a result is encoded as an Arrow IPC stream with a record
+// batch for each buffer of the table, and the streams of the tables are
+// written one after another. The column buffers are written as they are
+// so clients can use them without parsing. The schema of each stream has
+// the following metadata:
+//
+//	flux.result    the name of the result
+//	flux.table     the index of the table within the result
+//	flux.groupKey  the group key of the table as a JSON object
+//
+// Strings are encoded as utf8 and times as nanosecond timestamps in UTC.
+// An error that occurs after something has been written is encoded as
+// a stream without columns that has the error message in flux.error.
+package ipc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	arrowipc "github.com/apache/arrow/go/arrow/ipc"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	fluxarrow "github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	ResultMetadataKey   = "flux.result"
+	TableMetadataKey    = "flux.table"
+	GroupKeyMetadataKey = "flux.groupKey"
+	ErrorMetadataKey    = "flux.error"
+)
+
+// ResultEncoder encodes a result as a sequence of Arrow IPC streams.
+type ResultEncoder struct{}
+
+// NewResultEncoder creates a new ResultEncoder.
+func NewResultEncoder() *ResultEncoder {
+	return &ResultEncoder{}
+}
+
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	tableID := 0
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		if err := encodeTable(wc, result.Name(), tableID, tbl); err != nil {
+			return err
+		}
+		tableID++
+		return nil
+	})
+	return wc.Count(), err
+}
+
+// encodeTable writes the table as an Arrow IPC stream.
+// The stream is complete even when reading the table fails
+// so that the streams that follow it can be read.
+func encodeTable(w io.Writer, resultName string, id int, tbl flux.Table) error {
+	cols := tbl.Cols()
+	fields := make([]arrow.Field, len(cols))
+	for j, c := range cols {
+		typ, err := arrowType(c.Type)
+		if err != nil {
+			tbl.Done()
+			return wrapEncodingError(err)
+		}
+		fields[j] = arrow.Field{Name: c.Label, Type: typ, Nullable: true}
+	}
+	md := arrow.NewMetadata(
+		[]string{ResultMetadataKey, TableMetadataKey, GroupKeyMetadataKey},
+		[]string{resultName, strconv.Itoa(id), encodeGroupKey(tbl.Key())},
+	)
+	schema := arrow.NewSchema(fields, &md)
+	writer := arrowipc.NewWriter(w, arrowipc.WithSchema(schema), arrowipc.WithAllocator(arrowmemory.DefaultAllocator))
+
+	err := tbl.Do(func(cr flux.ColReader) error {
+		arrs := make([]array.Interface, len(cols))
+		for j, c := range cols {
+			arrs[j] = toArrow(table.Values(cr, j), c.Type)
+		}
+		rec := array.NewRecord(schema, arrs, int64(cr.Len()))
+		for _, arr := range arrs {
+			arr.Release()
+		}
+		defer rec.Release()
+		return wrapEncodingError(writer.Write(rec))
+	})
+	if isEncoderError(err) {
+		return err
+	}
+	if cerr := writer.Close(); cerr != nil {
+		return wrapEncodingError(cerr)
+	}
+	return err
+}
+
+// EncodeError writes the error as a stream without columns.
+func (e *ResultEncoder) EncodeError(w io.Writer, err error) error {
+	md := arrow.NewMetadata([]string{ErrorMetadataKey}, []string{err.Error()})
+	writer := arrowipc.NewWriter(w, arrowipc.WithSchema(arrow.NewSchema(nil, &md)))
+	return writer.Close()
+}
+
+// NewMultiResultEncoder creates a new encoder that writes
+// the results one after another as Arrow IPC streams.
+func NewMultiResultEncoder() flux.MultiResultEncoder {
+	return &flux.DelimitedMultiResultEncoder{
+		Encoder: NewResultEncoder(),
+	}
+}
+
+type ipcEncoderError struct {
+	err error
+}
+
+func (e *ipcEncoderError) Error() string {
+	return fmt.Sprintf("arrow encoder error: %s", e.err.Error())
+}
+
+func (e *ipcEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func (e *ipcEncoderError) Unwrap() error {
+	return e.err
+}
+
+func wrapEncodingError(err error) error {
+	if err == nil {
+		return err
+	}
+	return &ipcEncoderError{err: err}
+}
+
+func isEncoderError(err error) bool {
+	encErr, ok := err.(flux.EncoderError)
+	return ok && encErr.IsEncoderError()
+}
+
+// arrowType returns the arrow data type that a column is encoded with.
+func arrowType(typ flux.ColType) (arrow.DataType, error) {
+	switch typ {
+	case flux.TBool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case flux.TInt:
+		return arrow.PrimitiveTypes.Int64, nil
+	case flux.TUInt:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case flux.TFloat:
+		return arrow.PrimitiveTypes.Float64, nil
+	case flux.TString:
+		return arrow.BinaryTypes.String, nil
+	case flux.TTime:
+		return arrow.FixedWidthTypes.Timestamp_ns, nil
+	default:
+		return nil, errors.Newf(codes.Internal, "unsupported column type %s", typ)
+	}
+}
+
+// columnType returns the flux column type of an arrow data type.
+func columnType(typ arrow.DataType) (flux.ColType, error) {
+	switch typ.ID() {
+	case arrow.BOOL:
+		return flux.TBool, nil
+	case arrow.INT64:
+		return flux.TInt, nil
+	case arrow.UINT64:
+		return flux.TUInt, nil
+	case arrow.FLOAT64:
+		return flux.TFloat, nil
+	case arrow.STRING, arrow.BINARY:
+		return flux.TString, nil
+	case arrow.TIMESTAMP:
+		if typ.(*arrow.TimestampType).Unit == arrow.Nanosecond {
+			return flux.TTime, nil
+		}
+	}
+	return flux.TInvalid, errors.Newf(codes.Invalid, "unsupported arrow type %s", typ)
+}
+
+// toArrow returns an array that shares the buffers of a column
+// of a flux table and has the type the column is encoded with.
+func toArrow(arr array.Interface, typ flux.ColType) array.Interface {
+	switch typ {
+	case flux.TString:
+		data := retype(arr.Data(), arrow.BinaryTypes.String)
+		defer data.Release()
+		return array.NewStringData(data)
+	case flux.TTime:
+		data := retype(arr.Data(), arrow.FixedWidthTypes.Timestamp_ns)
+		defer data.Release()
+		return array.NewTimestampData(data)
+	default:
+		arr.Retain()
+		return arr
+	}
+}
+
+// fromArrow returns an array that shares the buffers of a decoded
+// column and has the representation flux uses for the column type.
+func fromArrow(arr array.Interface, typ flux.ColType) array.Interface {
+	switch typ {
+	case flux.TString:
+		data := retype(arr.Data(), arrow.BinaryTypes.String)
+		defer data.Release()
+		return array.NewBinaryData(data)
+	case flux.TTime:
+		data := retype(arr.Data(), arrow.PrimitiveTypes.Int64)
+		defer data.Release()
+		return array.NewInt64Data(data)
+	default:
+		arr.Retain()
+		return arr
+	}
+}
+
+// retype returns array data that shares the buffers of data, but has the given data type.
+// The caller releases the returned data once it has been used to make an array.
+func retype(data *array.Data, dt arrow.DataType) *array.Data {
+	return array.NewData(dt, data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+}
+
+// encodeGroupKey encodes the group key as a JSON object
+// with the columns of the group key in order.
+func encodeGroupKey(key flux.GroupKey) string {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for j, c := range key.Cols() {
+		if j > 0 {
+			buf.WriteByte(',')
+		}
+		// Marshaling strings and scalar values cannot fail.
+		label, _ := json.Marshal(c.Label)
+		v, _ := json.Marshal(jsonValue(key.Value(j)))
+		buf.Write(label)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+func jsonValue(v values.Value) interface{} {
+	if v.IsNull() {
+		return nil
+	}
+	switch v.Type().Nature() {
+	case semantic.Bool:
+		return v.Bool()
+	case semantic.Int:
+		return v.Int()
+	case semantic.UInt:
+		return v.UInt()
+	case semantic.Float:
+		// JSON has no numbers for NaN and infinity.
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+		return v.Float()
+	case semantic.String:
+		return v.Str()
+	case semantic.Time:
+		return v.Time().Time().UTC().Format(time.RFC3339Nano)
+	default:
+		return nil
+	}
+}
+
+// decodeGroupKey decodes a group key that was encoded by encodeGroupKey.
+func decodeGroupKey(s string, cols []flux.ColMeta) (flux.GroupKey, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	if t, err := dec.Token(); err != nil || t != json.Delim('{') {
+		return nil, errors.Newf(codes.Invalid, "invalid group key %s", s)
+	}
+	var (
+		keyCols   []flux.ColMeta
+		keyValues []values.Value
+	)
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid group key %s", s)
+		}
+		label := t.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid group key %s", s)
+		}
+		idx := execute.ColIdx(label, cols)
+		if idx < 0 {
+			return nil, errors.Newf(codes.Invalid, "group key column %q is not a column of the table", label)
+		}
+		v, err := decodeValue(raw, cols[idx].Type)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "group key column %q", label)
+		}
+		keyCols = append(keyCols, cols[idx])
+		keyValues = append(keyValues, v)
+	}
+	return execute.NewGroupKey(keyCols, keyValues), nil
+}
+
+func decodeValue(raw json.RawMessage, typ flux.ColType) (values.Value, error) {
+	if string(raw) == "null" {
+		return values.NewNull(flux.SemanticType(typ)), nil
+	}
+	var (
+		v   interface{}
+		err error
+	)
+	switch typ {
+	case flux.TBool:
+		var b bool
+		err = json.Unmarshal(raw, &b)
+		v = b
+	case flux.TInt:
+		v, err = strconv.ParseInt(string(raw), 10, 64)
+	case flux.TUInt:
+		v, err = strconv.ParseUint(string(raw), 10, 64)
+	case flux.TFloat:
+		s := string(raw)
+		if strings.HasPrefix(s, `"`) {
+			err = json.Unmarshal(raw, &s)
+		}
+		if err == nil {
+			v, err = strconv.ParseFloat(s, 64)
+		}
+	case flux.TString:
+		var s string
+		err = json.Unmarshal(raw, &s)
+		v = s
+	case flux.TTime:
+		var s string
+		if err = json.Unmarshal(raw, &s); err == nil {
+			var t time.Time
+			t, err = time.Parse(time.RFC3339Nano, s)
+			v = values.ConvertTime(t)
+		}
+	}
+	if err != nil {
+		return nil, errors.Newf(codes.Invalid, "invalid %s value %s", typ, raw)
+	}
+	return values.New(v), nil
+}
+
+// ResultDecoderConfig are options that can be specified on the decoders.
+type ResultDecoderConfig struct {
+	// Allocator is the memory allocator that will be used during decoding.
+	// The default is to use an unlimited allocator when this is not set.
+	Allocator *memory.Allocator
+}
+
+// decode reads the streams in r into results.
+// The returned iterator reports an error that was encoded after the results.
+func decode(r io.Reader, c ResultDecoderConfig) (*resultIterator, error) {
+	alloc := c.Allocator
+	if alloc == nil {
+		alloc = &memory.Allocator{}
+	}
+	mem := fluxarrow.NewAllocator(alloc)
+
+	// The reader is buffered so the end of the data can be
+	// detected without starting to read another stream.
+	br := bufio.NewReader(r)
+	iter := &resultIterator{}
+	var res *result
+	for {
+		if _, err := br.Peek(1); err == io.EOF {
+			return iter, nil
+		} else if err != nil {
+			iter.Release()
+			return nil, err
+		}
+
+		s, err := decodeStream(br, mem)
+		if err != nil {
+			iter.Release()
+			return nil, err
+		}
+		if s.err != nil {
+			iter.err = s.err
+			return iter, nil
+		}
+		if res == nil || res.name != s.result {
+			res = &result{name: s.result}
+			iter.results = append(iter.results, res)
+		}
+		res.tables = append(res.tables, s.table)
+	}
+}
+
+// stream is a decoded stream. It is either a table or an error.
+type stream struct {
+	result string
+	table  flux.Table
+	err    error
+}
+
+func decodeStream(r io.Reader, mem arrowmemory.Allocator) (stream, error) {
+	rdr, err := arrowipc.NewReader(r, arrowipc.WithAllocator(mem))
+	if err != nil {
+		return stream{}, errors.Wrap(err, codes.Invalid, "invalid Arrow IPC stream")
+	}
+	defer rdr.Release()
+
+	schema := rdr.Schema()
+	md := schema.Metadata()
+	if i := md.FindKey(ErrorMetadataKey); i >= 0 {
+		for rdr.Next() {
+		}
+		return stream{err: errors.New(codes.Internal, md.Values()[i])}, nil
+	}
+	metadata := func(key string) (string, error) {
+		i := md.FindKey(key)
+		if i < 0 {
+			return "", errors.Newf(codes.Invalid, "schema is missing %s metadata", key)
+		}
+		return md.Values()[i], nil
+	}
+	name, err := metadata(ResultMetadataKey)
+	if err != nil {
+		return stream{}, err
+	}
+	encodedKey, err := metadata(GroupKeyMetadataKey)
+	if err != nil {
+		return stream{}, err
+	}
+
+	cols := make([]flux.ColMeta, len(schema.Fields()))
+	for j, f := range schema.Fields() {
+		typ, err := columnType(f.Type)
+		if err != nil {
+			return stream{}, errors.Wrapf(err, codes.Invalid, "column %q", f.Name)
+		}
+		cols[j] = flux.ColMeta{Label: f.Name, Type: typ}
+	}
+	key, err := decodeGroupKey(encodedKey, cols)
+	if err != nil {
+		return stream{}, err
+	}
+
+	tbl := &table.BufferedTable{
+		GroupKey: key,
+		Columns:  cols,
+	}
+	for rdr.Next() {
+		rec := rdr.Record()
+		buf := &fluxarrow.TableBuffer{
+			GroupKey: key,
+			Columns:  cols,
+			Values:   make([]array.Interface, len(cols)),
+		}
+		for j, c := range cols {
+			buf.Values[j] = fromArrow(rec.Column(j), c.Type)
+		}
+		tbl.Buffers = append(tbl.Buffers, buf)
+	}
+	if err := rdr.Err(); err != nil {
+		tbl.Done()
+		return stream{}, errors.Wrap(err, codes.Invalid, "invalid Arrow IPC stream")
+	}
+	return stream{result: name, table: tbl}, nil
+}
+
+// result is a decoded result with its tables in memory.
+type result struct {
+	name   string
+	tables []flux.Table
+}
+
+func (r *result) Name() string {
+	return r.name
+}
+
+func (r *result) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *result) Do(f func(flux.Table) error) error {
+	tables := r.tables
+	r.tables = nil
+	for i, tbl := range tables {
+		if err := f(tbl); err != nil {
+			for _, tbl := range tables[i+1:] {
+				tbl.Done()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *result) release() {
+	for _, tbl := range r.tables {
+		tbl.Done()
+	}
+	r.tables = nil
+}
+
+// resultIterator iterates over decoded results.
+// The error is an error that was encoded after the results.
+type resultIterator struct {
+	results []*result
+	err     error
+}
+
+func (r *resultIterator) More() bool {
+	return len(r.results) > 0
+}
+
+func (r *resultIterator) Next() flux.Result {
+	next := r.results[0]
+	r.results = r.results[1:]
+	return next
+}
+
+func (r *resultIterator) Release() {
+	for _, res := range r.results {
+		res.release()
+	}
+	r.results = nil
+}
+
+func (r *resultIterator) Err() error {
+	return r.err
+}
+
+func (r *resultIterator) Statistics() flux.Statistics {
+	return flux.Statistics{}
+}
+
+// ResultDecoder decodes a result that was encoded by the ResultEncoder.
+type ResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewResultDecoder creates a new ResultDecoder.
+func NewResultDecoder(c ResultDecoderConfig) *ResultDecoder {
+	return &ResultDecoder{c: c}
+}
+
+// Decode decodes the first result in r.
+func (d *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	iter, err := decode(r, d.c)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	if !iter.More() {
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(codes.Invalid, "no result")
+	}
+	return iter.Next(), nil
+}
+
+// MultiResultDecoder decodes results that were encoded by the multi result encoder.
+type MultiResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewMultiResultDecoder creates a new MultiResultDecoder.
+func NewMultiResultDecoder(c ResultDecoderConfig) *MultiResultDecoder {
+	return &MultiResultDecoder{c: c}
+}
+
+// Decode reads the results from r and closes r.
+// An error that was encoded after the results is reported by the Err method
+// of the iterator.
+func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	defer r.Close()
+	iter, err := decode(r, d.c)
+	if err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
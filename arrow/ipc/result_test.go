@@ -0,0 +1,226 @@
+package ipc_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	arrowipc "github.com/apache/arrow/go/arrow/ipc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow/ipc"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/values"
+)
+
+var (
+	start = values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC))
+	stop  = values.ConvertTime(time.Date(2018, 4, 17, 0, 5, 0, 0, time.UTC))
+	t1    = values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 500, time.UTC))
+)
+
+// newResults returns results with every column type, nulls
+// and an empty table.
+func newResults() []*executetest.Result {
+	return []*executetest.Result{
+		{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "_stop", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "count", Type: flux.TInt},
+						{Label: "total", Type: flux.TUInt},
+						{Label: "ok", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{start, stop, start, "A", 42.5, int64(math.MaxInt64), uint64(math.MaxUint64), true},
+						{start, stop, t1, "A", nil, nil, nil, nil},
+						{start, stop, t1, "A", math.NaN(), int64(-1), uint64(0), false},
+					},
+				},
+				{
+					KeyCols:   []string{"_start", "_stop", "host"},
+					KeyValues: []interface{}{start, stop, nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+				},
+			},
+		},
+		{
+			Nm: "mean",
+			Tbls: []*executetest.Table{{
+				KeyCols: []string{"_value"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"B", math.Inf(-1)},
+				},
+			}},
+		},
+	}
+}
+
+func resultIterator(results []*executetest.Result) flux.ResultIterator {
+	rs := make([]flux.Result, len(results))
+	for i, r := range results {
+		rs[i] = r
+	}
+	return flux.NewSliceResultIterator(rs)
+}
+
+func decodeResults(t *testing.T, data []byte) ([]*executetest.Result, error) {
+	t.Helper()
+	results, err := ipc.NewMultiResultDecoder(ipc.ResultDecoderConfig{}).Decode(ioutil.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer results.Release()
+
+	var got []*executetest.Result
+	for results.More() {
+		res := executetest.ConvertResult(results.Next())
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		res.Normalize()
+		got = append(got, res)
+	}
+	return got, results.Err()
+}
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := ipc.NewMultiResultEncoder().Encode(&buf, resultIterator(newResults())); err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeResults(t, buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := newResults()
+	for _, res := range want {
+		res.Normalize()
+	}
+	if !cmp.Equal(want, got, cmpopts.EquateNaNs()) {
+		t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got, cmpopts.EquateNaNs()))
+	}
+}
+
+func TestEncode_Schema(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := ipc.NewMultiResultEncoder().Encode(&buf, resultIterator(newResults()[:1])); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first table can be read by any Arrow IPC stream reader.
+	rdr, err := arrowipc.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rdr.Release()
+
+	wantFields := []arrow.Field{
+		{Name: "_start", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+		{Name: "_stop", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+		{Name: "_time", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+		{Name: "host", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "_value", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "count", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "total", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+		{Name: "ok", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	}
+	wantMeta := arrow.NewMetadata(
+		[]string{ipc.ResultMetadataKey, ipc.TableMetadataKey, ipc.GroupKeyMetadataKey},
+		[]string{"_result", "0", `{"_start":"2018-04-17T00:00:00Z","_stop":"2018-04-17T00:05:00Z","host":"A"}`},
+	)
+	if want, got := arrow.NewSchema(wantFields, &wantMeta), rdr.Schema(); !want.Equal(got) {
+		t.Fatalf("unexpected schema -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if got, want := rdr.Schema().Metadata().Values(), wantMeta.Values(); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected metadata -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	if !rdr.Next() {
+		t.Fatalf("expected a record batch: %v", rdr.Err())
+	}
+	rec := rdr.Record()
+	if got, want := rec.NumRows(), int64(3); got != want {
+		t.Fatalf("unexpected number of rows -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	hosts := rec.Column(3).(*array.String)
+	if got, want := hosts.Value(0), "A"; got != want {
+		t.Errorf("unexpected string value -want/+got:\n\t- %q\n\t+ %q", want, got)
+	}
+	times := rec.Column(2).(*array.Timestamp)
+	if got, want := times.Value(1), arrow.Timestamp(t1); got != want {
+		t.Errorf("unexpected time value -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if rdr.Next() {
+		t.Fatal("expected the end of the stream")
+	}
+}
+
+func TestEncode_Error(t *testing.T) {
+	results := []*executetest.Result{
+		newResults()[1],
+		{
+			Nm:  "b",
+			Err: errors.New("test error"),
+		},
+	}
+	var buf bytes.Buffer
+	if _, err := ipc.NewMultiResultEncoder().Encode(&buf, resultIterator(results)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeResults(t, buf.Bytes())
+	if err == nil {
+		t.Fatal("expected error")
+	} else if got, want := err.Error(), "test error"; got != want {
+		t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+	}
+	if len(got) != 1 || got[0].Nm != "mean" {
+		t.Errorf("expected the result that precedes the error, got %v", got)
+	}
+
+	// An error before anything is written is returned.
+	buf.Reset()
+	if _, err := ipc.NewMultiResultEncoder().Encode(&buf, resultIterator(results[1:])); err == nil {
+		t.Fatal("expected error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %d bytes", buf.Len())
+	}
+}
+
+func TestDialectMappings(t *testing.T) {
+	mappings := make(flux.DialectMappings)
+	if err := ipc.AddDialectMappings(mappings); err != nil {
+		t.Fatal(err)
+	}
+	create, ok := mappings[ipc.DialectType]
+	if !ok {
+		t.Fatalf("missing dialect mapping for %q", ipc.DialectType)
+	}
+	if got := create().DialectType(); got != ipc.DialectType {
+		t.Errorf("unexpected dialect type -want/+got:\n\t- %q\n\t+ %q", ipc.DialectType, got)
+	}
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/andreyvit/diff"
+	"github.com/influxdata/flux/parser"
+	"github.com/spf13/cobra"
+)
+
+// fmtCmd represents the fmt command
+var fmtCmd = &cobra.Command{
+	Use:   "fmt [paths...]",
+	Short: "Format Flux source files",
+	Long: `Format the Flux source files (files ending in .flux) found in the given paths.
+Directories are searched recursively. If no path is given, the source is read
+from standard input. Comments within the source are preserved.
+
+By default, the formatted source is written to standard output.`,
+	RunE:         fmtFiles,
+	SilenceUsage: true,
+}
+
+var fmtFlags struct {
+	write bool
+	diff  bool
+	check bool
+}
+
+func init() {
+	rootCmd.AddCommand(fmtCmd)
+	fmtCmd.Flags().BoolVarP(&fmtFlags.write, "write", "w", false, "write the formatted source back to the file instead of standard output")
+	fmtCmd.Flags().BoolVarP(&fmtFlags.diff, "diff", "d", false, "display a diff of the changes instead of the formatted source")
+	fmtCmd.Flags().BoolVar(&fmtFlags.check, "check", false, "list the files that are not formatted and exit with a non-zero status if there are any")
+}
+
+const fluxFileSuffix = ".flux"
+
+// errNotFormatted is returned by the check mode when a file is not formatted.
+var errNotFormatted = errors.New("file is not formatted")
+
+func fmtFiles(cmd *cobra.Command, args []string) error {
+	f := &formatter{
+		w:      os.Stdout,
+		stderr: os.Stderr,
+		write:  fmtFlags.write,
+		diff:   fmtFlags.diff,
+		check:  fmtFlags.check,
+	}
+
+	if len(args) == 0 {
+		if f.write {
+			return fmt.Errorf("cannot use -w with standard input")
+		}
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		if err := f.format("<standard input>", src, 0); err != nil {
+			if err == errNotFormatted {
+				return fmt.Errorf("standard input is not formatted")
+			}
+			return err
+		}
+		return nil
+	}
+	return f.formatPaths(args)
+}
+
+// findFluxFiles returns the Flux source files within the given path.
+// A directory is searched recursively.
+func findFluxFiles(p string) ([]string, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{p}, nil
+	}
+
+	var files []string
+	if err := filepath.Walk(p, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(info.Name(), fluxFileSuffix) {
+			files = append(files, fpath)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// formatter formats Flux source files and reports the result
+// according to the mode that it was configured with.
+type formatter struct {
+	w      io.Writer
+	stderr io.Writer
+	write  bool
+	diff   bool
+	check  bool
+}
+
+// formatPaths formats the Flux source files within the given paths.
+// Errors for individual files are reported to stderr and the files
+// after them are still formatted.
+func (f *formatter) formatPaths(paths []string) error {
+	var failed, unformatted int
+	for _, p := range paths {
+		files, err := findFluxFiles(p)
+		if err != nil {
+			fmt.Fprintln(f.stderr, err)
+			failed++
+			continue
+		}
+		for _, fpath := range files {
+			switch err := f.formatFile(fpath); {
+			case err == errNotFormatted:
+				unformatted++
+			case err != nil:
+				fmt.Fprintf(f.stderr, "%s: %v\n", fpath, err)
+				failed++
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to format %d file(s)", failed)
+	}
+	if unformatted > 0 {
+		return fmt.Errorf("%d file(s) are not formatted", unformatted)
+	}
+	return nil
+}
+
+func (f *formatter) formatFile(fpath string) error {
+	info, err := os.Stat(fpath)
+	if err != nil {
+		return err
+	}
+	src, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	return f.format(fpath, src, info.Mode().Perm())
+}
+
+func (f *formatter) format(fpath string, src []byte, perm os.FileMode) error {
+	out, err := parser.FormatSource(src)
+	if err != nil {
+		return err
+	}
+	// Files are terminated with a newline.
+	if !bytes.HasSuffix(out, []byte("\n")) {
+		out = append(out, '\n')
+	}
+
+	changed := !bytes.Equal(src, out)
+	if f.check && changed {
+		fmt.Fprintln(f.w, fpath)
+	}
+	if f.diff && changed {
+		fmt.Fprintf(f.w, "--- %s (original)\n+++ %s (formatted)\n%s\n", fpath, fpath, diff.LineDiff(string(src), string(out)))
+	}
+	if f.write && changed {
+		if err := ioutil.WriteFile(fpath, out, perm); err != nil {
+			return err
+		}
+	}
+	if !f.write && !f.diff && !f.check {
+		if _, err := f.w.Write(out); err != nil {
+			return err
+		}
+	}
+	if f.check && changed {
+		return errNotFormatted
+	}
+	return nil
+}
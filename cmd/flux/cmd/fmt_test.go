@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	unformattedSource = "x=1\n"
+	formattedSource   = "x = 1\n"
+)
+
+func TestFormatter(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		f    formatter
+		// wantErr is whether the command exits with a non-zero status.
+		wantErr bool
+		// wantFiles is the content of the files afterwards.
+		wantFiles map[string]string
+		// wantOut is the output, with the directory replaced by $DIR.
+		wantOut []string
+	}{
+		{
+			name: "write",
+			f:    formatter{write: true},
+			wantFiles: map[string]string{
+				"a.flux":     formattedSource,
+				"b.flux":     formattedSource,
+				"sub/c.flux": formattedSource,
+			},
+		},
+		{
+			name: "diff",
+			f:    formatter{diff: true},
+			wantFiles: map[string]string{
+				"a.flux":     unformattedSource,
+				"b.flux":     formattedSource,
+				"sub/c.flux": unformattedSource,
+			},
+			wantOut: []string{
+				"--- $DIR/a.flux (original)",
+				"+++ $DIR/a.flux (formatted)",
+				"-x=1",
+				"+x = 1",
+				"--- $DIR/sub/c.flux (original)",
+			},
+		},
+		{
+			name:    "check",
+			f:       formatter{check: true},
+			wantErr: true,
+			wantFiles: map[string]string{
+				"a.flux":     unformattedSource,
+				"b.flux":     formattedSource,
+				"sub/c.flux": unformattedSource,
+			},
+			wantOut: []string{
+				"$DIR/a.flux\n$DIR/sub/c.flux\n",
+			},
+		},
+		{
+			name: "check and write",
+			f:    formatter{check: true, write: true},
+			// The unformatted files are reported even though they are fixed.
+			wantErr: true,
+			wantFiles: map[string]string{
+				"a.flux":     formattedSource,
+				"b.flux":     formattedSource,
+				"sub/c.flux": formattedSource,
+			},
+			wantOut: []string{
+				"$DIR/a.flux\n$DIR/sub/c.flux\n",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := writeFiles(t, map[string]string{
+				"a.flux":     unformattedSource,
+				"b.flux":     formattedSource,
+				"sub/c.flux": unformattedSource,
+				"sub/d.txt":  unformattedSource,
+			})
+			defer os.RemoveAll(dir)
+
+			var stdout, stderr bytes.Buffer
+			f := tc.f
+			f.w, f.stderr = &stdout, &stderr
+			err := f.formatPaths([]string{dir})
+			if got := err != nil; got != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stderr.Len() > 0 {
+				t.Errorf("unexpected output to stderr:\n%s", stderr.String())
+			}
+
+			out := strings.Replace(stdout.String(), dir, "$DIR", -1)
+			for _, want := range tc.wantOut {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected output to contain %q:\n%s", want, out)
+				}
+			}
+			if len(tc.wantOut) == 0 && out != "" {
+				t.Errorf("unexpected output:\n%s", out)
+			}
+			if strings.Contains(out, "b.flux") {
+				t.Errorf("unexpected output for a formatted file:\n%s", out)
+			}
+
+			tc.wantFiles["sub/d.txt"] = unformattedSource
+			got := make(map[string]string)
+			for name := range tc.wantFiles {
+				src, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+				if err != nil {
+					t.Fatal(err)
+				}
+				got[name] = string(src)
+			}
+			if !cmp.Equal(tc.wantFiles, got) {
+				t.Errorf("unexpected files -want/+got:\n%s", cmp.Diff(tc.wantFiles, got))
+			}
+		})
+	}
+}
+
+func TestFormatter_Invalid(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.flux": "x = (\n",
+		"b.flux": unformattedSource,
+	})
+	defer os.RemoveAll(dir)
+
+	// The files after the one that fails are still formatted.
+	var stdout, stderr bytes.Buffer
+	f := &formatter{w: &stdout, stderr: &stderr, write: true}
+	if err := f.formatPaths([]string{dir}); err == nil {
+		t.Fatal("expected error")
+	}
+	if want := filepath.Join(dir, "a.flux") + ": "; !strings.HasPrefix(stderr.String(), want) {
+		t.Errorf("expected stderr to start with %q:\n%s", want, stderr.String())
+	}
+	src, err := ioutil.ReadFile(filepath.Join(dir, "b.flux"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(src); got != formattedSource {
+		t.Errorf("unexpected source -want/+got:\n\t- %q\n\t+ %q", formattedSource, got)
+	}
+}
+
+func TestFormatter_WritePreservesMode(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.flux": unformattedSource,
+	})
+	defer os.RemoveAll(dir)
+
+	fpath := filepath.Join(dir, "a.flux")
+	if err := os.Chmod(fpath, 0600); err != nil {
+		t.Fatal(err)
+	}
+	f := &formatter{w: ioutil.Discard, stderr: ioutil.Discard, write: true}
+	if err := f.formatPaths([]string{fpath}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := info.Mode().Perm(), os.FileMode(0600); got != want {
+		t.Errorf("unexpected file mode -want/+got:\n\t- %v\n\t+ %v", want, got)
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/influxdata/flux/lsp"
+	"github.com/influxdata/flux/runtime"
+	"github.com/spf13/cobra"
+)
+
+// lspCmd represents the lsp command
+var lspCmd = &cobra.Command{
+	Use:   "lsp",
+	Short: "Start a Flux language server",
+	Long: `Start a Language Server Protocol server that communicates over standard input
+and output. Editors that support the protocol can use it for diagnostics,
+completion, hover, go-to-definition and formatting of Flux source files.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s := lsp.New(lsp.Config{
+			Scope:    runtime.Prelude(),
+			Importer: runtime.StdLib(),
+			Packages: runtime.Packages(),
+			Analyze:  runtime.AnalyzeSource,
+		})
+		return s.Serve(context.Background(), os.Stdin, os.Stdout)
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	rootCmd.AddCommand(lspCmd)
+}
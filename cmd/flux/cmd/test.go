@@ -0,0 +1,280 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	fluxexecute "github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/token"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/stdlib"
+	"github.com/spf13/cobra"
+)
+
+// testCmd represents the test command
+var testCmd = &cobra.Command{
+	Use:   "test [paths...]",
+	Short: "Run the test cases in Flux test files",
+	Long: `Run the test cases in the Flux test files (files ending in _test.flux) found in the given paths.
+A path that ends in /... is searched recursively. If no path is given, the current directory is used.
+
+Imports that are not part of the standard library are resolved relative to the directory being tested,
+so the test files in dir/... can import the Flux files in dir/mylib with import "mylib".
+
+Each test case is run with testing.run. If it fails, it is run again with testing.inspect
+and the output of testing.diff is reported.`,
+	RunE:         test,
+	SilenceUsage: true,
+}
+
+var testFlags struct {
+	run     string
+	verbose bool
+}
+
+func init() {
+	rootCmd.AddCommand(testCmd)
+	testCmd.Flags().StringVar(&testFlags.run, "run", "", "only run the test cases whose names match this regular expression")
+	testCmd.Flags().BoolVarP(&testFlags.verbose, "verbose", "v", false, "report every test case that is run, not only those that fail")
+}
+
+const testFileSuffix = "_test.flux"
+
+func test(cmd *cobra.Command, args []string) error {
+	var filter *regexp.Regexp
+	if testFlags.run != "" {
+		re, err := regexp.Compile(testFlags.run)
+		if err != nil {
+			return fmt.Errorf("invalid run pattern: %v", err)
+		}
+		filter = re
+	}
+
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+	files, err := findTestFiles(args)
+	if err != nil {
+		return err
+	}
+
+	r := &testRunner{
+		w:       os.Stdout,
+		filter:  filter,
+		verbose: testFlags.verbose,
+		stdlib:  stdlibPackages(),
+	}
+	for _, f := range files {
+		r.runFile(f)
+	}
+	return r.summary()
+}
+
+// stdlibPackages returns the set of import paths in the standard library.
+func stdlibPackages() map[string]bool {
+	pkgs := make(map[string]bool)
+	for _, pkgpath := range runtime.Packages() {
+		pkgs[pkgpath] = true
+	}
+	return pkgs
+}
+
+// testFile is a Flux test file along with the directory
+// that its imports are resolved from.
+type testFile struct {
+	path string
+	root string
+}
+
+// findTestFiles returns the Flux test files within the given paths.
+func findTestFiles(paths []string) ([]testFile, error) {
+	var files []testFile
+	seen := make(map[string]bool)
+	add := func(fpath, root string) {
+		if !seen[fpath] {
+			seen[fpath] = true
+			files = append(files, testFile{path: fpath, root: root})
+		}
+	}
+	for _, p := range paths {
+		if strings.HasSuffix(p, "/...") || p == "..." {
+			root := strings.TrimSuffix(strings.TrimSuffix(p, "..."), "/")
+			if root == "" {
+				root = "."
+			}
+			if err := filepath.Walk(root, func(fpath string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && strings.HasSuffix(info.Name(), testFileSuffix) {
+					add(fpath, root)
+				}
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			add(p, filepath.Dir(p))
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(p, "*"+testFileSuffix))
+		if err != nil {
+			return nil, err
+		}
+		for _, fpath := range matches {
+			add(fpath, p)
+		}
+	}
+	return files, nil
+}
+
+// testRunner runs the test cases of Flux test files and reports their results.
+type testRunner struct {
+	w       io.Writer
+	filter  *regexp.Regexp
+	verbose bool
+	// stdlib contains the import paths of the standard library.
+	stdlib map[string]bool
+
+	passed, failed int
+}
+
+func (r *testRunner) runFile(f testFile) {
+	fpath := f.path
+	file, err := parser.ParseFile(new(token.FileSet), fpath)
+	if err == nil {
+		err = ast.GetError(file)
+	}
+	if err == nil {
+		imp := &localImporter{root: f.root, stdlib: r.stdlib}
+		file, err = imp.resolve(file)
+	}
+	if err != nil {
+		r.failed++
+		fmt.Fprintf(r.w, "--- FAIL: %s\n    %v\n", fpath, err)
+		return
+	}
+
+	for _, stmt := range file.Body {
+		tc, ok := stmt.(*ast.TestStatement)
+		if !ok {
+			continue
+		}
+		name := tc.Assignment.ID.Name
+		if r.filter != nil && !r.filter.MatchString(name) {
+			continue
+		}
+		r.runTest(fpath, file, tc)
+	}
+}
+
+func (r *testRunner) runTest(fpath string, file *ast.File, tc *ast.TestStatement) {
+	name := fmt.Sprintf("%s/%s", fpath, tc.Assignment.ID.Name)
+	start := time.Now()
+	err := runTestCase(file, stdlib.TestingRunCall(tc), nil)
+	elapsed := time.Since(start).Seconds()
+	if err == nil {
+		r.passed++
+		if r.verbose {
+			fmt.Fprintf(r.w, "--- PASS: %s (%.2fs)\n", name, elapsed)
+		}
+		return
+	}
+
+	r.failed++
+	fmt.Fprintf(r.w, "--- FAIL: %s (%.2fs)\n    %v\n", name, elapsed, err)
+
+	// Rerun the test case using testing.inspect to report the diff.
+	var diff bytes.Buffer
+	if err := runTestCase(file, stdlib.TestingInspectCall(tc), func(res flux.Result) error {
+		if res.Name() != "diff" {
+			return res.Tables().Do(func(flux.Table) error { return nil })
+		}
+		return fluxexecute.FormatResult(&diff, res)
+	}); err != nil {
+		fmt.Fprintf(r.w, "    failed to inspect test case: %v\n", err)
+		return
+	}
+	fmt.Fprintln(r.w, diff.String())
+}
+
+func (r *testRunner) summary() error {
+	if r.failed > 0 {
+		fmt.Fprintf(r.w, "FAIL\t%d passed, %d failed\n", r.passed, r.failed)
+		return fmt.Errorf("%d test(s) failed", r.failed)
+	}
+	fmt.Fprintf(r.w, "ok\t%d passed\n", r.passed)
+	return nil
+}
+
+// runTestCase runs the test file along with the file that calls the testing package.
+// The results are passed to f or, if f is nil, are consumed and discarded.
+func runTestCase(file, calls *ast.File, f func(res flux.Result) error) error {
+	file = file.Copy().(*ast.File)
+	if file.Package != nil {
+		file.Package.Name.Name = "main"
+	}
+	pkg := &ast.Package{
+		Package: "main",
+		Files:   []*ast.File{file, calls},
+	}
+	bs, err := json.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+	c := lang.ASTCompiler{AST: bs}
+
+	deps := flux.NewDefaultDependencies()
+	deps.Deps.FilesystemService = filesystem.SystemFS
+	ctx := deps.Inject(context.Background())
+
+	program, err := c.Compile(ctx, runtime.Default)
+	if err != nil {
+		return fmt.Errorf("failed to compile test case: %v", err)
+	}
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		return fmt.Errorf("failed to start test case: %v", err)
+	}
+
+	if f == nil {
+		f = func(res flux.Result) error {
+			return res.Tables().Do(func(flux.Table) error {
+				return nil
+			})
+		}
+	}
+	var firstErr error
+	for res := range q.Results() {
+		if err := f(res); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		return err
+	}
+	return firstErr
+}
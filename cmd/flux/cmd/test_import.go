@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/internal/token"
+	"github.com/influxdata/flux/parser"
+)
+
+// localImporter resolves the imports of a test file that refer to
+// Flux packages within the directory being tested.
+//
+// The type checker only knows about the packages in the standard library,
+// so a local package is evaluated as part of the test file. Each local
+// package becomes a variable, named after its import, that holds a record
+// of the values the package assigns. A local package may only contain
+// variable assignments and imports.
+type localImporter struct {
+	// root is the directory that import paths are relative to.
+	root string
+	// stdlib contains the import paths of the standard library.
+	// These are never resolved to local packages.
+	stdlib map[string]bool
+}
+
+// resolve returns a copy of the file with its local imports
+// replaced by the packages they refer to.
+func (imp *localImporter) resolve(file *ast.File) (*ast.File, error) {
+	r := &importResolver{
+		imp:     imp,
+		names:   make(map[string]string),
+		loaded:  make(map[string]string),
+		loading: make(map[string]bool),
+	}
+	if err := r.addImports(file.Imports); err != nil {
+		return nil, err
+	}
+	if len(r.body) == 0 {
+		return file, nil
+	}
+	file = file.Copy().(*ast.File)
+	file.Imports = r.imports
+	file.Body = append(r.body, file.Body...)
+	return file, nil
+}
+
+// isLocal reports whether the import path refers to a
+// directory of Flux files instead of the standard library.
+func (imp *localImporter) isLocal(pkgpath string) bool {
+	if imp.stdlib[pkgpath] {
+		return false
+	}
+	files, err := imp.files(pkgpath)
+	return err == nil && len(files) > 0
+}
+
+// files returns the Flux files of a local package, ignoring its tests.
+func (imp *localImporter) files(pkgpath string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(imp.root, filepath.FromSlash(pkgpath), "*.flux"))
+	if err != nil {
+		return nil, err
+	}
+	files := matches[:0]
+	for _, fpath := range matches {
+		if !strings.HasSuffix(fpath, testFileSuffix) {
+			files = append(files, fpath)
+		}
+	}
+	return files, nil
+}
+
+// importResolver tracks the packages imported by a single test file.
+type importResolver struct {
+	imp *localImporter
+
+	// names maps each import name to its import path.
+	names map[string]string
+	// loaded maps the path of each local package to
+	// the name of the variable that holds it.
+	loaded  map[string]string
+	loading map[string]bool
+
+	// imports are the standard library imports of the test file
+	// and of the local packages that it imports.
+	imports []*ast.ImportDeclaration
+	// body assigns the local packages in the order they depend on each other.
+	body []ast.Statement
+}
+
+func (r *importResolver) addImports(decls []*ast.ImportDeclaration) error {
+	for _, dec := range decls {
+		pkgpath := dec.Path.Value
+		name := path.Base(pkgpath)
+		if dec.As != nil {
+			name = dec.As.Name
+		}
+		if prev, ok := r.names[name]; ok {
+			if prev != pkgpath {
+				return fmt.Errorf("import name %q is used for both %q and %q", name, prev, pkgpath)
+			}
+			if r.loading[pkgpath] {
+				return fmt.Errorf("import cycle through local package %q", pkgpath)
+			}
+			continue
+		}
+		r.names[name] = pkgpath
+
+		if !r.imp.isLocal(pkgpath) {
+			r.imports = append(r.imports, dec)
+			continue
+		}
+		if err := r.load(pkgpath, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load assigns the local package to a variable with the given name.
+func (r *importResolver) load(pkgpath, name string) error {
+	if prev, ok := r.loaded[pkgpath]; ok {
+		r.body = append(r.body, &ast.VariableAssignment{
+			ID:   &ast.Identifier{Name: name},
+			Init: &ast.Identifier{Name: prev},
+		})
+		return nil
+	}
+	if r.loading[pkgpath] {
+		return fmt.Errorf("import cycle through local package %q", pkgpath)
+	}
+	r.loading[pkgpath] = true
+	defer delete(r.loading, pkgpath)
+
+	files, err := r.imp.files(pkgpath)
+	if err != nil {
+		return err
+	}
+	var (
+		stmts []ast.Statement
+		props []*ast.Property
+	)
+	for _, fpath := range files {
+		file, err := parser.ParseFile(new(token.FileSet), fpath)
+		if err == nil {
+			err = ast.GetError(file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse local package %q: %v", pkgpath, err)
+		}
+		if err := r.addImports(file.Imports); err != nil {
+			return err
+		}
+		for _, stmt := range file.Body {
+			va, ok := stmt.(*ast.VariableAssignment)
+			if !ok {
+				return fmt.Errorf("%s: local packages may only contain variable assignments, found %s", fpath, stmt.Type())
+			}
+			stmts = append(stmts, va)
+			props = append(props, &ast.Property{
+				Key:   &ast.Identifier{Name: va.ID.Name},
+				Value: &ast.Identifier{Name: va.ID.Name},
+			})
+		}
+	}
+
+	// name = (() => { ...; return {...} })()
+	stmts = append(stmts, &ast.ReturnStatement{
+		Argument: &ast.ObjectExpression{Properties: props},
+	})
+	r.body = append(r.body, &ast.VariableAssignment{
+		ID: &ast.Identifier{Name: name},
+		Init: &ast.CallExpression{
+			Callee: &ast.FunctionExpression{
+				Body: &ast.Block{Body: stmts},
+			},
+		},
+	})
+	r.loaded[pkgpath] = name
+	return nil
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates the files, keyed by their slash separated
+// paths, within a temporary directory and returns the directory.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flux-cmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		fpath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fpath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fpath, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// runTests runs the test files within dir and its subdirectories.
+func runTests(t *testing.T, dir string) (string, error) {
+	t.Helper()
+	files, err := findTestFiles([]string{dir + "/..."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	r := &testRunner{
+		w:      &buf,
+		stdlib: stdlibPackages(),
+	}
+	for _, f := range files {
+		r.runFile(f)
+	}
+	err = r.summary()
+	return buf.String(), err
+}
+
+const incrementTest = `package increment_test
+
+import "array"
+import "testing"
+
+test _increment = () =>
+	({
+		input: array.from(rows: [{_value: 1}]),
+		want: array.from(rows: [{_value: %s}]),
+		fn: (table=<-) => table |> map(fn: (r) => ({_value: r._value + 1})),
+	})
+`
+
+func TestTest_Pass(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"increment_test.flux": strings.Replace(incrementTest, "%s", "2", 1),
+	})
+	defer os.RemoveAll(dir)
+
+	out, err := runTests(t, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out)
+	}
+	if want := "ok\t1 passed\n"; out != want {
+		t.Fatalf("unexpected output -want/+got:\n\t- %q\n\t+ %q", want, out)
+	}
+}
+
+func TestTest_Fail(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"increment_test.flux": strings.Replace(incrementTest, "%s", "3", 1),
+	})
+	defer os.RemoveAll(dir)
+
+	out, err := runTests(t, dir)
+	if err == nil {
+		t.Fatalf("expected error:\n%s", out)
+	}
+	for _, want := range []string{
+		"--- FAIL: " + filepath.Join(dir, "increment_test.flux") + "/_increment",
+		"_diff",
+		"FAIL\t0 passed, 1 failed\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTest_LocalImport(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"mylib/mylib.flux": `package mylib
+
+import "helpers"
+
+increment = (tables=<-) => tables |> map(fn: (r) => ({_value: helpers.add(a: r._value, b: 1)}))
+`,
+		"helpers/helpers.flux": `package helpers
+
+add = (a, b) => a + b
+`,
+		"mylib/mylib_test.flux": `package mylib_test
+
+import "array"
+import "testing"
+import "mylib"
+
+test _increment = () =>
+	({
+		input: array.from(rows: [{_value: 1}]),
+		want: array.from(rows: [{_value: 2}]),
+		fn: (table=<-) => table |> mylib.increment(),
+	})
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	out, err := runTests(t, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s\n%s", err, out)
+	}
+	if want := "ok\t1 passed\n"; out != want {
+		t.Fatalf("unexpected output -want/+got:\n\t- %q\n\t+ %q", want, out)
+	}
+}
+
+func TestTest_LocalImportCycle(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a/a.flux":      "package a\n\nimport \"b\"\n\nx = b.x\n",
+		"b/b.flux":      "package b\n\nimport \"a\"\n\nx = a.x\n",
+		"a/a_test.flux": "package a_test\n\nimport \"a\"\n\ny = a.x\n",
+	})
+	defer os.RemoveAll(dir)
+
+	out, err := runTests(t, dir)
+	if err == nil {
+		t.Fatalf("expected error:\n%s", out)
+	}
+	if want := `import cycle through local package "a"`; !strings.Contains(out, want) {
+		t.Fatalf("expected output to contain %q:\n%s", want, out)
+	}
+}
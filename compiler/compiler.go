@@ -132,6 +132,28 @@ func substituteTypes(subst map[uint64]semantic.MonoType, inType, in semantic.Mon
 			return err
 		}
 		return substituteTypes(subst, lt, rt)
+	case semantic.Dict:
+		lk, err := inType.KeyType()
+		if err != nil {
+			return err
+		}
+		rk, err := in.KeyType()
+		if err != nil {
+			return err
+		}
+		if err := substituteTypes(subst, lk, rk); err != nil {
+			return err
+		}
+
+		lv, err := inType.ValueType()
+		if err != nil {
+			return err
+		}
+		rv, err := in.ValueType()
+		if err != nil {
+			return err
+		}
+		return substituteTypes(subst, lv, rv)
 	case semantic.Row:
 		// We need to compare the row type that was inferred
 		// and the reality. It is ok for row properties to exist
@@ -273,6 +295,16 @@ func apply(sub map[uint64]semantic.MonoType, props []semantic.PropertyType, t se
 			return t
 		}
 		return semantic.NewArrayType(apply(sub, props, element))
+	case semantic.Dict:
+		key, err := t.KeyType()
+		if err != nil {
+			return t
+		}
+		value, err := t.ValueType()
+		if err != nil {
+			return t
+		}
+		return semantic.NewDictType(apply(sub, nil, key), apply(sub, nil, value))
 	case semantic.Row:
 		n, err := t.NumProperties()
 		if err != nil {
@@ -0,0 +1,622 @@
+package compiler
+
+import (
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/arrowutil"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// VectorizedFunc is a function that is evaluated over whole columns
+// of its record argument instead of once for each row.
+type VectorizedFunc interface {
+	// Type returns the type of the value that the function returns.
+	Type() semantic.MonoType
+
+	// Eval evaluates the function for n rows. The columns of the record
+	// are keyed by label and must each have a length of n.
+	// The caller must release the returned vector.
+	Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (*Vector, error)
+}
+
+// Vector holds the values that a vectorized function returns for each row.
+type Vector struct {
+	// Values are the values of a function that returns a basic type.
+	Values array.Interface
+
+	// Record are the values of each property of a function that returns a record.
+	Record map[string]array.Interface
+}
+
+// Release releases the arrays of the vector.
+func (v *Vector) Release() {
+	if v.Values != nil {
+		v.Values.Release()
+	}
+	for _, arr := range v.Record {
+		arr.Release()
+	}
+}
+
+// CompileVectorized compiles a function with a single record parameter
+// into a function that is evaluated over whole columns of the record.
+// The input type is the type of the arguments as with Compile and the
+// properties of the record must have basic types.
+//
+// Only arithmetic, comparisons, logical operators, string concatenation,
+// conditionals and record literals over the properties of the record,
+// literals and values in the scope are supported. An error with the
+// codes.Unimplemented code is returned for a function that cannot be
+// vectorized. Such a function can still be compiled with Compile.
+func CompileVectorized(scope Scope, f *semantic.FunctionExpression, in semantic.MonoType) (VectorizedFunc, error) {
+	if scope == nil {
+		scope = NewScope()
+	}
+	if f.Parameters == nil || len(f.Parameters.List) != 1 || f.Defaults != nil {
+		return nil, errNotVectorized
+	}
+	expr, ok := f.GetFunctionBodyExpression()
+	if !ok {
+		return nil, errNotVectorized
+	}
+
+	c := &vectorCompiler{
+		scope:  scope,
+		record: f.Parameters.List[0].Key.Name,
+		cols:   make(map[string]semantic.Nature),
+	}
+	prop, ok, err := findProperty(c.record, in)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, errNotVectorized
+	}
+	recordType, err := prop.TypeOf()
+	if err != nil {
+		return nil, err
+	}
+	n, err := recordType.NumProperties()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		p, err := recordType.RowProperty(i)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := p.TypeOf()
+		if err != nil {
+			return nil, err
+		}
+		c.cols[p.Name()] = typ.Nature()
+	}
+
+	if obj, ok := expr.(*semantic.ObjectExpression); ok {
+		return c.compileRecord(obj)
+	}
+	e, err := c.compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return vectorizedExpr{e: e}, nil
+}
+
+// errNotVectorized is returned when a function cannot be vectorized.
+var errNotVectorized = errors.New(codes.Unimplemented, "function cannot be vectorized")
+
+// vectorNatures are the natures of the values that can be vectorized.
+var vectorNatures = map[semantic.Nature]semantic.MonoType{
+	semantic.Int:    semantic.BasicInt,
+	semantic.UInt:   semantic.BasicUint,
+	semantic.Float:  semantic.BasicFloat,
+	semantic.String: semantic.BasicString,
+	semantic.Bool:   semantic.BasicBool,
+	semantic.Time:   semantic.BasicTime,
+}
+
+// vectorEvaluator evaluates an expression over whole columns.
+type vectorEvaluator interface {
+	// Nature returns the nature of the values of the expression.
+	Nature() semantic.Nature
+	// Fallible reports whether the evaluation can fail for some rows.
+	Fallible() bool
+	// Eval returns an array with the value of the expression for each row.
+	Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error)
+}
+
+type vectorCompiler struct {
+	scope  Scope
+	record string
+	cols   map[string]semantic.Nature
+}
+
+func (c *vectorCompiler) compileRecord(obj *semantic.ObjectExpression) (VectorizedFunc, error) {
+	r := &vectorizedRecord{
+		properties: make(map[string]vectorEvaluator, len(obj.Properties)),
+	}
+	if obj.With != nil {
+		if obj.With.Name != c.record {
+			return nil, errNotVectorized
+		}
+		r.with = c.cols
+	}
+	for _, p := range obj.Properties {
+		e, err := c.compile(p.Value)
+		if err != nil {
+			return nil, err
+		}
+		r.properties[p.Key.Key()] = e
+	}
+	return r, nil
+}
+
+func (c *vectorCompiler) compile(n semantic.Expression) (vectorEvaluator, error) {
+	switch n := n.(type) {
+	case *semantic.MemberExpression:
+		id, ok := n.Object.(*semantic.IdentifierExpression)
+		if !ok || id.Name != c.record {
+			return nil, errNotVectorized
+		}
+		nature, ok := c.cols[n.Property]
+		if _, supported := vectorNatures[nature]; !ok || !supported {
+			return nil, errNotVectorized
+		}
+		return &columnVectorEvaluator{label: n.Property, nature: nature}, nil
+	case *semantic.IdentifierExpression:
+		if n.Name == c.record {
+			return nil, errNotVectorized
+		}
+		v, ok := c.scope.Lookup(n.Name)
+		if !ok {
+			return nil, errNotVectorized
+		}
+		return newConstVectorEvaluator(v)
+	case *semantic.BooleanLiteral:
+		return newConstVectorEvaluator(values.NewBool(n.Value))
+	case *semantic.IntegerLiteral:
+		return newConstVectorEvaluator(values.NewInt(n.Value))
+	case *semantic.UnsignedIntegerLiteral:
+		return newConstVectorEvaluator(values.NewUInt(n.Value))
+	case *semantic.FloatLiteral:
+		return newConstVectorEvaluator(values.NewFloat(n.Value))
+	case *semantic.StringLiteral:
+		return newConstVectorEvaluator(values.NewString(n.Value))
+	case *semantic.DateTimeLiteral:
+		return newConstVectorEvaluator(values.NewTime(values.ConvertTime(n.Value)))
+	case *semantic.UnaryExpression:
+		e, err := c.compile(n.Argument)
+		if err != nil {
+			return nil, err
+		}
+		return newUnaryVectorEvaluator(n.Operator, e)
+	case *semantic.BinaryExpression:
+		l, err := c.compile(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.compile(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		return newBinaryVectorEvaluator(n.Operator, l, r)
+	case *semantic.LogicalExpression:
+		l, err := c.compile(n.Left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := c.compile(n.Right)
+		if err != nil {
+			return nil, err
+		}
+		// The right side is evaluated for every row so it must
+		// not fail for the rows where it would not be evaluated.
+		if l.Nature() != semantic.Bool || r.Nature() != semantic.Bool || r.Fallible() {
+			return nil, errNotVectorized
+		}
+		return &logicalVectorEvaluator{op: n.Operator, left: l, right: r}, nil
+	case *semantic.ConditionalExpression:
+		test, err := c.compile(n.Test)
+		if err != nil {
+			return nil, err
+		}
+		cons, err := c.compile(n.Consequent)
+		if err != nil {
+			return nil, err
+		}
+		alt, err := c.compile(n.Alternate)
+		if err != nil {
+			return nil, err
+		}
+		if test.Nature() != semantic.Bool ||
+			cons.Nature() != alt.Nature() ||
+			cons.Fallible() || alt.Fallible() {
+			return nil, errNotVectorized
+		}
+		return &conditionalVectorEvaluator{
+			test:       test,
+			consequent: cons,
+			alternate:  alt,
+		}, nil
+	default:
+		return nil, errNotVectorized
+	}
+}
+
+// vectorizedExpr is a vectorized function that returns a basic type.
+type vectorizedExpr struct {
+	e vectorEvaluator
+}
+
+func (f vectorizedExpr) Type() semantic.MonoType {
+	return vectorNatures[f.e.Nature()]
+}
+
+func (f vectorizedExpr) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (*Vector, error) {
+	arr, err := f.e.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	return &Vector{Values: arr}, nil
+}
+
+// vectorizedRecord is a vectorized function that returns a record.
+type vectorizedRecord struct {
+	// with are the columns of the record that the record extends, if any.
+	with       map[string]semantic.Nature
+	properties map[string]vectorEvaluator
+}
+
+func (f *vectorizedRecord) Type() semantic.MonoType {
+	properties := make([]semantic.PropertyType, 0, len(f.with)+len(f.properties))
+	for label, nature := range f.with {
+		if _, ok := f.properties[label]; ok {
+			continue
+		}
+		typ, ok := vectorNatures[nature]
+		if !ok {
+			continue
+		}
+		properties = append(properties, semantic.PropertyType{
+			Key:   []byte(label),
+			Value: typ,
+		})
+	}
+	for label, e := range f.properties {
+		properties = append(properties, semantic.PropertyType{
+			Key:   []byte(label),
+			Value: vectorNatures[e.Nature()],
+		})
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		return string(properties[i].Key) < string(properties[j].Key)
+	})
+	return semantic.NewObjectType(properties)
+}
+
+func (f *vectorizedRecord) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (*Vector, error) {
+	v := &Vector{
+		Record: make(map[string]array.Interface, len(f.with)+len(f.properties)),
+	}
+	for label, e := range f.properties {
+		arr, err := e.Eval(n, cols, mem)
+		if err != nil {
+			v.Release()
+			return nil, err
+		}
+		v.Record[label] = arr
+	}
+	if f.with != nil {
+		for label, arr := range cols {
+			if _, ok := v.Record[label]; ok {
+				continue
+			}
+			arr.Retain()
+			v.Record[label] = arr
+		}
+	}
+	return v, nil
+}
+
+type columnVectorEvaluator struct {
+	label  string
+	nature semantic.Nature
+}
+
+func (e *columnVectorEvaluator) Nature() semantic.Nature {
+	return e.nature
+}
+
+func (e *columnVectorEvaluator) Fallible() bool {
+	return false
+}
+
+func (e *columnVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	arr, ok := cols[e.label]
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "missing column %q", e.label)
+	}
+	arr.Retain()
+	return arr, nil
+}
+
+type constVectorEvaluator struct {
+	v values.Value
+}
+
+func newConstVectorEvaluator(v values.Value) (vectorEvaluator, error) {
+	if _, ok := vectorNatures[v.Type().Nature()]; !ok || v.IsNull() {
+		return nil, errNotVectorized
+	}
+	return &constVectorEvaluator{v: v}, nil
+}
+
+func (e *constVectorEvaluator) Nature() semantic.Nature {
+	return e.v.Type().Nature()
+}
+
+func (e *constVectorEvaluator) Fallible() bool {
+	return false
+}
+
+func (e *constVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	return arrow.Repeat(e.v, n, mem), nil
+}
+
+type unaryVectorEvaluator struct {
+	op     ast.OperatorKind
+	arg    vectorEvaluator
+	nature semantic.Nature
+}
+
+func newUnaryVectorEvaluator(op ast.OperatorKind, arg vectorEvaluator) (vectorEvaluator, error) {
+	e := &unaryVectorEvaluator{op: op, arg: arg}
+	switch nature := arg.Nature(); {
+	case op == ast.ExistsOperator:
+		e.nature = semantic.Bool
+	case op == ast.AdditionOperator:
+		e.nature = nature
+	case op == ast.NotOperator && nature == semantic.Bool:
+		e.nature = nature
+	case op == ast.SubtractionOperator && (nature == semantic.Int || nature == semantic.Float):
+		e.nature = nature
+	default:
+		return nil, errNotVectorized
+	}
+	return e, nil
+}
+
+func (e *unaryVectorEvaluator) Nature() semantic.Nature {
+	return e.nature
+}
+
+func (e *unaryVectorEvaluator) Fallible() bool {
+	return e.arg.Fallible()
+}
+
+func (e *unaryVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	arr, err := e.arg.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case ast.AdditionOperator:
+		return arr, nil
+	case ast.ExistsOperator:
+		defer arr.Release()
+		b := arrowutil.NewBooleanBuilder(mem)
+		b.Resize(n)
+		for i := 0; i < n; i++ {
+			b.Append(arr.IsValid(i))
+		}
+		return b.NewArray(), nil
+	}
+	defer arr.Release()
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		b := arrowutil.NewBooleanBuilder(mem)
+		b.Resize(n)
+		for i := 0; i < n; i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(!arr.Value(i))
+		}
+		return b.NewArray(), nil
+	case *array.Int64:
+		b := arrowutil.NewInt64Builder(mem)
+		b.Resize(n)
+		for i := 0; i < n; i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(-arr.Value(i))
+		}
+		return b.NewArray(), nil
+	case *array.Float64:
+		b := arrowutil.NewFloat64Builder(mem)
+		b.Resize(n)
+		for i := 0; i < n; i++ {
+			if arr.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(-arr.Value(i))
+		}
+		return b.NewArray(), nil
+	default:
+		return nil, errors.Newf(codes.Internal, "unknown unary operator: %s", e.op)
+	}
+}
+
+type binaryVectorEvaluator struct {
+	op          ast.OperatorKind
+	left, right vectorEvaluator
+	nature      semantic.Nature
+}
+
+func newBinaryVectorEvaluator(op ast.OperatorKind, l, r vectorEvaluator) (vectorEvaluator, error) {
+	nature := l.Nature()
+	if r.Nature() != nature {
+		return nil, errNotVectorized
+	}
+	e := &binaryVectorEvaluator{op: op, left: l, right: r}
+	switch op {
+	case ast.AdditionOperator:
+		if nature != semantic.Int && nature != semantic.UInt && nature != semantic.Float && nature != semantic.String {
+			return nil, errNotVectorized
+		}
+		e.nature = nature
+	case ast.SubtractionOperator, ast.MultiplicationOperator, ast.DivisionOperator, ast.ModuloOperator:
+		if nature != semantic.Int && nature != semantic.UInt && nature != semantic.Float {
+			return nil, errNotVectorized
+		}
+		e.nature = nature
+	case ast.EqualOperator, ast.NotEqualOperator:
+		e.nature = semantic.Bool
+	case ast.LessThanOperator, ast.LessThanEqualOperator, ast.GreaterThanOperator, ast.GreaterThanEqualOperator:
+		if nature == semantic.Bool {
+			return nil, errNotVectorized
+		}
+		e.nature = semantic.Bool
+	default:
+		return nil, errNotVectorized
+	}
+	return e, nil
+}
+
+func (e *binaryVectorEvaluator) Nature() semantic.Nature {
+	return e.nature
+}
+
+func (e *binaryVectorEvaluator) Fallible() bool {
+	return e.op == ast.DivisionOperator || e.op == ast.ModuloOperator ||
+		e.left.Fallible() || e.right.Fallible()
+}
+
+func (e *binaryVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	l, err := e.left.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Release()
+	r, err := e.right.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Release()
+
+	switch e.op {
+	case ast.AdditionOperator:
+		return arrowutil.Add(l, r, mem), nil
+	case ast.SubtractionOperator:
+		return arrowutil.Subtract(l, r, mem), nil
+	case ast.MultiplicationOperator:
+		return arrowutil.Multiply(l, r, mem), nil
+	case ast.DivisionOperator:
+		return arrowutil.Divide(l, r, mem)
+	case ast.ModuloOperator:
+		return arrowutil.Modulo(l, r, mem)
+	case ast.EqualOperator:
+		return arrowutil.Equal(l, r, mem), nil
+	case ast.NotEqualOperator:
+		return arrowutil.NotEqual(l, r, mem), nil
+	case ast.LessThanOperator:
+		return arrowutil.Less(l, r, mem), nil
+	case ast.LessThanEqualOperator:
+		return arrowutil.LessEqual(l, r, mem), nil
+	case ast.GreaterThanOperator:
+		return arrowutil.Greater(l, r, mem), nil
+	case ast.GreaterThanEqualOperator:
+		return arrowutil.GreaterEqual(l, r, mem), nil
+	default:
+		return nil, errors.Newf(codes.Internal, "unknown binary operator: %s", e.op)
+	}
+}
+
+// logicalVectorEvaluator evaluates a logical expression with the same
+// semantics as logicalEvaluator. The value of the right side is used for
+// the rows where the left side does not determine the result even if it is null.
+type logicalVectorEvaluator struct {
+	op          ast.LogicalOperatorKind
+	left, right vectorEvaluator
+}
+
+func (e *logicalVectorEvaluator) Nature() semantic.Nature {
+	return semantic.Bool
+}
+
+func (e *logicalVectorEvaluator) Fallible() bool {
+	return e.left.Fallible()
+}
+
+func (e *logicalVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	l, err := e.left.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer l.Release()
+	r, err := e.right.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Release()
+
+	lb, rb := l.(*array.Boolean), r.(*array.Boolean)
+	b := arrowutil.NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		lv := lb.IsValid(i) && lb.Value(i)
+		switch {
+		case e.op == ast.AndOperator && !lv:
+			b.Append(false)
+		case e.op == ast.OrOperator && lv:
+			b.Append(true)
+		case rb.IsNull(i):
+			b.AppendNull()
+		default:
+			b.Append(rb.Value(i))
+		}
+	}
+	return b.NewArray(), nil
+}
+
+type conditionalVectorEvaluator struct {
+	test, consequent, alternate vectorEvaluator
+}
+
+func (e *conditionalVectorEvaluator) Nature() semantic.Nature {
+	return e.consequent.Nature()
+}
+
+func (e *conditionalVectorEvaluator) Fallible() bool {
+	return e.test.Fallible()
+}
+
+func (e *conditionalVectorEvaluator) Eval(n int, cols map[string]array.Interface, mem memory.Allocator) (array.Interface, error) {
+	test, err := e.test.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer test.Release()
+	c, err := e.consequent.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Release()
+	a, err := e.alternate.Eval(n, cols, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer a.Release()
+	return arrowutil.Select(test.(*array.Boolean), c, a, mem), nil
+}
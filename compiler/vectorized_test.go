@@ -0,0 +1,258 @@
+package compiler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/compiler"
+	"github.com/influxdata/flux/internal/arrowutil"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+var (
+	t0 = values.ConvertTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 = t0 + values.Time(time.Hour)
+)
+
+// vectorColumns are the columns of the record that is passed to the
+// vectorized functions. The fourth row is null in every column.
+func vectorColumns(mem memory.Allocator) map[string]array.Interface {
+	a := arrowutil.NewInt64Builder(mem)
+	a.AppendValues([]int64{1, 2, 3, 0}, []bool{true, true, true, false})
+	b := arrowutil.NewInt64Builder(mem)
+	b.AppendValues([]int64{2, 0, -1, 0}, []bool{true, true, true, false})
+	f := arrowutil.NewFloat64Builder(mem)
+	f.AppendValues([]float64{1.5, 2, 0.25, 0}, []bool{true, true, true, false})
+	s := arrowutil.NewStringBuilder(mem)
+	s.AppendStringValues([]string{"a", "b", "c", ""}, []bool{true, true, true, false})
+	tm := arrowutil.NewInt64Builder(mem)
+	tm.AppendValues([]int64{int64(t0), int64(t1), int64(t1), 0}, []bool{true, true, true, false})
+	ok := arrowutil.NewBooleanBuilder(mem)
+	ok.AppendValues([]bool{true, false, true, false}, []bool{true, true, true, false})
+	return map[string]array.Interface{
+		"a":  a.NewArray(),
+		"b":  b.NewArray(),
+		"f":  f.NewArray(),
+		"s":  s.NewArray(),
+		"t":  tm.NewArray(),
+		"ok": ok.NewArray(),
+	}
+}
+
+var vectorInType = semantic.NewObjectType([]semantic.PropertyType{
+	{Key: []byte("r"), Value: semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte("a"), Value: semantic.BasicInt},
+		{Key: []byte("b"), Value: semantic.BasicInt},
+		{Key: []byte("f"), Value: semantic.BasicFloat},
+		{Key: []byte("s"), Value: semantic.BasicString},
+		{Key: []byte("t"), Value: semantic.BasicTime},
+		{Key: []byte("ok"), Value: semantic.BasicBool},
+	})},
+})
+
+// arrayValues returns the values of an array with nil for null values.
+func arrayValues(arr array.Interface) []interface{} {
+	vs := make([]interface{}, arr.Len())
+	for i := range vs {
+		if arr.IsNull(i) {
+			continue
+		}
+		switch arr := arr.(type) {
+		case *array.Int64:
+			vs[i] = arr.Value(i)
+		case *array.Uint64:
+			vs[i] = arr.Value(i)
+		case *array.Float64:
+			vs[i] = arr.Value(i)
+		case *array.Binary:
+			vs[i] = arr.ValueString(i)
+		case *array.Boolean:
+			vs[i] = arr.Value(i)
+		}
+	}
+	return vs
+}
+
+func TestCompileVectorized(t *testing.T) {
+	scope := values.NewScope()
+	scope.Set("x", values.NewInt(10))
+
+	testCases := []struct {
+		name string
+		fn   string
+		want []interface{}
+		// wantRecord are the values of each property of a record.
+		wantRecord map[string][]interface{}
+		wantErr    error
+	}{
+		{
+			name: "add ints",
+			fn:   `(r) => r.a + r.b`,
+			want: []interface{}{int64(3), int64(2), int64(2), nil},
+		},
+		{
+			name: "subtract literal",
+			fn:   `(r) => r.a - 1`,
+			want: []interface{}{int64(0), int64(1), int64(2), nil},
+		},
+		{
+			name: "multiply floats",
+			fn:   `(r) => r.f * 2.0`,
+			want: []interface{}{3.0, 4.0, 0.5, nil},
+		},
+		{
+			name: "modulo",
+			fn:   `(r) => r.a % 2`,
+			want: []interface{}{int64(1), int64(0), int64(1), nil},
+		},
+		{
+			name: "scope value",
+			fn:   `(r) => r.a * x`,
+			want: []interface{}{int64(10), int64(20), int64(30), nil},
+		},
+		{
+			name: "concatenate strings",
+			fn:   `(r) => r.s + "!"`,
+			want: []interface{}{"a!", "b!", "c!", nil},
+		},
+		{
+			name: "compare strings",
+			fn:   `(r) => r.s != "b"`,
+			want: []interface{}{true, false, true, nil},
+		},
+		{
+			name: "compare times",
+			fn:   `(r) => r.t > 2020-01-01T00:00:00Z`,
+			want: []interface{}{false, true, true, nil},
+		},
+		{
+			name: "and",
+			fn:   `(r) => r.a > 1 and r.ok`,
+			want: []interface{}{false, false, true, false},
+		},
+		{
+			name: "or",
+			fn:   `(r) => r.a > 2 or r.ok`,
+			want: []interface{}{true, false, true, nil},
+		},
+		{
+			name: "not",
+			fn:   `(r) => not r.ok`,
+			want: []interface{}{false, true, false, nil},
+		},
+		{
+			name: "negate",
+			fn:   `(r) => -r.b`,
+			want: []interface{}{int64(-2), int64(0), int64(1), nil},
+		},
+		{
+			name: "exists",
+			fn:   `(r) => exists r.s`,
+			want: []interface{}{true, true, true, false},
+		},
+		{
+			name: "conditional",
+			fn:   `(r) => if r.a > 1 then r.s else "small"`,
+			want: []interface{}{"small", "b", "c", "small"},
+		},
+		{
+			name: "record",
+			fn:   `(r) => ({a: r.a, c: r.a * 10})`,
+			wantRecord: map[string][]interface{}{
+				"a": {int64(1), int64(2), int64(3), nil},
+				"c": {int64(10), int64(20), int64(30), nil},
+			},
+		},
+		{
+			name: "extend record",
+			fn:   `(r) => ({r with a: r.b, ok: r.a > 0})`,
+			wantRecord: map[string][]interface{}{
+				"a":  {int64(2), int64(0), int64(-1), nil},
+				"b":  {int64(2), int64(0), int64(-1), nil},
+				"f":  {1.5, 2.0, 0.25, nil},
+				"s":  {"a", "b", "c", nil},
+				"t":  {int64(t0), int64(t1), int64(t1), nil},
+				"ok": {true, true, true, nil},
+			},
+		},
+		{
+			name:    "divide by zero",
+			fn:      `(r) => r.a / r.b`,
+			wantErr: errors.New(codes.FailedPrecondition, "cannot divide by zero"),
+		},
+		{
+			name:    "function call",
+			fn:      `(r) => ((v) => v)(v: r.a)`,
+			wantErr: errors.New(codes.Unimplemented, "function cannot be vectorized"),
+		},
+		{
+			name:    "division that is not always evaluated",
+			fn:      `(r) => r.ok and r.a / r.b > 1`,
+			wantErr: errors.New(codes.Unimplemented, "function cannot be vectorized"),
+		},
+		{
+			name:    "string interpolation",
+			fn:      `(r) => "${r.s}"`,
+			wantErr: errors.New(codes.Unimplemented, "function cannot be vectorized"),
+		},
+		{
+			name: "block",
+			fn: `(r) => {
+				y = r.a + 1
+				return y
+			}`,
+			wantErr: errors.New(codes.Unimplemented, "function cannot be vectorized"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pkg, err := runtime.AnalyzeSource(tc.fn)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			stmt := pkg.Files[0].Body[0].(*semantic.ExpressionStatement)
+			fn := stmt.Expression.(*semantic.FunctionExpression)
+
+			mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+			defer mem.AssertSize(t, 0)
+
+			cols := vectorColumns(mem)
+			defer func() {
+				for _, arr := range cols {
+					arr.Release()
+				}
+			}()
+
+			f, err := compiler.CompileVectorized(compiler.ToScope(scope), fn, vectorInType)
+			if err == nil {
+				var v *compiler.Vector
+				if v, err = f.Eval(4, cols, mem); err == nil {
+					defer v.Release()
+					if tc.wantRecord != nil {
+						got := make(map[string][]interface{}, len(v.Record))
+						for label, arr := range v.Record {
+							got[label] = arrayValues(arr)
+						}
+						if !cmp.Equal(tc.wantRecord, got) {
+							t.Errorf("unexpected record -want/+got:\n%s", cmp.Diff(tc.wantRecord, got))
+						}
+					} else if got := arrayValues(v.Values); !cmp.Equal(tc.want, got) {
+						t.Errorf("unexpected values -want/+got:\n%s", cmp.Diff(tc.want, got))
+					}
+				}
+			}
+			if !cmp.Equal(tc.wantErr, err) {
+				t.Errorf("unexpected error -want/+got:\n%s", cmp.Diff(tc.wantErr, err))
+			}
+		})
+	}
+}
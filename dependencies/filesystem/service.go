@@ -18,3 +18,10 @@ type Service interface {
 	Create(fpath string) (File, error)
 	Stat(fpath string) (os.FileInfo, error)
 }
+
+// Remover is implemented by a Service that can remove files.
+// It is kept separate from the Service so that existing
+// implementations are not required to support it.
+type Remover interface {
+	Remove(fpath string) error
+}
@@ -102,6 +102,31 @@ func TestSystemFS_Stat(t *testing.T) {
 	}
 }
 
+func TestSystemFS_Remove(t *testing.T) {
+	tmpfile, err := ioutil.TempFile("", "flux-systemfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Remove(tmpfile.Name()) }()
+	defer func() { _ = tmpfile.Close() }()
+
+	if err := tmpfile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	fs, ok := filesystem.SystemFS.(filesystem.Remover)
+	if !ok {
+		t.Fatal("expected the system filesystem to implement filesystem.Remover")
+	}
+	if err := fs.Remove(tmpfile.Name()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(tmpfile.Name()); !os.IsNotExist(err) {
+		t.Fatalf("expected file to be removed, got error: %v", err)
+	}
+}
+
 func TestReadFile(t *testing.T) {
 	tmpfile, err := ioutil.TempFile("", "flux-systemfs-test")
 	if err != nil {
@@ -18,7 +18,6 @@ type aggregateTransformation struct {
 }
 
 type AggregateConfig struct {
-	plan.DefaultCost
 	Columns []string `json:"columns"`
 }
 
@@ -35,6 +34,27 @@ func (c AggregateConfig) Copy() AggregateConfig {
 	return nc
 }
 
+// Cost estimates that an aggregate produces one row for each table.
+func (c AggregateConfig) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return aggregateCost(inStats)
+}
+
+// IsPartitionable implements plan.PartitionableProcedureSpec.
+// Each table is aggregated independently of the others.
+func (c AggregateConfig) IsPartitionable() bool {
+	return true
+}
+
+// aggregateCost returns the cost of a transformation
+// that reads every row and produces one row for each table.
+func aggregateCost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, plan.Statistics{
+		Cardinality:      in.GroupCardinality,
+		GroupCardinality: in.GroupCardinality,
+	}
+}
+
 func (c *AggregateConfig) ReadArgs(args flux.Arguments) error {
 	if col, ok, err := args.GetString("column"); err != nil {
 		return err
@@ -111,6 +131,9 @@ func (t *aggregateTransformation) Process(id DatasetID, tbl flux.Table) error {
 		if vf == nil {
 			return errors.Newf(codes.FailedPrecondition, "unsupported aggregate column type %v", c.Type)
 		}
+		if err := valueFuncErr(vf); err != nil {
+			return err
+		}
 		aggregates[j] = vf
 
 		var err error
@@ -150,6 +173,11 @@ func (t *aggregateTransformation) Process(id DatasetID, tbl flux.Table) error {
 	}); err != nil {
 		return err
 	}
+	for _, vf := range aggregates {
+		if err := finishValueFunc(vf); err != nil {
+			return err
+		}
+	}
 	for j, vf := range aggregates {
 		bj := builderColMap[j]
 
@@ -216,6 +244,34 @@ type ValueFunc interface {
 	Type() flux.ColType
 	IsNull() bool
 }
+
+// FallibleValueFunc is a ValueFunc that can fail to aggregate the values.
+// Err reports an error that has already occurred, such as a failure
+// to create the aggregate, and does not change the aggregate.
+// Finish is called once after all of the values have been aggregated,
+// before the value is read, and computes the value of the aggregate.
+type FallibleValueFunc interface {
+	ValueFunc
+	Err() error
+	Finish() error
+}
+
+// valueFuncErr returns the error of a FallibleValueFunc.
+func valueFuncErr(vf ValueFunc) error {
+	if f, ok := vf.(FallibleValueFunc); ok {
+		return f.Err()
+	}
+	return nil
+}
+
+// finishValueFunc finishes a FallibleValueFunc.
+func finishValueFunc(vf ValueFunc) error {
+	if f, ok := vf.(FallibleValueFunc); ok {
+		return f.Finish()
+	}
+	return nil
+}
+
 type DoBoolAgg interface {
 	ValueFunc
 	DoBool(*array.Boolean)
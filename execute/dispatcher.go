@@ -40,10 +40,12 @@ type poolDispatcher struct {
 	logger *zap.Logger
 }
 
-func newPoolDispatcher(throughput int, logger *zap.Logger) *poolDispatcher {
+// newPoolDispatcher creates a dispatcher whose work queue holds up to
+// queueSize functions. Schedule blocks while the queue is full.
+func newPoolDispatcher(throughput, queueSize int, logger *zap.Logger) *poolDispatcher {
 	return &poolDispatcher{
 		throughput: throughput,
-		work:       make(chan ScheduleFunc, 100),
+		work:       make(chan ScheduleFunc, queueSize),
 		closing:    make(chan struct{}),
 		errC:       make(chan error, 1),
 		logger:     logger.With(zap.String("component", "dispatcher")),
@@ -61,6 +61,7 @@ type executionState struct {
 	metaCh  chan flux.Metadata
 
 	transports []Transport
+	profiler   *profiler
 
 	dispatcher *poolDispatcher
 	logger     *zap.Logger
@@ -93,12 +94,16 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 		resources: p.Resources,
 		results:   make(map[string]flux.Result),
 		// TODO(nathanielc): Have the planner specify the dispatcher throughput
-		dispatcher: newPoolDispatcher(10, e.logger),
+		dispatcher: newPoolDispatcher(10, dispatcherQueueSize(p), e.logger),
+	}
+	if IsProfilerEnabled(ctx) {
+		es.profiler = new(profiler)
 	}
 	v := &createExecutionNodeVisitor{
-		ctx:   ctx,
-		es:    es,
-		nodes: make(map[plan.Node]Node),
+		ctx:        ctx,
+		es:         es,
+		nodes:      make(map[plan.Node]Node),
+		partitions: make(map[plan.Node][]Dataset),
 	}
 
 	if err := p.BottomUpWalk(v.Visit); err != nil {
@@ -107,18 +112,50 @@ func (e *executor) createExecutionState(ctx context.Context, p *plan.Spec, a *me
 
 	// Only sources can be a MetadataNode at the moment so allocate enough
 	// space for all of them to report metadata. Not all of them will necessarily
-	// report metadata.
-	es.metaCh = make(chan flux.Metadata, len(es.sources))
+	// report metadata. The profiler reports its metadata
+	// once all of the sources and transformations have finished.
+	n := len(es.sources)
+	if es.profiler != nil {
+		n++
+	}
+	es.metaCh = make(chan flux.Metadata, n)
 
 	return v.es, nil
 }
 
+// minDispatcherQueueSize is the smallest work queue of a dispatcher.
+const minDispatcherQueueSize = 100
+
+// dispatcherQueueSize returns the size of the work queue of the dispatcher
+// for the plan. A transport has at most one function in the queue at a time,
+// so the queue has room for a function from every transport, including the
+// transport of each partition of a node. The workers then never block when
+// they schedule the transports that they send tables to.
+func dispatcherQueueSize(p *plan.Spec) int {
+	n := 0
+	_ = p.BottomUpWalk(func(node plan.Node) error {
+		partitions := 1
+		if ppn, ok := node.(*plan.PhysicalPlanNode); ok && ppn.Parallelism > 1 {
+			partitions = ppn.Parallelism
+		}
+		n += partitions * len(node.Predecessors())
+		return nil
+	})
+	if n < minDispatcherQueueSize {
+		n = minDispatcherQueueSize
+	}
+	return n
+}
+
 // createExecutionNodeVisitor visits each node in a physical query plan
 // and creates a node responsible for executing that physical operation.
 type createExecutionNodeVisitor struct {
 	ctx   context.Context
 	es    *executionState
 	nodes map[plan.Node]Node
+	// partitions are the datasets of each partition of the nodes
+	// whose partitions continue into their successor.
+	partitions map[plan.Node][]Dataset
 }
 
 func skipYields(pn plan.Node) plan.Node {
@@ -173,6 +210,7 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 	ec := executionContext{
 		ctx:           v.ctx,
 		es:            v.es,
+		alloc:         v.es.alloc,
 		parents:       make([]DatasetID, len(node.Predecessors())),
 		streamContext: streamContext,
 	}
@@ -189,14 +227,26 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 			return fmt.Errorf("unsupported source kind %v", kind)
 		}
 
+		// The profiler tracks the memory allocated by
+		// each source with its own allocator.
+		if v.es.profiler != nil {
+			ec.alloc = &memory.Allocator{Allocator: v.es.alloc}
+		}
+
 		source, err := createSourceFn(spec, id, ec)
 
 		if err != nil {
 			return err
 		}
 
+		if v.es.profiler != nil {
+			source = v.es.profiler.wrapSource(node, ec.alloc, source)
+		}
+
 		v.es.sources = append(v.es.sources, source)
 		v.nodes[node] = source
+	} else if ppn.Parallelism > 1 {
+		return v.createPartitions(node, ppn, ec)
 	} else {
 
 		// If node is internal, create a transformation.
@@ -207,12 +257,22 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 			return fmt.Errorf("unsupported procedure %v", kind)
 		}
 
+		// The profiler tracks the memory allocated by
+		// each transformation with its own allocator.
+		if v.es.profiler != nil {
+			ec.alloc = &memory.Allocator{Allocator: v.es.alloc}
+		}
+
 		tr, ds, err := createTransformationFn(id, DiscardingMode, spec, ec)
 
 		if err != nil {
 			return err
 		}
 
+		if v.es.profiler != nil {
+			tr = v.es.profiler.wrap(node, ec.alloc, tr)
+		}
+
 		if ppn.TriggerSpec == nil {
 			ppn.TriggerSpec = plan.DefaultTriggerSpec
 		}
@@ -237,6 +297,92 @@ func (v *createExecutionNodeVisitor) Visit(node plan.Node) error {
 	return nil
 }
 
+// createPartitions creates a transformation for each partition of a node.
+// The tables of the predecessor are divided among the partitions unless
+// the predecessor has the same partitions, in which case each partition
+// reads the tables of the matching partition of the predecessor.
+// The partitions are merged into a single dataset unless they continue
+// into the successor of the node.
+//
+// The merged dataset has the tables of each partition in turn, so the
+// tables are not in the order that the node produces them when it is not
+// partitioned. The order only depends on the group keys and the number
+// of partitions, so it is the same on every run.
+//
+// The partitions share a single profile, so the profile of the node
+// reports the tables and rows of all of them, the sum of the time that
+// each spent and the maximum memory that they allocated together.
+func (v *createExecutionNodeVisitor) createPartitions(node plan.Node, ppn *plan.PhysicalPlanNode, ec executionContext) error {
+	spec := node.ProcedureSpec()
+	createTransformationFn, ok := procedureToTransformation[spec.Kind()]
+	if !ok {
+		return fmt.Errorf("unsupported procedure %v", spec.Kind())
+	}
+	id := DatasetIDFromNodeID(node.ID())
+	if ppn.TriggerSpec == nil {
+		ppn.TriggerSpec = plan.DefaultTriggerSpec
+	}
+
+	var profile *nodeProfile
+	if v.es.profiler != nil {
+		ec.alloc = &memory.Allocator{Allocator: v.es.alloc}
+		profile = v.es.profiler.newProfile(node, ec.alloc)
+	}
+
+	n := ppn.Parallelism
+	datasets := make([]Dataset, n)
+	transports := make([]Transformation, n)
+	for i := range datasets {
+		// Each partition has its own copy of the spec
+		// so the transformations do not share any state.
+		tr, ds, err := createTransformationFn(id, DiscardingMode, spec.Copy(), ec)
+		if err != nil {
+			return err
+		}
+
+		if profile != nil {
+			tr = profile.wrap(tr)
+		}
+
+		ds.SetTriggerSpec(ppn.TriggerSpec)
+		datasets[i] = ds
+
+		transport := newConsecutiveTransport(v.es.dispatcher, tr)
+		v.es.transports = append(v.es.transports, transport)
+		transports[i] = transport
+	}
+
+	pred := nonYieldPredecessors(node)[0]
+	if predDatasets, ok := v.partitions[pred]; ok && len(predDatasets) == n {
+		for i, ds := range predDatasets {
+			ds.AddTransformation(transports[i])
+		}
+	} else {
+		v.nodes[pred].AddTransformation(newPartitionTransformation(transports))
+	}
+
+	if continuesPartitions(node, n) {
+		v.partitions[node] = datasets
+		return nil
+	}
+	merge := newMergeTransformation(id, n)
+	for i, ds := range datasets {
+		ds.AddTransformation(merge.input(i))
+	}
+	v.nodes[node] = merge
+	return nil
+}
+
+// continuesPartitions reports whether the only successor of the node
+// reads from the node alone and has the same number of partitions.
+func continuesPartitions(node plan.Node, n int) bool {
+	if len(node.Successors()) != 1 {
+		return false
+	}
+	succ, ok := node.Successors()[0].(*plan.PhysicalPlanNode)
+	return ok && succ.Parallelism == n && len(succ.Predecessors()) == 1
+}
+
 func (es *executionState) abort(err error) {
 	for _, r := range es.results {
 		r.(*result).abort(err)
@@ -244,11 +390,15 @@ func (es *executionState) abort(err error) {
 }
 
 func (es *executionState) do(ctx context.Context) {
-	var wg sync.WaitGroup
+	// sources tracks the running sources separately so the
+	// profiler can wait for them without waiting for itself.
+	var wg, sources sync.WaitGroup
 	for _, src := range es.sources {
 		wg.Add(1)
+		sources.Add(1)
 		go func(src Source) {
 			defer wg.Done()
+			defer sources.Done()
 
 			// Setup panic handling on the source goroutines
 			defer func() {
@@ -280,6 +430,12 @@ func (es *executionState) do(ctx context.Context) {
 		}(src)
 	}
 
+	// The metadata channel is closed once the profiler has reported
+	// the metadata of the sources and transformations.
+	if es.profiler != nil {
+		wg.Add(1)
+	}
+
 	go func() {
 		defer close(es.metaCh)
 		wg.Wait()
@@ -304,6 +460,14 @@ func (es *executionState) do(ctx context.Context) {
 		if err != nil {
 			es.abort(err)
 		}
+
+		if es.profiler != nil {
+			// A source records its profile after it has
+			// finished its transformations.
+			sources.Wait()
+			es.metaCh <- es.profiler.Metadata()
+			wg.Done()
+		}
 	}()
 }
 
@@ -311,6 +475,7 @@ func (es *executionState) do(ctx context.Context) {
 type executionContext struct {
 	ctx           context.Context
 	es            *executionState
+	alloc         *memory.Allocator
 	parents       []DatasetID
 	streamContext streamContext
 }
@@ -332,7 +497,7 @@ func (ec executionContext) StreamContext() StreamContext {
 }
 
 func (ec executionContext) Allocator() *memory.Allocator {
-	return ec.es.alloc
+	return ec.alloc
 }
 
 func (ec executionContext) Parents() []DatasetID {
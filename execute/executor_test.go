@@ -2,6 +2,7 @@ package execute_test
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -758,3 +759,285 @@ func TestExecutor_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutor_Partitions(t *testing.T) {
+	// Each table has its own group key so that the
+	// tables are spread across the partitions.
+	var input []*executetest.Table
+	want := &executetest.Table{
+		KeyCols: []string{"host"},
+		ColMeta: []flux.ColMeta{
+			{Label: "host", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+		},
+	}
+	for i := 0; i < 16; i++ {
+		host := fmt.Sprintf("host%02d", i)
+		input = append(input, &executetest.Table{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{host, execute.Time(0), float64(i)},
+				{host, execute.Time(1), 10.0},
+				{host, execute.Time(2), 100.0},
+			},
+		})
+		if i%2 == 0 {
+			want.Data = append(want.Data, []interface{}{host, float64(i) + 10})
+		}
+	}
+
+	run := func() []*executetest.Table {
+		// The filter and the sum share the same partitions
+		// and the partitions are merged before the yield.
+		filter := plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+			Fn: interpreter.ResolvedFunction{
+				Fn:    executetest.FunctionExpression(t, `(r) => r._value < 50.0 and r.host =~ /[02468]$/`),
+				Scope: runtime.Prelude(),
+			},
+		})
+		filter.Parallelism = 4
+		sum := plan.CreatePhysicalNode("sum", &universe.SumProcedureSpec{
+			AggregateConfig: execute.DefaultAggregateConfig,
+		})
+		sum.Parallelism = 4
+
+		spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+			Nodes: []plan.Node{
+				plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(input)),
+				filter,
+				sum,
+				plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+			},
+			Edges: [][2]int{
+				{0, 1},
+				{1, 2},
+				{2, 3},
+			},
+			Resources: flux.ResourceManagement{
+				ConcurrencyQuota: 4,
+				MemoryBytesQuota: math.MaxInt64,
+			},
+			Now: time.Now(),
+		})
+
+		exe := execute.NewExecutor(zaptest.NewLogger(t))
+		ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+		results, _, err := exe.Execute(ctx, spec, executetest.UnlimitedAllocator)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []*executetest.Table
+		if err := results["_result"].Tables().Do(func(tbl flux.Table) error {
+			cb, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			got = append(got, cb)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	first := run()
+	got := make([]*executetest.Table, len(first))
+	copy(got, first)
+	executetest.NormalizeTables(got)
+
+	// Compare the rows of all of the tables at once
+	// since each row has its own group key.
+	var rows [][]interface{}
+	for _, tbl := range got {
+		rows = append(rows, tbl.Data...)
+	}
+	if !cmp.Equal(want.Data, rows) {
+		t.Fatalf("unexpected results -want/+got:\n%s", cmp.Diff(want.Data, rows))
+	}
+
+	// The tables are produced in the same order every time.
+	for i := 0; i < 10; i++ {
+		if got := run(); !cmp.Equal(first, got) {
+			t.Fatalf("tables are not in the same order -want/+got:\n%s", cmp.Diff(first, got))
+		}
+	}
+}
+
+func TestExecutor_Profiler(t *testing.T) {
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(
+				[]*executetest.Table{&executetest.Table{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(5), execute.Time(0), 1.0},
+						{execute.Time(0), execute.Time(5), execute.Time(1), 2.0},
+						{execute.Time(0), execute.Time(5), execute.Time(2), 3.0},
+						{execute.Time(0), execute.Time(5), execute.Time(3), 4.0},
+						{execute.Time(0), execute.Time(5), execute.Time(4), 5.0},
+					},
+				}},
+			)),
+			plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+				Fn: interpreter.ResolvedFunction{
+					Fn:    executetest.FunctionExpression(t, "(r) => r._value < 2.5"),
+					Scope: runtime.Prelude(),
+				},
+			}),
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 2},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	exe := execute.NewExecutor(zaptest.NewLogger(t))
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = execute.EnableProfiler(ctx)
+	results, metaCh, err := exe.Execute(ctx, plantest.CreatePlanSpec(spec), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var profiles []execute.TransformationProfile
+	for md := range metaCh {
+		for _, v := range md[execute.ProfilerMetadataKey] {
+			profiles = append(profiles, v.(execute.TransformationProfile))
+		}
+	}
+	if got, want := len(profiles), 2; got != want {
+		t.Fatalf("unexpected number of profiles -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The source produces the table that the filter processes.
+	for i, nodeID := range []string{"from-test", "filter"} {
+		p := profiles[i]
+		if got, want := p.NodeID, nodeID; got != want {
+			t.Errorf("unexpected node id -want/+got:\n\t- %s\n\t+ %s", want, got)
+		}
+		if got, want := p.Tables, int64(1); got != want {
+			t.Errorf("unexpected number of tables for %s -want/+got:\n\t- %d\n\t+ %d", nodeID, want, got)
+		}
+		if got, want := p.Rows, int64(5); got != want {
+			t.Errorf("unexpected number of rows for %s -want/+got:\n\t- %d\n\t+ %d", nodeID, want, got)
+		}
+		if p.Duration <= 0 {
+			t.Errorf("expected a positive duration for %s, got %v", nodeID, p.Duration)
+		}
+	}
+
+	// The filter allocates the table that it produces.
+	p := profiles[1]
+	if p.MaxAllocated <= 0 {
+		t.Errorf("expected memory to be allocated, got %d", p.MaxAllocated)
+	}
+}
+
+func TestExecutor_PartitionsProfiler(t *testing.T) {
+	var input []*executetest.Table
+	for i := 0; i < 16; i++ {
+		host := fmt.Sprintf("host%02d", i)
+		input = append(input, &executetest.Table{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{host, execute.Time(0), 1.0},
+				{host, execute.Time(1), 2.0},
+			},
+		})
+	}
+	filter := plan.CreatePhysicalNode("filter", &universe.FilterProcedureSpec{
+		Fn: interpreter.ResolvedFunction{
+			Fn:    executetest.FunctionExpression(t, "(r) => r._value < 1.5"),
+			Scope: runtime.Prelude(),
+		},
+	})
+	filter.Parallelism = 4
+	spec := &plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("from-test", executetest.NewFromProcedureSpec(input)),
+			filter,
+			plan.CreatePhysicalNode("yield", executetest.NewYieldProcedureSpec("_result")),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{1, 2},
+		},
+		Resources: flux.ResourceManagement{
+			ConcurrencyQuota: 4,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Now: time.Now(),
+	}
+
+	exe := execute.NewExecutor(zaptest.NewLogger(t))
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	ctx = execute.EnableProfiler(ctx)
+	results, metaCh, err := exe.Execute(ctx, plantest.CreatePlanSpec(spec), executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Tables().Do(func(tbl flux.Table) error {
+			_, err := executetest.ConvertTable(tbl)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var profiles []execute.TransformationProfile
+	for md := range metaCh {
+		for _, v := range md[execute.ProfilerMetadataKey] {
+			profiles = append(profiles, v.(execute.TransformationProfile))
+		}
+	}
+
+	// The partitions of the filter report a single profile.
+	if got, want := len(profiles), 2; got != want {
+		t.Fatalf("unexpected number of profiles -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	p := profiles[1]
+	if got, want := p.NodeID, "filter"; got != want {
+		t.Errorf("unexpected node id -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if got, want := p.Tables, int64(16); got != want {
+		t.Errorf("unexpected number of tables -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if got, want := p.Rows, int64(32); got != want {
+		t.Errorf("unexpected number of rows -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if p.MaxAllocated <= 0 {
+		t.Errorf("expected memory to be allocated, got %d", p.MaxAllocated)
+	}
+}
@@ -0,0 +1,160 @@
+package execute
+
+import (
+	"sync"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// Location is a time zone used when computing calendar based
+// times such as the start of a day or a month.
+// The zero value is UTC.
+type Location struct {
+	// Name is the IANA name of the time zone.
+	// An empty name is the same as UTC.
+	Name string `json:"zone"`
+	// Offset is an additional fixed offset that is
+	// added to the offset of the time zone.
+	Offset Duration `json:"offset"`
+}
+
+// UTC is the location for coordinated universal time.
+var UTC = Location{}
+
+// NewLocation creates a Location from a time zone name and an offset.
+// It returns an error if the time zone is not known or if the offset
+// is not a fixed duration.
+func NewLocation(name string, offset Duration) (Location, error) {
+	if name == "UTC" {
+		name = ""
+	}
+	if offset.Months() != 0 {
+		return Location{}, errors.New(codes.Invalid, "location offset cannot contain month units")
+	}
+	l := Location{Name: name, Offset: offset}
+	if _, err := l.zone(); err != nil {
+		return Location{}, err
+	}
+	return l, nil
+}
+
+// LocationFromObject creates a Location from a record
+// with a zone and an offset as it is represented in Flux.
+func LocationFromObject(obj values.Object) (Location, error) {
+	var (
+		name   string
+		offset Duration
+	)
+	if v, ok := obj.Get("zone"); ok && !v.IsNull() {
+		if v.Type().Nature() != semantic.String {
+			return Location{}, errors.Newf(codes.Invalid, "location zone must be a string, got %v", v.Type().Nature())
+		}
+		name = v.Str()
+	}
+	if v, ok := obj.Get("offset"); ok && !v.IsNull() {
+		if v.Type().Nature() != semantic.Duration {
+			return Location{}, errors.Newf(codes.Invalid, "location offset must be a duration, got %v", v.Type().Nature())
+		}
+		offset = v.Duration()
+	}
+	return NewLocation(name, offset)
+}
+
+// IsUTC reports whether the location is the same as UTC.
+func (l Location) IsUTC() bool {
+	return (l.Name == "" || l.Name == "UTC") && l.Offset.IsZero()
+}
+
+// String returns the name of the time zone.
+func (l Location) String() string {
+	if l.Name == "" {
+		return "UTC"
+	}
+	return l.Name
+}
+
+// zones caches loaded time zones by name since
+// loading a time zone reads it from the file system.
+var zones sync.Map
+
+func (l Location) zone() (*time.Location, error) {
+	if l.Name == "" || l.Name == "UTC" {
+		return time.UTC, nil
+	}
+	if z, ok := zones.Load(l.Name); ok {
+		return z.(*time.Location), nil
+	}
+	z, err := time.LoadLocation(l.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Invalid, "invalid time zone %q", l.Name)
+	}
+	zones.Store(l.Name, z)
+	return z, nil
+}
+
+func (l Location) mustZone() *time.Location {
+	z, err := l.zone()
+	if err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// offsetAt returns the offset from UTC in nanoseconds
+// that is in effect at the given time.
+func (l Location) offsetAt(t Time) int64 {
+	if l.IsUTC() {
+		return 0
+	}
+	_, secs := time.Unix(0, int64(t)).In(l.mustZone()).Zone()
+	return int64(secs)*int64(time.Second) + int64(l.Offset.Duration())
+}
+
+// standardOffsetAt returns the offset from UTC in nanoseconds
+// of standard time in the year of the given time.
+// Daylight saving time is ignored.
+func (l Location) standardOffsetAt(t Time) int64 {
+	if l.IsUTC() {
+		return 0
+	}
+	// Daylight saving time always moves the clock forward
+	// and is only observed for part of the year, so the
+	// smaller of the two offsets at opposite sides of the
+	// year is the standard offset.
+	zone := l.mustZone()
+	year := t.Time().Year()
+	_, jan := time.Date(year, time.January, 1, 0, 0, 0, 0, zone).Zone()
+	_, jul := time.Date(year, time.July, 1, 0, 0, 0, 0, zone).Zone()
+	if jul < jan {
+		jan = jul
+	}
+	return int64(jan)*int64(time.Second) + int64(l.Offset.Duration())
+}
+
+// ToLocal converts the time into the wall clock time in this location.
+// The returned time has the same year, month, day and clock fields,
+// when interpreted as UTC, as the time does in this location.
+func (l Location) ToLocal(t Time) Time {
+	return t + Time(l.offsetAt(t))
+}
+
+// FromLocal converts a wall clock time in this location back into
+// an absolute time. Wall clock times that are skipped or repeated by
+// a daylight saving time transition resolve to the earlier of the
+// two possible times.
+func (l Location) FromLocal(t Time) Time {
+	if l.IsUTC() {
+		return t
+	}
+	wall := (t - Time(int64(l.Offset.Duration()))).Time()
+	ts := time.Date(
+		wall.Year(), wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(),
+		l.mustZone(),
+	)
+	return values.ConvertTime(ts)
+}
@@ -0,0 +1,200 @@
+package execute
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/influxdata/flux"
+)
+
+// partitionTransformation divides the tables of a dataset into partitions
+// by group key. Each partition is processed by its own transformation.
+// All of the tables with the same group key are sent to the same partition.
+type partitionTransformation struct {
+	partitions TransformationSet
+}
+
+func newPartitionTransformation(partitions []Transformation) *partitionTransformation {
+	return &partitionTransformation{
+		partitions: partitions,
+	}
+}
+
+// partition returns the transformation for the tables with the group key.
+func (t *partitionTransformation) partition(key flux.GroupKey) Transformation {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key.String()))
+	return t.partitions[h.Sum64()%uint64(len(t.partitions))]
+}
+
+func (t *partitionTransformation) RetractTable(id DatasetID, key flux.GroupKey) error {
+	return t.partition(key).RetractTable(id, key)
+}
+
+func (t *partitionTransformation) Process(id DatasetID, tbl flux.Table) error {
+	return t.partition(tbl.Key()).Process(id, tbl)
+}
+
+func (t *partitionTransformation) UpdateWatermark(id DatasetID, time Time) error {
+	return t.partitions.UpdateWatermark(id, time)
+}
+
+func (t *partitionTransformation) UpdateProcessingTime(id DatasetID, time Time) error {
+	return t.partitions.UpdateProcessingTime(id, time)
+}
+
+func (t *partitionTransformation) Finish(id DatasetID, err error) {
+	t.partitions.Finish(id, err)
+}
+
+// mergeTransformation combines the tables of each partition of a node
+// into a single dataset. The tables are sent downstream in the order of
+// the partitions so the output does not depend on how the work of the
+// partitions was scheduled. The tables of the first partition that has
+// not finished are sent as they arrive and the tables of the later
+// partitions are held until the partitions before them have finished.
+//
+// If a partition finishes with an error, the error is sent downstream
+// right away and the tables that are held or arrive later are released
+// without being sent.
+//
+// The watermark and processing time of the partitions are not sent
+// downstream. The triggers of the downstream transformations fire
+// once all of the partitions have finished.
+type mergeTransformation struct {
+	id DatasetID
+	ts TransformationSet
+
+	mu sync.Mutex
+	// current is the index of the partition whose tables are sent downstream.
+	current  int
+	finished []bool
+	// buffers hold the messages of the partitions after the current one.
+	buffers [][]Message
+	done    bool
+}
+
+func newMergeTransformation(id DatasetID, n int) *mergeTransformation {
+	return &mergeTransformation{
+		id:       id,
+		finished: make([]bool, n),
+		buffers:  make([][]Message, n),
+	}
+}
+
+func (m *mergeTransformation) AddTransformation(t Transformation) {
+	m.ts = append(m.ts, t)
+}
+
+// input returns the transformation that receives the tables of partition i.
+func (m *mergeTransformation) input(i int) Transformation {
+	return &mergeInput{m: m, i: i}
+}
+
+func (m *mergeTransformation) push(i int, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done {
+		discard(msg)
+		return nil
+	}
+	if i != m.current {
+		m.buffers[i] = append(m.buffers[i], msg)
+		return nil
+	}
+	return m.send(msg)
+}
+
+// send sends the message downstream.
+func (m *mergeTransformation) send(msg Message) error {
+	switch msg := msg.(type) {
+	case RetractTableMsg:
+		return m.ts.RetractTable(m.id, msg.Key())
+	case ProcessMsg:
+		return m.ts.Process(m.id, msg.Table())
+	}
+	return nil
+}
+
+func (m *mergeTransformation) finish(i int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done {
+		return
+	}
+	if err != nil {
+		m.abort(err)
+		return
+	}
+
+	m.finished[i] = true
+	for m.current < len(m.finished) && m.finished[m.current] {
+		m.current++
+		if m.current == len(m.finished) {
+			break
+		}
+		for len(m.buffers[m.current]) > 0 {
+			msg := m.buffers[m.current][0]
+			m.buffers[m.current] = m.buffers[m.current][1:]
+			if err := m.send(msg); err != nil {
+				m.abort(err)
+				return
+			}
+		}
+		m.buffers[m.current] = nil
+	}
+	if m.current == len(m.finished) {
+		m.done = true
+		m.ts.Finish(m.id, nil)
+	}
+}
+
+// abort sends the error downstream and releases the held tables.
+func (m *mergeTransformation) abort(err error) {
+	for _, msgs := range m.buffers {
+		for _, msg := range msgs {
+			discard(msg)
+		}
+	}
+	m.done, m.buffers = true, nil
+	m.ts.Finish(m.id, err)
+}
+
+// discard releases the table of a message that is not sent downstream.
+func discard(msg Message) {
+	if msg, ok := msg.(ProcessMsg); ok {
+		msg.Table().Done()
+	}
+}
+
+// mergeInput receives the tables of one partition of a mergeTransformation.
+type mergeInput struct {
+	m *mergeTransformation
+	i int
+}
+
+func (t *mergeInput) RetractTable(id DatasetID, key flux.GroupKey) error {
+	return t.m.push(t.i, &retractTableMsg{
+		srcMessage: srcMessage(id),
+		key:        key,
+	})
+}
+
+func (t *mergeInput) Process(id DatasetID, tbl flux.Table) error {
+	return t.m.push(t.i, &processMsg{
+		srcMessage: srcMessage(id),
+		table:      tbl,
+	})
+}
+
+func (t *mergeInput) UpdateWatermark(id DatasetID, time Time) error {
+	return nil
+}
+
+func (t *mergeInput) UpdateProcessingTime(id DatasetID, time Time) error {
+	return nil
+}
+
+func (t *mergeInput) Finish(id DatasetID, err error) {
+	t.m.finish(t.i, err)
+}
@@ -0,0 +1,181 @@
+package execute
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/values"
+)
+
+// mergeOutput records the tables that a mergeTransformation sends downstream.
+type mergeOutput struct {
+	hosts    []string
+	err      error
+	finished int
+}
+
+func (o *mergeOutput) RetractTable(id DatasetID, key flux.GroupKey) error {
+	return nil
+}
+
+func (o *mergeOutput) Process(id DatasetID, tbl flux.Table) error {
+	o.hosts = append(o.hosts, tbl.Key().ValueString(0))
+	tbl.Done()
+	return nil
+}
+
+func (o *mergeOutput) UpdateWatermark(id DatasetID, t Time) error {
+	return nil
+}
+
+func (o *mergeOutput) UpdateProcessingTime(id DatasetID, t Time) error {
+	return nil
+}
+
+func (o *mergeOutput) Finish(id DatasetID, err error) {
+	o.finished++
+	o.err = err
+}
+
+func newMergeTable(t *testing.T, host string) *ColListTable {
+	t.Helper()
+	cols := []flux.ColMeta{{Label: "host", Type: flux.TString}}
+	key := NewGroupKey(cols, []values.Value{values.NewString(host)})
+	b := NewColListTableBuilder(key, &memory.Allocator{})
+	if _, err := b.AddCol(cols[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AppendString(0, host); err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := b.Table()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Release()
+	return tbl.(*ColListTable)
+}
+
+func TestMergeTransformation_Order(t *testing.T) {
+	out := &mergeOutput{}
+	m := newMergeTransformation(DatasetID{}, 3)
+	m.AddTransformation(out)
+
+	// The later partitions are held until the partitions before them finish.
+	for _, p := range []struct {
+		i    int
+		host string
+	}{
+		{i: 2, host: "c"},
+		{i: 1, host: "b"},
+		{i: 0, host: "a"},
+	} {
+		if err := m.input(p.i).Process(DatasetID{}, newMergeTable(t, p.host)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := out.hosts, []string{"a"}; !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	m.input(2).Finish(DatasetID{}, nil)
+	m.input(0).Finish(DatasetID{}, nil)
+	if out.finished != 0 {
+		t.Fatal("expected the merge to wait for all of the partitions")
+	}
+	m.input(1).Finish(DatasetID{}, nil)
+	if got, want := out.hosts, []string{"a", "b", "c"}; !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if out.finished != 1 || out.err != nil {
+		t.Fatalf("expected the merge to finish once without an error, finished %d times with %v", out.finished, out.err)
+	}
+}
+
+func TestMergeTransformation_ErrorWhileBuffered(t *testing.T) {
+	out := &mergeOutput{}
+	m := newMergeTransformation(DatasetID{}, 3)
+	m.AddTransformation(out)
+
+	a := newMergeTable(t, "a")
+	b := newMergeTable(t, "b")
+	c := newMergeTable(t, "c")
+	for i, tbl := range []*ColListTable{a, b, c} {
+		if err := m.input(i).Process(DatasetID{}, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The tables of the partitions after the one that
+	// failed are never sent and they are released.
+	wantErr := errors.New("expected error")
+	m.input(1).Finish(DatasetID{}, wantErr)
+	if got, want := out.hosts, []string{"a"}; !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if out.finished != 1 || out.err != wantErr {
+		t.Fatalf("expected the merge to finish once with %v, finished %d times with %v", wantErr, out.finished, out.err)
+	}
+	if !b.IsDone() || !c.IsDone() {
+		t.Fatal("expected the buffered tables to be released")
+	}
+
+	// The tables that arrive after the error are released as well.
+	d := newMergeTable(t, "d")
+	if err := m.input(0).Process(DatasetID{}, d); err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsDone() {
+		t.Fatal("expected the table to be released")
+	}
+	m.input(0).Finish(DatasetID{}, nil)
+	m.input(2).Finish(DatasetID{}, nil)
+	if got, want := out.hosts, []string{"a"}; !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if out.finished != 1 {
+		t.Fatalf("expected the merge to finish once, finished %d times", out.finished)
+	}
+}
+
+type partitionTestSpec struct {
+	plan.DefaultCost
+}
+
+func (s *partitionTestSpec) Kind() plan.ProcedureKind {
+	return "partition-test"
+}
+
+func (s *partitionTestSpec) Copy() plan.ProcedureSpec {
+	return &partitionTestSpec{}
+}
+
+func TestDispatcherQueueSize(t *testing.T) {
+	newPlan := func(nodes, parallelism int) *plan.Spec {
+		var last plan.Node = plan.CreatePhysicalNode("source", &partitionTestSpec{})
+		for i := 0; i < nodes; i++ {
+			node := plan.CreatePhysicalNode(plan.NodeID(fmt.Sprintf("node%d", i)), &partitionTestSpec{})
+			node.Parallelism = parallelism
+			last.AddSuccessors(node)
+			node.AddPredecessors(last)
+			last = node
+		}
+		ps := plan.NewPlanSpec()
+		ps.Roots[last] = struct{}{}
+		return ps
+	}
+
+	// Small plans keep the default size.
+	if got, want := dispatcherQueueSize(newPlan(3, 0)), minDispatcherQueueSize; got != want {
+		t.Errorf("unexpected queue size -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	// Each partition has a transport.
+	if got, want := dispatcherQueueSize(newPlan(10, 16)), 160; got != want {
+		t.Errorf("unexpected queue size -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+}
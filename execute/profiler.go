@@ -0,0 +1,235 @@
+package execute
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// ProfilerMetadataKey is the metadata key that the profile of each
+// source and transformation is reported with when the profiler is enabled.
+// Each value has the type TransformationProfile.
+const ProfilerMetadataKey = "flux/profiler"
+
+type profilerKey int
+
+const profilerEnabledKey profilerKey = iota
+
+// EnableProfiler enables the profiler for queries that are executed
+// with the returned context. The profile of each source and transformation
+// is reported in the query metadata once the query has finished.
+func EnableProfiler(ctx context.Context) context.Context {
+	return context.WithValue(ctx, profilerEnabledKey, true)
+}
+
+// IsProfilerEnabled reports whether the profiler has been enabled for the context.
+func IsProfilerEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(profilerEnabledKey).(bool)
+	return enabled
+}
+
+// TransformationProfile reports how a source or transformation
+// performed during the execution of a query.
+type TransformationProfile struct {
+	// NodeID is the id of the plan node that the source or transformation was created for.
+	NodeID string `json:"node_id"`
+	// Kind is the kind of procedure that the source or transformation executes.
+	Kind string `json:"kind"`
+	// Duration is the wall time spent in the source or transformation.
+	// For a transformation whose tables are processed in partitions,
+	// it is the sum of the time spent in each partition.
+	Duration time.Duration `json:"duration"`
+	// Tables is the number of tables the transformation processed
+	// or the source produced.
+	Tables int64 `json:"tables"`
+	// Rows is the number of rows in those tables.
+	Rows int64 `json:"rows"`
+	// MaxAllocated is the maximum amount of memory in bytes
+	// that was allocated by the source or transformation at any one time.
+	MaxAllocated int64 `json:"max_allocated"`
+}
+
+// profiler records the profiles of the sources and transformations of a query.
+type profiler struct {
+	profiles []*nodeProfile
+}
+
+func (p *profiler) newProfile(node plan.Node, mem *memory.Allocator) *nodeProfile {
+	np := &nodeProfile{
+		nodeID: string(node.ID()),
+		kind:   string(node.Kind()),
+		mem:    mem,
+	}
+	p.profiles = append(p.profiles, np)
+	return np
+}
+
+// wrap returns a transformation that records the profile of t.
+func (p *profiler) wrap(node plan.Node, mem *memory.Allocator, t Transformation) Transformation {
+	return p.newProfile(node, mem).wrap(t)
+}
+
+// wrapSource returns a source that records the profile of s.
+// The returned source is a MetadataNode if s is one.
+func (p *profiler) wrapSource(node plan.Node, mem *memory.Allocator, s Source) Source {
+	ps := &profilingSource{
+		s: s,
+		p: p.newProfile(node, mem),
+	}
+	if mdn, ok := s.(MetadataNode); ok {
+		return &profilingMetadataSource{
+			profilingSource: ps,
+			mdn:             mdn,
+		}
+	}
+	return ps
+}
+
+// Metadata returns the metadata that reports the profile
+// of each source and transformation.
+func (p *profiler) Metadata() flux.Metadata {
+	md := make(flux.Metadata)
+	for _, np := range p.profiles {
+		md.Add(ProfilerMetadataKey, np.profile())
+	}
+	return md
+}
+
+// nodeProfile records the wall time spent in a source or
+// transformation and the number of tables and rows that it handles.
+type nodeProfile struct {
+	duration int64
+	tables   int64
+	rows     int64
+
+	nodeID string
+	kind   string
+	mem    *memory.Allocator
+}
+
+// wrap returns a transformation that records its profile in p.
+// The transformations of the partitions of a node share a profile.
+func (p *nodeProfile) wrap(t Transformation) Transformation {
+	return &profilingTransformation{
+		t: t,
+		p: p,
+	}
+}
+
+func (p *nodeProfile) time(start time.Time) {
+	atomic.AddInt64(&p.duration, int64(time.Since(start)))
+}
+
+// table counts the table and returns a table that counts its rows as it is read.
+func (p *nodeProfile) table(tbl flux.Table) flux.Table {
+	atomic.AddInt64(&p.tables, 1)
+	return &profiledTable{Table: tbl, rows: &p.rows}
+}
+
+func (p *nodeProfile) profile() TransformationProfile {
+	return TransformationProfile{
+		NodeID:       p.nodeID,
+		Kind:         p.kind,
+		Duration:     time.Duration(atomic.LoadInt64(&p.duration)),
+		Tables:       atomic.LoadInt64(&p.tables),
+		Rows:         atomic.LoadInt64(&p.rows),
+		MaxAllocated: p.mem.MaxAllocated(),
+	}
+}
+
+// profilingTransformation is a Transformation that records
+// the profile of the wrapped Transformation.
+type profilingTransformation struct {
+	t Transformation
+	p *nodeProfile
+}
+
+func (t *profilingTransformation) RetractTable(id DatasetID, key flux.GroupKey) error {
+	defer t.p.time(time.Now())
+	return t.t.RetractTable(id, key)
+}
+
+func (t *profilingTransformation) Process(id DatasetID, tbl flux.Table) error {
+	defer t.p.time(time.Now())
+	return t.t.Process(id, t.p.table(tbl))
+}
+
+func (t *profilingTransformation) UpdateWatermark(id DatasetID, ts Time) error {
+	defer t.p.time(time.Now())
+	return t.t.UpdateWatermark(id, ts)
+}
+
+func (t *profilingTransformation) UpdateProcessingTime(id DatasetID, ts Time) error {
+	defer t.p.time(time.Now())
+	return t.t.UpdateProcessingTime(id, ts)
+}
+
+func (t *profilingTransformation) Finish(id DatasetID, err error) {
+	defer t.p.time(time.Now())
+	t.t.Finish(id, err)
+}
+
+// profilingSource is a Source that records the wall time spent
+// running the wrapped Source and the tables that it produces.
+type profilingSource struct {
+	s Source
+	p *nodeProfile
+	// counted is set once a transformation that
+	// counts the produced tables has been added.
+	counted bool
+}
+
+// AddTransformation adds the transformation to the wrapped source.
+// A source sends the same tables to each of its transformations,
+// so only the tables sent to the first one are counted.
+func (s *profilingSource) AddTransformation(t Transformation) {
+	if !s.counted {
+		t = &countingTransformation{Transformation: t, p: s.p}
+		s.counted = true
+	}
+	s.s.AddTransformation(t)
+}
+
+func (s *profilingSource) Run(ctx context.Context) {
+	defer s.p.time(time.Now())
+	s.s.Run(ctx)
+}
+
+// profilingMetadataSource is a profilingSource
+// for a source that is also a MetadataNode.
+type profilingMetadataSource struct {
+	*profilingSource
+	mdn MetadataNode
+}
+
+func (s *profilingMetadataSource) Metadata() flux.Metadata {
+	return s.mdn.Metadata()
+}
+
+// countingTransformation counts the tables and rows that are sent to
+// the wrapped Transformation without recording the time spent in it.
+type countingTransformation struct {
+	Transformation
+	p *nodeProfile
+}
+
+func (t *countingTransformation) Process(id DatasetID, tbl flux.Table) error {
+	return t.Transformation.Process(id, t.p.table(tbl))
+}
+
+// profiledTable counts the rows of a table as it is read.
+type profiledTable struct {
+	flux.Table
+	rows *int64
+}
+
+func (t *profiledTable) Do(f func(flux.ColReader) error) error {
+	return t.Table.Do(func(cr flux.ColReader) error {
+		atomic.AddInt64(t.rows, int64(cr.Len()))
+		return f(cr)
+	})
+}
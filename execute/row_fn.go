@@ -2,8 +2,12 @@ package execute
 
 import (
 	"context"
+	"sort"
 
+	"github.com/apache/arrow/go/arrow/array"
+	arrowmem "github.com/apache/arrow/go/arrow/memory"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/compiler"
 	"github.com/influxdata/flux/internal/errors"
@@ -79,11 +83,60 @@ func (f *dynamicFn) prepare(cols []flux.ColMeta, extraTypes map[string]semantic.
 	}, nil
 }
 
+// vectorize compiles the function so that it is evaluated over whole
+// columns. It returns nil if the function cannot be vectorized.
+func (f *dynamicFn) vectorize(cols []flux.ColMeta) compiler.VectorizedFunc {
+	recordType, err := f.typeof(cols)
+	if err != nil {
+		return nil
+	}
+	inType := semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte(f.recordName), Value: recordType},
+	})
+	fn, err := compiler.CompileVectorized(f.scope, f.fn, inType)
+	if err != nil {
+		return nil
+	}
+	return fn
+}
+
 type preparedFn struct {
 	fn         compiler.Func
 	recordName string
 	arg0       values.Object
 	args       values.Object
+
+	// vectorized is the function compiled to be evaluated
+	// over whole columns if it can be vectorized.
+	vectorized compiler.VectorizedFunc
+}
+
+// IsVectorized reports whether the function can be evaluated
+// over whole columns with EvalVector.
+func (f *preparedFn) IsVectorized() bool {
+	return f.vectorized != nil
+}
+
+// evalVector evaluates the vectorized function for every row of cr.
+func (f *preparedFn) evalVector(cr flux.ColReader, mem arrowmem.Allocator) (*compiler.Vector, error) {
+	cols := make(map[string]array.Interface, len(cr.Cols()))
+	for j, c := range cr.Cols() {
+		switch c.Type {
+		case flux.TBool:
+			cols[c.Label] = cr.Bools(j)
+		case flux.TInt:
+			cols[c.Label] = cr.Ints(j)
+		case flux.TUInt:
+			cols[c.Label] = cr.UInts(j)
+		case flux.TFloat:
+			cols[c.Label] = cr.Floats(j)
+		case flux.TString:
+			cols[c.Label] = cr.Strings(j)
+		case flux.TTime:
+			cols[c.Label] = cr.Times(j)
+		}
+	}
+	return f.vectorized.Eval(cr.Len(), cols, mem)
 }
 
 // returnType will return the return type of the prepared function.
@@ -211,6 +264,7 @@ func (f *RowPredicateFn) Prepare(cols []flux.ColMeta) (*RowPredicatePreparedFn,
 	} else if fn.returnType().Nature() != semantic.Bool {
 		return nil, errors.New(codes.Invalid, "row predicate function does not evaluate to a boolean")
 	}
+	fn.vectorized = f.vectorize(cols)
 	return &RowPredicatePreparedFn{
 		rowFn: rowFn{preparedFn: fn},
 	}, nil
@@ -242,6 +296,18 @@ func (f *RowPredicatePreparedFn) EvalRow(ctx context.Context, row int, cr flux.C
 	return !v.IsNull() && v.Bool(), nil
 }
 
+// EvalVector evaluates the predicate for every row of the column reader.
+// The predicate is true for a row if its value is true and not null.
+// It must only be called if IsVectorized is true.
+// The caller must release the returned array.
+func (f *RowPredicatePreparedFn) EvalVector(cr flux.ColReader, mem arrowmem.Allocator) (*array.Boolean, error) {
+	v, err := f.evalVector(cr, mem)
+	if err != nil {
+		return nil, err
+	}
+	return v.Values.(*array.Boolean), nil
+}
+
 func (f *RowPredicatePreparedFn) Eval(ctx context.Context, record values.Object) (bool, error) {
 	f.args.Set(f.recordName, record)
 	v, err := f.fn.Eval(ctx, f.args)
@@ -268,6 +334,9 @@ func (f *RowMapFn) Prepare(cols []flux.ColMeta) (*RowMapPreparedFn, error) {
 	} else if k := fn.returnType().Nature(); k != semantic.Object {
 		return nil, errors.Newf(codes.Invalid, "map function must return an object, got %s", k.String())
 	}
+	if v := f.vectorize(cols); v != nil && v.Type().Nature() == semantic.Object {
+		fn.vectorized = v
+	}
 	return &RowMapPreparedFn{
 		rowFn: rowFn{preparedFn: fn},
 	}, nil
@@ -289,6 +358,53 @@ func (f *RowMapPreparedFn) Eval(ctx context.Context, row int, cr flux.ColReader)
 	return v.Object(), nil
 }
 
+// EvalVector evaluates the map function for every row of the column reader.
+// It returns a buffer with a column for each property of the records that
+// the function returns, sorted by label. The buffer does not have a group key.
+// It must only be called if IsVectorized is true.
+// The caller must release the returned buffer.
+func (f *RowMapPreparedFn) EvalVector(cr flux.ColReader, mem arrowmem.Allocator) (*arrow.TableBuffer, error) {
+	v, err := f.evalVector(cr, mem)
+	if err != nil {
+		return nil, err
+	}
+	typ := f.vectorized.Type()
+	types := make(map[string]flux.ColType, len(v.Record))
+	n, err := typ.NumProperties()
+	if err != nil {
+		v.Release()
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		prop, err := typ.RowProperty(i)
+		if err != nil {
+			v.Release()
+			return nil, err
+		}
+		ptyp, err := prop.TypeOf()
+		if err != nil {
+			v.Release()
+			return nil, err
+		}
+		types[prop.Name()] = ConvertFromKind(ptyp.Nature())
+	}
+
+	labels := make([]string, 0, len(v.Record))
+	for label := range v.Record {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	buf := &arrow.TableBuffer{
+		Columns: make([]flux.ColMeta, len(labels)),
+		Values:  make([]array.Interface, len(labels)),
+	}
+	for j, label := range labels {
+		buf.Columns[j] = flux.ColMeta{Label: label, Type: types[label]}
+		buf.Values[j] = v.Record[label]
+	}
+	return buf, nil
+}
+
 type RowReduceFn struct {
 	dynamicFn
 }
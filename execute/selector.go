@@ -18,7 +18,6 @@ type selectorTransformation struct {
 }
 
 type SelectorConfig struct {
-	plan.DefaultCost
 	Column string `json:"column"`
 }
 
@@ -26,6 +25,20 @@ var DefaultSelectorConfig = SelectorConfig{
 	Column: DefaultValueColLabel,
 }
 
+// Cost estimates that a selector selects one row from each table.
+// The rows are selected with all of their columns.
+func (c SelectorConfig) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	cost, stats := aggregateCost(inStats)
+	stats.Columns = plan.SumStatistics(inStats).Columns
+	return cost, stats
+}
+
+// IsPartitionable implements plan.PartitionableProcedureSpec.
+// Each table is selected from independently of the others.
+func (c SelectorConfig) IsPartitionable() bool {
+	return true
+}
+
 func (c *SelectorConfig) ReadArgs(args flux.Arguments) error {
 	if col, ok, err := args.GetString("column"); err != nil {
 		return err
@@ -0,0 +1,270 @@
+package spill
+
+import (
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// Cache is an execute.TableBuilderCache and execute.DataCache that
+// writes its table builders to a Store so that transformations which
+// hold all of their output tables until they finish can release
+// that memory when the allocation limit is reached.
+//
+// Tables that are spilled are read back into a table builder when
+// the transformation requests the builder again or when the
+// dataset reads the table so it can be sent downstream.
+type Cache struct {
+	store  *Store
+	alloc  *memory.Allocator
+	tables *execute.GroupLookup
+
+	triggerSpec plan.TriggerSpec
+}
+
+type cacheEntry struct {
+	builder *execute.ColListTableBuilder
+	seg     *Segment
+	trigger execute.Trigger
+}
+
+// NewCache creates a Cache that spills its table builders to the store.
+func NewCache(store *Store, alloc *memory.Allocator) *Cache {
+	return &Cache{
+		store:  store,
+		alloc:  alloc,
+		tables: execute.NewGroupLookup(),
+	}
+}
+
+func (c *Cache) SetTriggerSpec(ts plan.TriggerSpec) {
+	c.triggerSpec = ts
+}
+
+func (c *Cache) lookup(key flux.GroupKey) (*cacheEntry, bool) {
+	v, ok := c.tables.Lookup(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*cacheEntry), true
+}
+
+// TableBuilder returns the builder for the specified table.
+// If no builder exists, one will be created. If the table was
+// spilled, it is read back into memory. This panics if the table
+// cannot be read back, so callers that can handle the error
+// should call Restore first.
+func (c *Cache) TableBuilder(key flux.GroupKey) (execute.TableBuilder, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		e = &cacheEntry{
+			builder: execute.NewColListTableBuilder(key, c.alloc),
+			trigger: execute.NewTriggerFromSpec(c.triggerSpec),
+		}
+		c.tables.Set(key, e)
+		return e.builder, true
+	}
+	if err := c.restore(e); err != nil {
+		panic(err)
+	}
+	return e.builder, false
+}
+
+// Restore reads the table with the given key back into memory
+// if it was spilled. It does nothing if the table is in memory
+// or does not exist.
+func (c *Cache) Restore(key flux.GroupKey) error {
+	e, ok := c.lookup(key)
+	if !ok {
+		return nil
+	}
+	return c.restore(e)
+}
+
+func (c *Cache) restore(e *cacheEntry) error {
+	if e.seg == nil {
+		return nil
+	}
+	var builder *execute.ColListTableBuilder
+	if err := c.Guard(func() error {
+		tbl, err := c.store.Read(e.seg)
+		if err != nil {
+			return err
+		}
+		builder = execute.NewColListTableBuilder(tbl.Key(), c.alloc)
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			builder.Release()
+			return err
+		}
+		if err := guardRelease(builder, func() error {
+			return execute.AppendTable(tbl, builder)
+		}); err != nil {
+			builder.Release()
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	seg := e.seg
+	e.builder, e.seg = builder, nil
+	return c.store.Remove(seg)
+}
+
+// guardRelease calls f and releases the builder
+// if f panics before propagating the panic.
+func guardRelease(builder *execute.ColListTableBuilder, f func() error) error {
+	defer func() {
+		if r := recover(); r != nil {
+			builder.Release()
+			panic(r)
+		}
+	}()
+	return f()
+}
+
+func (c *Cache) ForEachBuilder(f func(flux.GroupKey, execute.TableBuilder)) {
+	c.tables.Range(func(key flux.GroupKey, value interface{}) {
+		if e := value.(*cacheEntry); e.seg == nil {
+			f(key, e.builder)
+		}
+	})
+}
+
+// Table returns the table with the given key.
+// If the table was spilled, it is read back from its spill file.
+func (c *Cache) Table(key flux.GroupKey) (flux.Table, error) {
+	e, ok := c.lookup(key)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "table not found with key %v", key)
+	}
+	var tbl flux.Table
+	if e.seg == nil {
+		// Copying the builder into a table allocates memory
+		// so the other tables may need to be spilled first.
+		if err := c.Guard(func() (err error) {
+			tbl, err = e.builder.Table()
+			return err
+		}, e.builder); err != nil {
+			return nil, err
+		}
+		return tbl, nil
+	}
+
+	if err := c.Guard(func() error {
+		spilled, err := c.store.Read(e.seg)
+		if err != nil {
+			return err
+		}
+		tbl, err = execute.CopyTable(spilled)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return tbl, nil
+}
+
+func (c *Cache) DiscardTable(key flux.GroupKey) {
+	e, ok := c.lookup(key)
+	if !ok {
+		return
+	}
+	if e.seg != nil {
+		// Failing to remove the file only leaves it behind until the store is closed.
+		_ = c.store.Remove(e.seg)
+		e.seg = nil
+		e.builder = execute.NewColListTableBuilder(key, c.alloc)
+		return
+	}
+	e.builder.ClearData()
+}
+
+func (c *Cache) ExpireTable(key flux.GroupKey) {
+	v, ok := c.tables.Delete(key)
+	if !ok {
+		return
+	}
+	if e := v.(*cacheEntry); e.seg != nil {
+		_ = c.store.Remove(e.seg)
+	} else {
+		e.builder.Release()
+	}
+}
+
+func (c *Cache) ForEach(f func(flux.GroupKey)) {
+	c.tables.Range(func(key flux.GroupKey, value interface{}) {
+		f(key)
+	})
+}
+
+func (c *Cache) ForEachWithContext(f func(flux.GroupKey, execute.Trigger, execute.TableContext)) {
+	c.tables.Range(func(key flux.GroupKey, value interface{}) {
+		e := value.(*cacheEntry)
+		count := 0
+		if e.seg != nil {
+			count = e.seg.Len()
+		} else {
+			count = e.builder.NRows()
+		}
+		f(key, e.trigger, execute.TableContext{
+			Key:   key,
+			Count: count,
+		})
+	})
+}
+
+// Spill writes the table builders of the cache to spill files
+// and releases their memory. The builders that are in use are
+// kept in memory.
+func (c *Cache) Spill(inUse ...execute.TableBuilder) (err error) {
+	c.tables.Range(func(key flux.GroupKey, value interface{}) {
+		e := value.(*cacheEntry)
+		if err != nil || e.seg != nil || e.builder.NRows() == 0 {
+			return
+		}
+		for _, b := range inUse {
+			if b == execute.TableBuilder(e.builder) {
+				return
+			}
+		}
+		var seg *Segment
+		if seg, err = c.store.WriteBuilder(e.builder); err != nil {
+			return
+		}
+		e.builder.Release()
+		e.builder, e.seg = nil, seg
+	})
+	return err
+}
+
+// Guard calls f and, if f reaches the memory allocation limit,
+// spills the table builders that are not in use and calls f again.
+// If f reaches the limit again, it panics as it would have without
+// spilling. The function f must be safe to call more than once.
+func (c *Cache) Guard(f func() error, inUse ...execute.TableBuilder) error {
+	err := Guard(f)
+	if !memory.IsLimitExceeded(err) {
+		return err
+	}
+	if err := c.Spill(inUse...); err != nil {
+		return err
+	}
+	return f()
+}
+
+// NearLimit reports whether more of the memory allocation limit than
+// the threshold of the store is in use. Transformations that modify their
+// table builders in place cannot call them again after reaching the limit
+// part way through so they use this to spill ahead of time instead.
+func (c *Cache) NearLimit() bool {
+	return c.store.nearLimit(c.alloc)
+}
+
+// Close removes any remaining spill files.
+func (c *Cache) Close() error {
+	return c.store.Close()
+}
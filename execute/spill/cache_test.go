@@ -0,0 +1,175 @@
+package spill_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/execute/spill"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+func cacheTables() []*executetest.Table {
+	return []*executetest.Table{
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1), 1.0, "a"},
+				{execute.Time(2), nil, "a"},
+			},
+		},
+		{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1), 3.0, "b"},
+			},
+		},
+	}
+}
+
+func TestCache_SpillAndRestore(t *testing.T) {
+	store, dir := newStore(t)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	c := spill.NewCache(store, executetest.UnlimitedAllocator)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+	tables := cacheTables()
+	var builders []execute.TableBuilder
+	for _, tbl := range tables {
+		b, created := c.TableBuilder(tbl.Key())
+		if !created {
+			t.Fatal("expected a new table builder")
+		}
+		if err := execute.AddTableCols(tbl, b); err != nil {
+			t.Fatal(err)
+		}
+		if err := execute.AppendTable(tbl, b); err != nil {
+			t.Fatal(err)
+		}
+		builders = append(builders, b)
+	}
+
+	// Only the table that is not in use is spilled.
+	if err := c.Spill(builders[1]); err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	c.ForEachBuilder(func(flux.GroupKey, execute.TableBuilder) { n++ })
+	if got, want := n, 1; got != want {
+		t.Fatalf("unexpected number of builders in memory -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The spilled table can be read without restoring it.
+	tbl, err := c.Table(tables[0].Key())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := cacheTables()[0]
+	got.Normalize()
+	want.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// Requesting the builder again reads it back into memory
+	// and more rows can be appended to it.
+	if err := c.Restore(tables[0].Key()); err != nil {
+		t.Fatal(err)
+	}
+	b, created := c.TableBuilder(tables[0].Key())
+	if created {
+		t.Fatal("expected the restored table builder")
+	}
+	if err := b.AppendTime(0, execute.Time(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AppendFloat(1, 3.0); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AppendString(2, "a"); err != nil {
+		t.Fatal(err)
+	}
+	assertDirEmpty(t, dir)
+
+	tbl, err = c.Table(tables[0].Key())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.Data = append(want.Data, []interface{}{execute.Time(3), 3.0, "a"})
+	got.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	if err := c.Spill(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	assertDirEmpty(t, dir)
+}
+
+func TestCache_Guard(t *testing.T) {
+	store, dir := newStore(t)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	limit := int64(4096)
+	mem := &memory.Allocator{Limit: &limit}
+	c := spill.NewCache(store, mem)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	defer func() { _ = c.Close() }()
+
+	key := execute.NewGroupKey(nil, nil)
+	b, _ := c.TableBuilder(key)
+	if _, err := b.AddCol(flux.ColMeta{Label: "_value", Type: flux.TInt}); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.GrowInts(0, 256); err != nil {
+		t.Fatal(err)
+	}
+
+	if !c.NearLimit() {
+		t.Fatal("expected more than half of the limit to be in use")
+	}
+
+	// The allocation only fits once the other builder is spilled.
+	calls := 0
+	if err := c.Guard(func() error {
+		calls++
+		buf := mem.Allocate(2048)
+		mem.Free(buf)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls, 2; got != want {
+		t.Fatalf("unexpected number of calls -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if c.NearLimit() {
+		t.Fatal("expected memory to be released after spilling")
+	}
+}
@@ -0,0 +1,486 @@
+// Package spill implements writing tables to temporary files so that
+// transformations that buffer large amounts of data can release their
+// memory when the allocator limit is reached instead of failing the query.
+//
+// Spilling is opt-in. It is enabled for a query by injecting a Dependency
+// into its context and all files are accessed through the
+// filesystem.Service of the query dependencies.
+package spill
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/ipc"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+	uuid "github.com/satori/go.uuid"
+)
+
+// batchSize is the number of rows that are written
+// to a single record batch when spilling a table builder.
+const batchSize = 1024
+
+type key int
+
+const dependencyKey key = iota
+
+// DefaultThreshold is the fraction of the memory allocation limit
+// that may be in use before transformations spill ahead of time
+// when the Dependency does not set a threshold.
+const DefaultThreshold = 0.5
+
+// Dependency enables spilling for the queries whose context it is injected into.
+type Dependency struct {
+	// Dir is the directory that spill files are created in.
+	Dir string
+
+	// Threshold is the fraction of the memory allocation limit that may be
+	// in use before transformations that cannot retry their work once they
+	// reach the limit spill their tables ahead of time. A lower threshold
+	// spills more often, but leaves more room for the work that cannot be
+	// retried. It must be between zero and one. If it is zero,
+	// DefaultThreshold is used.
+	Threshold float64
+}
+
+func (d Dependency) Inject(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dependencyKey, d)
+}
+
+// GetDependency returns the spill Dependency of the context
+// and whether spilling has been enabled.
+func GetDependency(ctx context.Context) (Dependency, bool) {
+	d, ok := ctx.Value(dependencyKey).(Dependency)
+	return d, ok
+}
+
+// Store writes tables to spill files and reads them back.
+// A Store is safe for concurrent use so that it can be closed
+// when the query is canceled while a transformation is using it.
+type Store struct {
+	fs  filesystem.Service
+	rm  filesystem.Remover
+	dir string
+	mem *memory.Allocator
+	// threshold is the fraction of the limit of mem
+	// above which NearLimit reports true.
+	threshold float64
+
+	mu       sync.Mutex
+	prefix   string
+	n        int
+	segments map[*Segment]bool
+	closed   bool
+	done     chan struct{}
+}
+
+// NewStore creates a Store for the query associated with the context.
+// Tables that are read back from the Store are allocated with mem.
+// The spill files are removed when the Store is closed or when
+// the context is done, whichever happens first, so the files
+// do not outlive the query even if it is canceled or fails.
+// If spilling has not been enabled for the query, this returns nil.
+func NewStore(ctx context.Context, mem *memory.Allocator) (*Store, error) {
+	d, ok := GetDependency(ctx)
+	if !ok {
+		return nil, nil
+	}
+	threshold := d.Threshold
+	if threshold < 0 || threshold > 1 {
+		return nil, errors.Newf(codes.Invalid, "spill threshold must be between 0 and 1, got %v", threshold)
+	} else if threshold == 0 {
+		threshold = DefaultThreshold
+	}
+	deps := flux.GetDependencies(ctx)
+	fs, err := deps.FilesystemService()
+	if err != nil {
+		return nil, err
+	}
+	rm, ok := fs.(filesystem.Remover)
+	if !ok {
+		return nil, errors.New(codes.Invalid, "spilling requires a filesystem service that can remove files")
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Internal, "could not generate spill file prefix")
+	}
+	s := &Store{
+		fs:        fs,
+		rm:        rm,
+		dir:       d.Dir,
+		mem:       mem,
+		threshold: threshold,
+		prefix:    "flux-spill-" + id.String(),
+		segments:  make(map[*Segment]bool),
+		done:      make(chan struct{}),
+	}
+	if ctxDone := ctx.Done(); ctxDone != nil {
+		go func() {
+			select {
+			case <-ctxDone:
+				_ = s.Close()
+			case <-s.done:
+			}
+		}()
+	}
+	return s, nil
+}
+
+// NearLimit reports whether more of the memory allocation limit
+// than the threshold of the Dependency is in use by the allocator
+// of the Store. Transformations that cannot retry their work
+// once they reach the limit use this to spill ahead of time.
+func (s *Store) NearLimit() bool {
+	return s.nearLimit(s.mem)
+}
+
+func (s *Store) nearLimit(mem *memory.Allocator) bool {
+	if mem == nil || mem.Limit == nil {
+		return false
+	}
+	return float64(mem.Allocated()) > s.threshold*float64(*mem.Limit)
+}
+
+// Segment references a table that was written to a spill file.
+type Segment struct {
+	key   flux.GroupKey
+	cols  []flux.ColMeta
+	path  string
+	nrows int
+}
+
+// Key returns the group key of the spilled table.
+func (s *Segment) Key() flux.GroupKey {
+	return s.key
+}
+
+// Cols returns the columns of the spilled table.
+func (s *Segment) Cols() []flux.ColMeta {
+	return s.cols
+}
+
+// Len returns the number of rows in the spilled table.
+func (s *Segment) Len() int {
+	return s.nrows
+}
+
+// Write writes the table to a new spill file. The table is consumed
+// by this call and its buffers are written without being copied.
+func (s *Store) Write(tbl flux.Table) (*Segment, error) {
+	w, err := s.create(tbl.Key(), tbl.Cols())
+	if err != nil {
+		tbl.Done()
+		return nil, err
+	}
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		arrs := make([]array.Interface, len(w.seg.cols))
+		for j := range arrs {
+			arrs[j] = table.Values(cr, j)
+		}
+		return w.write(arrs, cr.Len())
+	}); err != nil {
+		_ = w.close()
+		_ = s.Remove(w.seg)
+		return nil, err
+	}
+	if err := w.close(); err != nil {
+		_ = s.Remove(w.seg)
+		return nil, err
+	}
+	return w.seg, nil
+}
+
+// WriteBuilder writes the contents of the table builder to a new spill file.
+// The builder is left unmodified so the caller is free to clear its data
+// once this returns. The record batches are built directly from the
+// column data of the builder and only the null bitmaps, the boolean
+// bitmaps and the string buffers are allocated. That memory is not
+// counted against the query allocator since the memory is likely being
+// spilled because that allocator has reached its limit.
+func (s *Store) WriteBuilder(b *execute.ColListTableBuilder) (*Segment, error) {
+	w, err := s.create(b.Key(), b.Cols())
+	if err != nil {
+		return nil, err
+	}
+	for i, nrows := 0, b.NRows(); i < nrows; i += batchSize {
+		n := nrows - i
+		if n > batchSize {
+			n = batchSize
+		}
+		arrs := make([]array.Interface, len(w.seg.cols))
+		for j := range arrs {
+			arrs[j] = builderArray(b, j, i, n)
+		}
+		err := w.write(arrs, n)
+		for _, arr := range arrs {
+			arr.Release()
+		}
+		if err != nil {
+			_ = w.close()
+			_ = s.Remove(w.seg)
+			return nil, err
+		}
+	}
+	if err := w.close(); err != nil {
+		_ = s.Remove(w.seg)
+		return nil, err
+	}
+	return w.seg, nil
+}
+
+// builderArray returns an arrow array with the n values of column j
+// of the builder that start at row i. Fixed width values reference
+// the builder data without copying it.
+func builderArray(b *execute.ColListTableBuilder, j, i, n int) array.Interface {
+	var (
+		dt      arrow.DataType
+		buffers []*arrowmemory.Buffer
+	)
+	nulls, nullN := nullBitmap(b, j, i, n)
+	switch typ := b.Cols()[j].Type; typ {
+	case flux.TBool:
+		vs := b.Bools(j)[i : i+n]
+		bitmap := make([]byte, bitutil.BytesForBits(int64(n)))
+		for k, v := range vs {
+			if v {
+				bitutil.SetBit(bitmap, k)
+			}
+		}
+		dt = arrow.FixedWidthTypes.Boolean
+		buffers = []*arrowmemory.Buffer{nulls, arrowmemory.NewBufferBytes(bitmap)}
+	case flux.TInt:
+		dt = arrow.PrimitiveTypes.Int64
+		buffers = []*arrowmemory.Buffer{nulls, arrowmemory.NewBufferBytes(arrow.Int64Traits.CastToBytes(b.Ints(j)[i : i+n]))}
+	case flux.TUInt:
+		dt = arrow.PrimitiveTypes.Uint64
+		buffers = []*arrowmemory.Buffer{nulls, arrowmemory.NewBufferBytes(arrow.Uint64Traits.CastToBytes(b.UInts(j)[i : i+n]))}
+	case flux.TFloat:
+		dt = arrow.PrimitiveTypes.Float64
+		buffers = []*arrowmemory.Buffer{nulls, arrowmemory.NewBufferBytes(arrow.Float64Traits.CastToBytes(b.Floats(j)[i : i+n]))}
+	case flux.TTime:
+		ts := b.Times(j)[i : i+n]
+		vs := make([]int64, n)
+		for k, t := range ts {
+			vs[k] = int64(t)
+		}
+		dt = arrow.PrimitiveTypes.Int64
+		buffers = []*arrowmemory.Buffer{nulls, arrowmemory.NewBufferBytes(arrow.Int64Traits.CastToBytes(vs))}
+	case flux.TString:
+		vs := b.Strings(j)[i : i+n]
+		offsets := make([]int32, n+1)
+		sz := 0
+		for k, v := range vs {
+			offsets[k] = int32(sz)
+			sz += len(v)
+		}
+		offsets[n] = int32(sz)
+		data := make([]byte, 0, sz)
+		for _, v := range vs {
+			data = append(data, v...)
+		}
+		dt = arrow.BinaryTypes.Binary
+		buffers = []*arrowmemory.Buffer{
+			nulls,
+			arrowmemory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets)),
+			arrowmemory.NewBufferBytes(data),
+		}
+	default:
+		panic(errors.Newf(codes.Internal, "unimplemented column type: %s", typ))
+	}
+	data := array.NewData(dt, n, buffers, nil, nullN, 0)
+	defer data.Release()
+	return array.MakeFromData(data)
+}
+
+// nullBitmap returns the validity bitmap for the n values of column j
+// of the builder that start at row i and the number of null values.
+// The bitmap is nil if none of the values are null.
+func nullBitmap(b *execute.ColListTableBuilder, j, i, n int) (*arrowmemory.Buffer, int) {
+	var bitmap []byte
+	nullN := 0
+	for k := 0; k < n; k++ {
+		if !b.IsNil(i+k, j) {
+			continue
+		}
+		if bitmap == nil {
+			bitmap = make([]byte, bitutil.BytesForBits(int64(n)))
+			for l := 0; l < n; l++ {
+				bitutil.SetBit(bitmap, l)
+			}
+		}
+		bitutil.ClearBit(bitmap, k)
+		nullN++
+	}
+	if bitmap == nil {
+		return nil, 0
+	}
+	return arrowmemory.NewBufferBytes(bitmap), nullN
+}
+
+// Read returns a table that reads the segment back from its spill file.
+// The record batches are read one at a time when the table is consumed
+// and the segment remains valid so it may be read again.
+func (s *Store) Read(seg *Segment) (flux.Table, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.segments[seg] {
+		return nil, errors.Newf(codes.Internal, "spill segment %q does not exist", seg.path)
+	}
+	return &spilledTable{fs: s.fs, mem: s.mem, seg: seg}, nil
+}
+
+// Remove removes the spill file associated with the segment.
+func (s *Store) Remove(seg *Segment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.remove(seg)
+}
+
+func (s *Store) remove(seg *Segment) error {
+	if !s.segments[seg] {
+		return nil
+	}
+	delete(s.segments, seg)
+	return s.rm.Remove(seg.path)
+}
+
+// Close removes all of the spill files created by this Store.
+// The Store cannot be written to once it has been closed.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+	var firstErr error
+	for seg := range s.segments {
+		if err := s.remove(seg); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Guard calls f and returns an error instead of panicking
+// if f reaches the memory allocation limit. Other panics
+// are propagated to the caller.
+func Guard(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok && memory.IsLimitExceeded(e) {
+				err = e
+				return
+			}
+			panic(r)
+		}
+	}()
+	return f()
+}
+
+type segmentWriter struct {
+	f      filesystem.File
+	w      *ipc.Writer
+	schema *arrow.Schema
+	seg    *Segment
+}
+
+func (s *Store) create(key flux.GroupKey, cols []flux.ColMeta) (*segmentWriter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errors.New(codes.Canceled, "spill store is closed")
+	}
+	s.n++
+	fpath := path.Join(s.dir, fmt.Sprintf("%s-%d.arrow", s.prefix, s.n))
+	f, err := s.fs.Create(fpath)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Internal, "could not create spill file")
+	}
+	seg := &Segment{
+		key:  key,
+		cols: cols,
+		path: fpath,
+	}
+	s.segments[seg] = true
+
+	fields := make([]arrow.Field, len(cols))
+	for j, c := range cols {
+		fields[j] = arrow.Field{
+			Name:     c.Label,
+			Type:     arrowType(c.Type),
+			Nullable: true,
+		}
+	}
+	schema := arrow.NewSchema(fields, nil)
+	return &segmentWriter{
+		f:      f,
+		w:      ipc.NewWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(arrowmemory.DefaultAllocator)),
+		schema: schema,
+		seg:    seg,
+	}, nil
+}
+
+func (w *segmentWriter) write(arrs []array.Interface, n int) error {
+	for j, arr := range arrs {
+		if w.seg.cols[j].Type == flux.TString {
+			arrs[j] = retype(arr, arrow.BinaryTypes.Binary)
+			defer arrs[j].Release()
+		}
+	}
+	rec := array.NewRecord(w.schema, arrs, int64(n))
+	defer rec.Release()
+	if err := w.w.Write(rec); err != nil {
+		return errors.Wrap(err, codes.Internal, "could not write to spill file")
+	}
+	w.seg.nrows += n
+	return nil
+}
+
+func (w *segmentWriter) close() error {
+	if err := w.w.Close(); err != nil {
+		_ = w.f.Close()
+		return errors.Wrap(err, codes.Internal, "could not write to spill file")
+	}
+	return w.f.Close()
+}
+
+// arrowType returns the arrow data type used to store a column in a spill file.
+// Strings are stored as binary data because flux represents them
+// with binary arrays that the ipc writer does not accept as strings.
+func arrowType(typ flux.ColType) arrow.DataType {
+	switch typ {
+	case flux.TBool:
+		return arrow.FixedWidthTypes.Boolean
+	case flux.TInt, flux.TTime:
+		return arrow.PrimitiveTypes.Int64
+	case flux.TUInt:
+		return arrow.PrimitiveTypes.Uint64
+	case flux.TFloat:
+		return arrow.PrimitiveTypes.Float64
+	case flux.TString:
+		return arrow.BinaryTypes.Binary
+	default:
+		panic(errors.Newf(codes.Internal, "unimplemented column type: %s", typ))
+	}
+}
+
+// retype returns a binary array that shares the data of arr, but has the given data type.
+func retype(arr array.Interface, dt arrow.DataType) array.Interface {
+	data := arr.Data()
+	nd := array.NewData(dt, data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+	defer nd.Release()
+	return array.NewBinaryData(nd)
+}
@@ -0,0 +1,317 @@
+package spill_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/execute/spill"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+)
+
+func newStore(t *testing.T) (*spill.Store, string) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+	store, err := spill.NewStore(ctx, executetest.UnlimitedAllocator)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return store, dir
+}
+
+func assertDirEmpty(t *testing.T, dir string) {
+	t.Helper()
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected spill directory to be empty, found %d files", len(files))
+	}
+}
+
+func testTable() *executetest.Table {
+	return &executetest.Table{
+		KeyCols: []string{"t0"},
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "b", Type: flux.TBool},
+			{Label: "i", Type: flux.TInt},
+			{Label: "u", Type: flux.TUInt},
+			{Label: "t0", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{execute.Time(1), 1.0, true, int64(1), uint64(1), "a"},
+			{execute.Time(2), nil, false, nil, uint64(2), "a"},
+			{execute.Time(3), 3.0, nil, int64(3), nil, "a"},
+		},
+	}
+}
+
+func TestStore_Write(t *testing.T) {
+	store, dir := newStore(t)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	want := testTable()
+	seg, err := store.Write(testTable())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := seg.Len(), 3; got != want {
+		t.Fatalf("unexpected number of rows -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// The segment can be read more than once.
+	for i := 0; i < 2; i++ {
+		tbl, err := store.Read(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got.Normalize()
+		want.Normalize()
+		if !cmp.Equal(want, got) {
+			t.Fatalf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+		}
+	}
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	assertDirEmpty(t, dir)
+}
+
+func TestStore_WriteBuilder(t *testing.T) {
+	store, dir := newStore(t)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	want := testTable()
+	want.Normalize()
+
+	// Use more rows than a single record batch to
+	// ensure the table is written in multiple batches.
+	for i := 3; i < 2500; i++ {
+		want.Data = append(want.Data, []interface{}{
+			execute.Time(i + 1), float64(i), i%2 == 0, int64(i), uint64(i), "a",
+		})
+	}
+
+	input := *want
+	b := execute.NewColListTableBuilder(want.Key(), executetest.UnlimitedAllocator)
+	if err := execute.AddTableCols(&input, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := execute.AppendTable(&input, b); err != nil {
+		t.Fatal(err)
+	}
+
+	seg, err := store.WriteBuilder(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tbl, err := store.Read(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	if err := store.Remove(seg); err != nil {
+		t.Fatal(err)
+	}
+	assertDirEmpty(t, dir)
+
+	if _, err := store.Read(seg); err == nil {
+		t.Fatal("expected error reading removed segment")
+	}
+}
+
+func TestNewStore_Disabled(t *testing.T) {
+	ctx := dependenciestest.Default().Inject(context.Background())
+	store, err := spill.NewStore(ctx, executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store != nil {
+		t.Fatal("expected no store when spilling is not enabled")
+	}
+}
+
+func TestNewStore_RequiresRemover(t *testing.T) {
+	deps := dependenciestest.Default()
+	deps.Deps.FilesystemService = noRemoveFS{Service: filesystem.SystemFS}
+	ctx := deps.Inject(context.Background())
+	ctx = spill.Dependency{Dir: os.TempDir()}.Inject(ctx)
+	if _, err := spill.NewStore(ctx, executetest.UnlimitedAllocator); err == nil {
+		t.Fatal("expected error for a filesystem that cannot remove files")
+	}
+}
+
+// noRemoveFS hides the Remove method of the underlying filesystem.
+type noRemoveFS struct {
+	filesystem.Service
+}
+
+func TestStore_NearLimit(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		threshold float64
+		allocated int
+		want      bool
+	}{
+		{
+			name:      "below default threshold",
+			allocated: 400,
+		},
+		{
+			name:      "above default threshold",
+			allocated: 600,
+			want:      true,
+		},
+		{
+			name:      "below threshold",
+			threshold: 0.75,
+			allocated: 600,
+		},
+		{
+			name:      "above threshold",
+			threshold: 0.75,
+			allocated: 800,
+			want:      true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			limit := int64(1000)
+			mem := &memory.Allocator{Limit: &limit}
+			ctx := dependenciestest.Default().Inject(context.Background())
+			ctx = spill.Dependency{Dir: os.TempDir(), Threshold: tc.threshold}.Inject(ctx)
+			store, err := spill.NewStore(ctx, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = store.Close() }()
+
+			buf := mem.Allocate(tc.allocated)
+			defer mem.Free(buf)
+			if got := store.NearLimit(); got != tc.want {
+				t.Fatalf("unexpected near limit -want/+got:\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestNewStore_InvalidThreshold(t *testing.T) {
+	for _, threshold := range []float64{-0.5, 1.5} {
+		ctx := dependenciestest.Default().Inject(context.Background())
+		ctx = spill.Dependency{Dir: os.TempDir(), Threshold: threshold}.Inject(ctx)
+		if _, err := spill.NewStore(ctx, executetest.UnlimitedAllocator); err == nil {
+			t.Errorf("expected error for threshold %v", threshold)
+		}
+	}
+}
+
+func TestStore_ContextDone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx, cancel := context.WithCancel(dependenciestest.Default().Inject(context.Background()))
+	defer cancel()
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+	store, err := spill.NewStore(ctx, executetest.UnlimitedAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := store.Write(testTable()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Canceling the query context removes the spill files
+	// even though the store was never closed.
+	cancel()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected spill files to be removed after cancel, found %d files", len(files))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := store.Write(testTable()); err == nil {
+		t.Fatal("expected error writing to a closed store")
+	}
+	assertDirEmpty(t, dir)
+
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	limit := int64(64)
+	mem := &memory.Allocator{Limit: &limit}
+
+	err := spill.Guard(func() error {
+		b := mem.Allocate(128)
+		mem.Free(b)
+		return nil
+	})
+	if !memory.IsLimitExceeded(err) {
+		t.Fatalf("expected limit exceeded error, got: %v", err)
+	}
+
+	want := errors.New(codes.Internal, "expected error")
+	if got := spill.Guard(func() error { return want }); got != want {
+		t.Fatalf("unexpected error -want/+got:\n\t- %v\n\t+ %v", want, got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected unrelated panic to be propagated")
+		}
+	}()
+	_ = spill.Guard(func() error {
+		panic("unrelated")
+	})
+}
@@ -0,0 +1,84 @@
+package spill
+
+import (
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/influxdata/flux"
+	fluxarrow "github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/dependencies/filesystem"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+)
+
+// spilledTable is a flux.Table that reads its
+// buffers from a spill file as it is consumed.
+type spilledTable struct {
+	used int32
+	fs   filesystem.Service
+	mem  *memory.Allocator
+	seg  *Segment
+}
+
+func (t *spilledTable) Key() flux.GroupKey {
+	return t.seg.key
+}
+
+func (t *spilledTable) Cols() []flux.ColMeta {
+	return t.seg.cols
+}
+
+func (t *spilledTable) Do(f func(flux.ColReader) error) error {
+	if !atomic.CompareAndSwapInt32(&t.used, 0, 1) {
+		return errors.New(codes.Internal, "table already read")
+	}
+
+	file, err := t.fs.Open(t.seg.path)
+	if err != nil {
+		return errors.Wrap(err, codes.Internal, "could not open spill file")
+	}
+	defer func() { _ = file.Close() }()
+
+	rdr, err := ipc.NewReader(file, ipc.WithAllocator(t.mem))
+	if err != nil {
+		return errors.Wrap(err, codes.Internal, "could not read spill file")
+	}
+	defer rdr.Release()
+
+	for rdr.Next() {
+		rec := rdr.Record()
+		buf := &fluxarrow.TableBuffer{
+			GroupKey: t.seg.key,
+			Columns:  t.seg.cols,
+			Values:   make([]array.Interface, len(t.seg.cols)),
+		}
+		for j, c := range t.seg.cols {
+			if c.Type == flux.TString {
+				buf.Values[j] = retype(rec.Column(j), arrow.BinaryTypes.String)
+				continue
+			}
+			buf.Values[j] = rec.Column(j)
+			buf.Values[j].Retain()
+		}
+		err := f(buf)
+		buf.Release()
+		if err != nil {
+			return err
+		}
+	}
+	if err := rdr.Err(); err != nil {
+		return errors.Wrap(err, codes.Internal, "could not read spill file")
+	}
+	return nil
+}
+
+func (t *spilledTable) Done() {
+	atomic.StoreInt32(&t.used, 1)
+}
+
+func (t *spilledTable) Empty() bool {
+	return t.seg.nrows == 0
+}
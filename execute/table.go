@@ -1242,6 +1242,11 @@ func (b *ColListTableBuilder) Times(j int) []values.Time {
 	return b.cols[j].(*timeColumnBuilder).data
 }
 
+// IsNil reports whether the value at row i of column j is null.
+func (b *ColListTableBuilder) IsNil(i, j int) bool {
+	return b.cols[j].IsNil(i)
+}
+
 // GetRow takes a row index and returns the record located at that index in the cache
 func (b *ColListTableBuilder) GetRow(row int) values.Object {
 	record, _ := values.BuildObjectWithSize(len(b.colMeta), func(set values.ObjectSetter) error {
@@ -1542,6 +1547,14 @@ func (c *columnBuilderBase) IsNil(i int) bool {
 	return c.nils[i]
 }
 
+// clearNils forgets which rows were null so the
+// cleared column does not report stale nulls.
+func (c *columnBuilderBase) clearNils() {
+	for i := range c.nils {
+		delete(c.nils, i)
+	}
+}
+
 func (c *columnBuilderBase) SetNil(i int, isNil bool) {
 	if isNil {
 		c.nils[i] = isNil
@@ -1619,6 +1632,7 @@ type boolColumnBuilder struct {
 }
 
 func (c *boolColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
@@ -1704,6 +1718,7 @@ type intColumnBuilder struct {
 }
 
 func (c *intColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
@@ -1786,6 +1801,7 @@ type uintColumnBuilder struct {
 }
 
 func (c *uintColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
@@ -1869,6 +1885,7 @@ type floatColumnBuilder struct {
 }
 
 func (c *floatColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
@@ -1952,6 +1969,7 @@ type stringColumnBuilder struct {
 }
 
 func (c *stringColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
@@ -2042,6 +2060,7 @@ type timeColumnBuilder struct {
 }
 
 func (c *timeColumnBuilder) Clear() {
+	c.clearNils()
 	c.data = c.data[0:0]
 }
 
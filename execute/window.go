@@ -12,6 +12,13 @@ type Window struct {
 	Every  Duration
 	Period Duration
 	Offset Duration
+	// Location is the time zone used to align the window boundaries.
+	// Windows of a day or longer follow the calendar of the location,
+	// so a day that contains a daylight saving time transition
+	// will be 23 or 25 hours long. Shorter windows are aligned
+	// to the standard offset of the location so they remain evenly
+	// spaced across a transition.
+	Location Location
 }
 
 // NewWindow creates a window with the given parameters,
@@ -19,13 +26,20 @@ type Window struct {
 // It also validates that the durations are valid when
 // used within a window.
 func NewWindow(every, period, offset Duration) (Window, error) {
+	return NewWindowInLocation(every, period, offset, UTC)
+}
+
+// NewWindowInLocation creates a window with the given parameters
+// whose boundaries are aligned using the given location.
+func NewWindowInLocation(every, period, offset Duration, loc Location) (Window, error) {
 	// Normalize the offset to a small positive duration
 	offset = offset.Normalize(every)
 
 	w := Window{
-		Every:  every,
-		Period: period,
-		Offset: offset,
+		Every:    every,
+		Period:   period,
+		Offset:   offset,
+		Location: loc,
 	}
 	if err := w.IsValid(); err != nil {
 		return Window{}, err
@@ -60,16 +74,49 @@ func (w *Window) truncate(t Time) Time {
 // IsValid will check if this Window is valid and it will
 // return an error if it isn't.
 func (w Window) IsValid() error {
-	_, err := w.getTruncateFunc(w.Every)
-	return err
+	if _, err := w.getTruncateFunc(w.Every); err != nil {
+		return err
+	}
+	if _, err := w.Location.zone(); err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetEarliestBounds returns the bounds for the earliest window bounds
-// that contains the given time t.  For underlapping windows that
-// do not contain time t, the window directly after time t will be returned.
-func (w Window) GetEarliestBounds(t Time) Bounds {
-	// translate to not-offset coordinate
-	t = t.Add(w.Offset.Mul(-1))
+// isCalendar reports whether the window boundaries follow the
+// calendar of the location. This is true for windows that are
+// measured in months or in a whole number of days.
+func (w Window) isCalendar() bool {
+	if w.Location.IsUTC() {
+		return false
+	}
+	const day = int64(24 * time.Hour)
+	return w.Every.Months() != 0 || w.Every.Nanoseconds()%day == 0
+}
+
+// toLocal translates the time into the coordinate
+// where the window boundaries are computed.
+func (w Window) toLocal(t Time) Time {
+	if w.isCalendar() {
+		return w.Location.ToLocal(t)
+	}
+	return t + Time(w.Location.standardOffsetAt(t))
+}
+
+// fromLocal translates the time from the coordinate
+// where the window boundaries are computed.
+func (w Window) fromLocal(t Time) Time {
+	if w.isCalendar() {
+		return w.Location.FromLocal(t)
+	}
+	return t - Time(w.Location.standardOffsetAt(t))
+}
+
+// getEarliestLocalBounds computes the earliest bounds that contain
+// the time t in the coordinate returned by toLocal.
+func (w Window) getEarliestLocalBounds(t Time) Bounds {
+	// translate to local and not-offset coordinate
+	t = w.toLocal(t).Add(w.Offset.Mul(-1))
 
 	stop := w.truncate(t).Add(w.Every)
 
@@ -83,6 +130,17 @@ func (w Window) GetEarliestBounds(t Time) Bounds {
 	}
 }
 
+// GetEarliestBounds returns the bounds for the earliest window bounds
+// that contains the given time t.  For underlapping windows that
+// do not contain time t, the window directly after time t will be returned.
+func (w Window) GetEarliestBounds(t Time) Bounds {
+	b := w.getEarliestLocalBounds(t)
+	return Bounds{
+		Start: w.fromLocal(b.Start),
+		Stop:  w.fromLocal(b.Stop),
+	}
+}
+
 // GetOverlappingBounds returns a slice of bounds for each window
 // that overlaps the input bounds b.
 func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
@@ -94,9 +152,18 @@ func (w Window) GetOverlappingBounds(b Bounds) []Bounds {
 	c := (b.Duration().Duration() / w.Every.Duration()) + (w.Period.Duration() / w.Every.Duration())
 	bs := make([]Bounds, 0, c)
 
-	bi := w.GetEarliestBounds(b.Start)
-	for bi.Start < b.Stop {
-		bs = append(bs, bi)
+	// Step through the windows in the local coordinate so that
+	// calendar based windows are advanced using the local calendar.
+	bi := w.getEarliestLocalBounds(b.Start)
+	for {
+		bounds := Bounds{
+			Start: w.fromLocal(bi.Start),
+			Stop:  w.fromLocal(bi.Stop),
+		}
+		if bounds.Start >= b.Stop {
+			break
+		}
+		bs = append(bs, bounds)
 		bi.Start = bi.Start.Add(w.Every)
 		bi.Stop = bi.Stop.Add(w.Every)
 	}
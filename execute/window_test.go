@@ -385,6 +385,125 @@ func TestWindow_GetOverlappingBounds(t *testing.T) {
 	}
 }
 
+func TestWindow_Location(t *testing.T) {
+	ts, ds := mustParseTime, mustParseDuration
+	testcases := []struct {
+		name string
+		w    execute.Window
+		b    execute.Bounds
+		want []execute.Bounds
+	}{
+		{
+			name: "days across daylight saving time start",
+			w:    MustWindowInLocation(ds("1d"), ds("1d"), ds("0s"), "America/New_York", ds("0s")),
+			b: execute.Bounds{
+				Start: ts("2020-03-07T12:00:00Z"),
+				Stop:  ts("2020-03-09T12:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2020-03-07T05:00:00Z"), Stop: ts("2020-03-08T05:00:00Z")},
+				{Start: ts("2020-03-08T05:00:00Z"), Stop: ts("2020-03-09T04:00:00Z")},
+				{Start: ts("2020-03-09T04:00:00Z"), Stop: ts("2020-03-10T04:00:00Z")},
+			},
+		},
+		{
+			name: "days across daylight saving time end",
+			w:    MustWindowInLocation(ds("1d"), ds("1d"), ds("0s"), "Europe/Berlin", ds("0s")),
+			b: execute.Bounds{
+				Start: ts("2020-10-24T12:00:00Z"),
+				Stop:  ts("2020-10-25T12:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2020-10-23T22:00:00Z"), Stop: ts("2020-10-24T22:00:00Z")},
+				{Start: ts("2020-10-24T22:00:00Z"), Stop: ts("2020-10-25T23:00:00Z")},
+			},
+		},
+		{
+			name: "months",
+			w:    MustWindowInLocation(ds("1mo"), ds("1mo"), ds("0s"), "Europe/Berlin", ds("0s")),
+			b: execute.Bounds{
+				Start: ts("2020-02-15T00:00:00Z"),
+				Stop:  ts("2020-04-15T00:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2020-01-31T23:00:00Z"), Stop: ts("2020-02-29T23:00:00Z")},
+				{Start: ts("2020-02-29T23:00:00Z"), Stop: ts("2020-03-31T22:00:00Z")},
+				{Start: ts("2020-03-31T22:00:00Z"), Stop: ts("2020-04-30T22:00:00Z")},
+			},
+		},
+		{
+			name: "hours with half hour zone",
+			w:    MustWindowInLocation(ds("1h"), ds("1h"), ds("0s"), "Asia/Kolkata", ds("0s")),
+			b: execute.Bounds{
+				Start: ts("2020-01-01T00:10:00Z"),
+				Stop:  ts("2020-01-01T01:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2019-12-31T23:30:00Z"), Stop: ts("2020-01-01T00:30:00Z")},
+				{Start: ts("2020-01-01T00:30:00Z"), Stop: ts("2020-01-01T01:30:00Z")},
+			},
+		},
+		{
+			name: "hours across daylight saving time end",
+			w:    MustWindowInLocation(ds("2h"), ds("2h"), ds("0s"), "Europe/Berlin", ds("0s")),
+			b: execute.Bounds{
+				Start: ts("2020-10-24T23:00:00Z"),
+				Stop:  ts("2020-10-25T05:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2020-10-24T23:00:00Z"), Stop: ts("2020-10-25T01:00:00Z")},
+				{Start: ts("2020-10-25T01:00:00Z"), Stop: ts("2020-10-25T03:00:00Z")},
+				{Start: ts("2020-10-25T03:00:00Z"), Stop: ts("2020-10-25T05:00:00Z")},
+			},
+		},
+		{
+			name: "fixed offset",
+			w:    MustWindowInLocation(ds("1d"), ds("1d"), ds("0s"), "UTC", ds("-8h")),
+			b: execute.Bounds{
+				Start: ts("2020-01-01T00:00:00Z"),
+				Stop:  ts("2020-01-01T12:00:00Z"),
+			},
+			want: []execute.Bounds{
+				{Start: ts("2019-12-31T08:00:00Z"), Stop: ts("2020-01-01T08:00:00Z")},
+				{Start: ts("2020-01-01T08:00:00Z"), Stop: ts("2020-01-02T08:00:00Z")},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.w.GetOverlappingBounds(tc.b)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("got unexpected bounds; -want/+got:\n%v\n", cmp.Diff(tc.want, got))
+			}
+			for _, b := range got {
+				if want, got := b, tc.w.GetEarliestBounds(b.Start); !cmp.Equal(want, got) {
+					t.Errorf("got unexpected earliest bounds; -want/+got:\n%v\n", cmp.Diff(want, got))
+				}
+			}
+		})
+	}
+
+	t.Run("invalid zone", func(t *testing.T) {
+		if _, err := execute.NewLocation("Mars/Olympus_Mons", ds("0s")); err == nil {
+			t.Error("expected error for unknown time zone")
+		}
+	})
+}
+
+func MustWindowInLocation(every, period, offset execute.Duration, zone string, zoneOffset execute.Duration) execute.Window {
+	loc, err := execute.NewLocation(zone, zoneOffset)
+	if err != nil {
+		panic(err)
+	}
+	w, err := execute.NewWindowInLocation(every, period, offset, loc)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
 func MustWindow(every, period, offset execute.Duration) execute.Window {
 	w, err := execute.NewWindow(every, period, offset)
 	if err != nil {
@@ -17,7 +17,7 @@ require (
 	github.com/eclipse/paho.mqtt.golang v1.2.0
 	github.com/go-sql-driver/mysql v1.5.0
 	github.com/golang/geo v0.0.0-20190916061304-5b978397cfec
-	github.com/golang/snappy v0.0.0-20180518054509-2e65f85255db // indirect
+	github.com/golang/snappy v0.0.1
 	github.com/google/flatbuffers v1.11.0
 	github.com/google/go-cmp v0.3.0
 	github.com/google/uuid v1.1.1 // indirect
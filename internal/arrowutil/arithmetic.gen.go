@@ -0,0 +1,377 @@
+// Generated by tmpl
+// https://github.com/benbjohnson/tmpl
+//
+// DO NOT EDIT!
+// Source: arithmetic.gen.go.tmpl
+
+package arrowutil
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// Add returns the sum of the values at each index of the arrays.
+// Strings are concatenated. The result is null where either value is null.
+func Add(l, r array.Interface, mem memory.Allocator) array.Interface {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Add(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Add(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Add(l, r.(*array.Float64), mem)
+
+	case *array.Binary:
+		return StringAdd(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Subtract returns the difference of the values at each index of the arrays.
+// The result is null where either value is null.
+func Subtract(l, r array.Interface, mem memory.Allocator) array.Interface {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Subtract(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Subtract(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Subtract(l, r.(*array.Float64), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Multiply returns the product of the values at each index of the arrays.
+// The result is null where either value is null.
+func Multiply(l, r array.Interface, mem memory.Allocator) array.Interface {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Multiply(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Multiply(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Multiply(l, r.(*array.Float64), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Divide returns the quotient of the values at each index of the arrays.
+// The result is null where either value is null.
+// It returns an error if a divisor that is not null is zero.
+func Divide(l, r array.Interface, mem memory.Allocator) (array.Interface, error) {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Divide(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Divide(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Divide(l, r.(*array.Float64), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Modulo returns the remainder of the values at each index of the arrays.
+// The result is null where either value is null.
+// It returns an error if a divisor that is not null is zero.
+func Modulo(l, r array.Interface, mem memory.Allocator) (array.Interface, error) {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Modulo(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Modulo(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Modulo(l, r.(*array.Float64), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+func Int64Add(l, r *array.Int64, mem memory.Allocator) *array.Int64 {
+	n := l.Len()
+	b := NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) + r.Value(i))
+	}
+	return b.NewInt64Array()
+}
+
+func Int64Subtract(l, r *array.Int64, mem memory.Allocator) *array.Int64 {
+	n := l.Len()
+	b := NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) - r.Value(i))
+	}
+	return b.NewInt64Array()
+}
+
+func Int64Multiply(l, r *array.Int64, mem memory.Allocator) *array.Int64 {
+	n := l.Len()
+	b := NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) * r.Value(i))
+	}
+	return b.NewInt64Array()
+}
+
+func Int64Divide(l, r *array.Int64, mem memory.Allocator) (*array.Int64, error) {
+	n := l.Len()
+	b := NewInt64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+		b.Append(l.Value(i) / rv)
+	}
+	return b.NewInt64Array(), nil
+}
+
+func Int64Modulo(l, r *array.Int64, mem memory.Allocator) (*array.Int64, error) {
+	n := l.Len()
+	b := NewInt64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(l.Value(i) % rv)
+
+	}
+	return b.NewInt64Array(), nil
+}
+
+func Uint64Add(l, r *array.Uint64, mem memory.Allocator) *array.Uint64 {
+	n := l.Len()
+	b := NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) + r.Value(i))
+	}
+	return b.NewUint64Array()
+}
+
+func Uint64Subtract(l, r *array.Uint64, mem memory.Allocator) *array.Uint64 {
+	n := l.Len()
+	b := NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) - r.Value(i))
+	}
+	return b.NewUint64Array()
+}
+
+func Uint64Multiply(l, r *array.Uint64, mem memory.Allocator) *array.Uint64 {
+	n := l.Len()
+	b := NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) * r.Value(i))
+	}
+	return b.NewUint64Array()
+}
+
+func Uint64Divide(l, r *array.Uint64, mem memory.Allocator) (*array.Uint64, error) {
+	n := l.Len()
+	b := NewUint64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+		b.Append(l.Value(i) / rv)
+	}
+	return b.NewUint64Array(), nil
+}
+
+func Uint64Modulo(l, r *array.Uint64, mem memory.Allocator) (*array.Uint64, error) {
+	n := l.Len()
+	b := NewUint64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(l.Value(i) % rv)
+
+	}
+	return b.NewUint64Array(), nil
+}
+
+func Float64Add(l, r *array.Float64, mem memory.Allocator) *array.Float64 {
+	n := l.Len()
+	b := NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) + r.Value(i))
+	}
+	return b.NewFloat64Array()
+}
+
+func Float64Subtract(l, r *array.Float64, mem memory.Allocator) *array.Float64 {
+	n := l.Len()
+	b := NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) - r.Value(i))
+	}
+	return b.NewFloat64Array()
+}
+
+func Float64Multiply(l, r *array.Float64, mem memory.Allocator) *array.Float64 {
+	n := l.Len()
+	b := NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) * r.Value(i))
+	}
+	return b.NewFloat64Array()
+}
+
+func Float64Divide(l, r *array.Float64, mem memory.Allocator) (*array.Float64, error) {
+	n := l.Len()
+	b := NewFloat64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot divide by zero")
+		}
+		b.Append(l.Value(i) / rv)
+	}
+	return b.NewFloat64Array(), nil
+}
+
+func Float64Modulo(l, r *array.Float64, mem memory.Allocator) (*array.Float64, error) {
+	n := l.Len()
+	b := NewFloat64Builder(mem)
+	defer b.Release()
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		rv := r.Value(i)
+		if rv == 0 {
+			return nil, errors.New(codes.FailedPrecondition, "cannot mod zero")
+		}
+
+		b.Append(math.Mod(l.Value(i), rv))
+
+	}
+	return b.NewFloat64Array(), nil
+}
+
+func StringAdd(l, r *array.Binary, mem memory.Allocator) *array.Binary {
+	n := l.Len()
+	b := NewStringBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.AppendString(l.ValueString(i) + r.ValueString(i))
+	}
+	return b.NewBinaryArray()
+}
@@ -0,0 +1,516 @@
+// Generated by tmpl
+// https://github.com/benbjohnson/tmpl
+//
+// DO NOT EDIT!
+// Source: compare.gen.go.tmpl
+
+package arrowutil
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Equal reports whether the values at each index of the arrays are equal.
+// The result is null where either value is null.
+func Equal(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Equal(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Equal(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Equal(l, r.(*array.Float64), mem)
+
+	case *array.Boolean:
+		return BooleanEqual(l, r.(*array.Boolean), mem)
+
+	case *array.Binary:
+		return StringEqual(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// NotEqual reports whether the values at each index of the arrays are not equal.
+// The result is null where either value is null.
+func NotEqual(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64NotEqual(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64NotEqual(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64NotEqual(l, r.(*array.Float64), mem)
+
+	case *array.Boolean:
+		return BooleanNotEqual(l, r.(*array.Boolean), mem)
+
+	case *array.Binary:
+		return StringNotEqual(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Less reports whether the values at each index of l are less than those of r.
+// The result is null where either value is null.
+func Less(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Less(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Less(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Less(l, r.(*array.Float64), mem)
+
+	case *array.Binary:
+		return StringLess(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// LessEqual reports whether the values at each index of l are less than or equal to those of r.
+// The result is null where either value is null.
+func LessEqual(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64LessEqual(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64LessEqual(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64LessEqual(l, r.(*array.Float64), mem)
+
+	case *array.Binary:
+		return StringLessEqual(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// Greater reports whether the values at each index of l are greater than those of r.
+// The result is null where either value is null.
+func Greater(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64Greater(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64Greater(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64Greater(l, r.(*array.Float64), mem)
+
+	case *array.Binary:
+		return StringGreater(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+// GreaterEqual reports whether the values at each index of l are greater than or equal to those of r.
+// The result is null where either value is null.
+func GreaterEqual(l, r array.Interface, mem memory.Allocator) *array.Boolean {
+	switch l := l.(type) {
+
+	case *array.Int64:
+		return Int64GreaterEqual(l, r.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return Uint64GreaterEqual(l, r.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return Float64GreaterEqual(l, r.(*array.Float64), mem)
+
+	case *array.Binary:
+		return StringGreaterEqual(l, r.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", l.DataType()))
+	}
+}
+
+func Int64Equal(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) == r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Int64NotEqual(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) != r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Int64Less(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) < r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Int64LessEqual(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) <= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Int64Greater(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) > r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Int64GreaterEqual(l, r *array.Int64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) >= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64Equal(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) == r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64NotEqual(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) != r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64Less(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) < r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64LessEqual(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) <= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64Greater(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) > r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Uint64GreaterEqual(l, r *array.Uint64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) >= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64Equal(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) == r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64NotEqual(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) != r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64Less(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) < r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64LessEqual(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) <= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64Greater(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) > r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func Float64GreaterEqual(l, r *array.Float64, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) >= r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func BooleanEqual(l, r *array.Boolean, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) == r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func BooleanNotEqual(l, r *array.Boolean, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.Value(i) != r.Value(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringEqual(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) == r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringNotEqual(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) != r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringLess(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) < r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringLessEqual(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) <= r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringGreater(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) > r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
+
+func StringGreaterEqual(l, r *array.Binary, mem memory.Allocator) *array.Boolean {
+	n := l.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		if l.IsNull(i) || r.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		b.Append(l.ValueString(i) >= r.ValueString(i))
+	}
+	return b.NewBooleanArray()
+}
@@ -5,3 +5,6 @@ package arrowutil
 //go:generate tmpl -data=@types.tmpldata -o iterator.gen.go iterator.gen.go.tmpl
 //go:generate tmpl -data=@types.tmpldata -o iterator.gen_test.go iterator.gen_test.go.tmpl
 //go:generate tmpl -data=@types.tmpldata -o filter.gen.go filter.gen.go.tmpl
+//go:generate tmpl -data=@types.tmpldata -o arithmetic.gen.go arithmetic.gen.go.tmpl
+//go:generate tmpl -data=@types.tmpldata -o compare.gen.go compare.gen.go.tmpl
+//go:generate tmpl -data=@types.tmpldata -o select.gen.go select.gen.go.tmpl
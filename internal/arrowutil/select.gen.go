@@ -0,0 +1,129 @@
+// Generated by tmpl
+// https://github.com/benbjohnson/tmpl
+//
+// DO NOT EDIT!
+// Source: select.gen.go.tmpl
+
+package arrowutil
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Select returns the value of c at each index where test is true
+// and the value of a at each index where test is false or null.
+func Select(test *array.Boolean, c, a array.Interface, mem memory.Allocator) array.Interface {
+	switch c := c.(type) {
+
+	case *array.Int64:
+		return SelectInt64s(test, c, a.(*array.Int64), mem)
+
+	case *array.Uint64:
+		return SelectUint64s(test, c, a.(*array.Uint64), mem)
+
+	case *array.Float64:
+		return SelectFloat64s(test, c, a.(*array.Float64), mem)
+
+	case *array.Boolean:
+		return SelectBooleans(test, c, a.(*array.Boolean), mem)
+
+	case *array.Binary:
+		return SelectStrings(test, c, a.(*array.Binary), mem)
+
+	default:
+		panic(fmt.Errorf("unsupported array data type: %s", c.DataType()))
+	}
+}
+
+func SelectInt64s(test *array.Boolean, c, a *array.Int64, mem memory.Allocator) *array.Int64 {
+	n := test.Len()
+	b := NewInt64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		arr := a
+		if test.IsValid(i) && test.Value(i) {
+			arr = c
+		}
+		if arr.IsValid(i) {
+			b.Append(arr.Value(i))
+		} else {
+			b.AppendNull()
+		}
+	}
+	return b.NewInt64Array()
+}
+
+func SelectUint64s(test *array.Boolean, c, a *array.Uint64, mem memory.Allocator) *array.Uint64 {
+	n := test.Len()
+	b := NewUint64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		arr := a
+		if test.IsValid(i) && test.Value(i) {
+			arr = c
+		}
+		if arr.IsValid(i) {
+			b.Append(arr.Value(i))
+		} else {
+			b.AppendNull()
+		}
+	}
+	return b.NewUint64Array()
+}
+
+func SelectFloat64s(test *array.Boolean, c, a *array.Float64, mem memory.Allocator) *array.Float64 {
+	n := test.Len()
+	b := NewFloat64Builder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		arr := a
+		if test.IsValid(i) && test.Value(i) {
+			arr = c
+		}
+		if arr.IsValid(i) {
+			b.Append(arr.Value(i))
+		} else {
+			b.AppendNull()
+		}
+	}
+	return b.NewFloat64Array()
+}
+
+func SelectBooleans(test *array.Boolean, c, a *array.Boolean, mem memory.Allocator) *array.Boolean {
+	n := test.Len()
+	b := NewBooleanBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		arr := a
+		if test.IsValid(i) && test.Value(i) {
+			arr = c
+		}
+		if arr.IsValid(i) {
+			b.Append(arr.Value(i))
+		} else {
+			b.AppendNull()
+		}
+	}
+	return b.NewBooleanArray()
+}
+
+func SelectStrings(test *array.Boolean, c, a *array.Binary, mem memory.Allocator) *array.Binary {
+	n := test.Len()
+	b := NewStringBuilder(mem)
+	b.Resize(n)
+	for i := 0; i < n; i++ {
+		arr := a
+		if test.IsValid(i) && test.Value(i) {
+			arr = c
+		}
+		if arr.IsValid(i) {
+			b.AppendString(arr.ValueString(i))
+		} else {
+			b.AppendNull()
+		}
+	}
+	return b.NewBinaryArray()
+}
@@ -0,0 +1,118 @@
+package metric
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// jsonMetric is the JSON encoding of a metric. It is the same as
+// the encoding that is used by the Telegraf JSON serializer.
+type jsonMetric struct {
+	Name      string                 `json:"name"`
+	Tags      map[string]string      `json:"tags"`
+	Fields    map[string]interface{} `json:"fields"`
+	Timestamp json.RawMessage        `json:"timestamp"`
+}
+
+// ParseJSON parses metrics that are encoded as JSON.
+// The data is either a single metric, an array of metrics
+// or an object with the metrics in the metrics property:
+//
+//	{
+//	    "name": "cpu",
+//	    "tags": {"host": "server01"},
+//	    "fields": {"usage_idle": 98.2},
+//	    "timestamp": 1577836800
+//	}
+//
+// A numeric timestamp is the number of seconds since the Unix epoch
+// and a string timestamp is an RFC3339 time. All numeric fields
+// are read as floats.
+func ParseJSON(data []byte) ([]Metric, error) {
+	data = bytes.TrimSpace(data)
+	var jms []jsonMetric
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &jms); err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "invalid JSON metrics")
+		}
+	} else {
+		var batch struct {
+			jsonMetric
+			Metrics []jsonMetric `json:"metrics"`
+		}
+		if err := json.Unmarshal(data, &batch); err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "invalid JSON metrics")
+		}
+		if batch.Metrics != nil {
+			jms = batch.Metrics
+		} else {
+			jms = []jsonMetric{batch.jsonMetric}
+		}
+	}
+
+	metrics := make([]Metric, len(jms))
+	for i, jm := range jms {
+		m, err := jm.metric()
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid JSON metric %d", i)
+		}
+		metrics[i] = m
+	}
+	return metrics, nil
+}
+
+func (jm *jsonMetric) metric() (Metric, error) {
+	m := Metric{Name: jm.Name}
+	if m.Name == "" {
+		return m, errors.New(codes.Invalid, "missing name")
+	}
+	for k, v := range jm.Tags {
+		m.Tags = append(m.Tags, Tag{Key: k, Value: v})
+	}
+	sort.Slice(m.Tags, func(i, j int) bool {
+		return m.Tags[i].Key < m.Tags[j].Key
+	})
+
+	if len(jm.Fields) == 0 {
+		return m, errors.New(codes.Invalid, "missing fields")
+	}
+	for k, v := range jm.Fields {
+		switch v.(type) {
+		case float64, string, bool:
+		default:
+			return m, errors.Newf(codes.Invalid, "field %q has an unsupported value %v", k, v)
+		}
+		m.Fields = append(m.Fields, Field{Key: k, Value: v})
+	}
+	sort.Slice(m.Fields, func(i, j int) bool {
+		return m.Fields[i].Key < m.Fields[j].Key
+	})
+
+	if len(jm.Timestamp) == 0 || string(jm.Timestamp) == "null" {
+		return m, nil
+	}
+	var ts interface{}
+	if err := json.Unmarshal(jm.Timestamp, &ts); err != nil {
+		return m, err
+	}
+	switch ts := ts.(type) {
+	case float64:
+		sec, frac := math.Modf(ts)
+		m.Time = time.Unix(int64(sec), int64(frac*1e9)).UTC()
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			return m, errors.Wrap(err, codes.Invalid, "invalid timestamp")
+		}
+		m.Time = t.UTC()
+	default:
+		return m, errors.Newf(codes.Invalid, "invalid timestamp %s", jm.Timestamp)
+	}
+	return m, nil
+}
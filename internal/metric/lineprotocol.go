@@ -0,0 +1,235 @@
+package metric
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// ParseLineProtocol parses metrics that are encoded as line protocol.
+// Timestamps are read with nanosecond precision. Empty lines and
+// lines that start with a # are ignored.
+func ParseLineProtocol(data []byte) ([]Metric, error) {
+	p := &lpParser{data: data, line: 1}
+	var metrics []Metric
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return metrics, nil
+		}
+		switch p.peek() {
+		case '\n':
+			p.next()
+			p.line++
+			continue
+		case '#':
+			p.skipLine()
+			continue
+		}
+		m, err := p.parseMetric()
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Invalid, "invalid line protocol on line %d", p.line)
+		}
+		metrics = append(metrics, m)
+	}
+}
+
+type lpParser struct {
+	data []byte
+	pos  int
+	line int
+}
+
+func (p *lpParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *lpParser) peek() byte {
+	return p.data[p.pos]
+}
+
+func (p *lpParser) next() byte {
+	c := p.data[p.pos]
+	p.pos++
+	return c
+}
+
+func (p *lpParser) skipSpace() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t' || p.peek() == '\r') {
+		p.pos++
+	}
+}
+
+func (p *lpParser) skipLine() {
+	for !p.eof() && p.peek() != '\n' {
+		p.pos++
+	}
+}
+
+// atEndOfLine reports whether there is nothing
+// but whitespace left on the current line.
+func (p *lpParser) atEndOfLine() bool {
+	p.skipSpace()
+	return p.eof() || p.peek() == '\n'
+}
+
+func (p *lpParser) parseMetric() (Metric, error) {
+	var m Metric
+	name := p.readToken(",= ", " ,")
+	if name == "" {
+		return m, errors.New(codes.Invalid, "missing measurement")
+	}
+	m.Name = name
+
+	// The tag set is optional and follows the measurement.
+	for !p.eof() && p.peek() == ',' {
+		p.next()
+		key := p.readToken(",= ", "=")
+		if err := p.expect('='); err != nil {
+			return m, err
+		}
+		value := p.readToken(",= ", " ,")
+		if key == "" || value == "" {
+			return m, errors.New(codes.Invalid, "missing tag key or value")
+		}
+		m.Tags = append(m.Tags, Tag{Key: key, Value: value})
+	}
+	sort.Slice(m.Tags, func(i, j int) bool {
+		return m.Tags[i].Key < m.Tags[j].Key
+	})
+
+	if p.eof() || p.peek() != ' ' {
+		return m, errors.New(codes.Invalid, "missing fields")
+	}
+	p.skipSpace()
+	for {
+		key := p.readToken(",= ", "=")
+		if key == "" {
+			return m, errors.New(codes.Invalid, "missing field key")
+		}
+		if err := p.expect('='); err != nil {
+			return m, err
+		}
+		value, err := p.readFieldValue()
+		if err != nil {
+			return m, errors.Wrapf(err, codes.Inherit, "invalid value for field %q", key)
+		}
+		m.Fields = append(m.Fields, Field{Key: key, Value: value})
+		if p.eof() || p.peek() != ',' {
+			break
+		}
+		p.next()
+	}
+
+	if p.atEndOfLine() {
+		return m, nil
+	}
+	start := p.pos
+	for !p.eof() && p.peek() != ' ' && p.peek() != '\t' && p.peek() != '\r' && p.peek() != '\n' {
+		p.pos++
+	}
+	ts, err := strconv.ParseInt(string(p.data[start:p.pos]), 10, 64)
+	if err != nil {
+		return m, errors.Newf(codes.Invalid, "invalid timestamp %q", p.data[start:p.pos])
+	}
+	m.Time = time.Unix(0, ts).UTC()
+	if !p.atEndOfLine() {
+		return m, errors.New(codes.Invalid, "unexpected data after timestamp")
+	}
+	return m, nil
+}
+
+func (p *lpParser) expect(c byte) error {
+	if p.eof() || p.peek() != c {
+		return errors.Newf(codes.Invalid, "expected %q", c)
+	}
+	p.next()
+	return nil
+}
+
+// readToken reads a measurement, tag key, tag value or field key.
+// A backslash escapes any of the characters in escapes and
+// the token ends at the first unescaped character in stops
+// or at the end of the line.
+func (p *lpParser) readToken(escapes, stops string) string {
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		if c == '\n' || strings.IndexByte(stops, c) >= 0 {
+			break
+		}
+		p.next()
+		if c == '\\' && !p.eof() && strings.IndexByte(escapes, p.peek()) >= 0 {
+			c = p.next()
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+func (p *lpParser) readFieldValue() (interface{}, error) {
+	if p.eof() {
+		return nil, errors.New(codes.Invalid, "missing value")
+	}
+	if p.peek() == '"' {
+		p.next()
+		var sb strings.Builder
+		for {
+			if p.eof() {
+				return nil, errors.New(codes.Invalid, "unterminated string")
+			}
+			c := p.next()
+			switch c {
+			case '"':
+				return sb.String(), nil
+			case '\\':
+				if !p.eof() && (p.peek() == '"' || p.peek() == '\\') {
+					c = p.next()
+				}
+			case '\n':
+				p.line++
+			}
+			sb.WriteByte(c)
+		}
+	}
+
+	start := p.pos
+	for !p.eof() {
+		if c := p.peek(); c == ',' || c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			break
+		}
+		p.pos++
+	}
+	s := string(p.data[start:p.pos])
+	switch s {
+	case "":
+		return nil, errors.New(codes.Invalid, "missing value")
+	case "t", "T", "true", "True", "TRUE":
+		return true, nil
+	case "f", "F", "false", "False", "FALSE":
+		return false, nil
+	}
+	switch s[len(s)-1] {
+	case 'i':
+		v, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+		if err != nil {
+			return nil, errors.Newf(codes.Invalid, "invalid integer %q", s)
+		}
+		return v, nil
+	case 'u':
+		v, err := strconv.ParseUint(s[:len(s)-1], 10, 64)
+		if err != nil {
+			return nil, errors.Newf(codes.Invalid, "invalid unsigned integer %q", s)
+		}
+		return v, nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, errors.Newf(codes.Invalid, "invalid float %q", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,31 @@
+// Package metric decodes metrics that are encoded as line protocol
+// or JSON and builds Flux tables from them.
+package metric
+
+import (
+	"time"
+)
+
+// Tag is a key-value pair that identifies the series of a metric.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Field is a value of a metric.
+// The value is a float64, int64, uint64, string or bool.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Metric is a set of fields with a measurement name,
+// a set of tags and an optional timestamp.
+type Metric struct {
+	Name string
+	// Tags are sorted by key.
+	Tags   []Tag
+	Fields []Field
+	// Time is the zero time if the metric does not have a timestamp.
+	Time time.Time
+}
@@ -0,0 +1,281 @@
+package metric_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/metric"
+	"github.com/influxdata/flux/memory"
+)
+
+func TestParseLineProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		data    string
+		want    []metric.Metric
+		wantErr string
+	}{
+		{
+			name: "simple",
+			data: "cpu,host=a,cpu=cpu0 usage=1.5,count=2i,total=3u,ok=t,msg=\"hello, world\" 1000\n",
+			want: []metric.Metric{{
+				Name: "cpu",
+				Tags: []metric.Tag{{Key: "cpu", Value: "cpu0"}, {Key: "host", Value: "a"}},
+				Fields: []metric.Field{
+					{Key: "usage", Value: 1.5},
+					{Key: "count", Value: int64(2)},
+					{Key: "total", Value: uint64(3)},
+					{Key: "ok", Value: true},
+					{Key: "msg", Value: "hello, world"},
+				},
+				Time: time.Unix(0, 1000).UTC(),
+			}},
+		},
+		{
+			name: "no timestamp",
+			data: "mem free=10",
+			want: []metric.Metric{{
+				Name:   "mem",
+				Fields: []metric.Field{{Key: "free", Value: 10.0}},
+			}},
+		},
+		{
+			name: "escapes",
+			data: `my\ measurement,tag\,key=tag\ value field\=key="a \"quoted\" \\ string" -1`,
+			want: []metric.Metric{{
+				Name:   "my measurement",
+				Tags:   []metric.Tag{{Key: "tag,key", Value: "tag value"}},
+				Fields: []metric.Field{{Key: "field=key", Value: `a "quoted" \ string`}},
+				Time:   time.Unix(0, -1).UTC(),
+			}},
+		},
+		{
+			name: "multiple lines",
+			data: "# comment\n\ncpu v=1 1\r\n  \ncpu v=2 2\n",
+			want: []metric.Metric{
+				{Name: "cpu", Fields: []metric.Field{{Key: "v", Value: 1.0}}, Time: time.Unix(0, 1).UTC()},
+				{Name: "cpu", Fields: []metric.Field{{Key: "v", Value: 2.0}}, Time: time.Unix(0, 2).UTC()},
+			},
+		},
+		{
+			name:    "missing fields",
+			data:    "cpu\ncpu,host=a",
+			wantErr: "invalid line protocol on line 1: missing fields",
+		},
+		{
+			name:    "invalid integer",
+			data:    "cpu v=1\ncpu v=1.5i",
+			wantErr: `invalid line protocol on line 2: invalid value for field "v": invalid integer "1.5i"`,
+		},
+		{
+			name:    "invalid timestamp",
+			data:    "cpu v=1 now",
+			wantErr: `invalid line protocol on line 1: invalid timestamp "now"`,
+		},
+		{
+			name:    "unterminated string",
+			data:    `cpu v="abc`,
+			wantErr: `invalid line protocol on line 1: invalid value for field "v": unterminated string`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := metric.ParseLineProtocol([]byte(tc.data))
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := err.Error(), tc.wantErr; got != want {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected metrics -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	cpu := metric.Metric{
+		Name:   "cpu",
+		Tags:   []metric.Tag{{Key: "host", Value: "a"}},
+		Fields: []metric.Field{{Key: "msg", Value: "ok"}, {Key: "usage", Value: 1.5}},
+		Time:   time.Unix(1577836800, 0).UTC(),
+	}
+	for _, tc := range []struct {
+		name    string
+		data    string
+		want    []metric.Metric
+		wantErr string
+	}{
+		{
+			name: "single",
+			data: `{"name": "cpu", "tags": {"host": "a"}, "fields": {"usage": 1.5, "msg": "ok"}, "timestamp": 1577836800}`,
+			want: []metric.Metric{cpu},
+		},
+		{
+			name: "array",
+			data: `[
+				{"name": "cpu", "tags": {"host": "a"}, "fields": {"usage": 1.5, "msg": "ok"}, "timestamp": "2020-01-01T00:00:00Z"},
+				{"name": "mem", "fields": {"ok": true}, "timestamp": 1577836800.5}
+			]`,
+			want: []metric.Metric{cpu, {
+				Name:   "mem",
+				Fields: []metric.Field{{Key: "ok", Value: true}},
+				Time:   time.Unix(1577836800, 5e8).UTC(),
+			}},
+		},
+		{
+			name: "batch",
+			data: `{"metrics": [{"name": "cpu", "tags": {"host": "a"}, "fields": {"usage": 1.5, "msg": "ok"}, "timestamp": 1577836800}]}`,
+			want: []metric.Metric{cpu},
+		},
+		{
+			name:    "missing name",
+			data:    `{"fields": {"v": 1}}`,
+			wantErr: "invalid JSON metric 0: missing name",
+		},
+		{
+			name:    "nested field",
+			data:    `{"name": "cpu", "fields": {"v": [1]}}`,
+			wantErr: `invalid JSON metric 0: field "v" has an unsupported value [1]`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := metric.ParseJSON([]byte(tc.data))
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := err.Error(), tc.wantErr; got != want {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected metrics -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestTableBuilder(t *testing.T) {
+	metrics, err := metric.ParseLineProtocol([]byte(`
+cpu,host=a usage=2,idle=true 20
+cpu,host=b usage=3 10
+cpu,host=a usage=1 10
+mem free=5i
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mem := &memory.Allocator{}
+	b := metric.NewTableBuilder(mem)
+	for _, m := range metrics {
+		if err := b.Add(m, time.Unix(0, 30)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tables, err := b.Tables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]*executetest.Table, 0, len(tables))
+	for _, tbl := range tables {
+		et, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, et)
+	}
+
+	cols := func(typ flux.ColType, tags ...string) []flux.ColMeta {
+		cols := []flux.ColMeta{{Label: "_measurement", Type: flux.TString}}
+		for _, tag := range tags {
+			cols = append(cols, flux.ColMeta{Label: tag, Type: flux.TString})
+		}
+		return append(cols,
+			flux.ColMeta{Label: "_field", Type: flux.TString},
+			flux.ColMeta{Label: "_time", Type: flux.TTime},
+			flux.ColMeta{Label: "_value", Type: typ},
+		)
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"_measurement", "host", "_field"},
+			ColMeta: cols(flux.TFloat, "host"),
+			Data: [][]interface{}{
+				{"cpu", "a", "usage", execute.Time(10), 1.0},
+				{"cpu", "a", "usage", execute.Time(20), 2.0},
+			},
+		},
+		{
+			KeyCols: []string{"_measurement", "host", "_field"},
+			ColMeta: cols(flux.TBool, "host"),
+			Data: [][]interface{}{
+				{"cpu", "a", "idle", execute.Time(20), true},
+			},
+		},
+		{
+			KeyCols: []string{"_measurement", "host", "_field"},
+			ColMeta: cols(flux.TFloat, "host"),
+			Data: [][]interface{}{
+				{"cpu", "b", "usage", execute.Time(10), 3.0},
+			},
+		},
+		{
+			KeyCols: []string{"_measurement", "_field"},
+			ColMeta: cols(flux.TInt),
+			Data: [][]interface{}{
+				{"mem", "free", execute.Time(30), int64(5)},
+			},
+		},
+	}
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+	sort.Sort(executetest.SortedTables(got))
+	sort.Sort(executetest.SortedTables(want))
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	for _, tbl := range tables {
+		tbl.Done()
+	}
+	if mem.Allocated() != 0 {
+		t.Errorf("expected all memory to be released, got %d bytes", mem.Allocated())
+	}
+}
+
+func TestTableBuilder_SchemaCollision(t *testing.T) {
+	metrics, err := metric.ParseLineProtocol([]byte("cpu v=1 1\ncpu v=\"a\" 2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := metric.NewTableBuilder(&memory.Allocator{})
+	defer b.Release()
+	if err := b.Add(metrics[0], time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	err = b.Add(metrics[1], time.Time{})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, want := err.Error(), `schema collision: field "v" has type float and string`; got != want {
+		t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
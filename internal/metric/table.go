@@ -0,0 +1,159 @@
+package metric
+
+import (
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	// MeasurementColLabel is the label of the column with the name of a metric.
+	MeasurementColLabel = "_measurement"
+	// FieldColLabel is the label of the column with the key of a field.
+	FieldColLabel = "_field"
+)
+
+// TableBuilder builds tables from metrics. Each field of a metric
+// is a row with the _time and _value of the field. The tables are
+// grouped by _measurement, the tags, and _field.
+type TableBuilder struct {
+	alloc    *memory.Allocator
+	builders *execute.GroupLookup
+}
+
+// NewTableBuilder creates a TableBuilder that
+// allocates the tables with alloc.
+func NewTableBuilder(alloc *memory.Allocator) *TableBuilder {
+	return &TableBuilder{
+		alloc:    alloc,
+		builders: execute.NewGroupLookup(),
+	}
+}
+
+// Add adds the fields of the metric to the tables. If the
+// metric does not have a time, defaultTime is used instead.
+func (b *TableBuilder) Add(m Metric, defaultTime time.Time) error {
+	t := m.Time
+	if t.IsZero() {
+		t = defaultTime
+	}
+	for _, f := range m.Fields {
+		var v values.Value
+		switch f.Value.(type) {
+		case float64, int64, uint64, string, bool:
+			v = values.New(f.Value)
+		default:
+			return errors.Newf(codes.Invalid, "field %q has an unsupported value %v", f.Key, f.Value)
+		}
+		key, err := groupKey(m, f.Key)
+		if err != nil {
+			return err
+		}
+		tb, err := b.tableBuilder(key, flux.ColumnType(v.Type()))
+		if err != nil {
+			return err
+		}
+		if err := execute.AppendKeyValues(key, tb); err != nil {
+			return err
+		}
+		n := len(tb.Cols())
+		if err := tb.AppendTime(n-2, values.ConvertTime(t)); err != nil {
+			return err
+		}
+		if err := tb.AppendValue(n-1, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func groupKey(m Metric, field string) (flux.GroupKey, error) {
+	gkb := execute.NewGroupKeyBuilder(nil)
+	gkb.Grow(len(m.Tags) + 2)
+	gkb.AddKeyValue(MeasurementColLabel, values.NewString(m.Name))
+	for _, tag := range m.Tags {
+		switch tag.Key {
+		case MeasurementColLabel, FieldColLabel, execute.DefaultTimeColLabel, execute.DefaultValueColLabel:
+			return nil, errors.Newf(codes.Invalid, "tag key %q is reserved", tag.Key)
+		}
+		gkb.AddKeyValue(tag.Key, values.NewString(tag.Value))
+	}
+	gkb.AddKeyValue(FieldColLabel, values.NewString(field))
+	return gkb.Build()
+}
+
+// tableBuilder returns the builder for the table with the group key.
+// The columns of the table are the group key columns
+// followed by _time and _value.
+func (b *TableBuilder) tableBuilder(key flux.GroupKey, typ flux.ColType) (*execute.ColListTableBuilder, error) {
+	if tb, ok := b.builders.Lookup(key); ok {
+		tb := tb.(*execute.ColListTableBuilder)
+		cols := tb.Cols()
+		if want := cols[len(cols)-1].Type; want != typ {
+			field := key.LabelValue(FieldColLabel).Str()
+			return nil, errors.Newf(codes.FailedPrecondition, "schema collision: field %q has type %s and %s", field, want, typ)
+		}
+		return tb, nil
+	}
+	tb := execute.NewColListTableBuilder(key, b.alloc)
+	if err := execute.AddTableKeyCols(key, tb); err != nil {
+		return nil, err
+	}
+	if _, err := tb.AddCol(flux.ColMeta{Label: execute.DefaultTimeColLabel, Type: flux.TTime}); err != nil {
+		return nil, err
+	}
+	if _, err := tb.AddCol(flux.ColMeta{Label: execute.DefaultValueColLabel, Type: typ}); err != nil {
+		return nil, err
+	}
+	b.builders.Set(key, tb)
+	return tb, nil
+}
+
+// Tables returns the tables with the rows sorted by time.
+// The builder is empty afterwards.
+func (b *TableBuilder) Tables() ([]flux.BufferedTable, error) {
+	var (
+		tables []flux.BufferedTable
+		err    error
+	)
+	b.builders.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		tb := value.(*execute.ColListTableBuilder)
+		tb.Sort([]string{execute.DefaultTimeColLabel}, false)
+		var tbl flux.Table
+		if tbl, err = tb.Table(); err != nil {
+			return
+		}
+		var buffered flux.BufferedTable
+		if buffered, err = execute.CopyTable(tbl); err != nil {
+			return
+		}
+		tables = append(tables, buffered)
+	})
+	// The tables contain a copy of the data so
+	// the builders are no longer needed.
+	b.Release()
+	if err != nil {
+		for _, tbl := range tables {
+			tbl.Done()
+		}
+		return nil, err
+	}
+	return tables, nil
+}
+
+// Release releases the memory of the rows
+// that have been added to the builder.
+func (b *TableBuilder) Release() {
+	b.builders.Range(func(key flux.GroupKey, value interface{}) {
+		value.(*execute.ColListTableBuilder).Release()
+	})
+	b.builders = execute.NewGroupLookup()
+}
@@ -0,0 +1,414 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// julianUnixEpoch is the julian day of the unix epoch.
+// It is used to decode INT96 timestamps.
+const julianUnixEpoch = 2440588
+
+const nanosPerDay = 24 * 60 * 60 * 1e9
+
+// columnValues holds the decoded values of a column.
+// Only the slice that corresponds to the physical
+// type of the column is used.
+type columnValues struct {
+	typ    Type
+	bools  []bool
+	ints   []int64
+	floats []float64
+	bytes  [][]byte
+}
+
+func (v *columnValues) len() int {
+	switch v.typ {
+	case Boolean:
+		return len(v.bools)
+	case Int32, Int64, Int96:
+		return len(v.ints)
+	case Float, Double:
+		return len(v.floats)
+	default:
+		return len(v.bytes)
+	}
+}
+
+func (v *columnValues) reset() {
+	v.bools = v.bools[:0]
+	v.ints = v.ints[:0]
+	v.floats = v.floats[:0]
+	v.bytes = v.bytes[:0]
+}
+
+// appendIndices appends the values of the dictionary
+// at each of the indices.
+func (v *columnValues) appendIndices(dict *columnValues, indices []int32) error {
+	n := dict.len()
+	for _, i := range indices {
+		if i < 0 || int(i) >= n {
+			return errors.Newf(codes.Invalid, "dictionary index %d out of range", i)
+		}
+		switch v.typ {
+		case Boolean:
+			v.bools = append(v.bools, dict.bools[i])
+		case Int32, Int64, Int96:
+			v.ints = append(v.ints, dict.ints[i])
+		case Float, Double:
+			v.floats = append(v.floats, dict.floats[i])
+		default:
+			v.bytes = append(v.bytes, dict.bytes[i])
+		}
+	}
+	return nil
+}
+
+// bitReader reads little endian values of an arbitrary
+// bit width starting from the least significant bit.
+type bitReader struct {
+	data []byte
+	pos  uint
+}
+
+func (r *bitReader) read(width uint) (uint64, bool) {
+	var v uint64
+	for i := uint(0); i < width; {
+		idx := r.pos / 8
+		if idx >= uint(len(r.data)) {
+			return 0, false
+		}
+		off := r.pos % 8
+		take := 8 - off
+		if take > width-i {
+			take = width - i
+		}
+		bits := uint64(r.data[idx]>>off) & (1<<take - 1)
+		v |= bits << i
+		i += take
+		r.pos += take
+	}
+	return v, true
+}
+
+// bitWidth returns the number of bits needed to store v.
+func bitWidth(v uint64) int {
+	n := 0
+	for ; v != 0; v >>= 1 {
+		n++
+	}
+	return n
+}
+
+// decodeHybrid decodes n values that were encoded with the
+// RLE/bit-packing hybrid encoding. Bit-packed runs may contain
+// more values than requested and the extra values are discarded.
+func decodeHybrid(data []byte, width, n int) ([]int32, error) {
+	if width < 0 || width > 32 {
+		return nil, errors.Newf(codes.Invalid, "invalid bit width %d", width)
+	}
+	out := make([]int32, 0, n)
+	for len(out) < n {
+		header, k := binary.Uvarint(data)
+		if k <= 0 {
+			return nil, errors.New(codes.Invalid, "invalid run header in hybrid encoded data")
+		}
+		data = data[k:]
+		if header&1 == 1 {
+			groups := int(header >> 1)
+			size := groups * width
+			if size > len(data) {
+				return nil, errors.New(codes.Invalid, "bit-packed run is truncated")
+			}
+			br := bitReader{data: data[:size]}
+			for i := 0; i < groups*8 && len(out) < n; i++ {
+				v, _ := br.read(uint(width))
+				out = append(out, int32(v))
+			}
+			data = data[size:]
+		} else {
+			count := int(header >> 1)
+			size := (width + 7) / 8
+			if size > len(data) {
+				return nil, errors.New(codes.Invalid, "rle run is truncated")
+			}
+			var buf [4]byte
+			copy(buf[:], data[:size])
+			v := int32(binary.LittleEndian.Uint32(buf[:]))
+			for i := 0; i < count && len(out) < n; i++ {
+				out = append(out, v)
+			}
+			data = data[size:]
+		}
+	}
+	return out, nil
+}
+
+// encodeHybrid encodes the values with the RLE/bit-packing
+// hybrid encoding. Only run length encoded runs are produced.
+func encodeHybrid(values []int32, width int) []byte {
+	var (
+		out  []byte
+		hdr  [binary.MaxVarintLen64]byte
+		size = (width + 7) / 8
+	)
+	for i := 0; i < len(values); {
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+		n := binary.PutUvarint(hdr[:], uint64(j-i)<<1)
+		out = append(out, hdr[:n]...)
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], uint32(values[i]))
+		out = append(out, buf[:size]...)
+		i = j
+	}
+	return out
+}
+
+// decodeLevels decodes the repetition or definition levels
+// at the beginning of a version 1 data page. These levels
+// are prefixed with their length in bytes.
+// It returns the levels and the remaining data.
+func decodeLevels(data []byte, maxLevel, n int) ([]int32, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, errors.New(codes.Invalid, "levels are truncated")
+	}
+	size := int(binary.LittleEndian.Uint32(data))
+	data = data[4:]
+	if size < 0 || size > len(data) {
+		return nil, nil, errors.New(codes.Invalid, "levels are truncated")
+	}
+	levels, err := decodeHybrid(data[:size], bitWidth(uint64(maxLevel)), n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return levels, data[size:], nil
+}
+
+// decodePlain appends n values encoded with the plain encoding.
+// It returns the remaining data.
+func decodePlain(dst *columnValues, data []byte, typeLength, n int) ([]byte, error) {
+	size := 0
+	switch dst.typ {
+	case Boolean:
+		size = (n + 7) / 8
+	case Int32, Float:
+		size = 4 * n
+	case Int64, Double:
+		size = 8 * n
+	case Int96:
+		size = 12 * n
+	case FixedLenByteArray:
+		if typeLength <= 0 {
+			return nil, errors.New(codes.Invalid, "fixed length byte array has no length")
+		}
+		size = typeLength * n
+	}
+	if size > len(data) {
+		return nil, errors.Newf(codes.Invalid, "page does not contain %d %s values", n, dst.typ)
+	}
+	switch dst.typ {
+	case Boolean:
+		for i := 0; i < n; i++ {
+			dst.bools = append(dst.bools, data[i/8]&(1<<uint(i%8)) != 0)
+		}
+	case Int32:
+		for i := 0; i < n; i++ {
+			dst.ints = append(dst.ints, int64(int32(binary.LittleEndian.Uint32(data[4*i:]))))
+		}
+	case Int64:
+		for i := 0; i < n; i++ {
+			dst.ints = append(dst.ints, int64(binary.LittleEndian.Uint64(data[8*i:])))
+		}
+	case Int96:
+		for i := 0; i < n; i++ {
+			nanos := int64(binary.LittleEndian.Uint64(data[12*i:]))
+			days := int64(binary.LittleEndian.Uint32(data[12*i+8:]))
+			dst.ints = append(dst.ints, (days-julianUnixEpoch)*nanosPerDay+nanos)
+		}
+	case Float:
+		for i := 0; i < n; i++ {
+			dst.floats = append(dst.floats, float64(math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))))
+		}
+	case Double:
+		for i := 0; i < n; i++ {
+			dst.floats = append(dst.floats, math.Float64frombits(binary.LittleEndian.Uint64(data[8*i:])))
+		}
+	case ByteArray:
+		for i := 0; i < n; i++ {
+			if len(data) < 4 {
+				return nil, errors.New(codes.Invalid, "byte array is truncated")
+			}
+			l := int(binary.LittleEndian.Uint32(data))
+			if l < 0 || l > len(data)-4 {
+				return nil, errors.New(codes.Invalid, "byte array is truncated")
+			}
+			dst.bytes = append(dst.bytes, data[4:4+l])
+			data = data[4+l:]
+		}
+		return data, nil
+	case FixedLenByteArray:
+		for i := 0; i < n; i++ {
+			dst.bytes = append(dst.bytes, data[i*typeLength:(i+1)*typeLength])
+		}
+	}
+	return data[size:], nil
+}
+
+// decodeDeltaBinaryPacked decodes at most n integers encoded
+// with the delta binary packed encoding. It returns the values
+// and the remaining data.
+func decodeDeltaBinaryPacked(data []byte, n int) ([]int64, []byte, error) {
+	invalid := func() ([]int64, []byte, error) {
+		return nil, nil, errors.New(codes.Invalid, "invalid delta binary packed data")
+	}
+	blockSize, k := binary.Uvarint(data)
+	if k <= 0 {
+		return invalid()
+	}
+	data = data[k:]
+	miniBlocks, k := binary.Uvarint(data)
+	if k <= 0 || miniBlocks == 0 || blockSize%miniBlocks != 0 {
+		return invalid()
+	}
+	data = data[k:]
+	total, k := binary.Uvarint(data)
+	if k <= 0 {
+		return invalid()
+	}
+	data = data[k:]
+	first, k := binary.Varint(data)
+	if k <= 0 {
+		return invalid()
+	}
+	data = data[k:]
+	if total > uint64(n) {
+		return nil, nil, errors.Newf(codes.Invalid, "delta binary packed data contains %d values, expected at most %d", total, n)
+	}
+	n = int(total)
+
+	perMiniBlock := int(blockSize / miniBlocks)
+	if perMiniBlock%8 != 0 {
+		return invalid()
+	}
+	out := make([]int64, 0, n)
+	if total > 0 {
+		out = append(out, first)
+	}
+	last := first
+	for uint64(len(out)) < total {
+		minDelta, k := binary.Varint(data)
+		if k <= 0 || len(data) < k+int(miniBlocks) {
+			return invalid()
+		}
+		widths := data[k : k+int(miniBlocks)]
+		data = data[k+int(miniBlocks):]
+		for _, w := range widths {
+			if uint64(len(out)) >= total {
+				break
+			}
+			if w > 64 {
+				return invalid()
+			}
+			size := perMiniBlock * int(w) / 8
+			if size > len(data) {
+				return invalid()
+			}
+			br := bitReader{data: data[:size]}
+			for i := 0; i < perMiniBlock && uint64(len(out)) < total; i++ {
+				v, _ := br.read(uint(w))
+				last += minDelta + int64(v)
+				out = append(out, last)
+			}
+			data = data[size:]
+		}
+	}
+	return out, data, nil
+}
+
+// decodeDeltaLengthByteArray decodes n byte arrays encoded
+// with the delta length byte array encoding.
+func decodeDeltaLengthByteArray(data []byte, n int) ([][]byte, []byte, error) {
+	lengths, data, err := decodeDeltaBinaryPacked(data, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make([][]byte, 0, len(lengths))
+	for _, l := range lengths {
+		if l < 0 || l > int64(len(data)) {
+			return nil, nil, errors.New(codes.Invalid, "byte array is truncated")
+		}
+		out = append(out, data[:l])
+		data = data[l:]
+	}
+	return out, data, nil
+}
+
+// decodeDeltaByteArray decodes n byte arrays encoded
+// with the delta byte array encoding.
+func decodeDeltaByteArray(data []byte, n int) ([][]byte, error) {
+	prefixes, data, err := decodeDeltaBinaryPacked(data, n)
+	if err != nil {
+		return nil, err
+	}
+	suffixes, _, err := decodeDeltaLengthByteArray(data, n)
+	if err != nil {
+		return nil, err
+	}
+	if len(prefixes) != len(suffixes) {
+		return nil, errors.New(codes.Invalid, "invalid delta byte array data")
+	}
+	out := make([][]byte, 0, len(suffixes))
+	var prev []byte
+	for i, p := range prefixes {
+		if p < 0 || p > int64(len(prev)) {
+			return nil, errors.New(codes.Invalid, "invalid delta byte array prefix")
+		}
+		v := make([]byte, 0, int(p)+len(suffixes[i]))
+		v = append(v, prev[:p]...)
+		v = append(v, suffixes[i]...)
+		out = append(out, v)
+		prev = v
+	}
+	return out, nil
+}
+
+// decodeByteStreamSplit appends n values encoded with
+// the byte stream split encoding.
+func decodeByteStreamSplit(dst *columnValues, data []byte, n int) error {
+	width := 0
+	switch dst.typ {
+	case Int32, Float:
+		width = 4
+	case Int64, Double:
+		width = 8
+	default:
+		return errors.Newf(codes.Invalid, "byte stream split encoding is not valid for %s", dst.typ)
+	}
+	if width*n > len(data) {
+		return errors.New(codes.Invalid, "byte stream split data is truncated")
+	}
+	stride := len(data) / width
+	var buf [8]byte
+	for i := 0; i < n; i++ {
+		for k := 0; k < width; k++ {
+			buf[k] = data[k*stride+i]
+		}
+		switch dst.typ {
+		case Int32:
+			dst.ints = append(dst.ints, int64(int32(binary.LittleEndian.Uint32(buf[:]))))
+		case Int64:
+			dst.ints = append(dst.ints, int64(binary.LittleEndian.Uint64(buf[:])))
+		case Float:
+			dst.floats = append(dst.floats, float64(math.Float32frombits(binary.LittleEndian.Uint32(buf[:]))))
+		case Double:
+			dst.floats = append(dst.floats, math.Float64frombits(binary.LittleEndian.Uint64(buf[:])))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package parquet
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeHybrid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		data  []byte
+		width int
+		n     int
+		want  []int32
+	}{
+		{
+			// The example from the parquet format specification.
+			name:  "bit-packed",
+			data:  []byte{0x03, 0x88, 0xC6, 0xFA},
+			width: 3,
+			n:     8,
+			want:  []int32{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name:  "rle",
+			data:  []byte{0x06, 0x01, 0x04, 0x00},
+			width: 1,
+			n:     5,
+			want:  []int32{1, 1, 1, 0, 0},
+		},
+		{
+			name:  "partial bit-packed run",
+			data:  []byte{0x03, 0x88, 0xC6, 0xFA},
+			width: 3,
+			n:     3,
+			want:  []int32{0, 1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := decodeHybrid(tc.data, tc.width, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected values -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestEncodeHybrid(t *testing.T) {
+	want := []int32{1, 1, 0, 1, 1, 1, 0, 0}
+	got, err := decodeHybrid(encodeHybrid(want, 1), 1, len(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected values -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestDecodeDeltaBinaryPacked(t *testing.T) {
+	testCases := []struct {
+		name string
+		data []byte
+		want []int64
+	}{
+		{
+			// 1, 2, 3, 4, 5 have a constant delta
+			// so every miniblock has a bit width of zero.
+			name: "constant delta",
+			data: []byte{0x80, 0x01, 0x04, 0x05, 0x02, 0x02, 0x00, 0x00, 0x00, 0x00},
+			want: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			// 7, 5, 3, 1, 2, 3, 4, 5 with a block size of 8 and a
+			// single miniblock. The min delta is -2 and the deltas
+			// relative to it are 0, 0, 0, 3, 3, 3, 3 which need 2 bits.
+			name: "bit-packed deltas",
+			data: []byte{0x08, 0x01, 0x08, 0x0E, 0x03, 0x02, 0xC0, 0x3F},
+			want: []int64{7, 5, 3, 1, 2, 3, 4, 5},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, rest, err := decodeDeltaBinaryPacked(tc.data, len(tc.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rest) != 0 {
+				t.Errorf("expected all data to be consumed, %d bytes remain", len(rest))
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected values -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestDecodeDeltaByteArray(t *testing.T) {
+	data := []byte{
+		// prefix lengths: 0, 3, 2
+		0x08, 0x01, 0x03, 0x00, 0x01, 0x03, 0x04, 0x00, 0x00,
+		// suffix lengths: 5, 2, 5
+		0x08, 0x01, 0x03, 0x0A, 0x05, 0x03, 0x30, 0x00, 0x00,
+	}
+	data = append(data, "applelyricot"...)
+	got, err := decodeDeltaByteArray(data, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]byte{[]byte("apple"), []byte("apply"), []byte("apricot")}
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected values -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,725 @@
+package parquet
+
+import (
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// Type is the physical type of a column.
+type Type int32
+
+const (
+	Boolean           Type = 0
+	Int32             Type = 1
+	Int64             Type = 2
+	Int96             Type = 3
+	Float             Type = 4
+	Double            Type = 5
+	ByteArray         Type = 6
+	FixedLenByteArray Type = 7
+)
+
+func (t Type) String() string {
+	switch t {
+	case Boolean:
+		return "BOOLEAN"
+	case Int32:
+		return "INT32"
+	case Int64:
+		return "INT64"
+	case Int96:
+		return "INT96"
+	case Float:
+		return "FLOAT"
+	case Double:
+		return "DOUBLE"
+	case ByteArray:
+		return "BYTE_ARRAY"
+	case FixedLenByteArray:
+		return "FIXED_LEN_BYTE_ARRAY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ConvertedType is the legacy annotation of a column
+// that describes how to interpret its physical type.
+type ConvertedType int32
+
+const (
+	ConvertedUTF8            ConvertedType = 0
+	ConvertedEnum            ConvertedType = 4
+	ConvertedDecimal         ConvertedType = 5
+	ConvertedDate            ConvertedType = 6
+	ConvertedTimeMillis      ConvertedType = 7
+	ConvertedTimeMicros      ConvertedType = 8
+	ConvertedTimestampMillis ConvertedType = 9
+	ConvertedTimestampMicros ConvertedType = 10
+	ConvertedUint8           ConvertedType = 11
+	ConvertedUint16          ConvertedType = 12
+	ConvertedUint32          ConvertedType = 13
+	ConvertedUint64          ConvertedType = 14
+	ConvertedJSON            ConvertedType = 19
+)
+
+// Repetition describes whether a column may contain
+// null values or repeated values.
+type Repetition int32
+
+const (
+	Required Repetition = 0
+	Optional Repetition = 1
+	Repeated Repetition = 2
+)
+
+// Encoding is the encoding of the values in a page.
+type Encoding int32
+
+const (
+	EncodingPlain                Encoding = 0
+	EncodingPlainDictionary      Encoding = 2
+	EncodingRLE                  Encoding = 3
+	EncodingBitPacked            Encoding = 4
+	EncodingDeltaBinaryPacked    Encoding = 5
+	EncodingDeltaLengthByteArray Encoding = 6
+	EncodingDeltaByteArray       Encoding = 7
+	EncodingRLEDictionary        Encoding = 8
+	EncodingByteStreamSplit      Encoding = 9
+)
+
+// Codec is the compression codec of the pages in a column chunk.
+type Codec int32
+
+const (
+	Uncompressed Codec = 0
+	Snappy       Codec = 1
+	Gzip         Codec = 2
+)
+
+// PageType is the type of a page within a column chunk.
+type PageType int32
+
+const (
+	DataPage       PageType = 0
+	IndexPage      PageType = 1
+	DictionaryPage PageType = 2
+	DataPageV2     PageType = 3
+)
+
+// TimeUnit is the unit of a time or timestamp logical type.
+type TimeUnit int
+
+const (
+	Millis TimeUnit = iota + 1
+	Micros
+	Nanos
+)
+
+// LogicalType is the annotation of a column that describes
+// how to interpret its physical type. Only the logical
+// types that affect how a column is read are represented.
+type LogicalType struct {
+	String    bool
+	Decimal   *DecimalType
+	Date      bool
+	Time      *TimeType
+	Timestamp *TimeType
+	Integer   *IntType
+	JSON      bool
+	Enum      bool
+}
+
+type DecimalType struct {
+	Scale     int32
+	Precision int32
+}
+
+type TimeType struct {
+	IsAdjustedToUTC bool
+	Unit            TimeUnit
+}
+
+type IntType struct {
+	BitWidth int8
+	IsSigned bool
+}
+
+// SchemaElement is an element of the flattened schema tree.
+type SchemaElement struct {
+	Type           *Type
+	TypeLength     int32
+	RepetitionType *Repetition
+	Name           string
+	NumChildren    int32
+	ConvertedType  *ConvertedType
+	Scale          int32
+	Precision      int32
+	LogicalType    *LogicalType
+}
+
+type KeyValue struct {
+	Key   string
+	Value string
+}
+
+type ColumnMetaData struct {
+	Type                  Type
+	Encodings             []Encoding
+	PathInSchema          []string
+	Codec                 Codec
+	NumValues             int64
+	TotalUncompressedSize int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	DictionaryPageOffset  *int64
+}
+
+type ColumnChunk struct {
+	FileOffset int64
+	MetaData   *ColumnMetaData
+}
+
+type RowGroup struct {
+	Columns       []ColumnChunk
+	TotalByteSize int64
+	NumRows       int64
+}
+
+type FileMetaData struct {
+	Version          int32
+	Schema           []SchemaElement
+	NumRows          int64
+	RowGroups        []RowGroup
+	KeyValueMetadata []KeyValue
+	CreatedBy        string
+}
+
+type DataPageHeader struct {
+	NumValues               int32
+	Encoding                Encoding
+	DefinitionLevelEncoding Encoding
+	RepetitionLevelEncoding Encoding
+}
+
+type DictionaryPageHeader struct {
+	NumValues int32
+	Encoding  Encoding
+}
+
+type DataPageHeaderV2 struct {
+	NumValues                  int32
+	NumNulls                   int32
+	NumRows                    int32
+	Encoding                   Encoding
+	DefinitionLevelsByteLength int32
+	RepetitionLevelsByteLength int32
+	IsCompressed               bool
+}
+
+type PageHeader struct {
+	Type                 PageType
+	UncompressedPageSize int32
+	CompressedPageSize   int32
+	DataPageHeader       *DataPageHeader
+	DictionaryPageHeader *DictionaryPageHeader
+	DataPageHeaderV2     *DataPageHeaderV2
+}
+
+// readI32List reads a list of i32 values.
+func (r *thriftReader) readI32List(fn func(v int32)) error {
+	elemType, n, err := r.readListHeader()
+	if err != nil {
+		return err
+	}
+	if n > 0 && elemType != thriftI32 {
+		return errors.Newf(codes.Invalid, "expected list of i32, got element type %d", elemType)
+	}
+	for i := 0; i < n; i++ {
+		v, err := r.readI32()
+		if err != nil {
+			return err
+		}
+		fn(v)
+	}
+	return nil
+}
+
+// readStructList reads a list of structs.
+func (r *thriftReader) readStructList(fn func(i int) error) error {
+	elemType, n, err := r.readListHeader()
+	if err != nil {
+		return err
+	}
+	if n > 0 && elemType != thriftStruct {
+		return errors.Newf(codes.Invalid, "expected list of structs, got element type %d", elemType)
+	}
+	for i := 0; i < n; i++ {
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *thriftReader) readFileMetaData(m *FileMetaData) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.Version, err = r.readI32()
+		case id == 2 && typ == thriftList:
+			err = r.readStructList(func(i int) error {
+				m.Schema = append(m.Schema, SchemaElement{})
+				return r.readSchemaElement(&m.Schema[i])
+			})
+		case id == 3 && typ == thriftI64:
+			m.NumRows, err = r.readI64()
+		case id == 4 && typ == thriftList:
+			err = r.readStructList(func(i int) error {
+				m.RowGroups = append(m.RowGroups, RowGroup{})
+				return r.readRowGroup(&m.RowGroups[i])
+			})
+		case id == 5 && typ == thriftList:
+			err = r.readStructList(func(i int) error {
+				m.KeyValueMetadata = append(m.KeyValueMetadata, KeyValue{})
+				return r.readKeyValue(&m.KeyValueMetadata[i])
+			})
+		case id == 6 && typ == thriftBinary:
+			m.CreatedBy, err = r.readString()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readSchemaElement(e *SchemaElement) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			t := Type(v)
+			e.Type = &t
+		case id == 2 && typ == thriftI32:
+			e.TypeLength, err = r.readI32()
+		case id == 3 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			rep := Repetition(v)
+			e.RepetitionType = &rep
+		case id == 4 && typ == thriftBinary:
+			e.Name, err = r.readString()
+		case id == 5 && typ == thriftI32:
+			e.NumChildren, err = r.readI32()
+		case id == 6 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			ct := ConvertedType(v)
+			e.ConvertedType = &ct
+		case id == 7 && typ == thriftI32:
+			e.Scale, err = r.readI32()
+		case id == 8 && typ == thriftI32:
+			e.Precision, err = r.readI32()
+		case id == 10 && typ == thriftStruct:
+			e.LogicalType = new(LogicalType)
+			err = r.readLogicalType(e.LogicalType)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readLogicalType(lt *LogicalType) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		if typ != thriftStruct {
+			return r.skip(typ)
+		}
+		switch id {
+		case 1:
+			lt.String = true
+			err = r.skip(typ)
+		case 4:
+			lt.Enum = true
+			err = r.skip(typ)
+		case 5:
+			lt.Decimal = new(DecimalType)
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					lt.Decimal.Scale, err = r.readI32()
+				case id == 2 && typ == thriftI32:
+					lt.Decimal.Precision, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case 6:
+			lt.Date = true
+			err = r.skip(typ)
+		case 7:
+			lt.Time = new(TimeType)
+			err = r.readTimeType(lt.Time)
+		case 8:
+			lt.Timestamp = new(TimeType)
+			err = r.readTimeType(lt.Timestamp)
+		case 10:
+			lt.Integer = new(IntType)
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftByte:
+					var b byte
+					b, err = r.readByte()
+					lt.Integer.BitWidth = int8(b)
+				case id == 2 && (typ == thriftBoolTrue || typ == thriftBoolFalse):
+					lt.Integer.IsSigned, err = r.readBool(typ)
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case 12:
+			lt.JSON = true
+			err = r.skip(typ)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readTimeType(t *TimeType) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && (typ == thriftBoolTrue || typ == thriftBoolFalse):
+			t.IsAdjustedToUTC, err = r.readBool(typ)
+		case id == 2 && typ == thriftStruct:
+			// The time unit is a union of empty structs.
+			err = r.readStruct(func(id int16, typ byte) error {
+				switch id {
+				case 1:
+					t.Unit = Millis
+				case 2:
+					t.Unit = Micros
+				case 3:
+					t.Unit = Nanos
+				}
+				return r.skip(typ)
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readKeyValue(kv *KeyValue) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			kv.Key, err = r.readString()
+		case id == 2 && typ == thriftBinary:
+			kv.Value, err = r.readString()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readRowGroup(rg *RowGroup) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftList:
+			err = r.readStructList(func(i int) error {
+				rg.Columns = append(rg.Columns, ColumnChunk{})
+				return r.readColumnChunk(&rg.Columns[i])
+			})
+		case id == 2 && typ == thriftI64:
+			rg.TotalByteSize, err = r.readI64()
+		case id == 3 && typ == thriftI64:
+			rg.NumRows, err = r.readI64()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readColumnChunk(c *ColumnChunk) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			var path string
+			if path, err = r.readString(); err == nil && path != "" {
+				err = errors.New(codes.Unimplemented, "column chunks in external files are not supported")
+			}
+		case id == 2 && typ == thriftI64:
+			c.FileOffset, err = r.readI64()
+		case id == 3 && typ == thriftStruct:
+			c.MetaData = new(ColumnMetaData)
+			err = r.readColumnMetaData(c.MetaData)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readColumnMetaData(m *ColumnMetaData) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			m.Type = Type(v)
+		case id == 2 && typ == thriftList:
+			err = r.readI32List(func(v int32) {
+				m.Encodings = append(m.Encodings, Encoding(v))
+			})
+		case id == 3 && typ == thriftList:
+			var (
+				elemType byte
+				n        int
+			)
+			elemType, n, err = r.readListHeader()
+			if err == nil && n > 0 && elemType != thriftBinary {
+				err = errors.Newf(codes.Invalid, "expected list of strings, got element type %d", elemType)
+			}
+			for i := 0; i < n && err == nil; i++ {
+				var s string
+				s, err = r.readString()
+				m.PathInSchema = append(m.PathInSchema, s)
+			}
+		case id == 4 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			m.Codec = Codec(v)
+		case id == 5 && typ == thriftI64:
+			m.NumValues, err = r.readI64()
+		case id == 6 && typ == thriftI64:
+			m.TotalUncompressedSize, err = r.readI64()
+		case id == 7 && typ == thriftI64:
+			m.TotalCompressedSize, err = r.readI64()
+		case id == 9 && typ == thriftI64:
+			m.DataPageOffset, err = r.readI64()
+		case id == 11 && typ == thriftI64:
+			var v int64
+			v, err = r.readI64()
+			m.DictionaryPageOffset = &v
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readPageHeader(h *PageHeader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			h.Type = PageType(v)
+		case id == 2 && typ == thriftI32:
+			h.UncompressedPageSize, err = r.readI32()
+		case id == 3 && typ == thriftI32:
+			h.CompressedPageSize, err = r.readI32()
+		case id == 5 && typ == thriftStruct:
+			h.DataPageHeader = new(DataPageHeader)
+			err = r.readDataPageHeader(h.DataPageHeader)
+		case id == 7 && typ == thriftStruct:
+			h.DictionaryPageHeader = new(DictionaryPageHeader)
+			err = r.readDictionaryPageHeader(h.DictionaryPageHeader)
+		case id == 8 && typ == thriftStruct:
+			h.DataPageHeaderV2 = &DataPageHeaderV2{IsCompressed: true}
+			err = r.readDataPageHeaderV2(h.DataPageHeaderV2)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readDataPageHeader(h *DataPageHeader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		var v int32
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.NumValues, err = r.readI32()
+		case id == 2 && typ == thriftI32:
+			v, err = r.readI32()
+			h.Encoding = Encoding(v)
+		case id == 3 && typ == thriftI32:
+			v, err = r.readI32()
+			h.DefinitionLevelEncoding = Encoding(v)
+		case id == 4 && typ == thriftI32:
+			v, err = r.readI32()
+			h.RepetitionLevelEncoding = Encoding(v)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readDictionaryPageHeader(h *DictionaryPageHeader) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.NumValues, err = r.readI32()
+		case id == 2 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			h.Encoding = Encoding(v)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (r *thriftReader) readDataPageHeaderV2(h *DataPageHeaderV2) error {
+	return r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.NumValues, err = r.readI32()
+		case id == 2 && typ == thriftI32:
+			h.NumNulls, err = r.readI32()
+		case id == 3 && typ == thriftI32:
+			h.NumRows, err = r.readI32()
+		case id == 4 && typ == thriftI32:
+			var v int32
+			v, err = r.readI32()
+			h.Encoding = Encoding(v)
+		case id == 5 && typ == thriftI32:
+			h.DefinitionLevelsByteLength, err = r.readI32()
+		case id == 6 && typ == thriftI32:
+			h.RepetitionLevelsByteLength, err = r.readI32()
+		case id == 7 && (typ == thriftBoolTrue || typ == thriftBoolFalse):
+			h.IsCompressed, err = r.readBool(typ)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+func (w *thriftWriter) writeFileMetaData(m *FileMetaData) {
+	w.structBegin()
+	w.writeI32Field(1, m.Version)
+	w.fieldBegin(2, thriftList)
+	w.writeListHeader(thriftStruct, len(m.Schema))
+	for i := range m.Schema {
+		w.writeSchemaElement(&m.Schema[i])
+	}
+	w.writeI64Field(3, m.NumRows)
+	w.fieldBegin(4, thriftList)
+	w.writeListHeader(thriftStruct, len(m.RowGroups))
+	for i := range m.RowGroups {
+		w.writeRowGroup(&m.RowGroups[i])
+	}
+	if len(m.KeyValueMetadata) > 0 {
+		w.fieldBegin(5, thriftList)
+		w.writeListHeader(thriftStruct, len(m.KeyValueMetadata))
+		for _, kv := range m.KeyValueMetadata {
+			w.structBegin()
+			w.writeStringField(1, kv.Key)
+			w.writeStringField(2, kv.Value)
+			w.structEnd()
+		}
+	}
+	if m.CreatedBy != "" {
+		w.writeStringField(6, m.CreatedBy)
+	}
+	w.structEnd()
+}
+
+func (w *thriftWriter) writeSchemaElement(e *SchemaElement) {
+	w.structBegin()
+	if e.Type != nil {
+		w.writeI32Field(1, int32(*e.Type))
+	}
+	if e.RepetitionType != nil {
+		w.writeI32Field(3, int32(*e.RepetitionType))
+	}
+	w.writeStringField(4, e.Name)
+	if e.NumChildren > 0 {
+		w.writeI32Field(5, e.NumChildren)
+	}
+	if e.ConvertedType != nil {
+		w.writeI32Field(6, int32(*e.ConvertedType))
+	}
+	if lt := e.LogicalType; lt != nil {
+		w.writeStructField(10, func() {
+			switch {
+			case lt.String:
+				w.writeStructField(1, func() {})
+			case lt.Timestamp != nil:
+				w.writeStructField(8, func() {
+					w.writeBoolField(1, lt.Timestamp.IsAdjustedToUTC)
+					w.writeStructField(2, func() {
+						w.writeStructField(int16(lt.Timestamp.Unit), func() {})
+					})
+				})
+			case lt.Integer != nil:
+				w.writeStructField(10, func() {
+					w.fieldBegin(1, thriftByte)
+					w.buf = append(w.buf, byte(lt.Integer.BitWidth))
+					w.writeBoolField(2, lt.Integer.IsSigned)
+				})
+			}
+		})
+	}
+	w.structEnd()
+}
+
+func (w *thriftWriter) writeRowGroup(rg *RowGroup) {
+	w.structBegin()
+	w.fieldBegin(1, thriftList)
+	w.writeListHeader(thriftStruct, len(rg.Columns))
+	for _, c := range rg.Columns {
+		w.structBegin()
+		w.writeI64Field(2, c.FileOffset)
+		w.writeStructField(3, func() {
+			m := c.MetaData
+			w.writeI32Field(1, int32(m.Type))
+			w.fieldBegin(2, thriftList)
+			w.writeListHeader(thriftI32, len(m.Encodings))
+			for _, enc := range m.Encodings {
+				w.writeVarint(int64(enc))
+			}
+			w.fieldBegin(3, thriftList)
+			w.writeListHeader(thriftBinary, len(m.PathInSchema))
+			for _, p := range m.PathInSchema {
+				w.writeBinary([]byte(p))
+			}
+			w.writeI32Field(4, int32(m.Codec))
+			w.writeI64Field(5, m.NumValues)
+			w.writeI64Field(6, m.TotalUncompressedSize)
+			w.writeI64Field(7, m.TotalCompressedSize)
+			w.writeI64Field(9, m.DataPageOffset)
+		})
+		w.structEnd()
+	}
+	w.writeI64Field(2, rg.TotalByteSize)
+	w.writeI64Field(3, rg.NumRows)
+	w.structEnd()
+}
+
+func (w *thriftWriter) writePageHeader(h *PageHeader) {
+	w.structBegin()
+	w.writeI32Field(1, int32(h.Type))
+	w.writeI32Field(2, h.UncompressedPageSize)
+	w.writeI32Field(3, h.CompressedPageSize)
+	if dp := h.DataPageHeader; dp != nil {
+		w.writeStructField(5, func() {
+			w.writeI32Field(1, dp.NumValues)
+			w.writeI32Field(2, int32(dp.Encoding))
+			w.writeI32Field(3, int32(dp.DefinitionLevelEncoding))
+			w.writeI32Field(4, int32(dp.RepetitionLevelEncoding))
+		})
+	}
+	w.structEnd()
+}
@@ -0,0 +1,271 @@
+package parquet_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/internal/parquet"
+	"github.com/influxdata/flux/values"
+)
+
+// newTable builds a table from rows of values.
+// A nil value is a null.
+func newTable(t *testing.T, cols []flux.ColMeta, rows [][]interface{}) flux.Table {
+	t.Helper()
+	builders := make([]array.Builder, len(cols))
+	for j, col := range cols {
+		builders[j] = arrow.NewBuilder(col.Type, memory.DefaultAllocator)
+	}
+	for _, row := range rows {
+		for j, v := range row {
+			if v == nil {
+				builders[j].AppendNull()
+				continue
+			}
+			if err := arrow.AppendValue(builders[j], values.New(v)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	buffer := &arrow.TableBuffer{
+		GroupKey: execute.NewGroupKey(nil, nil),
+		Columns:  cols,
+		Values:   make([]array.Interface, len(cols)),
+	}
+	for j, b := range builders {
+		buffer.Values[j] = b.NewArray()
+	}
+	return table.FromBuffer(buffer)
+}
+
+// readRows reads every row group of the file as rows of values.
+func readRows(t *testing.T, r *parquet.Reader) [][][]interface{} {
+	t.Helper()
+	var groups [][][]interface{}
+	for i := 0; i < r.NumRowGroups(); i++ {
+		arrs, err := r.ReadRowGroup(i, memory.DefaultAllocator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var rows [][]interface{}
+		for k := 0; k < arrs[0].Len(); k++ {
+			row := make([]interface{}, len(arrs))
+			for j, arr := range arrs {
+				if arr.IsNull(k) {
+					continue
+				}
+				switch a := arr.(type) {
+				case *array.Int64:
+					row[j] = a.Value(k)
+				case *array.Uint64:
+					row[j] = a.Value(k)
+				case *array.Float64:
+					row[j] = a.Value(k)
+				case *array.Boolean:
+					row[j] = a.Value(k)
+				case *array.Binary:
+					row[j] = a.ValueString(k)
+				}
+			}
+			rows = append(rows, row)
+		}
+		groups = append(groups, rows)
+	}
+	return groups
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w := parquet.NewWriter(&buf)
+	tables := []flux.Table{
+		newTable(t, []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "host", Type: flux.TString},
+			{Label: "_value", Type: flux.TFloat},
+		}, [][]interface{}{
+			{values.Time(10), "a", 1.5},
+			{values.Time(20), nil, nil},
+			{values.Time(30), "b", -2.0},
+		}),
+		newTable(t, []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "count", Type: flux.TUInt},
+			{Label: "n", Type: flux.TInt},
+			{Label: "ok", Type: flux.TBool},
+		}, [][]interface{}{
+			{values.Time(40), uint64(1), int64(-1), true},
+			{values.Time(50), uint64(18446744073709551615), nil, false},
+		}),
+	}
+	for _, tbl := range tables {
+		if err := w.WriteTable(tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := parquet.NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+		{Label: "count", Type: flux.TUInt},
+		{Label: "n", Type: flux.TInt},
+		{Label: "ok", Type: flux.TBool},
+	}
+	if !cmp.Equal(wantCols, r.Columns()) {
+		t.Fatalf("unexpected columns -want/+got:\n%s", cmp.Diff(wantCols, r.Columns()))
+	}
+	want := [][][]interface{}{
+		{
+			{int64(10), "a", 1.5, nil, nil, nil},
+			{int64(20), nil, nil, nil, nil, nil},
+			{int64(30), "b", -2.0, nil, nil, nil},
+		},
+		{
+			{int64(40), nil, nil, uint64(1), int64(-1), true},
+			{int64(50), nil, nil, uint64(18446744073709551615), nil, false},
+		},
+	}
+	if got := readRows(t, r); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected rows -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestWriter_TypeConflict(t *testing.T) {
+	w := parquet.NewWriter(new(bytes.Buffer))
+	if err := w.WriteTable(newTable(t, []flux.ColMeta{
+		{Label: "_value", Type: flux.TFloat},
+	}, [][]interface{}{{1.0}})); err != nil {
+		t.Fatal(err)
+	}
+	err := w.WriteTable(newTable(t, []flux.ColMeta{
+		{Label: "_value", Type: flux.TString},
+	}, [][]interface{}{{"a"}}))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewReader_NotParquet(t *testing.T) {
+	if _, err := parquet.NewReader(bytes.NewReader([]byte("_time,_value\n0,1\n"))); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// The files in testdata are written by testdata/generate.py
+// from the parquet format specification and not by the writer.
+func TestReader_Files(t *testing.T) {
+	day := func(n int64) int64 { return n * 86400 * 1e9 }
+	for _, tc := range []struct {
+		name     string
+		wantCols []flux.ColMeta
+		want     [][][]interface{}
+	}{
+		{
+			name: "dictionary.parquet",
+			wantCols: []flux.ColMeta{
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "n", Type: flux.TInt},
+				{Label: "count", Type: flux.TUInt},
+			},
+			want: [][][]interface{}{{
+				{"a", 1.5, int64(7), uint64(1)},
+				{"b", 1.5, nil, uint64(2)},
+				{nil, -2.0, int64(7), uint64(3)},
+				{"a", 1.5, int64(-3), uint64(4)},
+				{"c", -2.0, nil, uint64(5)},
+				{"b", -2.0, int64(7), uint64(18446744073709551615)},
+			}},
+		},
+		{
+			name: "data_page_v2.parquet",
+			wantCols: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "name", Type: flux.TString},
+			},
+			want: [][][]interface{}{{
+				{int64(1577836800e9), 1.0, "x"},
+				{int64(1577836801e9), nil, "y"},
+				{int64(1577836802e9), 3.0, "y"},
+				{int64(1577836803e9), nil, nil},
+				{int64(1577836804e9), 5.0, "x"},
+			}},
+		},
+		{
+			name: "gzip.parquet",
+			wantCols: []flux.ColMeta{
+				{Label: "ok", Type: flux.TBool},
+				{Label: "day", Type: flux.TTime},
+				{Label: "n", Type: flux.TInt},
+			},
+			want: [][][]interface{}{
+				{
+					{true, day(18262), int64(-1)},
+					{false, day(18263), int64(0)},
+					{nil, day(18264), int64(1)},
+				},
+				{
+					{true, day(18265), int64(2147483647)},
+					{true, day(18266), int64(-2147483648)},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("testdata", tc.name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			r, err := parquet.NewReader(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(tc.wantCols, r.Columns()) {
+				t.Fatalf("unexpected columns -want/+got:\n%s", cmp.Diff(tc.wantCols, r.Columns()))
+			}
+			if got := readRows(t, r); !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected rows -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestReader_UnsupportedCodec(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "zstd.parquet"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	r, err := parquet.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = r.ReadRowGroup(0, memory.DefaultAllocator)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got, want := flux.ErrorCode(err), codes.Unimplemented; got != want {
+		t.Fatalf("unexpected error code -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
@@ -0,0 +1,521 @@
+package parquet
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/big"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/golang/snappy"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// magic is written at the beginning and end of a parquet file.
+const magic = "PAR1"
+
+// column describes how a leaf column of the parquet schema
+// is read into a flux column.
+type column struct {
+	flux.ColMeta
+	elem   SchemaElement
+	maxDef int
+
+	// unit is the number of nanoseconds in
+	// one unit of a time column.
+	unit int64
+	// scale is the scale of a decimal column.
+	scale int32
+	// decimal is set when the column is a decimal
+	// that is read as a float.
+	decimal bool
+}
+
+// Reader reads the row groups of a parquet file.
+// Nested and repeated columns are not supported.
+type Reader struct {
+	r       io.ReadSeeker
+	size    int64
+	meta    FileMetaData
+	columns []column
+}
+
+// NewReader reads the metadata of a parquet file.
+func NewReader(r io.ReadSeeker) (*Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	if size < int64(2*len(magic)+4) {
+		return nil, errors.New(codes.Invalid, "file is too small to be a parquet file")
+	}
+	var footer [8]byte
+	if _, err := r.Seek(size-8, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(r, footer[:]); err != nil {
+		return nil, err
+	}
+	if string(footer[4:]) != magic {
+		return nil, errors.New(codes.Invalid, "file is not a parquet file")
+	}
+	metaSize := int64(binary.LittleEndian.Uint32(footer[:4]))
+	if metaSize > size-int64(2*len(magic)+4) {
+		return nil, errors.New(codes.Invalid, "parquet metadata is larger than the file")
+	}
+	if _, err := r.Seek(size-8-metaSize, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	pr := &Reader{r: r, size: size}
+	tr := newThriftReader(bufio.NewReader(io.LimitReader(r, metaSize)))
+	if err := tr.readFileMetaData(&pr.meta); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "failed to read parquet metadata")
+	}
+	if err := pr.readSchema(); err != nil {
+		return nil, err
+	}
+	return pr, nil
+}
+
+func (r *Reader) readSchema() error {
+	schema := r.meta.Schema
+	if len(schema) == 0 {
+		return errors.New(codes.Invalid, "parquet schema is empty")
+	}
+	if int(schema[0].NumChildren) != len(schema)-1 {
+		return errors.New(codes.Unimplemented, "nested parquet columns are not supported")
+	}
+	r.columns = make([]column, 0, len(schema)-1)
+	for _, elem := range schema[1:] {
+		if elem.NumChildren > 0 || elem.Type == nil {
+			return errors.Newf(codes.Unimplemented, "nested parquet column %q is not supported", elem.Name)
+		}
+		col := column{elem: elem}
+		if rep := elem.RepetitionType; rep != nil {
+			switch *rep {
+			case Optional:
+				col.maxDef = 1
+			case Repeated:
+				return errors.Newf(codes.Unimplemented, "repeated parquet column %q is not supported", elem.Name)
+			}
+		}
+		if err := col.resolveType(); err != nil {
+			return err
+		}
+		r.columns = append(r.columns, col)
+	}
+	return nil
+}
+
+// resolveType determines the flux column type from the
+// physical and logical types of the parquet column.
+func (c *column) resolveType() error {
+	var (
+		lt = c.elem.LogicalType
+		ct = ConvertedType(-1)
+	)
+	if lt == nil {
+		lt = &LogicalType{}
+	}
+	if c.elem.ConvertedType != nil {
+		ct = *c.elem.ConvertedType
+	}
+	c.Label = c.elem.Name
+
+	switch typ := *c.elem.Type; typ {
+	case Boolean:
+		c.Type = flux.TBool
+	case Int32, Int64:
+		switch {
+		case lt.Decimal != nil || ct == ConvertedDecimal:
+			c.Type, c.decimal = flux.TFloat, true
+		case lt.Timestamp != nil:
+			c.Type, c.unit = flux.TTime, lt.Timestamp.Unit.nanoseconds()
+		case ct == ConvertedTimestampMillis:
+			c.Type, c.unit = flux.TTime, Millis.nanoseconds()
+		case ct == ConvertedTimestampMicros:
+			c.Type, c.unit = flux.TTime, Micros.nanoseconds()
+		case lt.Date || ct == ConvertedDate:
+			c.Type, c.unit = flux.TTime, nanosPerDay
+		case lt.Integer != nil && !lt.Integer.IsSigned,
+			ct >= ConvertedUint8 && ct <= ConvertedUint64:
+			c.Type = flux.TUInt
+		default:
+			c.Type = flux.TInt
+		}
+	case Int96:
+		c.Type, c.unit = flux.TTime, 1
+	case Float, Double:
+		c.Type = flux.TFloat
+	case ByteArray, FixedLenByteArray:
+		if lt.Decimal != nil || ct == ConvertedDecimal {
+			c.Type, c.decimal = flux.TFloat, true
+		} else {
+			c.Type = flux.TString
+		}
+	default:
+		return errors.Newf(codes.Invalid, "parquet column %q has unknown type %d", c.elem.Name, typ)
+	}
+	if c.decimal {
+		c.scale = c.elem.Scale
+		if lt.Decimal != nil {
+			c.scale = lt.Decimal.Scale
+		}
+	}
+	return nil
+}
+
+func (u TimeUnit) nanoseconds() int64 {
+	switch u {
+	case Millis:
+		return 1e6
+	case Micros:
+		return 1e3
+	default:
+		return 1
+	}
+}
+
+// Columns returns the flux columns that the
+// parquet columns are read as.
+func (r *Reader) Columns() []flux.ColMeta {
+	cols := make([]flux.ColMeta, len(r.columns))
+	for i, c := range r.columns {
+		cols[i] = c.ColMeta
+	}
+	return cols
+}
+
+// NumRowGroups returns the number of row groups in the file.
+func (r *Reader) NumRowGroups() int {
+	return len(r.meta.RowGroups)
+}
+
+// ReadRowGroup reads the row group with the given index
+// and returns an array for each column.
+func (r *Reader) ReadRowGroup(i int, mem memory.Allocator) ([]array.Interface, error) {
+	rg := r.meta.RowGroups[i]
+	if len(rg.Columns) != len(r.columns) {
+		return nil, errors.Newf(codes.Invalid, "row group %d has %d columns, expected %d", i, len(rg.Columns), len(r.columns))
+	}
+	arrs := make([]array.Interface, 0, len(r.columns))
+	release := func() {
+		for _, arr := range arrs {
+			arr.Release()
+		}
+	}
+	for j := range r.columns {
+		b := arrow.NewBuilder(r.columns[j].Type, mem)
+		b.Reserve(int(rg.NumRows))
+		err := r.readColumnChunk(&rg.Columns[j], &r.columns[j], b)
+		arr := b.NewArray()
+		b.Release()
+		arrs = append(arrs, arr)
+		if err != nil {
+			release()
+			return nil, errors.Wrapf(err, codes.Inherit, "failed to read parquet column %q", r.columns[j].Label)
+		}
+		if int64(arr.Len()) != rg.NumRows {
+			release()
+			return nil, errors.Newf(codes.Invalid, "parquet column %q has %d values, expected %d", r.columns[j].Label, arr.Len(), rg.NumRows)
+		}
+	}
+	return arrs, nil
+}
+
+func (r *Reader) readColumnChunk(cc *ColumnChunk, col *column, b array.Builder) error {
+	md := cc.MetaData
+	if md == nil {
+		return errors.New(codes.Invalid, "column chunk has no metadata")
+	}
+	if md.Type != *col.elem.Type {
+		return errors.Newf(codes.Invalid, "column chunk has type %s, expected %s", md.Type, *col.elem.Type)
+	}
+	start := md.DataPageOffset
+	if off := md.DictionaryPageOffset; off != nil && *off > 0 && *off < start {
+		start = *off
+	}
+	if start < 0 || md.TotalCompressedSize < 0 || start+md.TotalCompressedSize > r.size {
+		return errors.New(codes.Invalid, "column chunk is outside of the file")
+	}
+	if _, err := r.r.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+	data := make([]byte, md.TotalCompressedSize)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return err
+	}
+
+	var (
+		br   = bytes.NewReader(data)
+		tr   = newThriftReader(br)
+		dict *columnValues
+		vals = &columnValues{typ: md.Type}
+		read int64
+	)
+	for read < md.NumValues {
+		var h PageHeader
+		if err := tr.readPageHeader(&h); err != nil {
+			return err
+		}
+		if h.CompressedPageSize < 0 || int64(h.CompressedPageSize) > int64(br.Len()) {
+			return errors.New(codes.Invalid, "page is truncated")
+		}
+		page := make([]byte, h.CompressedPageSize)
+		if _, err := io.ReadFull(br, page); err != nil {
+			return err
+		}
+
+		switch h.Type {
+		case DictionaryPage:
+			if h.DictionaryPageHeader == nil {
+				return errors.New(codes.Invalid, "dictionary page has no header")
+			}
+			page, err := decompress(md.Codec, page, h.UncompressedPageSize)
+			if err != nil {
+				return err
+			}
+			dict = &columnValues{typ: md.Type}
+			if _, err := decodePlain(dict, page, int(col.elem.TypeLength), int(h.DictionaryPageHeader.NumValues)); err != nil {
+				return err
+			}
+		case DataPage, DataPageV2:
+			vals.reset()
+			n, defs, err := readDataPage(&h, md.Codec, page, col, dict, vals)
+			if err != nil {
+				return err
+			}
+			if err := col.appendValues(b, n, defs, vals); err != nil {
+				return err
+			}
+			read += int64(n)
+		default:
+			// Index pages and unknown pages are skipped.
+		}
+	}
+	return nil
+}
+
+// readDataPage decodes the values of a data page into vals.
+// It returns the number of values in the page including nulls
+// and the definition levels of each value.
+func readDataPage(h *PageHeader, codec Codec, page []byte, col *column, dict, vals *columnValues) (int, []int32, error) {
+	var (
+		n        int
+		defs     []int32
+		encoding Encoding
+		err      error
+	)
+	if h.Type == DataPage {
+		if h.DataPageHeader == nil {
+			return 0, nil, errors.New(codes.Invalid, "data page has no header")
+		}
+		n, encoding = int(h.DataPageHeader.NumValues), h.DataPageHeader.Encoding
+		if page, err = decompress(codec, page, h.UncompressedPageSize); err != nil {
+			return 0, nil, err
+		}
+		if col.maxDef > 0 {
+			if defs, page, err = decodeLevels(page, col.maxDef, n); err != nil {
+				return 0, nil, err
+			}
+		}
+	} else {
+		dp := h.DataPageHeaderV2
+		if dp == nil {
+			return 0, nil, errors.New(codes.Invalid, "data page has no header")
+		}
+		n, encoding = int(dp.NumValues), dp.Encoding
+		repLen, defLen := int(dp.RepetitionLevelsByteLength), int(dp.DefinitionLevelsByteLength)
+		if repLen < 0 || defLen < 0 || repLen+defLen > len(page) {
+			return 0, nil, errors.New(codes.Invalid, "levels are truncated")
+		}
+		if col.maxDef > 0 {
+			if defs, err = decodeHybrid(page[repLen:repLen+defLen], bitWidth(uint64(col.maxDef)), n); err != nil {
+				return 0, nil, err
+			}
+		}
+		page = page[repLen+defLen:]
+		if dp.IsCompressed {
+			size := h.UncompressedPageSize - int32(repLen+defLen)
+			if page, err = decompress(codec, page, size); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+
+	nonNull := n
+	if defs != nil {
+		nonNull = 0
+		for _, d := range defs {
+			if int(d) == col.maxDef {
+				nonNull++
+			}
+		}
+	}
+	if err := decodeValues(vals, encoding, page, col, dict, nonNull); err != nil {
+		return 0, nil, err
+	}
+	if vals.len() != nonNull {
+		return 0, nil, errors.Newf(codes.Invalid, "page contains %d values, expected %d", vals.len(), nonNull)
+	}
+	return n, defs, nil
+}
+
+func decodeValues(vals *columnValues, encoding Encoding, page []byte, col *column, dict *columnValues, n int) error {
+	var err error
+	switch encoding {
+	case EncodingPlain:
+		_, err = decodePlain(vals, page, int(col.elem.TypeLength), n)
+	case EncodingPlainDictionary, EncodingRLEDictionary:
+		if dict == nil {
+			return errors.New(codes.Invalid, "dictionary encoded page has no dictionary")
+		}
+		if len(page) < 1 {
+			return errors.New(codes.Invalid, "dictionary encoded page is empty")
+		}
+		var indices []int32
+		if indices, err = decodeHybrid(page[1:], int(page[0]), n); err == nil {
+			err = vals.appendIndices(dict, indices)
+		}
+	case EncodingRLE:
+		if vals.typ != Boolean {
+			return errors.Newf(codes.Invalid, "rle encoding is not valid for %s", vals.typ)
+		}
+		var bools []int32
+		if bools, _, err = decodeLevels(page, 1, n); err == nil {
+			for _, v := range bools {
+				vals.bools = append(vals.bools, v != 0)
+			}
+		}
+	case EncodingDeltaBinaryPacked:
+		if vals.typ != Int32 && vals.typ != Int64 {
+			return errors.Newf(codes.Invalid, "delta binary packed encoding is not valid for %s", vals.typ)
+		}
+		var ints []int64
+		if ints, _, err = decodeDeltaBinaryPacked(page, n); err == nil {
+			vals.ints = append(vals.ints, ints...)
+		}
+	case EncodingDeltaLengthByteArray:
+		if vals.typ != ByteArray {
+			return errors.Newf(codes.Invalid, "delta length byte array encoding is not valid for %s", vals.typ)
+		}
+		var bs [][]byte
+		if bs, _, err = decodeDeltaLengthByteArray(page, n); err == nil {
+			vals.bytes = append(vals.bytes, bs...)
+		}
+	case EncodingDeltaByteArray:
+		if vals.typ != ByteArray && vals.typ != FixedLenByteArray {
+			return errors.Newf(codes.Invalid, "delta byte array encoding is not valid for %s", vals.typ)
+		}
+		var bs [][]byte
+		if bs, err = decodeDeltaByteArray(page, n); err == nil {
+			vals.bytes = append(vals.bytes, bs...)
+		}
+	case EncodingByteStreamSplit:
+		err = decodeByteStreamSplit(vals, page, n)
+	default:
+		return errors.Newf(codes.Unimplemented, "parquet encoding %d is not supported", encoding)
+	}
+	return err
+}
+
+func decompress(codec Codec, data []byte, size int32) ([]byte, error) {
+	switch codec {
+	case Uncompressed:
+		return data, nil
+	case Snappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "failed to decompress page")
+		}
+		if n != int(size) {
+			return nil, errors.New(codes.Invalid, "decompressed page size does not match the page header")
+		}
+		out, err := snappy.Decode(nil, data)
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "failed to decompress page")
+		}
+		return out, nil
+	case Gzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "failed to decompress page")
+		}
+		out, err := ioutil.ReadAll(io.LimitReader(zr, int64(size)))
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "failed to decompress page")
+		}
+		return out, nil
+	default:
+		return nil, errors.Newf(codes.Unimplemented, "parquet compression codec %d is not supported", codec)
+	}
+}
+
+// appendValues appends the n values of a page to the builder.
+// A definition level below the maximum is a null value.
+func (c *column) appendValues(b array.Builder, n int, defs []int32, vals *columnValues) error {
+	next := 0
+	for i := 0; i < n; i++ {
+		if defs != nil && int(defs[i]) < c.maxDef {
+			b.AppendNull()
+			continue
+		}
+		if err := c.appendValue(b, vals, next); err != nil {
+			return err
+		}
+		next++
+	}
+	return nil
+}
+
+func (c *column) appendValue(b array.Builder, vals *columnValues, i int) error {
+	switch c.Type {
+	case flux.TBool:
+		return arrow.AppendBool(b, vals.bools[i])
+	case flux.TInt:
+		return arrow.AppendInt(b, vals.ints[i])
+	case flux.TUInt:
+		v := uint64(vals.ints[i])
+		if vals.typ == Int32 {
+			v = uint64(uint32(vals.ints[i]))
+		}
+		return arrow.AppendUint(b, v)
+	case flux.TTime:
+		return arrow.AppendInt(b, vals.ints[i]*c.unit)
+	case flux.TFloat:
+		if !c.decimal {
+			return arrow.AppendFloat(b, vals.floats[i])
+		}
+		unscaled := new(big.Float)
+		switch vals.typ {
+		case Int32, Int64:
+			unscaled.SetInt64(vals.ints[i])
+		default:
+			unscaled.SetInt(decodeBigInt(vals.bytes[i]))
+		}
+		v, _ := unscaled.Float64()
+		return arrow.AppendFloat(b, v/math.Pow10(int(c.scale)))
+	case flux.TString:
+		return arrow.AppendString(b, string(vals.bytes[i]))
+	default:
+		return errors.Newf(codes.Internal, "unexpected column type %v", c.Type)
+	}
+}
+
+// decodeBigInt decodes a big endian two's complement integer.
+func decodeBigInt(b []byte) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+	}
+	return v
+}
@@ -0,0 +1,473 @@
+#!/usr/bin/env python3
+"""Generates the parquet files that the reader is tested against.
+
+The files are written from the parquet format specification
+(https://github.com/apache/parquet-format) with only the Python standard
+library, so they do not share any code or assumptions with the writer in
+this package. Each file covers a part of the format that the writer does
+not produce:
+
+  dictionary.parquet        dictionary pages with PLAIN_DICTIONARY and
+                            RLE_DICTIONARY data pages, bit-packed and RLE
+                            runs and a chunk that falls back to PLAIN
+  data_page_v2.parquet      DATA_PAGE_V2 pages with GZIP, including a page
+                            whose values are not compressed
+  gzip.parquet              DATA_PAGE pages with GZIP in two row groups
+  zstd.parquet              the ZSTD codec, which the reader does not support
+
+Run it from this directory to regenerate the files:
+
+  python3 generate.py
+"""
+
+import gzip
+import struct
+
+# Thrift compact protocol types.
+T_BOOL_TRUE = 1
+T_BOOL_FALSE = 2
+T_BYTE = 3
+T_I32 = 5
+T_I64 = 6
+T_BINARY = 8
+T_LIST = 9
+T_STRUCT = 12
+
+# parquet.thrift enums.
+BOOLEAN, INT32, INT64, INT96, FLOAT, DOUBLE, BYTE_ARRAY = range(7)
+REQUIRED, OPTIONAL = 0, 1
+UTF8, DATE, TIMESTAMP_MILLIS, UINT_64 = 0, 6, 9, 14
+UNCOMPRESSED, GZIP, ZSTD = 0, 2, 6
+DATA_PAGE, DICTIONARY_PAGE, DATA_PAGE_V2 = 0, 2, 3
+PLAIN, PLAIN_DICTIONARY, RLE, RLE_DICTIONARY = 0, 2, 3, 8
+
+
+def varint(n):
+    out = bytearray()
+    while True:
+        b = n & 0x7F
+        n >>= 7
+        if n:
+            out.append(b | 0x80)
+        else:
+            out.append(b)
+            return bytes(out)
+
+
+def zigzag(n):
+    return (n << 1) ^ (n >> 63)
+
+
+class Struct:
+    """A thrift struct as a list of (field id, type, value)."""
+
+    def __init__(self, *fields):
+        self.fields = [f for f in fields if f[2] is not None]
+
+
+def encode_value(typ, v):
+    if typ in (T_I32, T_I64):
+        return varint(zigzag(v))
+    if typ == T_BYTE:
+        return bytes([v & 0xFF])
+    if typ == T_BINARY:
+        if isinstance(v, str):
+            v = v.encode()
+        return varint(len(v)) + v
+    if typ == T_STRUCT:
+        return encode_struct(v)
+    if typ == T_LIST:
+        elem, items = v
+        if len(items) < 15:
+            head = bytes([(len(items) << 4) | elem])
+        else:
+            head = bytes([0xF0 | elem]) + varint(len(items))
+        return head + b"".join(encode_value(elem, item) for item in items)
+    raise ValueError(typ)
+
+
+def encode_struct(s):
+    out = bytearray()
+    last = 0
+    for fid, typ, v in s.fields:
+        if typ == "bool":
+            typ = T_BOOL_TRUE if v else T_BOOL_FALSE
+        delta = fid - last
+        if 0 < delta <= 15:
+            out.append((delta << 4) | typ)
+        else:
+            out.append(typ)
+            out += varint(zigzag(fid))
+        last = fid
+        if typ not in (T_BOOL_TRUE, T_BOOL_FALSE):
+            out += encode_value(typ, v)
+    out.append(0)
+    return bytes(out)
+
+
+def bit_width(n):
+    return max(n, 0).bit_length()
+
+
+def rle_run(value, count, width):
+    return varint(count << 1) + value.to_bytes((width + 7) // 8, "little")
+
+
+def bit_packed_run(values, width):
+    """Encodes the values as a single bit-packed run of groups of 8."""
+    values = list(values) + [0] * (-len(values) % 8)
+    bits = 0
+    for i, v in enumerate(values):
+        bits |= v << (i * width)
+    return varint((len(values) // 8) << 1 | 1) + bits.to_bytes(len(values) * width // 8, "little")
+
+
+def rle_runs(values, width):
+    """Encodes the values as RLE runs of repeated values."""
+    out = bytearray()
+    i = 0
+    while i < len(values):
+        j = i
+        while j < len(values) and values[j] == values[i]:
+            j += 1
+        out += rle_run(values[i], j - i, width)
+        i = j
+    return bytes(out)
+
+
+def plain(typ, values):
+    if typ == BOOLEAN:
+        bits = 0
+        for i, v in enumerate(values):
+            bits |= int(v) << i
+        return bits.to_bytes((len(values) + 7) // 8, "little")
+    if typ == INT32:
+        return b"".join(struct.pack("<i", v) for v in values)
+    if typ == INT64:
+        return b"".join(struct.pack("<Q", v & 0xFFFFFFFFFFFFFFFF) for v in values)
+    if typ == DOUBLE:
+        return b"".join(struct.pack("<d", v) for v in values)
+    if typ == BYTE_ARRAY:
+        return b"".join(struct.pack("<I", len(v)) + v.encode() for v in values)
+    raise ValueError(typ)
+
+
+def compress(codec, data):
+    if codec == UNCOMPRESSED:
+        return data
+    if codec == GZIP:
+        return gzip.compress(data, mtime=0)
+    if codec == ZSTD:
+        # A single raw block, which is a valid zstd frame
+        # without any compression.
+        assert len(data) < 256
+        header = struct.pack("<I", 0xFD2FB528) + bytes([0x20, len(data)])
+        block = (len(data) << 3 | 1).to_bytes(3, "little")
+        return header + block + data
+    raise ValueError(codec)
+
+
+def page_header(typ, uncompressed, compressed, **headers):
+    return encode_struct(Struct(
+        (1, T_I32, typ),
+        (2, T_I32, uncompressed),
+        (3, T_I32, compressed),
+        (5, T_STRUCT, headers.get("data")),
+        (7, T_STRUCT, headers.get("dictionary")),
+        (8, T_STRUCT, headers.get("data_v2")),
+    ))
+
+
+def dictionary_page(codec, typ, values, encoding):
+    data = plain(typ, values)
+    body = compress(codec, data)
+    header = page_header(DICTIONARY_PAGE, len(data), len(body), dictionary=Struct(
+        (1, T_I32, len(values)),
+        (2, T_I32, encoding),
+    ))
+    return header + body
+
+
+def data_page(codec, defs, values, encoding, num_values):
+    """Writes a DATA_PAGE. The definition levels are prefixed by their length."""
+    data = b""
+    if defs is not None:
+        data += struct.pack("<I", len(defs)) + defs
+    data += values
+    body = compress(codec, data)
+    header = page_header(DATA_PAGE, len(data), len(body), data=Struct(
+        (1, T_I32, num_values),
+        (2, T_I32, encoding),
+        (3, T_I32, RLE),
+        (4, T_I32, RLE),
+    ))
+    return header + body
+
+
+def data_page_v2(codec, defs, values, encoding, num_values, num_nulls, compressed=True):
+    """Writes a DATA_PAGE_V2. Only the values are compressed."""
+    defs = defs or b""
+    body = compress(codec, values) if compressed else values
+    header = page_header(DATA_PAGE_V2, len(defs) + len(values), len(defs) + len(body), data_v2=Struct(
+        (1, T_I32, num_values),
+        (2, T_I32, num_nulls),
+        (3, T_I32, num_values),
+        (4, T_I32, encoding),
+        (5, T_I32, len(defs)),
+        (6, T_I32, 0),
+        (7, "bool", compressed),
+    ))
+    return header + defs + body
+
+
+def dictionary_indices(indices, width, packed=False):
+    """Encodes dictionary indices with their bit width."""
+    runs = bit_packed_run(indices, width) if packed else rle_runs(indices, width)
+    return bytes([width]) + runs
+
+
+class Column:
+    def __init__(self, name, typ, repetition, pages, encodings, num_values,
+                 converted=None, logical=None, dictionary=None):
+        self.name = name
+        self.typ = typ
+        self.repetition = repetition
+        self.pages = pages
+        self.encodings = encodings
+        self.num_values = num_values
+        self.converted = converted
+        self.logical = logical
+        self.dictionary = dictionary
+
+    def schema(self):
+        return Struct(
+            (1, T_I32, self.typ),
+            (3, T_I32, self.repetition),
+            (4, T_BINARY, self.name),
+            (6, T_I32, self.converted),
+            (10, T_STRUCT, self.logical),
+        )
+
+
+def write_file(path, codec, row_groups):
+    """Writes the row groups, each of which is a (rows, columns) tuple."""
+    out = bytearray(b"PAR1")
+    groups = []
+    for rows, columns in row_groups:
+        chunks = []
+        total = 0
+        for col in columns:
+            start = len(out)
+            dict_offset = None
+            if col.dictionary is not None:
+                dict_offset = len(out)
+                out += col.dictionary
+            data_offset = len(out)
+            for page in col.pages:
+                out += page
+            size = len(out) - start
+            total += size
+            chunks.append(Struct(
+                (2, T_I64, start),
+                (3, T_STRUCT, Struct(
+                    (1, T_I32, col.typ),
+                    (2, T_LIST, (T_I32, col.encodings)),
+                    (3, T_LIST, (T_BINARY, [col.name])),
+                    (4, T_I32, codec),
+                    (5, T_I64, col.num_values),
+                    (6, T_I64, size),
+                    (7, T_I64, size),
+                    (9, T_I64, data_offset),
+                    (11, T_I64, dict_offset),
+                )),
+            ))
+        groups.append(Struct(
+            (1, T_LIST, (T_STRUCT, chunks)),
+            (2, T_I64, total),
+            (3, T_I64, rows),
+        ))
+
+    columns = row_groups[0][1]
+    schema = [Struct((4, T_BINARY, "schema"), (5, T_I32, len(columns)))]
+    schema += [col.schema() for col in columns]
+    meta = encode_struct(Struct(
+        (1, T_I32, 1),
+        (2, T_LIST, (T_STRUCT, schema)),
+        (3, T_I64, sum(rows for rows, _ in row_groups)),
+        (4, T_LIST, (T_STRUCT, groups)),
+        (6, T_BINARY, "flux parquet fixture generator"),
+    ))
+    out += meta + struct.pack("<I", len(meta)) + b"PAR1"
+    with open(path, "wb") as f:
+        f.write(out)
+
+
+STRING = Struct((1, T_STRUCT, Struct()))
+
+
+def timestamp(unit):
+    return Struct((8, T_STRUCT, Struct(
+        (1, "bool", True),
+        (2, T_STRUCT, Struct((unit, T_STRUCT, Struct()))),
+    )))
+
+
+def unsigned(width):
+    return Struct((10, T_STRUCT, Struct(
+        (1, T_BYTE, width),
+        (2, "bool", False),
+    )))
+
+
+def write_dictionary():
+    # host: a, b, null, a | c, b
+    # The second page falls back to PLAIN as a writer does
+    # once the dictionary becomes too large.
+    host = Column(
+        "host", BYTE_ARRAY, OPTIONAL,
+        dictionary=dictionary_page(UNCOMPRESSED, BYTE_ARRAY, ["a", "b"], PLAIN),
+        pages=[
+            data_page(UNCOMPRESSED,
+                      rle_runs([1, 1, 0, 1], 1),
+                      dictionary_indices([0, 1, 0], 1, packed=True),
+                      RLE_DICTIONARY, 4),
+            data_page(UNCOMPRESSED,
+                      rle_runs([1, 1], 1),
+                      plain(BYTE_ARRAY, ["c", "b"]),
+                      PLAIN, 2),
+        ],
+        encodings=[PLAIN, RLE, RLE_DICTIONARY],
+        num_values=6,
+        converted=UTF8,
+        logical=STRING,
+    )
+    # _value: 1.5, 1.5, -2, 1.5, -2, -2
+    value = Column(
+        "_value", DOUBLE, REQUIRED,
+        dictionary=dictionary_page(UNCOMPRESSED, DOUBLE, [1.5, -2.0], PLAIN_DICTIONARY),
+        pages=[
+            data_page(UNCOMPRESSED, None,
+                      dictionary_indices([0, 0, 1, 0, 1, 1], 1),
+                      PLAIN_DICTIONARY, 6),
+        ],
+        encodings=[PLAIN_DICTIONARY, RLE],
+        num_values=6,
+    )
+    # n: 7, null, 7, -3, null, 7
+    n = Column(
+        "n", INT64, OPTIONAL,
+        dictionary=dictionary_page(UNCOMPRESSED, INT64, [7, -3, 100], PLAIN),
+        pages=[
+            data_page(UNCOMPRESSED,
+                      rle_runs([1, 0, 1, 1, 0, 1], 1),
+                      dictionary_indices([0, 0, 1, 0], 2, packed=True),
+                      RLE_DICTIONARY, 6),
+        ],
+        encodings=[PLAIN, RLE, RLE_DICTIONARY],
+        num_values=6,
+    )
+    # count: 1, 2, 3, 4, 5, 2^64-1
+    count = Column(
+        "count", INT64, REQUIRED,
+        pages=[
+            data_page(UNCOMPRESSED, None, plain(INT64, [1, 2, 3, 4, 5, 2**64 - 1]), PLAIN, 6),
+        ],
+        encodings=[PLAIN],
+        num_values=6,
+        converted=UINT_64,
+        logical=unsigned(64),
+    )
+    write_file("dictionary.parquet", UNCOMPRESSED, [(6, [host, value, n, count])])
+
+
+def write_data_page_v2():
+    start = 1577836800000  # 2020-01-01T00:00:00Z
+    times = [start + i * 1000 for i in range(5)]
+    # _time: two pages of required values.
+    time = Column(
+        "_time", INT64, REQUIRED,
+        pages=[
+            data_page_v2(GZIP, None, plain(INT64, times[:3]), PLAIN, 3, 0),
+            data_page_v2(GZIP, None, plain(INT64, times[3:]), PLAIN, 2, 0),
+        ],
+        encodings=[PLAIN],
+        num_values=5,
+        converted=TIMESTAMP_MILLIS,
+        logical=timestamp(1),
+    )
+    # _value: 1, null, 3, null, 5
+    value = Column(
+        "_value", DOUBLE, OPTIONAL,
+        pages=[
+            data_page_v2(GZIP, rle_runs([1, 0, 1, 0, 1], 1), plain(DOUBLE, [1.0, 3.0, 5.0]), PLAIN, 5, 2),
+        ],
+        encodings=[PLAIN, RLE],
+        num_values=5,
+    )
+    # name: x, y, y, null, x
+    # The values of the page are not compressed.
+    name = Column(
+        "name", BYTE_ARRAY, OPTIONAL,
+        dictionary=dictionary_page(GZIP, BYTE_ARRAY, ["x", "y"], PLAIN),
+        pages=[
+            data_page_v2(GZIP,
+                         rle_runs([1, 1, 1, 0, 1], 1),
+                         dictionary_indices([0, 1, 1, 0], 1),
+                         RLE_DICTIONARY, 5, 1, compressed=False),
+        ],
+        encodings=[PLAIN, RLE, RLE_DICTIONARY],
+        num_values=5,
+        converted=UTF8,
+        logical=STRING,
+    )
+    write_file("data_page_v2.parquet", GZIP, [(5, [time, value, name])])
+
+
+def write_gzip():
+    def group(ok, days, n):
+        defs = [0 if v is None else 1 for v in ok]
+        return (len(n), [
+            Column(
+                "ok", BOOLEAN, OPTIONAL,
+                pages=[data_page(GZIP, rle_runs(defs, 1), plain(BOOLEAN, [v for v in ok if v is not None]), PLAIN, len(ok))],
+                encodings=[PLAIN, RLE],
+                num_values=len(ok),
+            ),
+            Column(
+                "day", INT32, REQUIRED,
+                pages=[data_page(GZIP, None, plain(INT32, days), PLAIN, len(days))],
+                encodings=[PLAIN],
+                num_values=len(days),
+                converted=DATE,
+                logical=Struct((6, T_STRUCT, Struct())),
+            ),
+            Column(
+                "n", INT32, REQUIRED,
+                pages=[data_page(GZIP, None, plain(INT32, n), PLAIN, len(n))],
+                encodings=[PLAIN],
+                num_values=len(n),
+            ),
+        ])
+
+    # Days since the epoch starting at 2020-01-01.
+    write_file("gzip.parquet", GZIP, [
+        group([True, False, None], [18262, 18263, 18264], [-1, 0, 1]),
+        group([True, True], [18265, 18266], [2147483647, -2147483648]),
+    ])
+
+
+def write_zstd():
+    n = Column(
+        "n", INT64, REQUIRED,
+        pages=[data_page(ZSTD, None, plain(INT64, [1, 2, 3]), PLAIN, 3)],
+        encodings=[PLAIN],
+        num_values=3,
+    )
+    write_file("zstd.parquet", ZSTD, [(3, [n])])
+
+
+if __name__ == "__main__":
+    write_dictionary()
+    write_data_page_v2()
+    write_gzip()
+    write_zstd()
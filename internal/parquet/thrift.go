@@ -0,0 +1,341 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// The types used by the thrift compact protocol.
+const (
+	thriftStop        = 0x00
+	thriftBoolTrue    = 0x01
+	thriftBoolFalse   = 0x02
+	thriftByte        = 0x03
+	thriftI16         = 0x04
+	thriftI32         = 0x05
+	thriftI64         = 0x06
+	thriftDouble      = 0x07
+	thriftBinary      = 0x08
+	thriftList        = 0x09
+	thriftSet         = 0x0A
+	thriftMap         = 0x0B
+	thriftStruct      = 0x0C
+	maxThriftNesting  = 64
+	maxThriftListSize = 1 << 24
+)
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// thriftReader decodes values that were encoded
+// with the thrift compact protocol.
+type thriftReader struct {
+	r     byteReader
+	depth int
+}
+
+func newThriftReader(r byteReader) *thriftReader {
+	return &thriftReader{r: r}
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return b, err
+}
+
+func (r *thriftReader) readUvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+func (r *thriftReader) readVarint() (int64, error) {
+	v, err := binary.ReadVarint(r.r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+func (r *thriftReader) readI32() (int32, error) {
+	v, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, errors.New(codes.Invalid, "thrift i32 value out of range")
+	}
+	return int32(v), nil
+}
+
+func (r *thriftReader) readI64() (int64, error) {
+	return r.readVarint()
+}
+
+func (r *thriftReader) readDouble() (float64, error) {
+	var b [8]byte
+	for i := range b {
+		c, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		b[i] = c
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b[:])), nil
+}
+
+func (r *thriftReader) readBinary() ([]byte, error) {
+	n, err := r.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > math.MaxInt32 {
+		return nil, errors.New(codes.Invalid, "thrift binary value is too large")
+	}
+	// Copy the value instead of allocating the length up front
+	// so a corrupt length cannot cause a large allocation.
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r.r, int64(n)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *thriftReader) readString() (string, error) {
+	b, err := r.readBinary()
+	return string(b), err
+}
+
+// readListHeader reads the header of a list or set
+// and returns the element type and the number of elements.
+func (r *thriftReader) readListHeader() (byte, int, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	size := int(b >> 4)
+	if size == 15 {
+		n, err := r.readUvarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		if n > maxThriftListSize {
+			return 0, 0, errors.New(codes.Invalid, "thrift list is too large")
+		}
+		size = int(n)
+	}
+	return b & 0x0F, size, nil
+}
+
+// readStruct reads the fields of a struct and calls fn with the
+// identifier and type of each field. The function must consume
+// the value of the field or call skip to ignore it.
+func (r *thriftReader) readStruct(fn func(id int16, typ byte) error) error {
+	r.depth++
+	defer func() { r.depth-- }()
+	if r.depth > maxThriftNesting {
+		return errors.New(codes.Invalid, "thrift structure is nested too deeply")
+	}
+
+	var last int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		typ := b & 0x0F
+		if typ == thriftStop {
+			return nil
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			v, err := r.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		last = id
+		if err := fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+// readBool reads a boolean field. The value of a boolean
+// field is stored in its type.
+func (r *thriftReader) readBool(typ byte) (bool, error) {
+	switch typ {
+	case thriftBoolTrue:
+		return true, nil
+	case thriftBoolFalse:
+		return false, nil
+	default:
+		return false, errors.Newf(codes.Invalid, "thrift type %d is not a boolean", typ)
+	}
+}
+
+// skip will read and discard a value of the given type.
+func (r *thriftReader) skip(typ byte) error {
+	switch typ {
+	case thriftBoolTrue, thriftBoolFalse:
+		return nil
+	case thriftByte:
+		_, err := r.readByte()
+		return err
+	case thriftI16, thriftI32, thriftI64:
+		_, err := r.readVarint()
+		return err
+	case thriftDouble:
+		_, err := r.readDouble()
+		return err
+	case thriftBinary:
+		_, err := r.readBinary()
+		return err
+	case thriftList, thriftSet:
+		elemType, n, err := r.readListHeader()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < n; i++ {
+			// Booleans within a list are stored as a single byte.
+			if elemType == thriftBoolTrue || elemType == thriftBoolFalse {
+				elemType = thriftByte
+			}
+			if err := r.skip(elemType); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftMap:
+		n, err := r.readUvarint()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		kv, err := r.readByte()
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < n; i++ {
+			if err := r.skip(kv >> 4); err != nil {
+				return err
+			}
+			if err := r.skip(kv & 0x0F); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftStruct:
+		return r.readStruct(func(id int16, typ byte) error {
+			return r.skip(typ)
+		})
+	default:
+		return errors.Newf(codes.Invalid, "unknown thrift type %d", typ)
+	}
+}
+
+// thriftWriter encodes values with the thrift compact protocol.
+type thriftWriter struct {
+	buf  []byte
+	last []int16
+}
+
+func (w *thriftWriter) bytes() []byte {
+	return w.buf
+}
+
+func (w *thriftWriter) writeUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *thriftWriter) writeVarint(v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	w.buf = append(w.buf, b[:n]...)
+}
+
+func (w *thriftWriter) structBegin() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) structEnd() {
+	w.buf = append(w.buf, thriftStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) fieldBegin(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.writeVarint(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) writeBoolField(id int16, v bool) {
+	typ := byte(thriftBoolFalse)
+	if v {
+		typ = thriftBoolTrue
+	}
+	w.fieldBegin(id, typ)
+}
+
+func (w *thriftWriter) writeI32Field(id int16, v int32) {
+	w.fieldBegin(id, thriftI32)
+	w.writeVarint(int64(v))
+}
+
+func (w *thriftWriter) writeI64Field(id int16, v int64) {
+	w.fieldBegin(id, thriftI64)
+	w.writeVarint(v)
+}
+
+func (w *thriftWriter) writeBinary(v []byte) {
+	w.writeUvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *thriftWriter) writeStringField(id int16, v string) {
+	w.fieldBegin(id, thriftBinary)
+	w.writeBinary([]byte(v))
+}
+
+func (w *thriftWriter) writeListHeader(elemType byte, n int) {
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elemType)
+		return
+	}
+	w.buf = append(w.buf, 0xF0|elemType)
+	w.writeUvarint(uint64(n))
+}
+
+// writeStructField writes a field containing a struct
+// whose fields are written by fn.
+func (w *thriftWriter) writeStructField(id int16, fn func()) {
+	w.fieldBegin(id, thriftStruct)
+	w.structBegin()
+	fn()
+	w.structEnd()
+}
@@ -0,0 +1,337 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+)
+
+// createdBy is recorded in the metadata of the files
+// that are written by the Writer.
+const createdBy = "flux"
+
+// columnBuffer accumulates the values of a column
+// for a single row group.
+type columnBuffer struct {
+	defs   []int32
+	values []byte
+	bools  []bool
+}
+
+// Writer writes flux tables to a parquet file.
+// Each table is written as a row group and the schema
+// of the file contains every column of every table.
+// A column that is not in a table is null in its row group.
+//
+// Close must be called to write the metadata of the file.
+type Writer struct {
+	w       io.Writer
+	pos     int64
+	started bool
+	closed  bool
+
+	columns []flux.ColMeta
+	index   map[string]int
+	// rowGroups contains the column chunks of each row group
+	// indexed by the position of the column in the schema.
+	rowGroups []map[int]ColumnChunk
+	numRows   []int64
+}
+
+// NewWriter creates a Writer that writes a parquet file to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w:     w,
+		index: make(map[string]int),
+	}
+}
+
+func (w *Writer) write(b []byte) error {
+	if !w.started {
+		w.started = true
+		if err := w.write([]byte(magic)); err != nil {
+			return err
+		}
+	}
+	n, err := w.w.Write(b)
+	w.pos += int64(n)
+	return err
+}
+
+// WriteTable writes the table as a new row group.
+// A table with no rows only adds its columns to the schema.
+func (w *Writer) WriteTable(tbl flux.Table) error {
+	if w.closed {
+		return errors.New(codes.Internal, "parquet writer is closed")
+	}
+	cols := tbl.Cols()
+	indices := make([]int, len(cols))
+	for j, col := range cols {
+		switch col.Type {
+		case flux.TBool, flux.TInt, flux.TUInt, flux.TFloat, flux.TString, flux.TTime:
+		default:
+			return errors.Newf(codes.Invalid, "column %q has type %v which cannot be written to parquet", col.Label, col.Type)
+		}
+		idx, ok := w.index[col.Label]
+		if !ok {
+			idx = len(w.columns)
+			w.columns = append(w.columns, col)
+			w.index[col.Label] = idx
+		} else if w.columns[idx].Type != col.Type {
+			return errors.Newf(codes.Invalid, "column %q has type %v, but it was previously written with type %v", col.Label, col.Type, w.columns[idx].Type)
+		}
+		indices[j] = idx
+	}
+
+	var (
+		buffers = make([]columnBuffer, len(cols))
+		rows    int64
+	)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		rows += int64(cr.Len())
+		for j, col := range cols {
+			bufferColumn(&buffers[j], cr, j, col.Type)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	chunks := make(map[int]ColumnChunk, len(cols))
+	for j, col := range cols {
+		cc, err := w.writeChunk(col, &buffers[j])
+		if err != nil {
+			return err
+		}
+		chunks[indices[j]] = cc
+	}
+	w.rowGroups = append(w.rowGroups, chunks)
+	w.numRows = append(w.numRows, rows)
+	return nil
+}
+
+// bufferColumn appends the values of a column
+// in the plain encoding to the buffer.
+func bufferColumn(buf *columnBuffer, cr flux.ColReader, j int, typ flux.ColType) {
+	var (
+		b     [8]byte
+		valid func(i int) bool
+		add   func(i int)
+	)
+	switch typ {
+	case flux.TBool:
+		vs := cr.Bools(j)
+		valid = vs.IsValid
+		add = func(i int) { buf.bools = append(buf.bools, vs.Value(i)) }
+	case flux.TInt, flux.TTime:
+		vs := cr.Ints(j)
+		if typ == flux.TTime {
+			vs = cr.Times(j)
+		}
+		valid = vs.IsValid
+		add = func(i int) {
+			binary.LittleEndian.PutUint64(b[:], uint64(vs.Value(i)))
+			buf.values = append(buf.values, b[:8]...)
+		}
+	case flux.TUInt:
+		vs := cr.UInts(j)
+		valid = vs.IsValid
+		add = func(i int) {
+			binary.LittleEndian.PutUint64(b[:], vs.Value(i))
+			buf.values = append(buf.values, b[:8]...)
+		}
+	case flux.TFloat:
+		vs := cr.Floats(j)
+		valid = vs.IsValid
+		add = func(i int) {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(vs.Value(i)))
+			buf.values = append(buf.values, b[:8]...)
+		}
+	case flux.TString:
+		vs := cr.Strings(j)
+		valid = vs.IsValid
+		add = func(i int) {
+			v := vs.Value(i)
+			binary.LittleEndian.PutUint32(b[:], uint32(len(v)))
+			buf.values = append(buf.values, b[:4]...)
+			buf.values = append(buf.values, v...)
+		}
+	}
+	for i, l := 0, cr.Len(); i < l; i++ {
+		if !valid(i) {
+			buf.defs = append(buf.defs, 0)
+			continue
+		}
+		add(i)
+		buf.defs = append(buf.defs, 1)
+	}
+}
+
+// writeChunk writes a column chunk with a single data page.
+func (w *Writer) writeChunk(col flux.ColMeta, buf *columnBuffer) (ColumnChunk, error) {
+	values := buf.values
+	if col.Type == flux.TBool {
+		values = make([]byte, (len(buf.bools)+7)/8)
+		for i, v := range buf.bools {
+			if v {
+				values[i/8] |= 1 << uint(i%8)
+			}
+		}
+	}
+
+	levels := encodeHybrid(buf.defs, 1)
+	body := make([]byte, 4, 4+len(levels)+len(values))
+	binary.LittleEndian.PutUint32(body, uint32(len(levels)))
+	body = append(body, levels...)
+	body = append(body, values...)
+	compressed := snappy.Encode(nil, body)
+
+	var tw thriftWriter
+	tw.writePageHeader(&PageHeader{
+		Type:                 DataPage,
+		UncompressedPageSize: int32(len(body)),
+		CompressedPageSize:   int32(len(compressed)),
+		DataPageHeader: &DataPageHeader{
+			NumValues:               int32(len(buf.defs)),
+			Encoding:                EncodingPlain,
+			DefinitionLevelEncoding: EncodingRLE,
+			RepetitionLevelEncoding: EncodingRLE,
+		},
+	})
+	header := tw.bytes()
+
+	if !w.started {
+		// Write the magic number before
+		// recording the offset of the chunk.
+		if err := w.write(nil); err != nil {
+			return ColumnChunk{}, err
+		}
+	}
+	offset := w.pos
+	if err := w.write(header); err != nil {
+		return ColumnChunk{}, err
+	}
+	if err := w.write(compressed); err != nil {
+		return ColumnChunk{}, err
+	}
+	return ColumnChunk{
+		FileOffset: offset,
+		MetaData: &ColumnMetaData{
+			Type:                  physicalType(col.Type),
+			Encodings:             []Encoding{EncodingPlain, EncodingRLE},
+			PathInSchema:          []string{col.Label},
+			Codec:                 Snappy,
+			NumValues:             int64(len(buf.defs)),
+			TotalUncompressedSize: int64(len(header) + len(body)),
+			TotalCompressedSize:   int64(len(header) + len(compressed)),
+			DataPageOffset:        offset,
+		},
+	}, nil
+}
+
+// Close writes the metadata of the file. It does not
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	meta := FileMetaData{
+		Version:   1,
+		Schema:    w.schema(),
+		RowGroups: make([]RowGroup, len(w.rowGroups)),
+		CreatedBy: createdBy,
+	}
+	for i, chunks := range w.rowGroups {
+		rg := &meta.RowGroups[i]
+		rg.NumRows = w.numRows[i]
+		rg.Columns = make([]ColumnChunk, len(w.columns))
+		for j, col := range w.columns {
+			cc, ok := chunks[j]
+			if !ok {
+				// The column was not part of the table so
+				// every value in the row group is null.
+				var err error
+				cc, err = w.writeChunk(col, &columnBuffer{
+					defs: make([]int32, rg.NumRows),
+				})
+				if err != nil {
+					return err
+				}
+			}
+			rg.Columns[j] = cc
+			rg.TotalByteSize += cc.MetaData.TotalUncompressedSize
+		}
+		meta.NumRows += rg.NumRows
+	}
+
+	var tw thriftWriter
+	tw.writeFileMetaData(&meta)
+	footer := tw.bytes()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(footer)))
+	if err := w.write(footer); err != nil {
+		return err
+	}
+	if err := w.write(size[:]); err != nil {
+		return err
+	}
+	return w.write([]byte(magic))
+}
+
+func (w *Writer) schema() []SchemaElement {
+	schema := make([]SchemaElement, 0, len(w.columns)+1)
+	schema = append(schema, SchemaElement{
+		Name:        "schema",
+		NumChildren: int32(len(w.columns)),
+	})
+	for _, col := range w.columns {
+		var (
+			typ = physicalType(col.Type)
+			rep = Optional
+		)
+		elem := SchemaElement{
+			Type:           &typ,
+			RepetitionType: &rep,
+			Name:           col.Label,
+		}
+		switch col.Type {
+		case flux.TUInt:
+			ct := ConvertedUint64
+			elem.ConvertedType = &ct
+			elem.LogicalType = &LogicalType{Integer: &IntType{BitWidth: 64}}
+		case flux.TString:
+			ct := ConvertedUTF8
+			elem.ConvertedType = &ct
+			elem.LogicalType = &LogicalType{String: true}
+		case flux.TTime:
+			elem.LogicalType = &LogicalType{
+				Timestamp: &TimeType{IsAdjustedToUTC: true, Unit: Nanos},
+			}
+		}
+		schema = append(schema, elem)
+	}
+	return schema
+}
+
+func physicalType(typ flux.ColType) Type {
+	switch typ {
+	case flux.TBool:
+		return Boolean
+	case flux.TFloat:
+		return Double
+	case flux.TString:
+		return ByteArray
+	default:
+		return Int64
+	}
+}
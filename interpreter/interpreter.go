@@ -15,9 +15,10 @@ import (
 )
 
 const (
-	PackageMain = "main"
-	NowPkg      = "universe"
-	NowOption   = "now"
+	PackageMain    = "main"
+	NowPkg         = "universe"
+	NowOption      = "now"
+	LocationOption = "location"
 )
 
 type Interpreter struct {
@@ -187,6 +188,22 @@ func (irtp *Interpreter) evaluateNowOption(ctx context.Context, name string, ini
 	deps.Inject(ctx)
 }
 
+// If the option is "location", store it in the execution dependencies
+// so functions that work with calendar times can use it as their default.
+func (irtp *Interpreter) evaluateLocationOption(ctx context.Context, name string, init values.Value) {
+	if name != LocationOption {
+		return
+	}
+	if !execdeps.HaveExecutionDependencies(ctx) {
+		return
+	}
+	deps := execdeps.GetExecutionDependencies(ctx)
+	if deps.Location == nil {
+		return
+	}
+	*deps.Location = init
+}
+
 func (itrp *Interpreter) doOptionStatement(ctx context.Context, s *semantic.OptionStatement, scope values.Scope) (values.Value, error) {
 	switch a := s.Assignment.(type) {
 	case *semantic.NativeVariableAssignment:
@@ -199,6 +216,7 @@ func (itrp *Interpreter) doOptionStatement(ctx context.Context, s *semantic.Opti
 		// (eg tableFind). For those cases we immediately evaluate and store it
 		// in the execution deps.
 		itrp.evaluateNowOption(ctx, a.Identifier.Name, init)
+		itrp.evaluateLocationOption(ctx, a.Identifier.Name, init)
 
 		// Retrieve an option with the name from the scope.
 		// If it exists and is an option, then set the option
@@ -1247,6 +1265,7 @@ type Arguments interface {
 	GetFunction(name string) (values.Function, bool, error)
 	GetArray(name string, t semantic.Nature) (values.Array, bool, error)
 	GetObject(name string) (values.Object, bool, error)
+	GetDictionary(name string) (values.Dictionary, bool, error)
 
 	GetRequiredString(name string) (string, error)
 	GetRequiredInt(name string) (int64, error)
@@ -1256,6 +1275,7 @@ type Arguments interface {
 	GetRequiredArray(name string, t semantic.Nature) (values.Array, error)
 	GetRequiredArrayAllowEmpty(name string, t semantic.Nature) (values.Array, error)
 	GetRequiredObject(name string) (values.Object, error)
+	GetRequiredDictionary(name string) (values.Dictionary, error)
 
 	// listUnused returns the list of provided arguments that were not used by the function.
 	listUnused() []string
@@ -1438,6 +1458,20 @@ func (a *arguments) GetRequiredObject(name string) (values.Object, error) {
 	}
 	return v.Object(), nil
 }
+func (a *arguments) GetDictionary(name string) (values.Dictionary, bool, error) {
+	v, ok, err := a.get(name, semantic.Dictionary, false)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+	return v.(values.Dictionary), ok, nil
+}
+func (a *arguments) GetRequiredDictionary(name string) (values.Dictionary, error) {
+	v, _, err := a.get(name, semantic.Dictionary, true)
+	if err != nil {
+		return nil, err
+	}
+	return v.(values.Dictionary), nil
+}
 
 func (a *arguments) get(name string, kind semantic.Nature, required bool) (values.Value, bool, error) {
 	a.used[name] = true
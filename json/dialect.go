@@ -0,0 +1,55 @@
+package json
+
+import (
+	"net/http"
+
+	"github.com/influxdata/flux"
+)
+
+const (
+	DialectType       = "json"
+	NDJSONDialectType = "ndjson"
+)
+
+// AddDialectMappings adds the JSON and NDJSON dialect mappings.
+func AddDialectMappings(mappings flux.DialectMappings) error {
+	if err := mappings.Add(DialectType, func() flux.Dialect {
+		return &Dialect{}
+	}); err != nil {
+		return err
+	}
+	return mappings.Add(NDJSONDialectType, func() flux.Dialect {
+		return &NDJSONDialect{}
+	})
+}
+
+// Dialect describes the output format of queries as a single JSON document.
+type Dialect struct{}
+
+func (d Dialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Transfer-Encoding", "chunked")
+}
+
+func (d Dialect) Encoder() flux.MultiResultEncoder {
+	return NewMultiResultEncoder()
+}
+func (d Dialect) DialectType() flux.DialectType {
+	return DialectType
+}
+
+// NDJSONDialect describes the output format of queries as newline delimited JSON
+// with one object for each row.
+type NDJSONDialect struct{}
+
+func (d NDJSONDialect) SetHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/x-ndjson; charset=utf-8")
+	w.Header().Set("Transfer-Encoding", "chunked")
+}
+
+func (d NDJSONDialect) Encoder() flux.MultiResultEncoder {
+	return NewNDJSONMultiResultEncoder()
+}
+func (d NDJSONDialect) DialectType() flux.DialectType {
+	return NDJSONDialectType
+}
@@ -0,0 +1,235 @@
+package json
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/iocounter"
+)
+
+// NDJSONResultEncoder encodes a result as newline delimited JSON.
+// Each table starts with an object that describes its columns
+// and is followed by an object for each row:
+//
+//	{"result":"_result","table":0,"groupKey":{"host":"A"},"columns":[{"label":"host","type":"string","group":true},{"label":"_value","type":"float","group":false}]}
+//	{"result":"_result","table":0,"groupKey":{"host":"A"},"record":{"host":"A","_value":42}}
+//
+// An error is encoded as an object with an error property.
+type NDJSONResultEncoder struct{}
+
+// NewNDJSONResultEncoder creates a new NDJSONResultEncoder.
+func NewNDJSONResultEncoder() *NDJSONResultEncoder {
+	return &NDJSONResultEncoder{}
+}
+
+func (e *NDJSONResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	resultName := appendString(nil, result.Name())
+
+	tableID := 0
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		// Every line of the table starts with the result, table and group key.
+		prefix := append([]byte(`{"result":`), resultName...)
+		prefix = append(prefix, `,"table":`...)
+		prefix = strconv.AppendInt(prefix, int64(tableID), 10)
+		prefix = append(prefix, `,"groupKey":`...)
+		prefix = appendGroupKey(prefix, tbl.Key())
+
+		buf := append([]byte(nil), prefix...)
+		buf = append(buf, `,"columns":`...)
+		buf = appendColumns(buf, tbl.Cols(), tbl.Key())
+		buf = append(buf, "}\n"...)
+		if _, err := wc.Write(buf); err != nil {
+			return wrapEncodingError(err)
+		}
+
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			buf = buf[:0]
+			cols := cr.Cols()
+			for i, l := 0, cr.Len(); i < l; i++ {
+				buf = append(buf, prefix...)
+				buf = append(buf, `,"record":{`...)
+				for j, c := range cols {
+					if j > 0 {
+						buf = append(buf, ',')
+					}
+					buf = appendString(buf, c.Label)
+					buf = append(buf, ':')
+					buf = appendValue(buf, execute.ValueForRow(cr, i, j))
+				}
+				buf = append(buf, "}}\n"...)
+			}
+			_, err := wc.Write(buf)
+			return wrapEncodingError(err)
+		}); err != nil {
+			return err
+		}
+		tableID++
+		return nil
+	})
+	return wc.Count(), err
+}
+
+// EncodeError encodes an error as a line with an error property.
+func (e *NDJSONResultEncoder) EncodeError(w io.Writer, err error) error {
+	buf := append([]byte(`{"error":`), appendString(nil, err.Error())...)
+	buf = append(buf, "}\n"...)
+	_, werr := w.Write(buf)
+	return werr
+}
+
+// NewNDJSONMultiResultEncoder creates a new encoder that writes
+// the results one after another as newline delimited JSON.
+func NewNDJSONMultiResultEncoder() flux.MultiResultEncoder {
+	return &flux.DelimitedMultiResultEncoder{
+		Encoder: NewNDJSONResultEncoder(),
+	}
+}
+
+// line is a decoded line of newline delimited JSON.
+// A line is either the start of a table, a row or an error.
+type line struct {
+	Result   string                     `json:"result"`
+	Table    *int                       `json:"table"`
+	Columns  []column                   `json:"columns"`
+	GroupKey map[string]json.RawMessage `json:"groupKey"`
+	Record   map[string]json.RawMessage `json:"record"`
+	Error    string                     `json:"error"`
+}
+
+// decodeNDJSON decodes the results in r.
+// The returned iterator reports an error that was encoded after the results.
+func decodeNDJSON(r io.Reader, c ResultDecoderConfig) (*resultIterator, error) {
+	var (
+		alloc = c.allocator()
+		iter  = &resultIterator{}
+		res   *result
+		tb    *execute.ColListTableBuilder
+		id    int
+	)
+	fail := func(err error) (*resultIterator, error) {
+		if tb != nil {
+			tb.Release()
+		}
+		iter.Release()
+		return nil, err
+	}
+	finishTable := func() error {
+		if tb == nil {
+			return nil
+		}
+		tbl, err := buildTable(tb)
+		tb = nil
+		if err != nil {
+			return err
+		}
+		res.tables = append(res.tables, tbl)
+		return nil
+	}
+
+	dec := json.NewDecoder(r)
+	for n := 1; ; n++ {
+		var l line
+		if err := dec.Decode(&l); err == io.EOF {
+			break
+		} else if err != nil {
+			return fail(errors.Wrapf(err, codes.Invalid, "invalid JSON on line %d", n))
+		}
+
+		switch {
+		case l.Error != "":
+			if err := finishTable(); err != nil {
+				return fail(err)
+			}
+			iter.err = errors.New(codes.Internal, l.Error)
+			return iter, nil
+		case l.Table == nil:
+			return fail(errors.Newf(codes.Invalid, "missing table ID on line %d", n))
+		case l.Columns != nil:
+			if err := finishTable(); err != nil {
+				return fail(err)
+			}
+			if res == nil || res.name != l.Result {
+				res = &result{name: l.Result}
+				iter.results = append(iter.results, res)
+			}
+			var err error
+			if tb, err = newTableBuilder(l.Columns, l.GroupKey, alloc); err != nil {
+				return fail(errors.Wrapf(err, codes.Invalid, "line %d", n))
+			}
+			id = *l.Table
+		case l.Record != nil:
+			if tb == nil || res.name != l.Result || id != *l.Table {
+				return fail(errors.Newf(codes.Invalid, "row on line %d does not follow the columns of table %d of result %q", n, *l.Table, l.Result))
+			}
+			cols := tb.Cols()
+			row := make([]json.RawMessage, len(cols))
+			for j, c := range cols {
+				row[j] = l.Record[c.Label]
+			}
+			if err := appendRow(tb, row); err != nil {
+				return fail(errors.Wrapf(err, codes.Invalid, "line %d", n))
+			}
+		default:
+			return fail(errors.Newf(codes.Invalid, "line %d has neither columns nor a record", n))
+		}
+	}
+	if err := finishTable(); err != nil {
+		return fail(err)
+	}
+	return iter, nil
+}
+
+// NDJSONResultDecoder decodes a result that was encoded by the NDJSONResultEncoder.
+type NDJSONResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewNDJSONResultDecoder creates a new NDJSONResultDecoder.
+func NewNDJSONResultDecoder(c ResultDecoderConfig) *NDJSONResultDecoder {
+	return &NDJSONResultDecoder{c: c}
+}
+
+// Decode decodes the first result in r.
+func (d *NDJSONResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	iter, err := decodeNDJSON(r, d.c)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	if !iter.More() {
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(codes.Invalid, "no result")
+	}
+	return iter.Next(), nil
+}
+
+// NDJSONMultiResultDecoder decodes results that were encoded by the
+// multi result encoder of the NDJSON dialect.
+type NDJSONMultiResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewNDJSONMultiResultDecoder creates a new NDJSONMultiResultDecoder.
+func NewNDJSONMultiResultDecoder(c ResultDecoderConfig) *NDJSONMultiResultDecoder {
+	return &NDJSONMultiResultDecoder{c: c}
+}
+
+// Decode reads the results from r and closes r.
+// An error that was encoded after the results is reported by the Err method
+// of the iterator.
+func (d *NDJSONMultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	defer r.Close()
+	iter, err := decodeNDJSON(r, d.c)
+	if err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
@@ -0,0 +1,625 @@
+// Package json contains the JSON and NDJSON result encoders and decoders.
+//
+// The JSON encoding of the results of a query is a single document:
+//
+//	{
+//	  "results": [{
+//	    "result": "_result",
+//	    "tables": [{
+//	      "table": 0,
+//	      "columns": [
+//	        {"label": "_time", "type": "time", "group": false},
+//	        {"label": "host", "type": "string", "group": true},
+//	        {"label": "_value", "type": "float", "group": false}
+//	      ],
+//	      "groupKey": {"host": "A"},
+//	      "data": [
+//	        ["2018-04-17T00:00:00Z", "A", 42],
+//	        ["2018-04-17T00:00:01Z", "A", null]
+//	      ]
+//	    }]
+//	  }],
+//	  "error": "an error that occurred while the results were encoded"
+//	}
+//
+// Times are RFC3339 strings, the float values NaN, +Inf and -Inf
+// are the strings "NaN", "+Inf" and "-Inf", and a missing value is null.
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/iocounter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// ResultEncoder encodes a result as a JSON object.
+type ResultEncoder struct{}
+
+// NewResultEncoder creates a new ResultEncoder.
+func NewResultEncoder() *ResultEncoder {
+	return &ResultEncoder{}
+}
+
+// Encode writes the result as a JSON object to w.
+// Nothing is written if the result fails before it produces a table.
+func (e *ResultEncoder) Encode(w io.Writer, result flux.Result) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	header := append([]byte(`{"result":`), appendString(nil, result.Name())...)
+	pw := &prefixWriter{w: wc, prefix: append(header, `,"tables":[`...)}
+
+	tableID := 0
+	err := result.Tables().Do(func(tbl flux.Table) error {
+		if tableID > 0 {
+			pw.prefix = []byte(",")
+		}
+		if err := encodeTable(pw, tableID, tbl); err != nil {
+			return err
+		}
+		tableID++
+		return nil
+	})
+	if isEncoderError(err) || (err != nil && wc.Count() == 0) {
+		return wc.Count(), err
+	}
+	if _, werr := pw.Write([]byte("]}")); werr != nil {
+		return wc.Count(), wrapEncodingError(werr)
+	}
+	return wc.Count(), err
+}
+
+// encodeTable writes the table as a JSON object to w.
+// The object is complete even when reading the table fails.
+func encodeTable(w io.Writer, id int, tbl flux.Table) error {
+	buf := []byte(`{"table":`)
+	buf = strconv.AppendInt(buf, int64(id), 10)
+	buf = append(buf, `,"columns":`...)
+	buf = appendColumns(buf, tbl.Cols(), tbl.Key())
+	buf = append(buf, `,"groupKey":`...)
+	buf = appendGroupKey(buf, tbl.Key())
+	buf = append(buf, `,"data":[`...)
+	if _, err := w.Write(buf); err != nil {
+		return wrapEncodingError(err)
+	}
+
+	n := 0
+	err := tbl.Do(func(cr flux.ColReader) error {
+		buf = buf[:0]
+		for i, l := 0, cr.Len(); i < l; i++ {
+			if n > 0 {
+				buf = append(buf, ',')
+			}
+			buf = append(buf, '[')
+			for j := range cr.Cols() {
+				if j > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendValue(buf, execute.ValueForRow(cr, i, j))
+			}
+			buf = append(buf, ']')
+			n++
+		}
+		_, err := w.Write(buf)
+		return wrapEncodingError(err)
+	})
+	if isEncoderError(err) {
+		return err
+	}
+	if _, werr := w.Write([]byte("]}")); werr != nil {
+		return wrapEncodingError(werr)
+	}
+	return err
+}
+
+// MultiResultEncoder encodes multiple results as a single JSON document.
+type MultiResultEncoder struct {
+	e *ResultEncoder
+}
+
+// NewMultiResultEncoder creates a new MultiResultEncoder.
+func NewMultiResultEncoder() flux.MultiResultEncoder {
+	return &MultiResultEncoder{e: NewResultEncoder()}
+}
+
+// Encode writes the results to w as a JSON document.
+// If an error occurs before anything has been written, the error is returned.
+// Otherwise, an error that is not an encoder error is added to the document
+// and the document is completed.
+func (e *MultiResultEncoder) Encode(w io.Writer, results flux.ResultIterator) (int64, error) {
+	wc := &iocounter.Writer{Writer: w}
+	pw := &prefixWriter{w: wc, prefix: []byte(`{"results":[`)}
+
+	err := func() error {
+		for results.More() {
+			before := wc.Count()
+			if _, err := e.e.Encode(pw, results.Next()); err != nil {
+				return err
+			}
+			if wc.Count() > before {
+				pw.prefix = []byte(",")
+			}
+		}
+		return results.Err()
+	}()
+	if isEncoderError(err) || (err != nil && wc.Count() == 0) {
+		return wc.Count(), err
+	}
+
+	if wc.Count() == 0 {
+		// Open the document when there are no results.
+		if _, werr := pw.Write(nil); werr != nil {
+			return wc.Count(), wrapEncodingError(werr)
+		}
+	}
+	buf := []byte("]")
+	if err != nil {
+		buf = append(buf, `,"error":`...)
+		buf = appendString(buf, err.Error())
+	}
+	buf = append(buf, "}\n"...)
+	if _, werr := wc.Write(buf); werr != nil {
+		return wc.Count(), wrapEncodingError(werr)
+	}
+	if f, ok := w.(flusher); ok {
+		f.Flush()
+	}
+	return wc.Count(), nil
+}
+
+type flusher interface {
+	Flush()
+}
+
+// prefixWriter writes the prefix before the next write to w.
+type prefixWriter struct {
+	w      io.Writer
+	prefix []byte
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if len(w.prefix) > 0 {
+		prefix := w.prefix
+		w.prefix = nil
+		if _, err := w.w.Write(prefix); err != nil {
+			return 0, err
+		}
+	}
+	return w.w.Write(p)
+}
+
+type jsonEncoderError struct {
+	err error
+}
+
+func (e *jsonEncoderError) Error() string {
+	return fmt.Sprintf("json encoder error: %s", e.err.Error())
+}
+
+func (e *jsonEncoderError) IsEncoderError() bool {
+	return true
+}
+
+func (e *jsonEncoderError) Unwrap() error {
+	return e.err
+}
+
+func wrapEncodingError(err error) error {
+	if err == nil {
+		return err
+	}
+	return &jsonEncoderError{err: err}
+}
+
+func isEncoderError(err error) bool {
+	encErr, ok := err.(flux.EncoderError)
+	return ok && encErr.IsEncoderError()
+}
+
+// column is the encoding of the metadata of a column.
+type column struct {
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	Group bool   `json:"group"`
+}
+
+func appendColumns(buf []byte, cols []flux.ColMeta, key flux.GroupKey) []byte {
+	buf = append(buf, '[')
+	for j, c := range cols {
+		if j > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, `{"label":`...)
+		buf = appendString(buf, c.Label)
+		buf = append(buf, `,"type":`...)
+		buf = appendString(buf, c.Type.String())
+		buf = append(buf, `,"group":`...)
+		buf = strconv.AppendBool(buf, key.HasCol(c.Label))
+		buf = append(buf, '}')
+	}
+	return append(buf, ']')
+}
+
+func appendGroupKey(buf []byte, key flux.GroupKey) []byte {
+	buf = append(buf, '{')
+	for j, c := range key.Cols() {
+		if j > 0 {
+			buf = append(buf, ',')
+		}
+		buf = appendString(buf, c.Label)
+		buf = append(buf, ':')
+		buf = appendValue(buf, key.Value(j))
+	}
+	return append(buf, '}')
+}
+
+func appendValue(buf []byte, v values.Value) []byte {
+	if v.IsNull() {
+		return append(buf, "null"...)
+	}
+	switch v.Type().Nature() {
+	case semantic.Bool:
+		return strconv.AppendBool(buf, v.Bool())
+	case semantic.Int:
+		return strconv.AppendInt(buf, v.Int(), 10)
+	case semantic.UInt:
+		return strconv.AppendUint(buf, v.UInt(), 10)
+	case semantic.Float:
+		f := v.Float()
+		switch {
+		case math.IsNaN(f):
+			return append(buf, `"NaN"`...)
+		case math.IsInf(f, 1):
+			return append(buf, `"+Inf"`...)
+		case math.IsInf(f, -1):
+			return append(buf, `"-Inf"`...)
+		}
+		return strconv.AppendFloat(buf, f, 'g', -1, 64)
+	case semantic.String:
+		return appendString(buf, v.Str())
+	case semantic.Time:
+		return appendString(buf, v.Time().Time().UTC().Format(time.RFC3339Nano))
+	default:
+		return append(buf, "null"...)
+	}
+}
+
+func appendString(buf []byte, s string) []byte {
+	// Marshaling a string cannot fail.
+	b, _ := json.Marshal(s)
+	return append(buf, b...)
+}
+
+func decodeType(typ string) (flux.ColType, error) {
+	switch typ {
+	case "bool":
+		return flux.TBool, nil
+	case "int":
+		return flux.TInt, nil
+	case "uint":
+		return flux.TUInt, nil
+	case "float":
+		return flux.TFloat, nil
+	case "string":
+		return flux.TString, nil
+	case "time":
+		return flux.TTime, nil
+	default:
+		return flux.TInvalid, errors.Newf(codes.Invalid, "unsupported column type %q", typ)
+	}
+}
+
+func decodeValue(data json.RawMessage, typ flux.ColType) (values.Value, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return values.NewNull(flux.SemanticType(typ)), nil
+	}
+	switch typ {
+	case flux.TBool:
+		var b bool
+		if err := json.Unmarshal(data, &b); err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewBool(b), nil
+	case flux.TInt:
+		i, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewInt(i), nil
+	case flux.TUInt:
+		u, err := strconv.ParseUint(string(data), 10, 64)
+		if err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewUInt(u), nil
+	case flux.TFloat:
+		if data[0] == '"' {
+			var s string
+			if err := json.Unmarshal(data, &s); err != nil {
+				return nil, invalidValue(data, typ)
+			}
+			switch s {
+			case "NaN":
+				return values.NewFloat(math.NaN()), nil
+			case "+Inf":
+				return values.NewFloat(math.Inf(1)), nil
+			case "-Inf":
+				return values.NewFloat(math.Inf(-1)), nil
+			}
+			return nil, invalidValue(data, typ)
+		}
+		f, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewFloat(f), nil
+	case flux.TString:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewString(s), nil
+	case flux.TTime:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, invalidValue(data, typ)
+		}
+		return values.NewTime(values.ConvertTime(t)), nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "unsupported column type %v", typ)
+	}
+}
+
+func invalidValue(data json.RawMessage, typ flux.ColType) error {
+	return errors.Newf(codes.Invalid, "invalid %s value %s", typ, data)
+}
+
+// newTableBuilder creates a table builder with the columns and group key of a decoded table.
+func newTableBuilder(cols []column, groupKey map[string]json.RawMessage, alloc *memory.Allocator) (*execute.ColListTableBuilder, error) {
+	meta := make([]flux.ColMeta, len(cols))
+	var (
+		keyCols   []flux.ColMeta
+		keyValues []values.Value
+	)
+	for j, c := range cols {
+		typ, err := decodeType(c.Type)
+		if err != nil {
+			return nil, err
+		}
+		meta[j] = flux.ColMeta{Label: c.Label, Type: typ}
+		if c.Group {
+			v, err := decodeValue(groupKey[c.Label], typ)
+			if err != nil {
+				return nil, errors.Wrapf(err, codes.Invalid, "group key column %q", c.Label)
+			}
+			keyCols = append(keyCols, meta[j])
+			keyValues = append(keyValues, v)
+		}
+	}
+
+	tb := execute.NewColListTableBuilder(execute.NewGroupKey(keyCols, keyValues), alloc)
+	for _, c := range meta {
+		if _, err := tb.AddCol(c); err != nil {
+			tb.Release()
+			return nil, err
+		}
+	}
+	return tb, nil
+}
+
+// appendRow decodes a row and appends it to the table builder.
+func appendRow(tb *execute.ColListTableBuilder, row []json.RawMessage) error {
+	cols := tb.Cols()
+	if len(row) != len(cols) {
+		return errors.Newf(codes.Invalid, "expected %d values in row but found %d", len(cols), len(row))
+	}
+	for j, c := range cols {
+		v, err := decodeValue(row[j], c.Type)
+		if err != nil {
+			return errors.Wrapf(err, codes.Invalid, "column %q", c.Label)
+		}
+		if err := tb.AppendValue(j, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildTable creates the table from the builder and releases the builder.
+func buildTable(tb *execute.ColListTableBuilder) (flux.Table, error) {
+	defer tb.Release()
+	return tb.Table()
+}
+
+// result is a decoded result with its tables in memory.
+type result struct {
+	name   string
+	tables []flux.Table
+}
+
+func (r *result) Name() string {
+	return r.name
+}
+
+func (r *result) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *result) Do(f func(flux.Table) error) error {
+	tables := r.tables
+	r.tables = nil
+	for i, tbl := range tables {
+		if err := f(tbl); err != nil {
+			for _, tbl := range tables[i+1:] {
+				tbl.Done()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *result) release() {
+	for _, tbl := range r.tables {
+		tbl.Done()
+	}
+	r.tables = nil
+}
+
+// resultIterator iterates over decoded results.
+// The error is an error that was encoded after the results.
+type resultIterator struct {
+	results []*result
+	err     error
+}
+
+func (r *resultIterator) More() bool {
+	return len(r.results) > 0
+}
+
+func (r *resultIterator) Next() flux.Result {
+	next := r.results[0]
+	r.results = r.results[1:]
+	return next
+}
+
+func (r *resultIterator) Release() {
+	for _, res := range r.results {
+		res.release()
+	}
+	r.results = nil
+}
+
+func (r *resultIterator) Err() error {
+	return r.err
+}
+
+func (r *resultIterator) Statistics() flux.Statistics {
+	return flux.Statistics{}
+}
+
+// ResultDecoderConfig are options that can be specified on the decoders.
+type ResultDecoderConfig struct {
+	// Allocator is the memory allocator that will be used during decoding.
+	// The default is to use an unlimited allocator when this is not set.
+	Allocator *memory.Allocator
+}
+
+func (c ResultDecoderConfig) allocator() *memory.Allocator {
+	if c.Allocator == nil {
+		return &memory.Allocator{}
+	}
+	return c.Allocator
+}
+
+type document struct {
+	Results []encodedResult `json:"results"`
+	Error   string          `json:"error"`
+}
+
+type encodedResult struct {
+	Name   string         `json:"result"`
+	Tables []encodedTable `json:"tables"`
+}
+
+type encodedTable struct {
+	ID       int                        `json:"table"`
+	Columns  []column                   `json:"columns"`
+	GroupKey map[string]json.RawMessage `json:"groupKey"`
+	Data     [][]json.RawMessage        `json:"data"`
+}
+
+func (er *encodedResult) decode(alloc *memory.Allocator) (*result, error) {
+	res := &result{name: er.Name}
+	for _, et := range er.Tables {
+		tbl, err := et.decode(alloc)
+		if err != nil {
+			res.release()
+			return nil, errors.Wrapf(err, codes.Invalid, "table %d of result %q", et.ID, er.Name)
+		}
+		res.tables = append(res.tables, tbl)
+	}
+	return res, nil
+}
+
+func (et *encodedTable) decode(alloc *memory.Allocator) (flux.Table, error) {
+	tb, err := newTableBuilder(et.Columns, et.GroupKey, alloc)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range et.Data {
+		if err := appendRow(tb, row); err != nil {
+			tb.Release()
+			return nil, err
+		}
+	}
+	return buildTable(tb)
+}
+
+// ResultDecoder decodes a result that was encoded by the ResultEncoder.
+type ResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewResultDecoder creates a new ResultDecoder.
+func NewResultDecoder(c ResultDecoderConfig) *ResultDecoder {
+	return &ResultDecoder{c: c}
+}
+
+func (d *ResultDecoder) Decode(r io.Reader) (flux.Result, error) {
+	var er encodedResult
+	if err := json.NewDecoder(r).Decode(&er); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "invalid JSON result")
+	}
+	return er.decode(d.c.allocator())
+}
+
+// MultiResultDecoder decodes a JSON document that was encoded by the MultiResultEncoder.
+type MultiResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewMultiResultDecoder creates a new MultiResultDecoder.
+func NewMultiResultDecoder(c ResultDecoderConfig) *MultiResultDecoder {
+	return &MultiResultDecoder{c: c}
+}
+
+// Decode reads the document from r and closes r.
+// An error that was encoded in the document is reported by the Err method
+// of the iterator after the results that precede it.
+func (d *MultiResultDecoder) Decode(r io.ReadCloser) (flux.ResultIterator, error) {
+	defer r.Close()
+	var doc document
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, errors.Wrap(err, codes.Invalid, "invalid JSON results")
+	}
+
+	alloc := d.c.allocator()
+	iter := &resultIterator{}
+	for i := range doc.Results {
+		res, err := doc.Results[i].decode(alloc)
+		if err != nil {
+			iter.Release()
+			return nil, err
+		}
+		iter.results = append(iter.results, res)
+	}
+	if doc.Error != "" {
+		iter.err = errors.New(codes.Internal, doc.Error)
+	}
+	return iter, nil
+}
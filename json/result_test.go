@@ -0,0 +1,379 @@
+package json_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreyvit/diff"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/json"
+	"github.com/influxdata/flux/values"
+)
+
+var (
+	start = values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC))
+	stop  = values.ConvertTime(time.Date(2018, 4, 17, 0, 5, 0, 0, time.UTC))
+	t0    = values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 0, 0, time.UTC))
+	t1    = values.ConvertTime(time.Date(2018, 4, 17, 0, 0, 1, 500, time.UTC))
+)
+
+// newResults returns results with every column type, nulls,
+// special float values and an empty table.
+func newResults() []*executetest.Result {
+	return []*executetest.Result{
+		{
+			Nm: "_result",
+			Tbls: []*executetest.Table{
+				{
+					KeyCols: []string{"_start", "_stop", "host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "count", Type: flux.TInt},
+						{Label: "total", Type: flux.TUInt},
+						{Label: "ok", Type: flux.TBool},
+					},
+					Data: [][]interface{}{
+						{start, stop, t0, "A", 42.5, int64(math.MaxInt64), uint64(math.MaxUint64), true},
+						{start, stop, t1, "A", nil, nil, nil, nil},
+						{start, stop, t1, "A", math.NaN(), int64(-1), uint64(0), false},
+						{start, stop, t1, "A", math.Inf(-1), int64(0), uint64(1), false},
+					},
+				},
+				{
+					KeyCols:   []string{"_start", "_stop", "host"},
+					KeyValues: []interface{}{start, stop, nil},
+					ColMeta: []flux.ColMeta{
+						{Label: "_start", Type: flux.TTime},
+						{Label: "_stop", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+				},
+			},
+		},
+		{
+			Nm: "mean",
+			Tbls: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"B \"quoted\"\n", 1e-7},
+				},
+			}},
+		},
+	}
+}
+
+func resultIterator(results []*executetest.Result) flux.ResultIterator {
+	rs := make([]flux.Result, len(results))
+	for i, r := range results {
+		rs[i] = r
+	}
+	return flux.NewSliceResultIterator(rs)
+}
+
+func TestMultiResultEncoder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		results []*executetest.Result
+		encoded string
+		err     error
+	}{
+		{
+			name: "single result",
+			results: []*executetest.Result{{
+				Nm: "_result",
+				Tbls: []*executetest.Table{{
+					KeyCols: []string{"host"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "host", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{t0, "A", 42.0},
+						{t1, "A", nil},
+					},
+				}},
+			}},
+			encoded: `{"results":[{"result":"_result","tables":[{"table":0,"columns":[{"label":"_time","type":"time","group":false},{"label":"host","type":"string","group":true},{"label":"_value","type":"float","group":false}],"groupKey":{"host":"A"},"data":[["2018-04-17T00:00:00Z","A",42],["2018-04-17T00:00:01.0000005Z","A",null]]}]}]}
+`,
+		},
+		{
+			name: "multiple results",
+			results: []*executetest.Result{
+				{
+					Nm: "a",
+					Tbls: []*executetest.Table{{
+						ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+						Data:    [][]interface{}{{int64(1)}},
+					}},
+				},
+				{
+					Nm: "b",
+					Tbls: []*executetest.Table{
+						{
+							ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TBool}},
+							Data:    [][]interface{}{{true}},
+						},
+						{
+							ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TUInt}},
+							Data:    [][]interface{}{{uint64(2)}},
+						},
+					},
+				},
+			},
+			encoded: `{"results":[{"result":"a","tables":[{"table":0,"columns":[{"label":"_value","type":"int","group":false}],"groupKey":{},"data":[[1]]}]},{"result":"b","tables":[{"table":0,"columns":[{"label":"_value","type":"bool","group":false}],"groupKey":{},"data":[[true]]},{"table":1,"columns":[{"label":"_value","type":"uint","group":false}],"groupKey":{},"data":[[2]]}]}]}
+`,
+		},
+		{
+			name:    "no results",
+			encoded: `{"results":[]}` + "\n",
+		},
+		{
+			name: "error after result",
+			results: []*executetest.Result{
+				{
+					Nm: "a",
+					Tbls: []*executetest.Table{{
+						ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+						Data:    [][]interface{}{{int64(1)}},
+					}},
+				},
+				{
+					Nm:  "b",
+					Err: errors.New("test error"),
+				},
+			},
+			encoded: `{"results":[{"result":"a","tables":[{"table":0,"columns":[{"label":"_value","type":"int","group":false}],"groupKey":{},"data":[[1]]}]}],"error":"test error"}
+`,
+		},
+		{
+			name: "error before anything is written",
+			results: []*executetest.Result{{
+				Nm:  "a",
+				Err: errors.New("test error"),
+			}},
+			err: errors.New("test error"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got bytes.Buffer
+			n, err := json.NewMultiResultEncoder().Encode(&got, resultIterator(tc.results))
+			if err != nil {
+				if tc.err == nil {
+					t.Fatal(err)
+				} else if g, w := err.Error(), tc.err.Error(); g != w {
+					t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", w, g)
+				}
+			} else if tc.err != nil {
+				t.Errorf("expected error %q", tc.err)
+			}
+			if g, w := got.String(), tc.encoded; g != w {
+				t.Errorf("unexpected encoding -want/+got:\n%s", diff.LineDiff(w, g))
+			}
+			if g, w := n, int64(len(tc.encoded)); g != w {
+				t.Errorf("unexpected encoding count -want/+got:\n%s", cmp.Diff(w, g))
+			}
+		})
+	}
+}
+
+func TestNDJSONMultiResultEncoder(t *testing.T) {
+	results := []*executetest.Result{
+		{
+			Nm: "_result",
+			Tbls: []*executetest.Table{{
+				KeyCols: []string{"host"},
+				ColMeta: []flux.ColMeta{
+					{Label: "host", Type: flux.TString},
+					{Label: "_value", Type: flux.TFloat},
+				},
+				Data: [][]interface{}{
+					{"A", 42.0},
+					{"A", math.Inf(1)},
+				},
+			}},
+		},
+		{
+			Nm:  "b",
+			Err: errors.New("test error"),
+		},
+	}
+	want := `{"result":"_result","table":0,"groupKey":{"host":"A"},"columns":[{"label":"host","type":"string","group":true},{"label":"_value","type":"float","group":false}]}
+{"result":"_result","table":0,"groupKey":{"host":"A"},"record":{"host":"A","_value":42}}
+{"result":"_result","table":0,"groupKey":{"host":"A"},"record":{"host":"A","_value":"+Inf"}}
+{"error":"test error"}
+`
+	var got bytes.Buffer
+	if _, err := json.NewNDJSONMultiResultEncoder().Encode(&got, resultIterator(results)); err != nil {
+		t.Fatal(err)
+	}
+	if g := got.String(); g != want {
+		t.Errorf("unexpected encoding -want/+got:\n%s", diff.LineDiff(want, g))
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		encoder func() flux.MultiResultEncoder
+		decoder flux.MultiResultDecoder
+	}{
+		{
+			name:    "json",
+			encoder: json.NewMultiResultEncoder,
+			decoder: json.NewMultiResultDecoder(json.ResultDecoderConfig{}),
+		},
+		{
+			name:    "ndjson",
+			encoder: json.NewNDJSONMultiResultEncoder,
+			decoder: json.NewNDJSONMultiResultDecoder(json.ResultDecoderConfig{}),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if _, err := tc.encoder().Encode(&buf, resultIterator(newResults())); err != nil {
+				t.Fatal(err)
+			}
+			results, err := tc.decoder.Decode(ioutil.NopCloser(&buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer results.Release()
+
+			var got []*executetest.Result
+			for results.More() {
+				res := executetest.ConvertResult(results.Next())
+				if res.Err != nil {
+					t.Fatal(res.Err)
+				}
+				res.Normalize()
+				got = append(got, res)
+			}
+			if err := results.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			want := newResults()
+			for _, res := range want {
+				res.Normalize()
+			}
+			if !cmp.Equal(want, got, cmpopts.EquateNaNs()) {
+				t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got, cmpopts.EquateNaNs()))
+			}
+		})
+	}
+}
+
+func TestMultiResultDecoder_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		decoder   flux.MultiResultDecoder
+		encoded   string
+		results   int
+		err       string
+		decodeErr string
+	}{
+		{
+			name:    "json encoded error",
+			decoder: json.NewMultiResultDecoder(json.ResultDecoderConfig{}),
+			encoded: `{"results":[{"result":"a","tables":[]}],"error":"test error"}`,
+			results: 1,
+			err:     "test error",
+		},
+		{
+			name:      "json invalid value",
+			decoder:   json.NewMultiResultDecoder(json.ResultDecoderConfig{}),
+			encoded:   `{"results":[{"result":"a","tables":[{"table":0,"columns":[{"label":"_value","type":"int","group":false}],"groupKey":{},"data":[[1.5]]}]}]}`,
+			decodeErr: `table 0 of result "a": column "_value": invalid int value 1.5`,
+		},
+		{
+			name:      "json unsupported type",
+			decoder:   json.NewMultiResultDecoder(json.ResultDecoderConfig{}),
+			encoded:   `{"results":[{"result":"a","tables":[{"table":0,"columns":[{"label":"_value","type":"long","group":false}],"groupKey":{},"data":[]}]}]}`,
+			decodeErr: `table 0 of result "a": unsupported column type "long"`,
+		},
+		{
+			name:    "ndjson encoded error",
+			decoder: json.NewNDJSONMultiResultDecoder(json.ResultDecoderConfig{}),
+			encoded: `{"result":"a","table":0,"groupKey":{},"columns":[{"label":"_value","type":"int","group":false}]}
+{"result":"a","table":0,"groupKey":{},"record":{"_value":1}}
+{"error":"test error"}
+`,
+			results: 1,
+			err:     "test error",
+		},
+		{
+			name:    "ndjson row without columns",
+			decoder: json.NewNDJSONMultiResultDecoder(json.ResultDecoderConfig{}),
+			encoded: `{"result":"a","table":0,"groupKey":{},"record":{"_value":1}}
+`,
+			decodeErr: `row on line 1 does not follow the columns of table 0 of result "a"`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := tc.decoder.Decode(ioutil.NopCloser(strings.NewReader(tc.encoded)))
+			if err != nil {
+				if tc.decodeErr == "" {
+					t.Fatal(err)
+				} else if got, want := err.Error(), tc.decodeErr; got != want {
+					t.Fatalf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+				}
+				return
+			} else if tc.decodeErr != "" {
+				t.Fatalf("expected error %q", tc.decodeErr)
+			}
+			defer results.Release()
+
+			n := 0
+			for results.More() {
+				results.Next()
+				n++
+			}
+			if n != tc.results {
+				t.Errorf("unexpected number of results -want/+got:\n\t- %d\n\t+ %d", tc.results, n)
+			}
+			if err := results.Err(); err == nil {
+				t.Errorf("expected error %q", tc.err)
+			} else if got, want := err.Error(), tc.err; got != want {
+				t.Errorf("unexpected error -want/+got:\n\t- %q\n\t+ %q", want, got)
+			}
+		})
+	}
+}
+
+func TestDialectMappings(t *testing.T) {
+	mappings := make(flux.DialectMappings)
+	if err := json.AddDialectMappings(mappings); err != nil {
+		t.Fatal(err)
+	}
+	for _, typ := range []flux.DialectType{json.DialectType, json.NDJSONDialectType} {
+		create, ok := mappings[typ]
+		if !ok {
+			t.Fatalf("missing dialect mapping for %q", typ)
+		}
+		if got := create().DialectType(); got != typ {
+			t.Errorf("unexpected dialect type -want/+got:\n\t- %q\n\t+ %q", typ, got)
+		}
+	}
+}
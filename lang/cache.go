@@ -0,0 +1,480 @@
+package lang
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/arrow/array"
+	arrowmemory "github.com/apache/arrow/go/arrow/memory"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+)
+
+// ResultCacheConfig configures a ResultCache.
+type ResultCacheConfig struct {
+	// MemoryBytesQuota is the number of bytes that the cached tables may use.
+	// The least recently used results are evicted to make room for new ones.
+	// If it is zero, the size of the cache is not limited.
+	MemoryBytesQuota int64
+
+	// TTL is how long the results of a query are replayed after they were cached.
+	// If it is zero, the results are replayed until they are evicted or invalidated.
+	TTL time.Duration
+
+	// Fingerprint returns a fingerprint of the dependencies in the context.
+	// The results of a query are only replayed for a query with the same
+	// plan and dependencies fingerprint. This must be set when queries with
+	// different dependencies, such as credentials, read different data.
+	// If it returns an error, the results of the query are not cached.
+	Fingerprint func(ctx context.Context) (string, error)
+}
+
+// ResultCache caches the results of queries in memory.
+//
+// The results are keyed on the physical plan of the query, with the times
+// that are relative to now replaced by absolute times, and on the fingerprint
+// of its dependencies. A program that is compiled with the WithResultCache
+// option replays the cached results when it is started with an identical
+// plan instead of executing it.
+//
+// Plans that produce side effects or read from a stream are never cached.
+// The results of a query are only cached once all of its tables have been
+// read without an error. The tables are then copied into memory that is
+// allocated by the cache, so the cached results do not keep the memory
+// of the query that produced them.
+type ResultCache struct {
+	c ResultCacheConfig
+
+	mu sync.Mutex
+	// mem allocates the buffers of the cached tables.
+	// It has no limit since the quota is enforced
+	// by evicting results once they are copied.
+	mem     *memory.Allocator
+	entries map[string]*list.Element
+	// lru orders the entries from the most to the least recently used.
+	lru *list.List
+}
+
+// NewResultCache creates a new ResultCache.
+func NewResultCache(c ResultCacheConfig) *ResultCache {
+	return &ResultCache{
+		c:       c,
+		mem:     &memory.Allocator{},
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// cacheEntry holds the results of a query.
+type cacheEntry struct {
+	key      string
+	spec     *plan.Spec
+	results  []*cachedResult
+	expireAt time.Time
+}
+
+// cachedResult is a result whose tables are buffered.
+type cachedResult struct {
+	name   string
+	tables []flux.BufferedTable
+}
+
+// release releases the tables of the entry.
+func (e *cacheEntry) release() {
+	releaseResults(e.results)
+}
+
+// releaseResults releases the tables of the results.
+func releaseResults(results []*cachedResult) {
+	for _, res := range results {
+		for _, tbl := range res.tables {
+			tbl.Done()
+		}
+	}
+}
+
+// Len returns the number of queries with cached results.
+func (c *ResultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Allocated returns the number of bytes used by the cached tables.
+func (c *ResultCache) Allocated() int64 {
+	return c.mem.Allocated()
+}
+
+// Invalidate removes the results of the queries whose plan matches the predicate.
+// It returns the number of queries whose results were removed.
+//
+// This can be used to invalidate the queries that read data that has changed.
+// The plan must not be modified.
+func (c *ResultCache) Invalidate(fn func(ps *plan.Spec) bool) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, el := range c.entries {
+		if e := el.Value.(*cacheEntry); fn(e.spec) {
+			c.remove(el)
+			n++
+		}
+	}
+	return n
+}
+
+// Purge removes all of the cached results.
+func (c *ResultCache) Purge() {
+	c.Invalidate(func(*plan.Spec) bool { return true })
+}
+
+// remove removes the entry from the cache and releases its tables.
+// The lock must be held.
+func (c *ResultCache) remove(el *list.Element) {
+	e := el.Value.(*cacheEntry)
+	c.lru.Remove(el)
+	delete(c.entries, e.key)
+	e.release()
+}
+
+// key returns the key of the results of the program.
+func (c *ResultCache) key(ctx context.Context, ps *plan.Spec) (string, error) {
+	key, err := planKey(ps)
+	if err != nil {
+		return "", err
+	}
+	if c.c.Fingerprint != nil {
+		fp, err := c.c.Fingerprint(ctx)
+		if err != nil {
+			return "", err
+		}
+		key += ":" + fp
+	}
+	return key, nil
+}
+
+// lookup returns copies of the results that are cached for the key.
+func (c *ResultCache) lookup(key string) ([]*cachedResult, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*cacheEntry)
+	if !e.expireAt.IsZero() && !time.Now().Before(e.expireAt) {
+		c.remove(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+
+	// The tables are copied while the lock is held so that
+	// the entry cannot be released before they are retained.
+	results := make([]*cachedResult, len(e.results))
+	for i, res := range e.results {
+		tables := make([]flux.BufferedTable, len(res.tables))
+		for j, tbl := range res.tables {
+			tables[j] = tbl.Copy()
+		}
+		results[i] = &cachedResult{name: res.name, tables: tables}
+	}
+	return results, true
+}
+
+// add caches the results for the key. It takes ownership of the tables.
+func (c *ResultCache) add(key string, ps *plan.Spec, results []*cachedResult) {
+	// The results are not cached if they do not fit within the quota
+	// by themselves, so that they do not evict the other results.
+	if quota := c.c.MemoryBytesQuota; quota > 0 && resultsSize(results) > quota {
+		releaseResults(results)
+		return
+	}
+	copied, err := c.copyResults(results)
+	releaseResults(results)
+	if err != nil {
+		return
+	}
+	e := &cacheEntry{
+		key:     key,
+		spec:    ps,
+		results: copied,
+	}
+	if c.c.TTL > 0 {
+		e.expireAt = time.Now().Add(c.c.TTL)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Another query may have cached the same results in the meantime.
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	// Evict the least recently used results until there is
+	// enough room. The results are not cached if the results
+	// that are being copied by other queries leave no room.
+	for quota := c.c.MemoryBytesQuota; quota > 0 && c.mem.Allocated() > quota; {
+		el := c.lru.Back()
+		if el == nil {
+			e.release()
+			return
+		}
+		c.remove(el)
+	}
+	c.entries[key] = c.lru.PushFront(e)
+}
+
+// copyResults copies the tables of the results into buffers
+// that are allocated by the cache.
+func (c *ResultCache) copyResults(results []*cachedResult) ([]*cachedResult, error) {
+	copied := make([]*cachedResult, 0, len(results))
+	for _, res := range results {
+		cr := &cachedResult{
+			name:   res.name,
+			tables: make([]flux.BufferedTable, 0, len(res.tables)),
+		}
+		copied = append(copied, cr)
+		for _, tbl := range res.tables {
+			cpy, err := copyTable(tbl, c.mem)
+			if err != nil {
+				releaseResults(copied)
+				return nil, err
+			}
+			cr.tables = append(cr.tables, cpy)
+		}
+	}
+	return copied, nil
+}
+
+// resultsSize returns the number of bytes that the
+// copies of the tables of the results allocate.
+func resultsSize(results []*cachedResult) int64 {
+	var size int64
+	for _, res := range results {
+		for _, tbl := range res.tables {
+			for i, n := 0, tbl.BufferN(); i < n; i++ {
+				cr := tbl.Buffer(i)
+				for j := range cr.Cols() {
+					for _, buf := range table.Values(cr, j).Data().Buffers() {
+						if buf != nil {
+							// Buffers are allocated in multiples of 64 bytes.
+							size += int64(buf.Len()+63) &^ 63
+						}
+					}
+				}
+			}
+		}
+	}
+	return size
+}
+
+// copyTable copies the buffers of the table into memory from mem.
+// The table is not consumed.
+func copyTable(tbl flux.BufferedTable, mem *memory.Allocator) (flux.BufferedTable, error) {
+	buffered := &table.BufferedTable{
+		GroupKey: tbl.Key(),
+		Columns:  tbl.Cols(),
+		Buffers:  make([]flux.ColReader, 0, tbl.BufferN()),
+	}
+	// The buffered table releases the copied
+	// buffers once they have been copied again.
+	defer buffered.Done()
+
+	for i, n := 0, tbl.BufferN(); i < n; i++ {
+		cr := tbl.Buffer(i)
+		buf := &arrow.TableBuffer{
+			GroupKey: cr.Key(),
+			Columns:  cr.Cols(),
+			Values:   make([]array.Interface, len(cr.Cols())),
+		}
+		for j := range cr.Cols() {
+			buf.Values[j] = copyArray(table.Values(cr, j), mem)
+		}
+		buffered.Buffers = append(buffered.Buffers, buf)
+	}
+	return execute.CopyTable(buffered)
+}
+
+// copyArray copies the buffers of the array into memory from mem.
+func copyArray(arr array.Interface, mem *memory.Allocator) array.Interface {
+	data := arr.Data()
+	buffers := make([]*arrowmemory.Buffer, len(data.Buffers()))
+	for i, buf := range data.Buffers() {
+		if buf == nil {
+			continue
+		}
+		buffers[i] = arrowmemory.NewResizableBuffer(mem)
+		buffers[i].Resize(buf.Len())
+		copy(buffers[i].Bytes(), buf.Bytes())
+	}
+	cpy := array.NewData(data.DataType(), data.Len(), buffers, nil, data.NullN(), data.Offset())
+	for _, buf := range buffers {
+		if buf != nil {
+			buf.Release()
+		}
+	}
+	defer cpy.Release()
+	if _, ok := arr.(*array.Binary); ok {
+		// Strings are binary arrays with the string data type.
+		return array.NewBinaryData(cpy)
+	}
+	return array.MakeFromData(cpy)
+}
+
+// start replays the cached results of the program or,
+// if there are none, starts the program and records its results.
+func (c *ResultCache) start(ctx context.Context, p *Program, alloc *memory.Allocator) (flux.Query, error) {
+	key, err := c.key(ctx, p.PlanSpec)
+	if err != nil {
+		return p.start(ctx, alloc, nil)
+	}
+	if results, ok := c.lookup(key); ok {
+		return replay(ctx, results, alloc), nil
+	}
+	return p.start(ctx, alloc, &recorder{
+		cache: c,
+		key:   key,
+		spec:  p.PlanSpec,
+	})
+}
+
+// replay returns a query that produces the cached results.
+func replay(ctx context.Context, results []*cachedResult, alloc *memory.Allocator) *query {
+	ctx, cancel := context.WithCancel(ctx)
+	q := &query{
+		results: make(chan flux.Result),
+		alloc:   alloc,
+		cancel:  cancel,
+		stats: flux.Statistics{
+			Metadata: make(flux.Metadata),
+		},
+		// The tables of the results that were not read
+		// are released once the query is done.
+		done: func() {
+			for _, res := range results {
+				for _, tbl := range res.tables {
+					tbl.Done()
+				}
+			}
+		},
+	}
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		defer close(q.results)
+		for _, res := range results {
+			select {
+			case q.results <- replayedResult{res}:
+			case <-ctx.Done():
+				q.err = ctx.Err()
+				return
+			}
+		}
+	}()
+	return q
+}
+
+// replayedResult is a result that produces cached tables.
+type replayedResult struct {
+	*cachedResult
+}
+
+func (r replayedResult) Name() string {
+	return r.name
+}
+
+func (r replayedResult) Tables() flux.TableIterator {
+	return r
+}
+
+func (r replayedResult) Do(f func(flux.Table) error) error {
+	for _, tbl := range r.tables {
+		if err := f(tbl); err != nil {
+			return err
+		}
+		tbl.Done()
+	}
+	return nil
+}
+
+// recorder records the results of a query so that
+// they can be cached once the query is done.
+type recorder struct {
+	cache *ResultCache
+	key   string
+	spec  *plan.Spec
+
+	mu      sync.Mutex
+	results []*recordedResult
+}
+
+// wrap returns a result that records the tables of res.
+func (r *recorder) wrap(res flux.Result) flux.Result {
+	rr := &recordedResult{Result: res}
+	r.mu.Lock()
+	r.results = append(r.results, rr)
+	r.mu.Unlock()
+	return rr
+}
+
+// done caches the recorded results if every table of
+// every result was read. Otherwise it releases them.
+func (r *recorder) done(n int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	complete := err == nil && len(r.results) == n
+	results := make([]*cachedResult, 0, len(r.results))
+	for _, rr := range r.results {
+		complete = complete && rr.complete
+		results = append(results, &cachedResult{
+			name:   rr.Name(),
+			tables: rr.tables,
+		})
+	}
+	if !complete {
+		for _, res := range results {
+			for _, tbl := range res.tables {
+				tbl.Done()
+			}
+		}
+		return
+	}
+	r.cache.add(r.key, r.spec, results)
+}
+
+// recordedResult is a result that buffers its tables
+// as they are read.
+type recordedResult struct {
+	flux.Result
+
+	tables   []flux.BufferedTable
+	complete bool
+}
+
+func (r *recordedResult) Tables() flux.TableIterator {
+	return r
+}
+
+func (r *recordedResult) Do(f func(flux.Table) error) error {
+	if err := r.Result.Tables().Do(func(tbl flux.Table) error {
+		buffered, err := execute.CopyTable(tbl)
+		if err != nil {
+			return err
+		}
+		r.tables = append(r.tables, buffered)
+		return f(buffered.Copy())
+	}); err != nil {
+		return err
+	}
+	r.complete = true
+	return nil
+}
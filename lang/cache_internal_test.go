@@ -0,0 +1,470 @@
+package lang
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const cacheTestSourceKind = "cache-test-source"
+
+func init() {
+	execute.RegisterSource(cacheTestSourceKind, createCacheTestSource)
+	execute.RegisterTransformation(executetest.ToTestKind, executetest.CreateToTransformation)
+	plan.RegisterProcedureSpecWithSideEffect(executetest.ToTestKind, executetest.NewToProcedure, executetest.ToTestKind)
+}
+
+// sourceRuns counts the number of times the test source was executed.
+var sourceRuns int32
+
+type cacheTestSourceSpec struct {
+	plan.DefaultCost
+	Bounds flux.Bounds
+	Fn     interpreter.ResolvedFunction
+	Value  int64
+	// Rows is the number of rows of the table.
+	// The table has two rows if it is zero.
+	Rows int
+}
+
+func (s *cacheTestSourceSpec) Kind() plan.ProcedureKind {
+	return cacheTestSourceKind
+}
+
+func (s *cacheTestSourceSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+type cacheTestSource struct {
+	spec  *cacheTestSourceSpec
+	alloc *memory.Allocator
+	ts    []execute.Transformation
+}
+
+func createCacheTestSource(spec plan.ProcedureSpec, id execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	return &cacheTestSource{
+		spec:  spec.(*cacheTestSourceSpec),
+		alloc: a.Allocator(),
+	}, nil
+}
+
+func (s *cacheTestSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *cacheTestSource) Run(ctx context.Context) {
+	atomic.AddInt32(&sourceRuns, 1)
+	id := executetest.RandomDatasetID()
+	for _, t := range s.ts {
+		rows := s.spec.Rows
+		if rows == 0 {
+			rows = 2
+		}
+		tbl := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "t0", Type: flux.TString},
+				{Label: "_value", Type: flux.TInt},
+			},
+		}
+		for i := 0; i < rows; i++ {
+			tbl.Data = append(tbl.Data, []interface{}{"a", s.spec.Value + int64(i)})
+		}
+		tbl.Normalize()
+		// The table is copied so that its buffers
+		// are allocated by the query.
+		builder := execute.NewColListTableBuilder(tbl.Key(), s.alloc)
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			t.Finish(id, err)
+			continue
+		}
+		if err := execute.AppendTable(tbl, builder); err != nil {
+			t.Finish(id, err)
+			continue
+		}
+		out, err := builder.Table()
+		builder.Release()
+		if err != nil {
+			t.Finish(id, err)
+			continue
+		}
+		if err := t.Process(id, out); err != nil {
+			t.Finish(id, err)
+			continue
+		}
+		t.Finish(id, nil)
+	}
+}
+
+// newPlan creates a plan where the first node is the predecessor of the second.
+// The plantest package cannot be used since it imports this package.
+func newPlan(source, sink plan.PhysicalProcedureSpec, now time.Time) *plan.Spec {
+	from := plan.CreatePhysicalNode("source", source)
+	to := plan.CreatePhysicalNode("sink", sink)
+	from.AddSuccessors(to)
+	to.AddPredecessors(from)
+
+	ps := plan.NewPlanSpec()
+	ps.Roots[to] = struct{}{}
+	ps.Resources = flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: 1 << 20}
+	ps.Now = now
+	return ps
+}
+
+func newCacheTestPlan(spec *cacheTestSourceSpec, now time.Time) *plan.Spec {
+	return newPlan(spec, executetest.NewYieldProcedureSpec("_result"), now)
+}
+
+// runCached starts the plan with the cache and returns its results.
+// The tables of the results are only read if read is set.
+// It returns whether the source was executed.
+func runCached(t *testing.T, ctx context.Context, c *ResultCache, ps *plan.Spec, read bool) ([]*executetest.Result, bool) {
+	t.Helper()
+	return runCachedWith(t, ctx, c, ps, read, &memory.Allocator{})
+}
+
+// runCachedWith is runCached with the allocator of the query.
+func runCachedWith(t *testing.T, ctx context.Context, c *ResultCache, ps *plan.Spec, read bool, alloc *memory.Allocator) ([]*executetest.Result, bool) {
+	t.Helper()
+	p := &Program{
+		PlanSpec: ps,
+		opts:     &compileOptions{cache: c},
+	}
+	runs := atomic.LoadInt32(&sourceRuns)
+	q, err := p.Start(executetest.NewTestExecuteDependencies().Inject(ctx), alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []*executetest.Result
+	for res := range q.Results() {
+		if read {
+			results = append(results, executetest.ConvertResult(res))
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil && read {
+		t.Fatal(err)
+	}
+	return results, atomic.LoadInt32(&sourceRuns) != runs
+}
+
+var cacheTestNow = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func relativeBounds(now time.Time) flux.Bounds {
+	return flux.Bounds{
+		Start: flux.Time{IsRelative: true, Relative: -time.Hour},
+		Stop:  flux.Now,
+		Now:   now,
+	}
+}
+
+func TestResultCache(t *testing.T) {
+	c := NewResultCache(ResultCacheConfig{})
+	ctx := context.Background()
+
+	want, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Bounds: relativeBounds(cacheTestNow)}, cacheTestNow), true)
+	if !executed {
+		t.Fatal("expected the plan to be executed")
+	}
+	if len(want) != 1 || len(want[0].Tbls) != 1 {
+		t.Fatalf("unexpected results: %v", want)
+	}
+	if c.Len() != 1 || c.Allocated() == 0 {
+		t.Fatalf("expected the results to be cached, got %d entries and %d bytes", c.Len(), c.Allocated())
+	}
+
+	// The same plan with absolute bounds that is created at a different time.
+	now := cacheTestNow.Add(time.Minute)
+	bounds := flux.Bounds{
+		Start: flux.Time{Absolute: cacheTestNow.Add(-time.Hour)},
+		Stop:  flux.Time{Absolute: cacheTestNow},
+		Now:   now,
+	}
+	for i := 0; i < 2; i++ {
+		got, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Bounds: bounds}, now), true)
+		if executed {
+			t.Fatal("expected the results to be replayed")
+		}
+		if !cmp.Equal(want, got) {
+			t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got))
+		}
+	}
+
+	// A different plan is executed.
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Bounds: relativeBounds(now)}, now), true); !executed {
+		t.Fatal("expected the plan to be executed")
+	}
+	if c.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", c.Len())
+	}
+
+	// Invalidating the results of a plan executes it again.
+	if n := c.Invalidate(func(ps *plan.Spec) bool { return ps.Now.Equal(cacheTestNow) }); n != 1 {
+		t.Fatalf("expected 1 entry to be invalidated, got %d", n)
+	}
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Bounds: bounds}, now), true); !executed {
+		t.Fatal("expected the plan to be executed")
+	}
+
+	c.Purge()
+	if c.Len() != 0 || c.Allocated() != 0 {
+		t.Fatalf("expected an empty cache, got %d entries and %d bytes", c.Len(), c.Allocated())
+	}
+}
+
+func TestResultCache_NotCached(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name   string
+		config ResultCacheConfig
+		// read is whether the results are read.
+		read bool
+	}{
+		{
+			name:   "unread results",
+			config: ResultCacheConfig{},
+		},
+		{
+			name:   "over quota",
+			config: ResultCacheConfig{MemoryBytesQuota: 1},
+			read:   true,
+		},
+		{
+			name: "fingerprint error",
+			config: ResultCacheConfig{
+				Fingerprint: func(ctx context.Context) (string, error) {
+					return "", context.Canceled
+				},
+			},
+			read: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewResultCache(tc.config)
+			for i := 0; i < 2; i++ {
+				_, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{}, cacheTestNow), tc.read)
+				if tc.read && !executed {
+					t.Fatal("expected the plan to be executed")
+				}
+			}
+			if c.Len() != 0 || c.Allocated() != 0 {
+				t.Fatalf("expected an empty cache, got %d entries and %d bytes", c.Len(), c.Allocated())
+			}
+		})
+	}
+}
+
+func TestResultCache_Evict(t *testing.T) {
+	ctx := context.Background()
+	c := NewResultCache(ResultCacheConfig{})
+	runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true)
+	size := c.Allocated()
+
+	// There is only room for a single result.
+	c = NewResultCache(ResultCacheConfig{MemoryBytesQuota: size})
+	runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true)
+	runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 1}, cacheTestNow), true)
+	if c.Len() != 1 || c.Allocated() != size {
+		t.Fatalf("expected 1 entry of %d bytes, got %d entries and %d bytes", size, c.Len(), c.Allocated())
+	}
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 1}, cacheTestNow), true); executed {
+		t.Error("expected the most recent results to be replayed")
+	}
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true); !executed {
+		t.Error("expected the least recent results to be evicted")
+	}
+}
+
+func TestResultCache_TooLarge(t *testing.T) {
+	ctx := context.Background()
+	c := NewResultCache(ResultCacheConfig{})
+	runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true)
+	size := c.Allocated()
+
+	// Results that do not fit within the quota
+	// do not evict the cached results.
+	c = NewResultCache(ResultCacheConfig{MemoryBytesQuota: size})
+	runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true)
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 1, Rows: 1000}, cacheTestNow), true); !executed {
+		t.Fatal("expected the plan to be executed")
+	}
+	if c.Len() != 1 || c.Allocated() != size {
+		t.Fatalf("expected 1 entry of %d bytes, got %d entries and %d bytes", size, c.Len(), c.Allocated())
+	}
+	if _, executed := runCached(t, ctx, c, newCacheTestPlan(&cacheTestSourceSpec{Value: 0}, cacheTestNow), true); executed {
+		t.Error("expected the cached results to be replayed")
+	}
+}
+
+func TestResultCache_Allocator(t *testing.T) {
+	ctx := context.Background()
+	c := NewResultCache(ResultCacheConfig{})
+	ps := func() *plan.Spec {
+		return newCacheTestPlan(&cacheTestSourceSpec{}, cacheTestNow)
+	}
+
+	// The cached tables are copied so they do not
+	// hold on to the memory of the query.
+	alloc := &memory.Allocator{}
+	want, _ := runCachedWith(t, ctx, c, ps(), true, alloc)
+	if got := alloc.Allocated(); got != 0 {
+		t.Fatalf("expected the memory of the query to be released, got %d bytes", got)
+	}
+	if c.Len() != 1 || c.Allocated() == 0 {
+		t.Fatalf("expected the results to be cached, got %d entries and %d bytes", c.Len(), c.Allocated())
+	}
+
+	got, executed := runCachedWith(t, ctx, c, ps(), true, alloc)
+	if executed {
+		t.Fatal("expected the results to be replayed")
+	}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected results -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	c.Purge()
+	if got := c.Allocated(); got != 0 {
+		t.Fatalf("expected the cached tables to be released, got %d bytes", got)
+	}
+}
+
+func TestResultCache_TTL(t *testing.T) {
+	ctx := context.Background()
+	c := NewResultCache(ResultCacheConfig{TTL: 50 * time.Millisecond})
+	ps := func() *plan.Spec {
+		return newCacheTestPlan(&cacheTestSourceSpec{}, cacheTestNow)
+	}
+	runCached(t, ctx, c, ps(), true)
+	if _, executed := runCached(t, ctx, c, ps(), true); executed {
+		t.Fatal("expected the results to be replayed")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, executed := runCached(t, ctx, c, ps(), true); !executed {
+		t.Fatal("expected the expired results to be executed")
+	}
+}
+
+type fingerprintKey struct{}
+
+func TestResultCache_Fingerprint(t *testing.T) {
+	c := NewResultCache(ResultCacheConfig{
+		Fingerprint: func(ctx context.Context) (string, error) {
+			return ctx.Value(fingerprintKey{}).(string), nil
+		},
+	})
+	a := context.WithValue(context.Background(), fingerprintKey{}, "a")
+	b := context.WithValue(context.Background(), fingerprintKey{}, "b")
+	ps := func() *plan.Spec {
+		return newCacheTestPlan(&cacheTestSourceSpec{}, cacheTestNow)
+	}
+
+	runCached(t, a, c, ps(), true)
+	if _, executed := runCached(t, b, c, ps(), true); !executed {
+		t.Fatal("expected the plan to be executed with different dependencies")
+	}
+	if _, executed := runCached(t, a, c, ps(), true); executed {
+		t.Fatal("expected the results to be replayed")
+	}
+}
+
+// returnIdent returns the function (r) => name.
+func returnIdent(name string) *semantic.FunctionExpression {
+	return &semantic.FunctionExpression{
+		Parameters: &semantic.FunctionParameters{
+			List: []*semantic.FunctionParameter{{Key: &semantic.Identifier{Name: "r"}}},
+		},
+		Block: &semantic.Block{
+			Body: []semantic.Statement{
+				&semantic.ReturnStatement{
+					Argument: &semantic.BinaryExpression{
+						Operator: ast.AdditionOperator,
+						Left:     &semantic.IdentifierExpression{Name: "r"},
+						Right:    &semantic.IdentifierExpression{Name: name},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPlanKey(t *testing.T) {
+	scope := func(x int64) values.Scope {
+		s := values.NewScope()
+		s.Set("x", values.NewInt(x))
+		s.Set("r", values.NewInt(x))
+		return s
+	}
+	later := cacheTestNow.Add(time.Hour)
+
+	for _, tc := range []struct {
+		name string
+		a, b *plan.Spec
+		same bool
+	}{
+		{
+			name: "relative and absolute bounds",
+			a:    newCacheTestPlan(&cacheTestSourceSpec{Bounds: relativeBounds(cacheTestNow)}, cacheTestNow),
+			b: newCacheTestPlan(&cacheTestSourceSpec{Bounds: flux.Bounds{
+				Start: flux.Time{Absolute: cacheTestNow.Add(-time.Hour)},
+				Stop:  flux.Time{Absolute: cacheTestNow},
+			}}, later),
+			same: true,
+		},
+		{
+			name: "relative bounds at different times",
+			a:    newCacheTestPlan(&cacheTestSourceSpec{Bounds: relativeBounds(cacheTestNow)}, cacheTestNow),
+			b:    newCacheTestPlan(&cacheTestSourceSpec{Bounds: relativeBounds(later)}, later),
+		},
+		{
+			name: "function with the same free variables",
+			a:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("x"), Scope: scope(1)}}, cacheTestNow),
+			b:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("x"), Scope: scope(1)}}, cacheTestNow),
+			same: true,
+		},
+		{
+			name: "function with different free variables",
+			a:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("x"), Scope: scope(1)}}, cacheTestNow),
+			b:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("x"), Scope: scope(2)}}, cacheTestNow),
+		},
+		{
+			name: "function parameters are not free",
+			a:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("r"), Scope: scope(1)}}, cacheTestNow),
+			b:    newCacheTestPlan(&cacheTestSourceSpec{Fn: interpreter.ResolvedFunction{Fn: returnIdent("r"), Scope: scope(2)}}, cacheTestNow),
+			same: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := planKey(tc.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := planKey(tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if same := a == b; same != tc.same {
+				t.Errorf("expected same key to be %v, got %v", tc.same, same)
+			}
+		})
+	}
+}
+
+func TestPlanKey_NotCacheable(t *testing.T) {
+	ps := newPlan(&cacheTestSourceSpec{}, &executetest.ToProcedureSpec{}, cacheTestNow)
+	if _, err := planKey(ps); err != errNotCacheable {
+		t.Errorf("expected a plan with side effects to not be cacheable, got %v", err)
+	}
+}
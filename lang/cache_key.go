@@ -0,0 +1,409 @@
+package lang
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"reflect"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// errNotCacheable is returned when the results of a plan cannot be cached.
+var errNotCacheable = errors.New(codes.Unimplemented, "plan is not cacheable")
+
+var (
+	fluxTimeType         = reflect.TypeOf(flux.Time{})
+	fluxBoundsType       = reflect.TypeOf(flux.Bounds{})
+	timeType             = reflect.TypeOf(time.Time{})
+	regexpType           = reflect.TypeOf(regexp.Regexp{})
+	resolvedFunctionType = reflect.TypeOf(interpreter.ResolvedFunction{})
+	valueType            = reflect.TypeOf((*values.Value)(nil)).Elem()
+	scopeType            = reflect.TypeOf((*values.Scope)(nil)).Elem()
+
+	// ignoredTypes do not change the results of a plan.
+	ignoredTypes = map[reflect.Type]bool{
+		reflect.TypeOf(plan.DefaultCost{}):  true,
+		reflect.TypeOf(semantic.Loc{}):      true,
+		reflect.TypeOf(semantic.MonoType{}): true,
+		reflect.TypeOf(semantic.PolyType{}): true,
+	}
+)
+
+// planKey returns a key that identifies the results of the plan.
+//
+// The key is computed from the kind, procedure spec and bounds of
+// every node along with the edges between the nodes. Times that are
+// relative to now are normalized to absolute times so that plans
+// created at different times with the same bounds have the same key.
+// The key does not include the node IDs.
+//
+// It returns errNotCacheable if the plan produces side effects,
+// reads from a stream or contains a value that cannot be compared.
+func planKey(ps *plan.Spec) (string, error) {
+	roots := make([]plan.Node, 0, len(ps.Roots))
+	for root := range ps.Roots {
+		if plan.IsStreaming(root) {
+			return "", errNotCacheable
+		}
+		roots = append(roots, root)
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return roots[i].ID() < roots[j].ID()
+	})
+
+	k := &keyWriter{
+		h:       sha256.New(),
+		now:     ps.Now,
+		nodes:   make(map[plan.Node]int),
+		visited: make(map[uintptr]bool),
+	}
+	for _, root := range roots {
+		if _, err := k.node(root); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(k.h.Sum(nil)), nil
+}
+
+// keyWriter writes the contents of a plan to a hash.
+type keyWriter struct {
+	h   hash.Hash
+	now time.Time
+	// nodes are the indexes of the nodes that have been written.
+	nodes map[plan.Node]int
+	// visited are the pointers that are being written.
+	visited map[uintptr]bool
+}
+
+func (k *keyWriter) printf(format string, a ...interface{}) {
+	_, _ = fmt.Fprintf(k.h, format, a...)
+}
+
+// node writes the node after its predecessors and returns its index.
+func (k *keyWriter) node(n plan.Node) (int, error) {
+	if i, ok := k.nodes[n]; ok {
+		return i, nil
+	}
+	spec := n.ProcedureSpec()
+	if plan.HasSideEffect(spec) {
+		return 0, errNotCacheable
+	}
+
+	preds := make([]int, len(n.Predecessors()))
+	for i, pred := range n.Predecessors() {
+		idx, err := k.node(pred)
+		if err != nil {
+			return 0, err
+		}
+		preds[i] = idx
+	}
+
+	idx := len(k.nodes)
+	k.nodes[n] = idx
+	k.printf("node %d %s %v;", idx, n.Kind(), preds)
+	if b := n.Bounds(); b != nil {
+		k.printf("bounds %d %d;", b.Start, b.Stop)
+	}
+	if err := k.value(reflect.ValueOf(spec)); err != nil {
+		return 0, err
+	}
+	k.printf(";")
+	return idx, nil
+}
+
+// value writes the contents of v.
+func (k *keyWriter) value(v reflect.Value) error {
+	if !v.IsValid() {
+		k.printf("nil;")
+		return nil
+	}
+	t := v.Type()
+	if ignoredTypes[t] {
+		return nil
+	}
+
+	switch t {
+	case fluxTimeType, fluxBoundsType, timeType, regexpType, resolvedFunctionType:
+		if !v.CanInterface() {
+			return errNotCacheable
+		}
+		switch x := v.Interface().(type) {
+		case flux.Time:
+			k.printf("%d;", x.Time(k.now).UnixNano())
+		case flux.Bounds:
+			now := x.Now
+			if now.IsZero() {
+				now = k.now
+			}
+			k.printf("%d %d;", x.Start.Time(now).UnixNano(), x.Stop.Time(now).UnixNano())
+		case time.Time:
+			k.printf("%d;", x.UnixNano())
+		case regexp.Regexp:
+			k.printf("%q;", x.String())
+		case interpreter.ResolvedFunction:
+			return k.function(x)
+		}
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		k.printf("%t;", v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		k.printf("%d;", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		k.printf("%d;", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		k.printf("%v;", v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		k.printf("%v;", v.Complex())
+	case reflect.String:
+		k.printf("%q;", v.String())
+	case reflect.Array, reflect.Slice:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			k.printf("nil;")
+			return nil
+		}
+		k.printf("[%d;", v.Len())
+		for i := 0; i < v.Len(); i++ {
+			if err := k.value(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		k.printf("]")
+	case reflect.Map:
+		return k.mapValue(v)
+	case reflect.Ptr:
+		if v.IsNil() {
+			k.printf("nil;")
+			return nil
+		}
+		// A pointer that refers to itself cannot be written.
+		p := v.Pointer()
+		if k.visited[p] {
+			return errNotCacheable
+		}
+		k.visited[p] = true
+		defer delete(k.visited, p)
+		return k.value(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			k.printf("nil;")
+			return nil
+		}
+		if t.Implements(scopeType) {
+			// A scope must be part of a resolved function
+			// so that only the values it refers to are written.
+			return errNotCacheable
+		}
+		if t.Implements(valueType) {
+			if !v.CanInterface() {
+				return errNotCacheable
+			}
+			return k.fluxValue(v.Interface().(values.Value))
+		}
+		k.printf("%s:", v.Elem().Type())
+		return k.value(v.Elem())
+	case reflect.Struct:
+		k.printf("%s{", t)
+		for i := 0; i < v.NumField(); i++ {
+			if err := k.value(v.Field(i)); err != nil {
+				return err
+			}
+		}
+		k.printf("}")
+	default:
+		// Functions, channels and unsafe pointers cannot be compared.
+		return errNotCacheable
+	}
+	return nil
+}
+
+func (k *keyWriter) mapValue(v reflect.Value) error {
+	if v.IsNil() {
+		k.printf("nil;")
+		return nil
+	}
+	// The keys are written in the order of their own keys
+	// since the order of a map is not defined.
+	type entry struct {
+		key, value string
+	}
+	entries := make([]entry, 0, v.Len())
+	for _, mk := range v.MapKeys() {
+		var e entry
+		for i, x := range []reflect.Value{mk, v.MapIndex(mk)} {
+			ek := &keyWriter{
+				h:       sha256.New(),
+				now:     k.now,
+				nodes:   k.nodes,
+				visited: k.visited,
+			}
+			if err := ek.value(x); err != nil {
+				return err
+			}
+			if i == 0 {
+				e.key = string(ek.h.Sum(nil))
+			} else {
+				e.value = string(ek.h.Sum(nil))
+			}
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+	k.printf("map[%d;", len(entries))
+	for _, e := range entries {
+		k.printf("%x:%x;", e.key, e.value)
+	}
+	k.printf("]")
+	return nil
+}
+
+// function writes a function along with the values of the scope
+// that it refers to. The rest of the scope is ignored.
+func (k *keyWriter) function(fn interpreter.ResolvedFunction) error {
+	if err := k.value(reflect.ValueOf(fn.Fn)); err != nil {
+		return err
+	}
+	if fn.Fn == nil || fn.Scope == nil {
+		return nil
+	}
+	names := freeVariables(fn.Fn)
+	for _, name := range names {
+		v, ok := fn.Scope.Lookup(name)
+		if !ok {
+			continue
+		}
+		k.printf("%s=", name)
+		if err := k.fluxValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// freeVariables returns the names of the identifiers in the function
+// that are not parameters or variables defined within the function.
+func freeVariables(fn *semantic.FunctionExpression) []string {
+	local := make(map[string]bool)
+	refs := make(map[string]bool)
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		switch n := n.(type) {
+		case *semantic.FunctionParameter:
+			if n.Key != nil {
+				local[n.Key.Name] = true
+			}
+		case *semantic.FunctionParameters:
+			if n.Pipe != nil {
+				local[n.Pipe.Name] = true
+			}
+		case *semantic.NativeVariableAssignment:
+			if n.Identifier != nil {
+				local[n.Identifier.Name] = true
+			}
+		case *semantic.IdentifierExpression:
+			refs[n.Name] = true
+		}
+	}), fn)
+	names := make([]string, 0, len(refs))
+	for name := range refs {
+		if !local[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fluxValue writes a Flux value.
+func (k *keyWriter) fluxValue(v values.Value) error {
+	if v == nil {
+		k.printf("nil;")
+		return nil
+	}
+	if pkg, ok := v.(values.Package); ok {
+		k.printf("package %q;", pkg.Path())
+		return nil
+	}
+	typ := v.Type()
+	k.printf("%s:", typ.CanonicalString())
+	if v.IsNull() {
+		k.printf("null;")
+		return nil
+	}
+	switch typ.Nature() {
+	case semantic.String:
+		k.printf("%q;", v.Str())
+	case semantic.Bytes:
+		k.printf("%x;", v.Bytes())
+	case semantic.Int:
+		k.printf("%d;", v.Int())
+	case semantic.UInt:
+		k.printf("%d;", v.UInt())
+	case semantic.Float:
+		k.printf("%v;", v.Float())
+	case semantic.Bool:
+		k.printf("%t;", v.Bool())
+	case semantic.Time:
+		k.printf("%d;", v.Time())
+	case semantic.Duration:
+		k.printf("%s;", v.Duration())
+	case semantic.Regexp:
+		k.printf("%q;", v.Regexp().String())
+	case semantic.Array:
+		var err error
+		v.Array().Range(func(i int, v values.Value) {
+			if err == nil {
+				err = k.fluxValue(v)
+			}
+		})
+		k.printf(";")
+		return err
+	case semantic.Object:
+		var err error
+		v.Object().Range(func(name string, v values.Value) {
+			if err == nil {
+				k.printf("%s=", name)
+				err = k.fluxValue(v)
+			}
+		})
+		k.printf(";")
+		return err
+	case semantic.Dictionary:
+		var err error
+		v.(values.Dictionary).Range(func(key, v values.Value) {
+			if err == nil {
+				if err = k.fluxValue(key); err == nil {
+					err = k.fluxValue(v)
+				}
+			}
+		})
+		k.printf(";")
+		return err
+	case semantic.Function:
+		// Functions cannot be compared so a function is identified
+		// by its address. A function defined in the prelude or a package
+		// is the same value every time it is referred to.
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Ptr {
+			return errNotCacheable
+		}
+		k.printf("%x;", rv.Pointer())
+	default:
+		return errNotCacheable
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -53,6 +54,8 @@ type compileOptions struct {
 
 	extern flux.ASTHandle
 
+	cache *ResultCache
+
 	planOptions struct {
 		logical  []plan.LogicalOption
 		physical []plan.PhysicalOption
@@ -75,6 +78,13 @@ func WithExtern(extern flux.ASTHandle) CompileOption {
 	}
 }
 
+// WithResultCache sets the cache that the results of the program are replayed from.
+func WithResultCache(c *ResultCache) CompileOption {
+	return func(o *compileOptions) {
+		o.cache = c
+	}
+}
+
 func defaultOptions() *compileOptions {
 	o := new(compileOptions)
 	return o
@@ -120,6 +130,100 @@ func CompileAST(astPkg flux.ASTHandle, runtime flux.Runtime, now time.Time, opts
 	}
 }
 
+// Prepare parses and analyzes a Flux script with parameters producing a PreparedProgram.
+// The parameters are available to the script as the properties of the params record
+// and their types are checked together with the rest of the script.
+// If now is zero, the time the program is started is used.
+func Prepare(q string, runtime flux.Runtime, now time.Time, params Params, opts ...CompileOption) (*PreparedProgram, error) {
+	astPkg, err := runtime.Parse(q)
+	if err != nil {
+		return nil, err
+	}
+	return PrepareAST(astPkg, runtime, now, params, opts...)
+}
+
+// PrepareAST analyzes a Flux handle to an AST with parameters producing a PreparedProgram.
+// The handle cannot be used after it has been prepared.
+// If now is zero, the time the program is started is used.
+// The runtime must implement flux.AnalyzingRuntime.
+func PrepareAST(astPkg flux.ASTHandle, runtime flux.Runtime, now time.Time, params Params, opts ...CompileOption) (*PreparedProgram, error) {
+	o := applyOptions(opts...)
+	if err := astPkg.GetError(); err != nil {
+		return nil, err
+	}
+	analyzer, ok := runtime.(flux.AnalyzingRuntime)
+	if !ok {
+		return nil, errors.New(codes.Unimplemented, "runtime does not support prepared programs")
+	}
+
+	// The params record is declared in a file of the package
+	// so that the analyzer checks the types of its properties.
+	var pkg flux.ASTHandle
+	if len(params) > 0 {
+		file, err := params.file()
+		if err != nil {
+			return nil, err
+		}
+		bs, err := json.Marshal(file)
+		if err != nil {
+			return nil, err
+		}
+		if pkg, err = runtime.JSONToHandle(wrapFileJSONInPkg(bs)); err != nil {
+			return nil, err
+		}
+	}
+	if o.extern != nil {
+		if pkg == nil {
+			pkg = o.extern
+		} else if err := runtime.MergePackages(pkg, o.extern); err != nil {
+			return nil, err
+		}
+		o.extern = nil
+	}
+	if pkg == nil {
+		pkg = astPkg
+	} else if err := runtime.MergePackages(pkg, astPkg); err != nil {
+		return nil, err
+	}
+
+	semPkg, err := analyzer.Analyze(pkg)
+	if err != nil {
+		return nil, err
+	}
+	if len(params) > 0 {
+		// The values of the parameters are added to the scope
+		// when the program is started, so the declaration is
+		// not evaluated.
+		if err := removeParamsFile(semPkg); err != nil {
+			return nil, err
+		}
+	}
+	return &PreparedProgram{
+		Program: &Program{
+			Runtime: runtime,
+			opts:    o,
+		},
+		Now:      now,
+		analyzer: analyzer,
+		pkg:      semPkg,
+		types:    params.types(),
+		params:   params,
+		plans:    new(planCache),
+	}, nil
+}
+
+// removeParamsFile removes the file that declares
+// the params record from the semantic graph.
+func removeParamsFile(semPkg *semantic.Package) error {
+	for i, file := range semPkg.Files {
+		if file.Loc.File == paramsFileName {
+			semPkg.Files = append(semPkg.Files[:i], semPkg.Files[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Newf(codes.Internal, "file %q is missing from the analyzed program", paramsFileName)
+}
+
 // CompileTableObject evaluates a TableObject and produces a flux.Program.
 // now parameter must be non-zero, that is the default now time should be set before compiling.
 func CompileTableObject(ctx context.Context, to *flux.TableObject, now time.Time, opts ...CompileOption) (*Program, error) {
@@ -166,6 +270,7 @@ type FluxCompiler struct {
 	Now    time.Time
 	Extern json.RawMessage `json:"extern,omitempty"`
 	Query  string          `json:"query"`
+	Params Params          `json:"params,omitempty"`
 }
 
 func wrapFileJSONInPkg(bs []byte) []byte {
@@ -196,14 +301,18 @@ func (c FluxCompiler) Compile(ctx context.Context, runtime flux.Runtime) (flux.P
 	}
 
 	// Ignore context, it will be provided upon Program Start.
+	var opts []CompileOption
 	if IsNonNullJSON(c.Extern) {
 		hdl, err := runtime.JSONToHandle(wrapFileJSONInPkg(c.Extern))
 		if err != nil {
 			return nil, err
 		}
-		return Compile(query, runtime, c.Now, WithExtern(hdl))
+		opts = append(opts, WithExtern(hdl))
 	}
-	return Compile(query, runtime, c.Now)
+	if len(c.Params) > 0 {
+		return Prepare(query, runtime, c.Now, c.Params, opts...)
+	}
+	return Compile(query, runtime, c.Now, opts...)
 }
 
 func (c FluxCompiler) CompilerType() flux.CompilerType {
@@ -215,6 +324,7 @@ type ASTCompiler struct {
 	Extern json.RawMessage `json:"extern,omitempty"`
 	AST    json.RawMessage `json:"ast"`
 	Now    time.Time
+	Params Params `json:"params,omitempty"`
 }
 
 func (c ASTCompiler) Compile(ctx context.Context, runtime flux.Runtime) (flux.Program, error) {
@@ -231,14 +341,18 @@ func (c ASTCompiler) Compile(ctx context.Context, runtime flux.Runtime) (flux.Pr
 	}
 
 	// Ignore context, it will be provided upon Program Start.
+	var opts []CompileOption
 	if IsNonNullJSON(c.Extern) {
 		extHdl, err := runtime.JSONToHandle(wrapFileJSONInPkg(c.Extern))
 		if err != nil {
 			return nil, err
 		}
-		return CompileAST(hdl, runtime, now, WithExtern(extHdl)), nil
+		opts = append(opts, WithExtern(extHdl))
+	}
+	if len(c.Params) > 0 {
+		return PrepareAST(hdl, runtime, c.Now, c.Params, opts...)
 	}
-	return CompileAST(hdl, runtime, now), nil
+	return CompileAST(hdl, runtime, now, opts...), nil
 }
 
 func (ASTCompiler) CompilerType() flux.CompilerType {
@@ -281,6 +395,14 @@ func (p *Program) SetLogger(logger *zap.Logger) {
 }
 
 func (p *Program) Start(ctx context.Context, alloc *memory.Allocator) (flux.Query, error) {
+	if p.opts != nil && p.opts.cache != nil {
+		return p.opts.cache.start(ctx, p, alloc)
+	}
+	return p.start(ctx, alloc, nil)
+}
+
+// start executes the plan. If rec is set, it records the results of the query.
+func (p *Program) start(ctx context.Context, alloc *memory.Allocator, rec *recorder) (flux.Query, error) {
 	ctx, cancel := context.WithCancel(ctx)
 
 	// This span gets closed by the query when it is done.
@@ -303,6 +425,14 @@ func (p *Program) Start(ctx context.Context, alloc *memory.Allocator) (flux.Quer
 		return nil, err
 	}
 
+	if rec != nil {
+		n := len(resultMap)
+		for name, res := range resultMap {
+			resultMap[name] = rec.wrap(res)
+		}
+		q.done = func() { rec.done(n, q.err) }
+	}
+
 	// There was no error so send the results downstream.
 	q.wg.Add(1)
 	go p.processResults(cctx, q, resultMap)
@@ -369,10 +499,6 @@ func (p *AstProgram) getSpec(ctx context.Context, alloc *memory.Allocator) (*flu
 		return nil, nil, astErr
 	}
 
-	// The program must inject execution dependencies to make it available to
-	// function calls during the evaluation phase (see `tableFind`).
-	deps := execdeps.NewExecutionDependencies(alloc, &p.Now, p.Logger)
-	ctx = deps.Inject(ctx)
 	s, cctx := opentracing.StartSpanFromContext(ctx, "eval")
 
 	sideEffects, scope, err := p.Runtime.Eval(cctx, ast, flux.SetNowOption(p.Now))
@@ -381,25 +507,42 @@ func (p *AstProgram) getSpec(ctx context.Context, alloc *memory.Allocator) (*flu
 	}
 	s.Finish()
 
-	s, cctx = opentracing.StartSpanFromContext(ctx, "compile")
+	sp, now, err := specFromEvaluation(ctx, sideEffects, scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.Now = now
+	return sp, scope, nil
+}
+
+// specFromEvaluation produces a spec from the side effects of an evaluated program.
+// It returns the value of the now option that the spec was created with.
+func specFromEvaluation(ctx context.Context, sideEffects []interpreter.SideEffect, scope values.Scope) (*flux.Spec, time.Time, error) {
+	s, cctx := opentracing.StartSpanFromContext(ctx, "compile")
 	defer s.Finish()
 	nowOpt, ok := scope.Lookup(interpreter.NowOption)
 	if !ok {
-		return nil, nil, fmt.Errorf("%q option not set", interpreter.NowOption)
+		return nil, time.Time{}, fmt.Errorf("%q option not set", interpreter.NowOption)
 	}
 	nowTime, err := nowOpt.Function().Call(ctx, nil)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, codes.Inherit, "error in evaluating AST while starting program")
+		return nil, time.Time{}, errors.Wrap(err, codes.Inherit, "error in evaluating AST while starting program")
 	}
-	p.Now = nowTime.Time().Time()
-	sp, err := spec.FromEvaluation(cctx, sideEffects, p.Now)
+	now := nowTime.Time().Time()
+	sp, err := spec.FromEvaluation(cctx, sideEffects, now)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, codes.Inherit, "error in query specification while starting program")
+		return nil, time.Time{}, errors.Wrap(err, codes.Inherit, "error in query specification while starting program")
 	}
-	return sp, scope, nil
+	return sp, now, nil
 }
 
 func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Query, error) {
+	// The program must inject execution dependencies to make it available to
+	// function calls during the evaluation phase (see `tableFind`).
+	// The same dependencies are used to execute the query so the options
+	// that are stored in them, such as location, apply to the execution.
+	deps := execdeps.NewExecutionDependencies(alloc, &p.Now, p.Logger)
+	ctx = deps.Inject(ctx)
 	sp, scope, err := p.getSpec(ctx, alloc)
 	if err != nil {
 		return nil, err
@@ -417,14 +560,18 @@ func (p *AstProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Q
 	}
 	p.PlanSpec = ps
 	s.Finish()
-	deps := execdeps.NewExecutionDependencies(alloc, &p.Now, p.Logger)
-	ctx = deps.Inject(ctx)
 	s, cctx = opentracing.StartSpanFromContext(ctx, "start-program")
 	defer s.Finish()
 	return p.Program.Start(cctx, alloc)
 }
 
 func (p *AstProgram) updateOpts(scope values.Scope) error {
+	return addPlannerOptions(p.opts, scope)
+}
+
+// addPlannerOptions adds the plan options that are set
+// by the planner package in the scope to opts.
+func addPlannerOptions(opts *compileOptions, scope values.Scope) error {
 	pkg, ok := getPlannerPkg(scope)
 	if !ok {
 		return nil
@@ -434,14 +581,216 @@ func (p *AstProgram) updateOpts(scope values.Scope) error {
 		return err
 	}
 	if lo != nil {
-		p.opts.planOptions.logical = append(p.opts.planOptions.logical, lo)
-	}
-	if po != nil {
-		p.opts.planOptions.physical = append(p.opts.planOptions.physical, po)
+		opts.planOptions.logical = append(opts.planOptions.logical, lo)
 	}
+	opts.planOptions.physical = append(opts.planOptions.physical, po...)
 	return nil
 }
 
+// PreparedProgram wraps a Program with a type checked semantic graph
+// that is evaluated with the values of its parameters upon Start.
+// The query is parsed and analyzed once, so a PreparedProgram can
+// be bound to new parameter values and started any number of times.
+//
+// The plan is built the first time the program starts because the
+// values of the parameters are part of the plan. It is reused by later
+// starts with the same parameters, including the starts of the programs
+// returned by Bind. When the program does not read now while it is
+// evaluated, the plan is run at the new value of now without being
+// evaluated and planned again. Otherwise it is only reused by the
+// starts with the same value of now.
+type PreparedProgram struct {
+	*Program
+
+	// Now is the default value of the now option.
+	// If it is zero, the time the program is started is used.
+	Now time.Time
+
+	analyzer flux.AnalyzingRuntime
+	pkg      *semantic.Package
+	types    map[string]string
+	params   Params
+	plans    *planCache
+}
+
+// preparedPlan is the plan of a prepared program that was
+// evaluated with particular values of its parameters and of now.
+// The plan is never executed itself. Each execution runs a copy.
+type preparedPlan struct {
+	params Params
+	now    time.Time
+	// anyNow is set when the plan can be run at any value of now,
+	// because the program did not read now while it was evaluated.
+	anyNow bool
+	// ownNow is set when the program sets the now option itself.
+	ownNow   bool
+	spec     *plan.Spec
+	opts     *compileOptions
+	location values.Value
+}
+
+// planCache holds the last plan of a prepared program.
+// It is shared by the copies of a program that are returned by Bind.
+type planCache struct {
+	mu   sync.Mutex
+	last *preparedPlan
+}
+
+// lookup returns the plan for the parameters and the value of now
+// along with the value of now that the plan is run at.
+func (c *planCache) lookup(params Params, now time.Time) (*preparedPlan, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pp := c.last
+	if pp == nil || !pp.params.equal(params) {
+		return nil, time.Time{}
+	}
+	switch {
+	case pp.ownNow || pp.now.Equal(now):
+		return pp, pp.spec.Now
+	case pp.anyNow:
+		return pp, now
+	default:
+		return nil, time.Time{}
+	}
+}
+
+func (c *planCache) store(pp *preparedPlan) {
+	c.mu.Lock()
+	c.last = pp
+	c.mu.Unlock()
+}
+
+// Params returns the parameters the program is bound to.
+func (p *PreparedProgram) Params() Params {
+	return p.params
+}
+
+// Bind returns a copy of the program that is bound to the given parameters.
+// The parameters must have the same names and types as the
+// parameters the program was prepared with.
+func (p *PreparedProgram) Bind(params Params) (*PreparedProgram, error) {
+	if err := params.checkTypes(p.types); err != nil {
+		return nil, err
+	}
+	np := *p
+	program := *p.Program
+	np.Program = &program
+	np.params = params
+	return &np, nil
+}
+
+func (p *PreparedProgram) Start(ctx context.Context, alloc *memory.Allocator) (flux.Query, error) {
+	now := p.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// The program must inject execution dependencies to make it available to
+	// function calls during the evaluation phase (see `tableFind`).
+	// The same dependencies are used to execute the query so the options
+	// that are stored in them, such as location, apply to the execution.
+	deps := execdeps.NewExecutionDependencies(alloc, &now, p.Logger)
+	ctx = deps.Inject(ctx)
+
+	pp, runNow := p.plans.lookup(p.params, now)
+	if pp == nil {
+		var err error
+		if pp, err = p.plan(ctx, now, deps); err != nil {
+			return nil, err
+		}
+		// The program may have set the now option.
+		runNow = pp.spec.Now
+		p.plans.store(pp)
+	}
+	// The evaluation may have been skipped, so the options
+	// it would have set are restored from the plan.
+	*deps.Now, *deps.Location = runNow, pp.location
+	return p.startPlan(ctx, alloc, pp, runNow)
+}
+
+// plan evaluates the program with the parameters it is bound to and builds its plan.
+func (p *PreparedProgram) plan(ctx context.Context, now time.Time, deps execdeps.ExecutionDependencies) (*preparedPlan, error) {
+	s, cctx := opentracing.StartSpanFromContext(ctx, "eval")
+	sideEffects, scope, err := p.analyzer.EvalPackage(cctx, p.pkg, readNowOption(now), p.params.scopeMutator())
+	s.Finish()
+	if err != nil {
+		return nil, err
+	}
+	nowRead := deps.NowRead()
+	sp, _, err := specFromEvaluation(ctx, sideEffects, scope)
+	if err != nil {
+		return nil, err
+	}
+	// The spec is created with the value of the now option,
+	// which reads now unless the program replaced the option.
+	ownNow := !deps.NowRead()
+
+	s, cctx = opentracing.StartSpanFromContext(ctx, "plan")
+	defer s.Finish()
+	if p.opts.verbose {
+		log.Println("Query Spec: ", flux.Formatted(sp, flux.FmtJSON))
+	}
+	// Copy the options so that the planner options of
+	// one evaluation do not carry over to the next.
+	opts := *p.opts
+	opts.planOptions.logical = append([]plan.LogicalOption(nil), p.opts.planOptions.logical...)
+	opts.planOptions.physical = append([]plan.PhysicalOption(nil), p.opts.planOptions.physical...)
+	if err := addPlannerOptions(&opts, scope); err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in reading options while starting program")
+	}
+	ps, err := buildPlan(cctx, sp, &opts)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "error in building plan while starting program")
+	}
+	return &preparedPlan{
+		params:   p.params,
+		now:      now,
+		anyNow:   !nowRead && !ps.HasFixedNow(),
+		ownNow:   ownNow,
+		spec:     ps,
+		opts:     &opts,
+		location: *deps.Location,
+	}, nil
+}
+
+// startPlan executes a copy of the plan at the given value of now,
+// because the executor completes the nodes of the plan it runs.
+func (p *PreparedProgram) startPlan(ctx context.Context, alloc *memory.Allocator, pp *preparedPlan, now time.Time) (flux.Query, error) {
+	ps, err := pp.spec.CopyAt(now)
+	if err != nil {
+		return nil, err
+	}
+	program := &Program{
+		Logger:   p.Logger,
+		PlanSpec: ps,
+		Runtime:  p.Runtime,
+		opts:     pp.opts,
+	}
+	s, cctx := opentracing.StartSpanFromContext(ctx, "start-program")
+	defer s.Finish()
+	return program.Start(cctx, alloc)
+}
+
+// readNowOption returns a scope mutator that sets the now option
+// to a function that reads now from the execution dependencies,
+// so that they record whether the program reads now.
+func readNowOption(now time.Time) flux.ScopeMutator {
+	return flux.SetOption(interpreter.NowPkg, interpreter.NowOption, func(r flux.Runtime) values.Value {
+		ftype, err := r.LookupBuiltinType("universe", "now")
+		if err != nil {
+			panic(err)
+		}
+		call := func(ctx context.Context, args values.Object) (values.Value, error) {
+			if execdeps.HaveExecutionDependencies(ctx) {
+				return values.NewTime(values.ConvertTime(execdeps.GetExecutionDependencies(ctx).GetNow())), nil
+			}
+			return values.NewTime(values.ConvertTime(now)), nil
+		}
+		return values.NewFunction(interpreter.NowOption, ftype, call, false)
+	})
+}
+
 func getPlannerPkg(scope values.Scope) (values.Package, bool) {
 	found := false
 	var foundPkg values.Package
@@ -459,7 +808,7 @@ func getPlannerPkg(scope values.Scope) (values.Package, bool) {
 	return foundPkg, found
 }
 
-func getPlanOptions(plannerPkg values.Package) (plan.LogicalOption, plan.PhysicalOption, error) {
+func getPlanOptions(plannerPkg values.Package) (plan.LogicalOption, []plan.PhysicalOption, error) {
 	if plannerPkg.Type().Nature() != semantic.Object {
 		// No import for planner, this is useless.
 		return nil, nil, nil
@@ -473,7 +822,25 @@ func getPlanOptions(plannerPkg values.Package) (plan.LogicalOption, plan.Physica
 	if err != nil {
 		return nil, nil, err
 	}
-	return plan.RemoveLogicalRules(ls...), plan.RemovePhysicalRules(ps...), nil
+	po := []plan.PhysicalOption{plan.RemovePhysicalRules(ps...)}
+	if costBased, err := getBool(plannerPkg.Object(), "enableCostBasedPlanning"); err != nil {
+		return nil, nil, err
+	} else if costBased {
+		po = append(po, plan.EnableCostBasedPlanning())
+	}
+	return plan.RemoveLogicalRules(ls...), po, nil
+}
+
+func getBool(plannerPkg values.Object, optionName string) (bool, error) {
+	value, ok := plannerPkg.Get(optionName)
+	if !ok {
+		// No value in package.
+		return false, nil
+	}
+	if t := value.Type().Nature(); t != semantic.Bool {
+		return false, fmt.Errorf("'planner.%s' must be a boolean, got %s", optionName, t.String())
+	}
+	return value.Bool(), nil
 }
 
 func getRules(plannerPkg values.Object, optionName string) ([]string, error) {
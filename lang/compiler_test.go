@@ -27,6 +27,7 @@ import (
 	"github.com/influxdata/flux/stdlib/csv"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
 )
 
 func init() {
@@ -209,6 +210,45 @@ func TestCompilationError(t *testing.T) {
 	}
 }
 
+func TestCompiler_LocationOption(t *testing.T) {
+	script := `
+import "array"
+import "date"
+
+option location = {zone: "America/New_York", offset: 0h}
+
+array.from(rows: [{_time: 2020-03-08T03:00:00Z}, {_time: 2020-03-08T12:00:00Z}])
+	|> map(fn: (r) => ({r with hour: date.hour(t: r._time)}))
+`
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "hour", Type: flux.TInt},
+		},
+		Data: [][]interface{}{
+			{values.ConvertTime(time.Date(2020, 3, 8, 3, 0, 0, 0, time.UTC)), int64(22)},
+			{values.ConvertTime(time.Date(2020, 3, 8, 12, 0, 0, 0, time.UTC)), int64(8)},
+		},
+	}}
+	executetest.NormalizeTables(want)
+
+	program, err := lang.Compile(script, runtime.Default, time.Unix(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := runPrepared(t, program); !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables from compiled program -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	prepared, err := lang.Prepare(script, runtime.Default, time.Unix(0, 0), lang.Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := runPrepared(t, prepared); !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables from prepared program -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
 func TestASTCompiler(t *testing.T) {
 	testcases := []struct {
 		name         string
@@ -664,6 +704,37 @@ option planner.disablePhysicalRules = [1.0]
 from(bucket: "does_not_matter")`},
 			wantErr: `type error @4:39-4:44: expected string but found float`,
 		},
+		{
+			name: "enable cost-based planning",
+			files: []string{`
+import "planner"
+
+option planner.enableCostBasedPlanning = true
+
+from(bucket: "bkt") |> range(start: 0) |> filter(fn: (r) => r._value > 0) |> count()`},
+			want: plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					&plan.PhysicalPlanNode{Spec: &influxdb.FromRemoteProcedureSpec{}},
+					&plan.PhysicalPlanNode{Spec: &universe.YieldProcedureSpec{}},
+				},
+				Edges: [][2]int{
+					{0, 1},
+				},
+				Resources: flux.ResourceManagement{ConcurrencyQuota: 1, MemoryBytesQuota: math.MaxInt64},
+				Now:       nowFn(),
+			}),
+		},
+		{
+			name: "cost-based planning option must be a boolean",
+			files: []string{`
+import "planner"
+
+option planner.enableCostBasedPlanning = "yes"
+
+// remember to return streaming data
+from(bucket: "does_not_matter")`},
+			wantErr: `type error @4:42-4:47: expected bool but found string`,
+		},
 		{
 			name: "planner is an object defined by the user",
 			files: []string{`
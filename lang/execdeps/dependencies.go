@@ -2,9 +2,11 @@ package execdeps
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/values"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +23,26 @@ type ExecutionDependencies struct {
 
 	// Allowed to be nil
 	Logger *zap.Logger
+	// Location holds the value of the location option
+	// once it has been set. A nil value is UTC.
+	Location *values.Value
+
+	// nowRead is set to one once GetNow is called.
+	nowRead *int32
+}
+
+// GetNow returns the value of now and records that it was read,
+// so that a program can tell whether its evaluation depends on now.
+func (d ExecutionDependencies) GetNow() time.Time {
+	if d.nowRead != nil {
+		atomic.StoreInt32(d.nowRead, 1)
+	}
+	return *d.Now
+}
+
+// NowRead reports whether now was read with GetNow.
+func (d ExecutionDependencies) NowRead() bool {
+	return d.nowRead != nil && atomic.LoadInt32(d.nowRead) != 0
 }
 
 func (d ExecutionDependencies) Inject(ctx context.Context) context.Context {
@@ -50,6 +72,8 @@ func NewExecutionDependencies(allocator *memory.Allocator, now *time.Time, logge
 		Allocator: allocator,
 		Now:       now,
 		Logger:    logger,
+		Location:  new(values.Value),
+		nowRead:   new(int32),
 	}
 }
 
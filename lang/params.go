@@ -0,0 +1,406 @@
+package lang
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// ParamsIdentifier is the name of the record that
+// holds the parameters of a program.
+const ParamsIdentifier = "params"
+
+// paramsFileName is the name of the file that declares the params record.
+// The file is found by this name once the program has been analyzed.
+const paramsFileName = "params.flux"
+
+// Params are the values of the parameters of a program.
+// The parameters are available to the program as the
+// properties of the params record.
+//
+// Parameters may be booleans, integers, unsigned integers, floats,
+// strings, times, durations, or non-empty arrays of those types.
+//
+// Params are encoded in JSON as an object. Strings, booleans and
+// numbers may be encoded as JSON values. A number without a fraction
+// or an exponent is an integer and any other number is a float.
+// Any parameter may also be encoded as an object with its Flux type
+// and its value, which is required for unsigned integers, times,
+// and durations:
+//
+//	{
+//	    "bucket": "telegraf",
+//	    "limit": 10,
+//	    "start": {"type": "time", "value": "2020-01-01T00:00:00Z"},
+//	    "every": {"type": "duration", "value": "1m"}
+//	}
+type Params map[string]values.Value
+
+// typedParam is the JSON encoding of a parameter with an explicit type.
+type typedParam struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (p Params) MarshalJSON() ([]byte, error) {
+	m := make(map[string]json.RawMessage, len(p))
+	for k, v := range p {
+		bs, err := marshalParam(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "invalid parameter %q", k)
+		}
+		m[k] = bs
+	}
+	return json.Marshal(m)
+}
+
+func marshalParam(v values.Value) (json.RawMessage, error) {
+	typed := func(typ string, v interface{}) (json.RawMessage, error) {
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(typedParam{Type: typ, Value: bs})
+	}
+	switch v.Type().Nature() {
+	case semantic.Bool:
+		return json.Marshal(v.Bool())
+	case semantic.Int:
+		return json.Marshal(v.Int())
+	case semantic.String:
+		return json.Marshal(v.Str())
+	case semantic.Float:
+		bs, err := json.Marshal(v.Float())
+		if err != nil {
+			return nil, err
+		}
+		// A float without a fraction or exponent would
+		// be read back as an integer.
+		if !bytes.ContainsAny(bs, ".eE") {
+			return json.Marshal(typedParam{Type: "float", Value: bs})
+		}
+		return bs, nil
+	case semantic.UInt:
+		return typed("uint", v.UInt())
+	case semantic.Time:
+		return typed("time", v.Time().Time())
+	case semantic.Duration:
+		return typed("duration", v.Duration().String())
+	case semantic.Array:
+		arr := v.Array()
+		elems := make([]json.RawMessage, arr.Len())
+		var err error
+		arr.Range(func(i int, v values.Value) {
+			if err == nil {
+				elems[i], err = marshalParam(v)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(elems)
+	default:
+		return nil, errors.Newf(codes.Invalid, "parameters of type %v are not supported", v.Type())
+	}
+}
+
+func (p *Params) UnmarshalJSON(data []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	params := make(Params, len(m))
+	for k, raw := range m {
+		v, err := unmarshalParam(raw)
+		if err != nil {
+			return errors.Wrapf(err, codes.Inherit, "invalid parameter %q", k)
+		}
+		params[k] = v
+	}
+	*p = params
+	return nil
+}
+
+func unmarshalParam(raw json.RawMessage) (values.Value, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok := tok.(type) {
+	case bool:
+		return values.NewBool(tok), nil
+	case string:
+		return values.NewString(tok), nil
+	case json.Number:
+		if !strings.ContainsAny(tok.String(), ".eE") {
+			i, err := tok.Int64()
+			if err != nil {
+				return nil, errors.Wrap(err, codes.Invalid, "integer parameter is out of range")
+			}
+			return values.NewInt(i), nil
+		}
+		f, err := tok.Float64()
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "invalid float parameter")
+		}
+		return values.NewFloat(f), nil
+	case json.Delim:
+		if tok == '[' {
+			var elems []json.RawMessage
+			if err := json.Unmarshal(raw, &elems); err != nil {
+				return nil, err
+			}
+			return newParamArray(elems)
+		}
+		var tp typedParam
+		if err := json.Unmarshal(raw, &tp); err != nil {
+			return nil, err
+		}
+		return unmarshalTypedParam(tp)
+	default:
+		return nil, errors.New(codes.Invalid, "parameters cannot be null")
+	}
+}
+
+func newParamArray(elems []json.RawMessage) (values.Value, error) {
+	if len(elems) == 0 {
+		return nil, errors.New(codes.Invalid, "array parameters cannot be empty")
+	}
+	vs := make([]values.Value, len(elems))
+	for i, raw := range elems {
+		v, err := unmarshalParam(raw)
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = v
+	}
+	return NewArrayParam(vs)
+}
+
+func unmarshalTypedParam(tp typedParam) (values.Value, error) {
+	switch tp.Type {
+	case "bool":
+		var v bool
+		err := json.Unmarshal(tp.Value, &v)
+		return values.NewBool(v), err
+	case "int":
+		var v int64
+		err := json.Unmarshal(tp.Value, &v)
+		return values.NewInt(v), err
+	case "uint":
+		var v uint64
+		err := json.Unmarshal(tp.Value, &v)
+		return values.NewUInt(v), err
+	case "float":
+		var v float64
+		err := json.Unmarshal(tp.Value, &v)
+		return values.NewFloat(v), err
+	case "string":
+		var v string
+		err := json.Unmarshal(tp.Value, &v)
+		return values.NewString(v), err
+	case "time":
+		var s string
+		if err := json.Unmarshal(tp.Value, &s); err != nil {
+			return nil, err
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "invalid time parameter")
+		}
+		return values.NewTime(values.ConvertTime(t)), nil
+	case "duration":
+		var s string
+		if err := json.Unmarshal(tp.Value, &s); err != nil {
+			return nil, err
+		}
+		d, err := values.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrap(err, codes.Invalid, "invalid duration parameter")
+		}
+		return values.NewDuration(d), nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "unsupported parameter type %q", tp.Type)
+	}
+}
+
+// NewArrayParam creates an array parameter from a non-empty
+// list of values that all have the same type.
+func NewArrayParam(elems []values.Value) (values.Value, error) {
+	if len(elems) == 0 {
+		return nil, errors.New(codes.Invalid, "array parameters cannot be empty")
+	}
+	typ := elems[0].Type()
+	for _, v := range elems[1:] {
+		if v.Type().String() != typ.String() {
+			return nil, errors.Newf(codes.Invalid, "array parameter elements must have the same type, got %v and %v", typ, v.Type())
+		}
+	}
+	return values.NewArrayWithBacking(semantic.NewArrayType(typ), elems), nil
+}
+
+// names returns the names of the parameters in sorted order.
+func (p Params) names() []string {
+	names := make([]string, 0, len(p))
+	for k := range p {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// types returns the type of each parameter.
+func (p Params) types() map[string]string {
+	types := make(map[string]string, len(p))
+	for k, v := range p {
+		types[k] = v.Type().String()
+	}
+	return types
+}
+
+// file returns a Flux file that declares the params record
+// with the values of the parameters. The file is analyzed
+// with the program so the parameters are type checked.
+func (p Params) file() (*ast.File, error) {
+	obj := &ast.ObjectExpression{}
+	for _, name := range p.names() {
+		if !isIdentifier(name) {
+			return nil, errors.Newf(codes.Invalid, "parameter name %q is not a valid identifier", name)
+		}
+		expr, err := paramExpression(p[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "invalid parameter %q", name)
+		}
+		obj.Properties = append(obj.Properties, &ast.Property{
+			Key:   &ast.Identifier{Name: name},
+			Value: expr,
+		})
+	}
+	return &ast.File{
+		// The name is also set on the location so that
+		// it carries over to the semantic graph.
+		BaseNode: ast.BaseNode{
+			Loc: &ast.SourceLocation{File: paramsFileName},
+		},
+		Name:    paramsFileName,
+		Package: &ast.PackageClause{Name: &ast.Identifier{Name: "main"}},
+		Body: []ast.Statement{
+			&ast.VariableAssignment{
+				ID:   &ast.Identifier{Name: ParamsIdentifier},
+				Init: obj,
+			},
+		},
+	}, nil
+}
+
+// paramExpression returns a literal expression for the value.
+func paramExpression(v values.Value) (ast.Expression, error) {
+	switch v.Type().Nature() {
+	case semantic.Bool:
+		return &ast.BooleanLiteral{Value: v.Bool()}, nil
+	case semantic.Int:
+		return &ast.IntegerLiteral{Value: v.Int()}, nil
+	case semantic.UInt:
+		return &ast.UnsignedIntegerLiteral{Value: v.UInt()}, nil
+	case semantic.Float:
+		return &ast.FloatLiteral{Value: v.Float()}, nil
+	case semantic.String:
+		return &ast.StringLiteral{Value: v.Str()}, nil
+	case semantic.Time:
+		return &ast.DateTimeLiteral{Value: v.Time().Time()}, nil
+	case semantic.Duration:
+		d := v.Duration()
+		lit := &ast.DurationLiteral{Values: d.AsValues()}
+		if len(lit.Values) == 0 {
+			lit.Values = []ast.Duration{{Magnitude: 0, Unit: ast.NanosecondUnit}}
+		}
+		if d.IsNegative() {
+			return &ast.UnaryExpression{Operator: ast.SubtractionOperator, Argument: lit}, nil
+		}
+		return lit, nil
+	case semantic.Array:
+		arr := v.Array()
+		if arr.Len() == 0 {
+			return nil, errors.New(codes.Invalid, "array parameters cannot be empty")
+		}
+		expr := &ast.ArrayExpression{Elements: make([]ast.Expression, arr.Len())}
+		var err error
+		arr.Range(func(i int, v values.Value) {
+			if err == nil {
+				expr.Elements[i], err = paramExpression(v)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		return expr, nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "parameters of type %v are not supported", v.Type())
+	}
+}
+
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// scopeMutator returns a function that adds
+// the params record to the scope of a program.
+func (p Params) scopeMutator() flux.ScopeMutator {
+	obj := values.NewObjectWithValues(p)
+	return func(r flux.Runtime, scope values.Scope) {
+		scope.Set(ParamsIdentifier, obj)
+	}
+}
+
+// equal reports whether the parameters have the same names and values.
+func (p Params) equal(o Params) bool {
+	if len(p) != len(o) {
+		return false
+	}
+	for name, v := range p {
+		if ov, ok := o[name]; !ok || !v.Equal(ov) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTypes verifies that the parameters have the
+// same names and types as the declared parameters.
+func (p Params) checkTypes(declared map[string]string) error {
+	for _, name := range p.names() {
+		want, ok := declared[name]
+		if !ok {
+			return errors.Newf(codes.Invalid, "parameter %q is not declared by the program", name)
+		}
+		if got := p[name].Type().String(); got != want {
+			return errors.Newf(codes.Invalid, "parameter %q has type %s, but the program was compiled with type %s", name, got, want)
+		}
+	}
+	for name := range declared {
+		if _, ok := p[name]; !ok {
+			return errors.Newf(codes.Invalid, "missing value for parameter %q", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,510 @@
+package lang_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/values"
+)
+
+func mustArrayParam(t *testing.T, elems ...values.Value) values.Value {
+	t.Helper()
+	v, err := lang.NewArrayParam(elems)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestParams_JSON(t *testing.T) {
+	params := lang.Params{
+		"b":   values.NewBool(true),
+		"i":   values.NewInt(-7),
+		"u":   values.NewUInt(7),
+		"f":   values.NewFloat(1.5),
+		"fi":  values.NewFloat(2),
+		"s":   values.NewString("telegraf"),
+		"t":   values.NewTime(values.ConvertTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+		"d":   values.NewDuration(values.ConvertDuration(-90 * time.Second)),
+		"arr": mustArrayParam(t, values.NewString("a"), values.NewString("b")),
+	}
+	bs, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got lang.Params
+	if err := json.Unmarshal(bs, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(params) {
+		t.Fatalf("unexpected number of parameters -want/+got:\n\t- %d\n\t+ %d", len(params), len(got))
+	}
+	for k, want := range params {
+		if !want.Equal(got[k]) {
+			t.Errorf("unexpected value for parameter %q -want/+got:\n\t- %v\n\t+ %v", k, want, got[k])
+		}
+	}
+}
+
+func TestParams_UnmarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		json    string
+		want    lang.Params
+		wantErr string
+	}{
+		{
+			name: "plain values",
+			json: `{"s": "cpu", "i": 10, "f": 10.5, "b": false}`,
+			want: lang.Params{
+				"s": values.NewString("cpu"),
+				"i": values.NewInt(10),
+				"f": values.NewFloat(10.5),
+				"b": values.NewBool(false),
+			},
+		},
+		{
+			name: "typed values",
+			json: `{"start": {"type": "time", "value": "2020-01-01T00:00:00Z"}, "every": {"type": "duration", "value": "1h30m"}}`,
+			want: lang.Params{
+				"start": values.NewTime(values.ConvertTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+				"every": values.NewDuration(values.ConvertDuration(90 * time.Minute)),
+			},
+		},
+		{
+			name:    "null",
+			json:    `{"s": null}`,
+			wantErr: `invalid parameter "s": parameters cannot be null`,
+		},
+		{
+			name:    "empty array",
+			json:    `{"a": []}`,
+			wantErr: `invalid parameter "a": array parameters cannot be empty`,
+		},
+		{
+			name:    "mixed array",
+			json:    `{"a": [1, "a"]}`,
+			wantErr: `invalid parameter "a": array parameter elements must have the same type, got int and string`,
+		},
+		{
+			name:    "unknown type",
+			json:    `{"a": {"type": "regexp", "value": "a"}}`,
+			wantErr: `invalid parameter "a": unsupported parameter type "regexp"`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got lang.Params
+			err := json.Unmarshal([]byte(tc.json), &got)
+			if tc.wantErr != "" {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := err.Error(), tc.wantErr; got != want {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, want := range tc.want {
+				if !want.Equal(got[k]) {
+					t.Errorf("unexpected value for parameter %q -want/+got:\n\t- %v\n\t+ %v", k, want, got[k])
+				}
+			}
+		})
+	}
+}
+
+func runPrepared(t *testing.T, program flux.Program) []*executetest.Table {
+	t.Helper()
+	ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+	q, err := program.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return readTables(t, q)
+}
+
+// readTables reads the tables of all of the results of a query.
+func readTables(t *testing.T, q flux.Query) []*executetest.Table {
+	t.Helper()
+	var got []*executetest.Table
+	for res := range q.Results() {
+		if err := res.Tables().Do(func(tbl flux.Table) error {
+			et, err := executetest.ConvertTable(tbl)
+			if err != nil {
+				return err
+			}
+			got = append(got, et)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	q.Done()
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(got)
+	return got
+}
+
+func TestPrepare(t *testing.T) {
+	script := `
+import "array"
+
+array.from(rows: [{_value: 1}, {_value: 5}, {_value: 10}])
+	|> filter(fn: (r) => r._value >= params.min)
+	|> map(fn: (r) => ({r with name: params.name}))
+`
+	program, err := lang.Prepare(script, runtime.Default, time.Unix(0, 0), lang.Params{
+		"min":  values.NewInt(5),
+		"name": values.NewString("a"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := func(name string, vs ...int64) []*executetest.Table {
+		tbl := &executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_value", Type: flux.TInt},
+				{Label: "name", Type: flux.TString},
+			},
+		}
+		for _, v := range vs {
+			tbl.Data = append(tbl.Data, []interface{}{v, name})
+		}
+		tables := []*executetest.Table{tbl}
+		executetest.NormalizeTables(tables)
+		return tables
+	}
+
+	// Start the program twice to ensure it can be started repeatedly.
+	for i := 0; i < 2; i++ {
+		if got, want := runPrepared(t, program), want("a", 5, 10); !cmp.Equal(want, got) {
+			t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+		}
+	}
+
+	bound, err := program.Bind(lang.Params{
+		"min":  values.NewInt(10),
+		"name": values.NewString("b"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := runPrepared(t, bound), want("b", 10); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// Binding a program does not modify the original program.
+	if got, want := runPrepared(t, program), want("a", 5, 10); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestPrepare_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		script  string
+		params  lang.Params
+		wantErr string
+	}{
+		{
+			name:    "type error",
+			script:  `x = params.n + "a"`,
+			params:  lang.Params{"n": values.NewInt(1)},
+			wantErr: "but found",
+		},
+		{
+			name:    "undeclared parameter",
+			script:  `x = params.m + 1`,
+			params:  lang.Params{"n": values.NewInt(1)},
+			wantErr: "record is missing label m",
+		},
+		{
+			name:    "invalid name",
+			script:  `x = 1`,
+			params:  lang.Params{"a-b": values.NewInt(1)},
+			wantErr: `parameter name "a-b" is not a valid identifier`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := lang.Prepare(tc.script, runtime.Default, time.Unix(0, 0), tc.params)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error to contain %q, got %q", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPreparedProgram_Bind(t *testing.T) {
+	program, err := lang.Prepare(`x = params.n + 1`, runtime.Default, time.Unix(0, 0), lang.Params{
+		"n": values.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name    string
+		params  lang.Params
+		wantErr string
+	}{
+		{
+			name:    "wrong type",
+			params:  lang.Params{"n": values.NewFloat(1)},
+			wantErr: `parameter "n" has type float, but the program was compiled with type int`,
+		},
+		{
+			name:    "missing",
+			params:  lang.Params{},
+			wantErr: `missing value for parameter "n"`,
+		},
+		{
+			name:    "extra",
+			params:  lang.Params{"n": values.NewInt(1), "m": values.NewInt(1)},
+			wantErr: `parameter "m" is not declared by the program`,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := program.Bind(tc.params)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), tc.wantErr; got != want {
+				t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
+
+func TestPreparedProgram_ReusesPlan(t *testing.T) {
+	// The rule counts the nodes it is applied to,
+	// which happens each time the program is planned.
+	var planned int
+	rule := &plantest.FunctionRule{
+		RewriteFn: func(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+			planned++
+			return node, false, nil
+		},
+	}
+	script := `
+import "array"
+
+array.from(rows: [{_value: params.v}])
+`
+	program, err := lang.Prepare(script, runtime.Default, time.Unix(0, 0), lang.Params{
+		"v": values.NewInt(1),
+	}, lang.WithLogPlanOpts(plan.AddLogicalRules(rule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := func(v int64) []*executetest.Table {
+		tables := []*executetest.Table{{
+			ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+			Data:    [][]interface{}{{v}},
+		}}
+		executetest.NormalizeTables(tables)
+		return tables
+	}
+
+	if got, want := runPrepared(t, program), want(1); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	n := planned
+	if n == 0 {
+		t.Fatal("expected the program to be planned")
+	}
+
+	// Starting the program again with the same value of now reuses the plan.
+	if got, want := runPrepared(t, program), want(1); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if got, want := planned, n; got != want {
+		t.Fatalf("unexpected number of planned nodes -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+
+	// A bound program is planned with its own parameters.
+	bound, err := program.Bind(lang.Params{"v": values.NewInt(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := runPrepared(t, bound), want(2); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	if got, want := planned, 2*n; got != want {
+		t.Fatalf("unexpected number of planned nodes -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+}
+
+func TestPreparedProgram_ReusesPlanAtAnyNow(t *testing.T) {
+	var planned int
+	rule := &plantest.FunctionRule{
+		RewriteFn: func(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+			planned++
+			return node, false, nil
+		},
+	}
+	// The range stops at now, but the program
+	// does not read now while it is evaluated.
+	script := `
+import "array"
+
+array.from(rows: [{_time: 2020-01-01T00:00:00Z, _value: params.v}])
+	|> range(start: 2020-01-01T00:00:00Z)
+`
+	program, err := lang.Prepare(script, runtime.Default, time.Time{}, lang.Params{
+		"v": values.NewInt(1),
+	}, lang.WithLogPlanOpts(plan.AddLogicalRules(rule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// stops returns the stop of the range of each table.
+	stops := func(tables []*executetest.Table) []execute.Time {
+		var stops []execute.Time
+		for _, tbl := range tables {
+			stop, value := execute.ColIdx("_stop", tbl.ColMeta), execute.ColIdx("_value", tbl.ColMeta)
+			if stop < 0 || value < 0 || len(tbl.Data) != 1 || tbl.Data[0][value] != int64(1) {
+				t.Fatalf("unexpected table %v", tbl)
+			}
+			stops = append(stops, tbl.Data[0][stop].(execute.Time))
+		}
+		return stops
+	}
+
+	// Every start is run at its own value of now with its own
+	// copy of the plan, so that the queries run concurrently.
+	var queries []flux.Query
+	programs := []flux.Program{program, program}
+	bound, err := program.Bind(lang.Params{"v": values.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	programs = append(programs, bound)
+	for _, p := range programs {
+		time.Sleep(time.Millisecond)
+		ctx := executetest.NewTestExecuteDependencies().Inject(context.Background())
+		q, err := p.Start(ctx, &memory.Allocator{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		queries = append(queries, q)
+	}
+	var last execute.Time
+	for _, q := range queries {
+		got := stops(readTables(t, q))
+		if len(got) != 1 || got[0] <= last {
+			t.Fatalf("expected the range to stop after %v, got %v", last, got)
+		}
+		last = got[0]
+	}
+
+	// The program is planned once, since the bound program has the same parameters.
+	n := planned
+	if n == 0 {
+		t.Fatal("expected the program to be planned")
+	}
+	if got, want := runPrepared(t, program), 1; len(got) != want {
+		t.Fatalf("unexpected number of tables -want/+got:\n\t- %d\n\t+ %d", want, len(got))
+	}
+	if got, want := planned, n; got != want {
+		t.Fatalf("unexpected number of planned nodes -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+}
+
+func TestPreparedProgram_ReadsNow(t *testing.T) {
+	var planned int
+	rule := &plantest.FunctionRule{
+		RewriteFn: func(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+			planned++
+			return node, false, nil
+		},
+	}
+	// The rows depend on the value of now,
+	// so the program is planned each time it starts.
+	script := `
+import "array"
+
+array.from(rows: [{_time: now(), _value: params.v}])
+`
+	program, err := lang.Prepare(script, runtime.Default, time.Time{}, lang.Params{
+		"v": values.NewInt(1),
+	}, lang.WithLogPlanOpts(plan.AddLogicalRules(rule)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		last execute.Time
+		n    int
+	)
+	for i := 1; i <= 2; i++ {
+		time.Sleep(time.Millisecond)
+		got := runPrepared(t, program)
+		if len(got) != 1 || len(got[0].Data) != 1 {
+			t.Fatalf("unexpected tables %v", got)
+		}
+		idx := execute.ColIdx("_time", got[0].ColMeta)
+		if idx < 0 {
+			t.Fatalf("expected a _time column in %v", got[0])
+		}
+		if tm := got[0].Data[0][idx].(execute.Time); tm <= last {
+			t.Fatalf("expected a row after %v, got %v", last, tm)
+		} else {
+			last = tm
+		}
+		if i == 1 {
+			n = planned
+		}
+	}
+	if got, want := planned, 2*n; got != want || n == 0 {
+		t.Fatalf("unexpected number of planned nodes -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+}
+
+func TestFluxCompiler_Params(t *testing.T) {
+	var c lang.FluxCompiler
+	if err := json.Unmarshal([]byte(`{
+	"query": "import \"array\"\narray.from(rows: [{_value: params.v}])",
+	"params": {"v": {"type": "uint", "value": 3}}
+}`), &c); err != nil {
+		t.Fatal(err)
+	}
+	program, err := c.Compile(context.Background(), runtime.Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := program.(*lang.PreparedProgram); !ok {
+		t.Fatalf("expected a prepared program, got %T", program)
+	}
+	want := []*executetest.Table{{
+		ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TUInt}},
+		Data:    [][]interface{}{{uint64(3)}},
+	}}
+	executetest.NormalizeTables(want)
+	if got := runPrepared(t, program); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
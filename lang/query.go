@@ -17,6 +17,9 @@ type query struct {
 	cancel  func()
 	err     error
 	wg      sync.WaitGroup
+
+	// done is called once the query is done, if it is set.
+	done func()
 }
 
 func (q *query) Results() <-chan flux.Result {
@@ -26,6 +29,10 @@ func (q *query) Results() <-chan flux.Result {
 func (q *query) Done() {
 	q.cancel()
 	q.wg.Wait()
+	if q.done != nil {
+		q.done()
+		q.done = nil
+	}
 	q.stats.MaxAllocated = q.alloc.MaxAllocated()
 	q.stats.TotalAllocated = q.alloc.TotalAllocated()
 	if q.span != nil {
@@ -14,7 +14,7 @@ package libflux
 var sourceHashes = map[string]string{
 	"libflux/Cargo.lock":                                                            "b0745b5aa727c26b41acf768f7def2b918720f1057c608631710da556a5ac7ac",
 	"libflux/Cargo.toml":                                                            "9ef291bc6fcfbdcd7a71946dd306fa2599d17c20be2ca15d59d93cec0a564f77",
-	"libflux/include/influxdata/flux.h":                                             "8a534237a7f7a36cea9c2ed24f10d436217e043b78de2129a820d72fb043ea4d",
+	"libflux/include/influxdata/flux.h":                                             "e642da05b2a53637e2dfe9be2f0e3ecd758cae3270325b54c227068e48b3d5ee",
 	"libflux/scanner.c":                                                             "0cad3d3ee8963a2ed7627efaaffe6111fd1a6563e5bc74113e62c11db438dfb0",
 	"libflux/src/core/Cargo.toml":                                                   "4f3093731281c0ef02151230f1bd83eb1a1f9b1a1cff0fc88ebe6b5b1fe2b6d7",
 	"libflux/src/core/ast/check/mod.rs":                                             "59de9596b266fd254785a81c1e1dbbd2ed18a2b66bafc9a4c9e51dba72cb8422",
@@ -40,24 +40,24 @@ var sourceHashes = map[string]string{
 	"libflux/src/core/scanner/unicode.rl":                                           "f923f3b385ddfa65c74427b11971785fc25ea806ca03d547045de808e16ef9a1",
 	"libflux/src/core/scanner/unicode.rl.COPYING":                                   "6cf2d5d26d52772ded8a5f0813f49f83dfa76006c5f398713be3854fe7bc4c7e",
 	"libflux/src/core/semantic/bootstrap.rs":                                        "db062aa0a39ef2a07fd72bab271359c91ccb4c842b234a19f9c6df4b00f9b4ad",
-	"libflux/src/core/semantic/builtins.rs":                                         "146e141f6e5e8e2bfcb10a903c2e699ab257d04799768200d6f8a17aedd2592d",
+	"libflux/src/core/semantic/builtins.rs":                                         "c61bb5702dc9cfd43f8e79874866fc9dbd4fcd912ea7084a8378de1c96255cfa",
 	"libflux/src/core/semantic/check.rs":                                            "acb29602ee01f636818ba3522b3f110018abca3e7b4a6b75c29eec97856a324e",
 	"libflux/src/core/semantic/convert.rs":                                          "e0e11c8b3111a7d87e256bb553a3a9e72b90af045a94671f437f4a3109d5d0e2",
 	"libflux/src/core/semantic/env.rs":                                              "e031d5b752d207a8f93bacd8515639e832735d5a85e90db76690aaeee8168127",
 	"libflux/src/core/semantic/flatbuffers/mod.rs":                                  "244afed2e7cee6dc5a80a03a1c91d38ad1137bc2163640b59f464f383d7a01d5",
-	"libflux/src/core/semantic/flatbuffers/semantic_generated.rs":                   "218c8e2b717ea6618e142d4a6563d6d9cb093c4e37653d799ee6774f20172d89",
+	"libflux/src/core/semantic/flatbuffers/semantic_generated.rs":                   "e427d1191eb77f9bd1579b885a84fe6c00b9e45dc4928bfd873a26714d1c2071",
 	"libflux/src/core/semantic/flatbuffers/tests.rs":                                "a70525abc2e73e18b2b1f513f753a70c214b9da613bd95c5432df87108251898",
-	"libflux/src/core/semantic/flatbuffers/types.rs":                                "8018b0bd5d902c4ecdb0426d96780a42b80359d2d5eea8a58a862f2a74d1ee35",
-	"libflux/src/core/semantic/fresh.rs":                                            "c2817d198eb63accb88476cb8946728647218703730c6ea9ee558649357d4a85",
+	"libflux/src/core/semantic/flatbuffers/types.rs":                                "5fddc591f35dad4c7ed40220cd447abca92da40ced6106583dab44d8864d8ec3",
+	"libflux/src/core/semantic/fresh.rs":                                            "124ea75019159be52c429dc14cc4456af42ff54fbb746c202adaee101c34bb5a",
 	"libflux/src/core/semantic/import.rs":                                           "7b0a9259bb86fc887707ac442a71ca63ea1ef61b150a376d973d5dd3172e6ec6",
 	"libflux/src/core/semantic/infer.rs":                                            "23fd3349fb08e950d521061c4bd7a10673816707bd7f26351aa959572dc0a408",
 	"libflux/src/core/semantic/mod.rs":                                              "2c04c1b9b2cc8b0546a9214d664ecf8e482594fcf00be61087b589ddc8a09e35",
 	"libflux/src/core/semantic/nodes.rs":                                            "bede17d5beff1e9434b1f26df358fff05965cca7a5838bd148508ca2c6a72f3d",
-	"libflux/src/core/semantic/parser/grammar.md":                                   "4ca1c833c11ebb9208d4c76108d86840bd062f8a241dce72fe1e87da05af633c",
-	"libflux/src/core/semantic/parser/mod.rs":                                       "f71b1624647f3a5601927e538a0133e793c5864da48412c2a004fc6c984a4301",
+	"libflux/src/core/semantic/parser/grammar.md":                                   "c27e409c02b32a9303246c11a6e6f8d269a78731b0662d9064502836eb3bc033",
+	"libflux/src/core/semantic/parser/mod.rs":                                       "e1abb742d5ea2a39093c30e3dc9bc92d5a32f3e996a7cf211a733f412b6e3aad",
 	"libflux/src/core/semantic/sub.rs":                                              "9fd72f30c4ea5fde11c636160dc88271d34d6f49e2c5f95d32d22a500ef109b9",
 	"libflux/src/core/semantic/tests.rs":                                            "a9261e40c47a6b234981cfe1c122ee7eb67d948e64b41712b91bfc6357070ea0",
-	"libflux/src/core/semantic/types.rs":                                            "ee1544446b679d52c04160bea061d0bff9b10a9d2c336bff056f2e929d4236dc",
+	"libflux/src/core/semantic/types.rs":                                            "e54f6e7d3cdeda6df1b01cad6267644b231045cd531c144da269271f81f4457e",
 	"libflux/src/core/semantic/walk/_walk.rs":                                       "750a18987c6f98adec60fd8b2736403b9501a15a2ab21ff3ec5cad9c5b2e3a08",
 	"libflux/src/core/semantic/walk/mod.rs":                                         "f0167952d639b075c45212d895aee87997e477a76446912a29ab6ed6a9c21325",
 	"libflux/src/core/semantic/walk/test_utils.rs":                                  "317606227b28f607273e4ff6ac431e148f753cc20ea1812fa7b97ecb262b3f90",
@@ -66,7 +66,10 @@ var sourceHashes = map[string]string{
 	"libflux/src/flux/Cargo.toml":                                                   "ede8860c53d1fd5cc7bc730a090e615b5668b0c340ccc8b1b9dbed426b5c8f76",
 	"libflux/src/flux/benches/builtins.rs":                                          "c60908c65ea51c225fccec19c44343af9103c950a91b23071ddb80292da708c8",
 	"libflux/src/flux/build.rs":                                                     "31a4f825297f9b79d1c8692a5fa3ff9211cb87d01650d147128d061588f75abd",
-	"libflux/src/flux/lib.rs":                                                       "ad1fc4c3b4809f1c76eb7752404699089143ecf5a34c9c14b333acc156c1b3dd",
+	"libflux/src/flux/lib.rs":                                                       "37377a61772247566ce3fbbe4fb1ee24f419359dc72180057fca37482ff4a926",
+	"stdlib/array/array.flux":                                                       "0787738a2c3319db1d4b1f25446f6772f03898d14ccf2e4681692f5324cc1c9e",
+	"stdlib/array/from_test.flux":                                                   "2f746fde44f684517a29e8b4886c2c57dc475e72338c566d9bc9c51301a15d67",
+	"stdlib/array/rows_test.flux":                                                   "c9bd187177a0fa5e6b100fe4ce3502f262f463c0373046b637de93712bb90fdb",
 	"stdlib/contrib/chobbs/discord/discord.flux":                                    "8fd42ce1b459969ec3254dc0215a21b3669e01960a203e93c05284384f3eb49a",
 	"stdlib/contrib/sranka/teams/teams.flux":                                        "57d5656dcb2db79f173e84d551efdbeefb643d028eaaecfff8ee7d2a033f9f50",
 	"stdlib/contrib/sranka/telegram/telegram.flux":                                  "37d1614a215c6ca523e4efa5642ec9104936755a403e5bc4481d82a602f7719b",
@@ -74,6 +77,9 @@ var sourceHashes = map[string]string{
 	"stdlib/date/date.flux":                                                         "4eef9579c89bb8b302ce9b78737c6b370638c021c36825744b89917be1e38bd2",
 	"stdlib/date/hour_duration_test.flux":                                           "e349cddb4cc4967fd578da8eb453e9f7d9776176249323755356362653962c9c",
 	"stdlib/date/hour_time_test.flux":                                               "b6a64b991e02d24abc7d4c86d7486e9b88ae5232043fa7ff44857065f48da696",
+	"stdlib/date/location_aggregate_window_test.flux":                               "bde3e348bed345b65623c763b710885d443949a7a747a6fe333ec0355440e6e5",
+	"stdlib/date/location_hour_time_test.flux":                                      "ed04260d901883a601376130f1c19529c7f4b9b7f1602544bfdae7117640a610",
+	"stdlib/date/location_window_test.flux":                                         "8f42651aa60407ba47a207862ce1539e8dd7429648b963fb39fbcd897f2daaeb",
 	"stdlib/date/microsecond_duration_test.flux":                                    "6bd0422f7933263730a7b5fd3329bfcef375cf48d4d2a9067ea819c262ac75a4",
 	"stdlib/date/microsecond_time_test.flux":                                        "6998411a0457994652ea4887a0b76bc8ddc4993ec1fcd1580aead2f682715a86",
 	"stdlib/date/millisecond_duration_test.flux":                                    "098e8168b946289e44aae35b4de437e97a2f4417d232131c7b53335582bfad5f",
@@ -100,11 +106,16 @@ var sourceHashes = map[string]string{
 	"stdlib/date/year_day_time_test.flux":                                           "432b68893d9038250d083102f601a891b90580cbe2b0952d383eb2d534f8ad85",
 	"stdlib/date/year_duration_test.flux":                                           "dedb797c14c3f8613956cb32112ff8c00913b3a68dc379e1fda5474719f11a2f",
 	"stdlib/date/year_time_test.flux":                                               "5a9095164a8cd9c9cbad7f2c3060d58231ecc01eb2c4587b3178a3c34e444009",
-	"stdlib/experimental/aggregate/aggregate.flux":                                  "71b7f9c4886a936e3d69df1d3c61e447de4fde15f0302b26d5e4b40d0dabcb4a",
+	"stdlib/dict/dict.flux":                                                         "972fef3440d6490680f0c49d374ecf3f71e1c87e120b852aaea72349cc7e3224",
+	"stdlib/dict/get_test.flux":                                                     "cc6ee48e7e14ed6129263765c0516905479514acfbd05ccaa74f204f372d6109",
+	"stdlib/dict/insert_remove_test.flux":                                           "52bffb80a66e4e7cc891295a150684ac3983c3f6555cc93c3b690c8db9c04a8a",
+	"stdlib/experimental/aggregate/aggregate.flux":                                  "e241d936abfbbeafaaef6fbd4e8528b083adbadfe4ce44f57fb558dc0b5b30ff",
 	"stdlib/experimental/aggregate/aggregate_test.flux":                             "92d999622f381a21c7a4312872de24c7f3186ee748e3ff4912f4ab9ea72ec085",
+	"stdlib/experimental/aggregate/define_test.flux":                                "dc951a307d74620293a2db2a1819c34bd32465aa3b3abb5ce3eb9cbc93b81bb1",
 	"stdlib/experimental/alignTime_test.flux":                                       "7d5f50f5623bdcbd4028099ecb0695551b232199488ebd0da0d710bd0e463941",
 	"stdlib/experimental/bigtable/bigtable.flux":                                    "bde1b50adbf3da9b3056f4fbc3078765d166454454d83bac22f88147d7d72137",
 	"stdlib/experimental/csv/csv.flux":                                              "ec4dab32d9155334de69180174d418d87b9c1668c9b9b2519f59f340a26cc462",
+	"stdlib/experimental/error/error.flux":                                          "b514640578bc35aa7529c0afdbdc4e9620cc1efe3e8cfda38279020add2696d0",
 	"stdlib/experimental/experimental.flux":                                         "a26528199923e1c54ce4cf0f4b5296bc849e7c65eab55e5f982f97345ee71dca",
 	"stdlib/experimental/geo/asTracks_test.flux":                                    "d7c6be02e79191e1bf192afd6293750720e9e2fc7681530e745297cd3072f82d",
 	"stdlib/experimental/geo/filterRowsNotStrict_test.flux":                         "0e1d55e64910f2c8bcabbb41e6bd5c58b920ad3cd392bf72e7773f21ed4ee112",
@@ -122,7 +133,7 @@ var sourceHashes = map[string]string{
 	"stdlib/experimental/join_test.flux":                                            "76f04e2d0d8806e4d2c9a8e5946d4d7f83cab805594a0a78ebb30567f1b47ff6",
 	"stdlib/experimental/json/json.flux":                                            "c1132b76c2291f678a7c1a2ff66db7a141dc77e0fe9f46e9a57150ecca8ae6bb",
 	"stdlib/experimental/json/json_test.flux":                                       "4f97387c67538eedce700a3bd079ee4082cb6c1eb95e77423a228853b71f320c",
-	"stdlib/experimental/mqtt/mqtt.flux":                                            "923d38837dcabbfa0371f5e247b1ecd6a0f74fba53e6d5d30c785d29348acae5",
+	"stdlib/experimental/mqtt/mqtt.flux":                                            "e92a69f92ef26101111720e0681e4d5d17d5ea5251613b50d9764f70698c1d69",
 	"stdlib/experimental/prometheus/prometheus.flux":                                "655b615888f3e244ccda41b0250228012f14b4ccec2d5c343fec52da9b708b17",
 	"stdlib/experimental/query/from.flux":                                           "1b09f777b01b83777d5c0d8754ef6f012ef1e7f4124882292dac3b36b35101fc",
 	"stdlib/experimental/set_test.flux":                                             "8a713dc4c5b4bce0d160ff3e86ae7b259c576b97243498d65e8e7e3a75404ed3",
@@ -161,9 +172,11 @@ var sourceHashes = map[string]string{
 	"stdlib/internal/promql/promql.flux":                                            "023b4a4778d18fa8fa794baf3ecec749392ba529921dfd42a90e09c262f6b7f5",
 	"stdlib/internal/testutil/testutil.flux":                                        "1ac908d7136ec2dc5bf6417affd37fc804a7e3e832527623d85e27258dd7c8ae",
 	"stdlib/json/json.flux":                                                         "506f7a7299b0bf2760562afa425672fa1707b9d1fc31aea21af35bf993904216",
-	"stdlib/kafka/kafka.flux":                                                       "91d64daea82faec77328a02cdd59076ed27700abb5a78132f1506fbd7a80c7b9",
+	"stdlib/kafka/kafka.flux":                                                       "1af038741e1b404edde3acf75148e9a2b6ef79e0fc2cece162da28a93e139f51",
 	"stdlib/math/math.flux":                                                         "324f5a1ab898e01faf6a04cbeeae981a114f4bda03a6c0369a0a5fdefd8c88f9",
 	"stdlib/pagerduty/pagerduty.flux":                                               "78326e880c6117d19cc9d59670b8d29c049a2ca8f5d29c2c089b912f0c82aa7d",
+	"stdlib/parquet/from_test.flux":                                                 "5a2a3a02dfb8d89f6bfefcd4ec75789bdd4519dc3e2971f4bbb0def5e6b7ac03",
+	"stdlib/parquet/parquet.flux":                                                   "ff310bcf36e20959b8b251fac2358aed38ed542a94180d69703ddb92b9e2c2e3",
 	"stdlib/planner/bare_count_eval_test.flux":                                      "daacfcb03684c2709dc80e5e1b7e968dd5e7ab9fe7ec8953b33c1219327c0255",
 	"stdlib/planner/bare_count_push_test.flux":                                      "8e464ed973af80cd4b9015cb507caf7a19cd49f7eafc16c853bd17154b41cf7a",
 	"stdlib/planner/bare_sum_eval_test.flux":                                        "57a6ab2bc9cf1660127709326a9362ff89830e2288eb99756e81d9f8c67f1548",
@@ -172,7 +185,7 @@ var sourceHashes = map[string]string{
 	"stdlib/planner/group_count_push_test.flux":                                     "dfc47c5a9c631ec861e95222299c1239cbe8dfd97fbf580bd4c65f53677e47f5",
 	"stdlib/planner/group_sum_eval_test.flux":                                       "0211734f95341f74a038598ed39250d7dd471892948740cbd976ceb0665d211c",
 	"stdlib/planner/group_sum_push_test.flux":                                       "08f828a9ec08c719c7a74059b00bdf7826fbe86fb3b12973d3c95ec73cef0ed1",
-	"stdlib/planner/planner.flux":                                                   "874b09fecfd60485aa9ea0ec878a102934558daafea87617860906b3ec3d5c76",
+	"stdlib/planner/planner.flux":                                                   "4044e3111e2d4f16d542680bb178a289e0f138ef16626f68ab711b37d64ba860",
 	"stdlib/planner/window_count_eval_test.flux":                                    "5ca30a244821abd9dc8fa8a975c0e987f4a8d395a92b4697e2a0be5acda1d1c3",
 	"stdlib/planner/window_count_push_test.flux":                                    "85be1d688cfba3014326dea22a11d0b3358a848eb0db4862e553d8b40c11b2b6",
 	"stdlib/planner/window_eval_test.flux":                                          "b199cf1576bd906af5d868f8e3cd744ea71c6a47629b8e628eb7cdae8a95424b",
@@ -455,7 +468,7 @@ var sourceHashes = map[string]string{
 	"stdlib/universe/union_heterogeneous_test.flux":                                 "faff22b3677dcd6e8af65c31761b58043aa86a55daf36f95530ec033c5ece123",
 	"stdlib/universe/union_test.flux":                                               "92a62ad863e95bf866e6b2e19f18938739c9661eff1a6892062bd22a3c0b0e0b",
 	"stdlib/universe/unique_test.flux":                                              "e08738ec832b831e98b43cba3b66bdb356b3ca1c0084954b5ea8b998744c11f9",
-	"stdlib/universe/universe.flux":                                                 "c999db7b30ebdc17db7a47f206dddeab7b6f64f2463851e4cae6394cbc58ae20",
+	"stdlib/universe/universe.flux":                                                 "f122ca3e93e2db8cb27c402cc4901f30f314e1cba8df29de05b73f9a946bfbef",
 	"stdlib/universe/universe_truncateTimeColumn_test.flux":                         "616e7c4ec942d52963a01f1231b17d8653312b163bc2b4012acd0d8c42458fb6",
 	"stdlib/universe/window_default_start_align_test.flux":                          "54fd2e6bc45814ee60428af3b53666536b92ec508a004d4c87351a7e900961ae",
 	"stdlib/universe/window_generate_empty_test.flux":                               "c929db635e600d668bd3f7cbf18fee4551d6b3e81ce4066059ec2db2d7a5d782",
@@ -87,6 +87,29 @@ func (p *ASTPkg) MarshalFB() ([]byte, error) {
 	return data, nil
 }
 
+// Format returns the AST formatted as Flux source.
+// Unlike ast.Format, the comments within the source are preserved.
+func (p *ASTPkg) Format() (string, error) {
+	var buf C.struct_flux_buffer_t
+	if err := C.flux_ast_format(p.ptr, &buf); err != nil {
+		defer C.flux_free_error(err)
+		cstr := C.flux_error_str(err)
+		defer C.flux_free_bytes(cstr)
+
+		str := C.GoString(cstr)
+		return "", errors.Newf(codes.Internal, "could not format AST: %v", str)
+	}
+	// Ensure that we don't free the pointer during the call to
+	// format. This is only needed on one path because
+	// the compiler recognizes the possibility that p might
+	// be used again and prevents it from being garbage collected.
+	runtime.KeepAlive(p)
+	defer C.flux_free_bytes(buf.data)
+
+	data := C.GoBytes(unsafe.Pointer(buf.data), C.int(buf.len))
+	return string(data), nil
+}
+
 func (p *ASTPkg) Free() {
 	if p.ptr != nil {
 		C.flux_free_ast_pkg(p.ptr)
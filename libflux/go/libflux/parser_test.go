@@ -57,6 +57,19 @@ func TestASTPkg_GetError(t *testing.T) {
 
 }
 
+func TestASTPkg_Format(t *testing.T) {
+	src := "package foo\n\n// a is assigned.\na = 1+1"
+	ast := libflux.ParseString(src)
+	defer ast.Free()
+	got, err := ast.Format()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "package foo\n\n// a is assigned.\na = 1 + 1"; want != got {
+		t.Error("unexpected formatted source; -want/+got:\n ", cmp.Diff(want, got))
+	}
+}
+
 func TestMergePackages(t *testing.T) {
 	outPkg := libflux.ParseString(`
 package foo
@@ -30,30 +30,54 @@ impl<'a> Builtins<'a> {
 pub fn builtins() -> Builtins<'static> {
     Builtins {
         pkgs: semantic_map! {
+            "array" => semantic_map! {
+                "from" => "forall [t0] where t0: Row (rows: [t0]) -> [t0]",
+                "toRows" => "forall [t0] where t0: Row (<-table: [t0]) -> [t0]",
+            },
             "csv" => semantic_map! {
                 // This is a "provide exactly one argument" function
                 // https://github.com/influxdata/flux/issues/2249
                 "from" => "forall [t0] where t0: Row (?csv: string, ?file: string) -> [t0]",
             },
             "date" => semantic_map! {
-                 "second" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "minute" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "hour" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "weekDay" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "monthDay" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "yearDay" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "month" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "year" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "week" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "quarter" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "millisecond" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "microsecond" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "nanosecond" => "forall [t0] where t0 : Timeable (t: t0) -> int",
-                 "truncate" => "forall [t0] where t0 : Timeable (t: t0, unit: duration) -> time",
+                 "second" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "minute" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "hour" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "weekDay" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "monthDay" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "yearDay" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "month" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "year" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "week" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "quarter" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "millisecond" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "microsecond" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "nanosecond" => "forall [t0] where t0 : Timeable (t: t0, ?location: {zone: string | offset: duration}) -> int",
+                 "truncate" => "forall [t0] where t0 : Timeable (t: t0, unit: duration, ?location: {zone: string | offset: duration}) -> time",
+            },
+            "dict" => semantic_map! {
+                "fromList" => "forall [t0, t1] where t0: Comparable (pairs: [{key: t0 | value: t1}]) -> [t0:t1]",
+                "get" => "forall [t0, t1] where t0: Comparable (dict: [t0:t1], key: t0, default: t1) -> t1",
+                "insert" => "forall [t0, t1] where t0: Comparable (dict: [t0:t1], key: t0, value: t1) -> [t0:t1]",
+                "remove" => "forall [t0, t1] where t0: Comparable (dict: [t0:t1], key: t0) -> [t0:t1]",
+            },
+            "experimental/aggregate" => semantic_map! {
+                     "define" => r#"
+                        forall [t0, t1, t2, t3, t4] where t3: Row, t4: Row (
+                            init: t0,
+                            update: (state: t0, value: t1) -> t0,
+                            merge: (state: t0, other: t0) -> t0,
+                            finalize: (state: t0) -> t2
+                        ) -> (<-tables: [t3], ?column: string) -> [t4]
+                     "#,
             },
             "experimental/bigtable" => semantic_map! {
                      "from" => "forall [t0] where t0: Row (token: string, project: string, instance: string, table: string) -> [t0]",
             },
+            "experimental/error" => semantic_map! {
+                     "catch" => "forall [t0] (fn: () -> t0, default: t0) -> t0",
+                     "try" => "forall [t0] (fn: () -> t0, default: t0) -> {value: t0 | isError: bool | code: string | message: string}",
+            },
             "experimental/geo" => semantic_map! {
                      "containsLatLon" => "forall [t0] where t0: Row (region: t0, lat: float, lon: float) -> bool",
                      "getGrid" => "forall [t0] where t0: Row (region: t0, ?minSize: int, ?maxSize: int, ?level: int, ?maxLevel: int) -> {level: int | set: [string]}",
@@ -73,6 +97,20 @@ pub fn builtins() -> Builtins<'static> {
                 "#,
             },
             "experimental/mqtt" => semantic_map! {
+                "from" => r#"
+                    forall [t0] where t0: Row (
+                        broker: string,
+                        topics: [string],
+                        ?qos: int,
+                        ?clientid: string,
+                        ?username: string,
+                        ?password: string,
+                        ?timeout: duration,
+                        ?duration: duration,
+                        ?count: int,
+                        ?format: string
+                    ) -> [t0]
+                "#,
                 "to" => r#"
                     forall [t0, t1] where t0: Row, t1: Row (
                         <-tables: [t0],
@@ -219,6 +257,18 @@ pub fn builtins() -> Builtins<'static> {
                 "encode" => "forall [t0] (v: t0) -> bytes",
             },
             "kafka" => semantic_map! {
+                "from" => r#"
+                    forall [t0] where t0: Row (
+                        brokers: [string],
+                        topic: string,
+                        ?groupID: string,
+                        ?partition: int,
+                        ?startOffset: int,
+                        ?stopOffset: int,
+                        ?maxMessages: int,
+                        ?format: string,
+                        ?timeout: duration
+                    ) -> [t0]"#,
                 "to" => r#"
                     forall [t0] where t0: Row (
                         <-tables: [t0],
@@ -319,6 +369,10 @@ pub fn builtins() -> Builtins<'static> {
             "pagerduty" => semantic_map! {
                 "dedupKey" => "forall [t0] (<-tables: [t0]) -> [{_pagerdutyDedupKey: string | t0}]",
             },
+            "parquet" => semantic_map! {
+                "from" => "forall [t0] where t0: Row (file: string, ?groupColumns: [string]) -> [t0]",
+                "to" => "forall [t0] (<-tables: [t0], file: string) -> [t0]",
+            },
             "regexp" => semantic_map! {
                 "compile" => "forall [] (v: string) -> regexp",
                 "quoteMeta" => "forall [] (v: string) -> string",
@@ -336,11 +390,25 @@ pub fn builtins() -> Builtins<'static> {
                 "validateColorString" => "forall [] (color: string) -> string",
             },
             "socket" => semantic_map! {
-                "from" => "forall [t0] (url: string, ?decoder: string) -> [t0]",
+                "from" => r#"forall [t0] (
+                    url: string,
+                    ?decoder: string,
+                    ?mode: string,
+                    ?flushInterval: duration
+                ) -> [t0]"#,
             },
             "sql" => semantic_map! {
-                "from" => "forall [t0] (driverName: string, dataSourceName: string, query: string) -> [t0]",
-                "to" => "forall [t0] (<-tables: [t0], driverName: string, dataSourceName: string, table: string, ?batchSize: int) -> [t0]",
+                "from" => "forall [t0] (driverName: string, dataSourceName: string, query: string, ?batchSize: int) -> [t0]",
+                "to" => r#"forall [t0] (
+                    <-tables: [t0],
+                    driverName: string,
+                    dataSourceName: string,
+                    table: string,
+                    ?batchSize: int,
+                    ?mode: string,
+                    ?keyColumns: [string],
+                    ?createTable: bool
+                ) -> [t0]"#,
             },
             "strings" => semantic_map! {
                 "title" => "forall [] (v: string) -> string",
@@ -835,7 +903,8 @@ pub fn builtins() -> Builtins<'static> {
                         ?timeColumn: string,
                         ?startColumn: string,
                         ?stopColumn: string,
-                        ?createEmpty: bool
+                        ?createEmpty: bool,
+                        ?location: {zone: string | offset: duration}
                     ) -> [t1]
                 "#,
                 "yield" => r#"
@@ -9,6 +9,7 @@ use crate::semantic::fresh::Fresher;
 #[rustfmt::skip]
 use crate::semantic::types::{
     Array,
+    Dictionary,
     Function,
     Kind,
     MonoType,
@@ -129,6 +130,10 @@ fn from_table(table: flatbuffers::Table, t: fb::MonoType) -> Option<MonoType> {
             Some(MonoType::Fun(Box::new(opt?)))
         }
         fb::MonoType::Row => fb::Row::init_from_table(table).into(),
+        fb::MonoType::Dict => {
+            let opt: Option<Dictionary> = fb::Dict::init_from_table(table).into();
+            Some(MonoType::Dict(Box::new(opt?)))
+        }
         fb::MonoType::NONE => None,
     }
 }
@@ -161,6 +166,15 @@ impl From<fb::Arr<'_>> for Option<Array> {
     }
 }
 
+impl From<fb::Dict<'_>> for Option<Dictionary> {
+    fn from(t: fb::Dict) -> Option<Dictionary> {
+        Some(Dictionary {
+            key: from_table(t.k()?, t.k_type())?,
+            val: from_table(t.v()?, t.v_type())?,
+        })
+    }
+}
+
 impl From<fb::Row<'_>> for Option<MonoType> {
     fn from(t: fb::Row) -> Option<MonoType> {
         let mut r = match t.extends() {
@@ -412,6 +426,10 @@ pub fn build_type<'a>(
             let offset = build_arr(builder, *arr);
             (offset.as_union_value(), fb::MonoType::Arr)
         }
+        MonoType::Dict(dict) => {
+            let offset = build_dict(builder, *dict);
+            (offset.as_union_value(), fb::MonoType::Dict)
+        }
         MonoType::Row(row) => {
             let offset = build_row(builder, *row);
             (offset.as_union_value(), fb::MonoType::Row)
@@ -444,6 +462,23 @@ fn build_arr<'a>(
     )
 }
 
+fn build_dict<'a>(
+    builder: &mut flatbuffers::FlatBufferBuilder<'a>,
+    dict: Dictionary,
+) -> flatbuffers::WIPOffset<fb::Dict<'a>> {
+    let (k, k_type) = build_type(builder, dict.key);
+    let (v, v_type) = build_type(builder, dict.val);
+    fb::Dict::create(
+        builder,
+        &fb::DictArgs {
+            k_type,
+            k: Some(k),
+            v_type,
+            v: Some(v),
+        },
+    )
+}
+
 fn build_row<'a>(
     builder: &mut flatbuffers::FlatBufferBuilder<'a>,
     mut row: Row,
@@ -617,6 +652,11 @@ mod tests {
         test_serde("forall [t0] [t0]");
     }
     #[test]
+    fn serde_dictionary_type() {
+        test_serde("forall [t0, t1] [t0:t1]");
+        test_serde("forall [] [string:[int:float]]");
+    }
+    #[test]
     fn serde_function_types() {
         test_serde("forall [t0] (<-tables: [t0], ?flag: bool, fn: (r: t0) -> bool) -> [t0]");
         test_serde("forall [t0, t1] where t0: Addable, t1: Divisible (a: t0, b: t1) -> bool");
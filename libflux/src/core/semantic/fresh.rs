@@ -1,5 +1,6 @@
 use crate::semantic::types::{
-    Array, Function, MonoType, MonoTypeVecMap, PolyType, Property, Row, SemanticMap, Tvar, TvarMap,
+    Array, Dictionary, Function, MonoType, MonoTypeVecMap, PolyType, Property, Row, SemanticMap,
+    Tvar, TvarMap,
 };
 use std::collections::BTreeMap;
 use std::hash::Hash;
@@ -89,6 +90,7 @@ impl Fresh for MonoType {
         match self {
             MonoType::Var(tvr) => MonoType::Var(tvr.fresh(f, sub)),
             MonoType::Arr(arr) => MonoType::Arr(arr.fresh(f, sub)),
+            MonoType::Dict(dict) => MonoType::Dict(dict.fresh(f, sub)),
             MonoType::Row(obj) => MonoType::Row(obj.fresh(f, sub)),
             MonoType::Fun(fun) => MonoType::Fun(fun.fresh(f, sub)),
             _ => self,
@@ -108,6 +110,15 @@ impl Fresh for Array {
     }
 }
 
+impl Fresh for Dictionary {
+    fn fresh(self, f: &mut Fresher, sub: &mut TvarMap) -> Self {
+        Dictionary {
+            key: self.key.fresh(f, sub),
+            val: self.val.fresh(f, sub),
+        }
+    }
+}
+
 impl Fresh for Row {
     fn fresh(mut self, f: &mut Fresher, sub: &mut TvarMap) -> Self {
         let mut props = MonoTypeVecMap::new();
@@ -1,7 +1,8 @@
 use std::{iter::Peekable, slice::Iter, str::Chars};
 
 use crate::semantic::types::{
-    Array, Function, Kind, MonoType, MonoTypeMap, PolyType, Property, Row, Tvar, TvarKinds,
+    Array, Dictionary, Function, Kind, MonoType, MonoTypeMap, PolyType, Property, Row, Tvar,
+    TvarKinds,
 };
 
 #[derive(Debug, PartialEq, Copy, Clone)]
@@ -473,7 +474,7 @@ impl Parser<'_> {
         }
     }
 
-    // parse_array parses an array monotype
+    // parse_array parses an array or a dictionary monotype
     fn parse_array(&mut self, token: &Token) -> Result<MonoType, &'static str> {
         if token.token_type != TokenType::LEFTSQUAREBRAC {
             Err("Not a valid array monotype")
@@ -487,6 +488,8 @@ impl Parser<'_> {
                     let token = self.next();
                     if token.token_type == TokenType::RIGHTSQUAREBRAC {
                         Ok(MonoType::Arr(Box::new(Array(monotype))))
+                    } else if token.token_type == TokenType::COLON {
+                        self.parse_dictionary(monotype)
                     } else {
                         Err("Array monotype must have right square bracket")
                     }
@@ -496,6 +499,18 @@ impl Parser<'_> {
         }
     }
 
+    // parse_dictionary parses the value type of a dictionary monotype
+    // whose key type has already been parsed
+    fn parse_dictionary(&mut self, key: MonoType) -> Result<MonoType, &'static str> {
+        let val = self.parse_monotype()?;
+        let token = self.next();
+        if token.token_type == TokenType::RIGHTSQUAREBRAC {
+            Ok(MonoType::Dict(Box::new(Dictionary { key, val })))
+        } else {
+            Err("Dictionary monotype must have right square bracket")
+        }
+    }
+
     // parse_function parses a single function monotype
     fn parse_function(&mut self, token: &Token) -> Result<MonoType, &'static str> {
         if token.token_type != TokenType::LEFTPAREN {
@@ -955,6 +970,26 @@ mod tests {
         assert_eq!(Ok(output), parse(parse_text));
     }
 
+    #[test]
+    fn parse_dictionary_test() {
+        let parse_text = "forall [t0] where t0: Comparable [t0:[string]]";
+
+        let mut bounds = TvarKinds::new();
+        bounds.insert(Tvar(0), vec![Kind::Comparable]);
+
+        let output = PolyType {
+            vars: vec![Tvar(0)],
+            cons: bounds,
+            expr: MonoType::Dict(Box::new(Dictionary {
+                key: MonoType::Var(Tvar(0)),
+                val: MonoType::Arr(Box::new(Array(MonoType::String))),
+            })),
+        };
+        assert_eq!(Ok(output), parse(parse_text));
+
+        assert!(parse("forall [] [string:int").is_err());
+    }
+
     #[test]
     fn parse_function_test() {
         let parse_text =
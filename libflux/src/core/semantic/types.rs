@@ -255,6 +255,7 @@ pub enum MonoType {
     Bytes,
     Var(Tvar),
     Arr(Box<Array>),
+    Dict(Box<Dictionary>),
     Row(Box<Row>),
     Fun(Box<Function>),
 }
@@ -277,6 +278,7 @@ impl fmt::Display for MonoType {
             MonoType::Bytes => f.write_str("bytes"),
             MonoType::Var(var) => var.fmt(f),
             MonoType::Arr(arr) => arr.fmt(f),
+            MonoType::Dict(dict) => dict.fmt(f),
             MonoType::Row(obj) => obj.fmt(f),
             MonoType::Fun(fun) => fun.fmt(f),
         }
@@ -297,6 +299,7 @@ impl Substitutable for MonoType {
             | MonoType::Bytes => self,
             MonoType::Var(tvr) => sub.apply(tvr),
             MonoType::Arr(arr) => MonoType::Arr(Box::new(arr.apply(sub))),
+            MonoType::Dict(dict) => MonoType::Dict(Box::new(dict.apply(sub))),
             MonoType::Row(obj) => MonoType::Row(Box::new(obj.apply(sub))),
             MonoType::Fun(fun) => MonoType::Fun(Box::new(fun.apply(sub))),
         }
@@ -314,6 +317,7 @@ impl Substitutable for MonoType {
             | MonoType::Bytes => Vec::new(),
             MonoType::Var(tvr) => vec![*tvr],
             MonoType::Arr(arr) => arr.free_vars(),
+            MonoType::Dict(dict) => dict.free_vars(),
             MonoType::Row(obj) => obj.free_vars(),
             MonoType::Fun(fun) => fun.free_vars(),
         }
@@ -334,6 +338,7 @@ impl MaxTvar for MonoType {
             | MonoType::Bytes => Tvar(0),
             MonoType::Var(tvr) => tvr.max_tvar(),
             MonoType::Arr(arr) => arr.max_tvar(),
+            MonoType::Dict(dict) => dict.max_tvar(),
             MonoType::Row(obj) => obj.max_tvar(),
             MonoType::Fun(fun) => fun.max_tvar(),
         }
@@ -367,6 +372,7 @@ impl MonoType {
             (MonoType::Var(tv), t) => tv.unify(t, cons),
             (t, MonoType::Var(tv)) => tv.unify(t, cons),
             (MonoType::Arr(t), MonoType::Arr(s)) => t.unify(*s, cons, f),
+            (MonoType::Dict(t), MonoType::Dict(s)) => t.unify(*s, cons, f),
             (MonoType::Row(t), MonoType::Row(s)) => t.unify(*s, cons, f),
             (MonoType::Fun(t), MonoType::Fun(s)) => t.unify(*s, cons, f),
             (exp, act) => Err(Error::CannotUnify { exp, act }),
@@ -470,6 +476,7 @@ impl MonoType {
                 Ok(Substitution::empty())
             }
             MonoType::Arr(arr) => arr.constrain(with, cons),
+            MonoType::Dict(dict) => dict.constrain(with, cons),
             MonoType::Row(obj) => obj.constrain(with, cons),
             MonoType::Fun(fun) => fun.constrain(with, cons),
         }
@@ -488,6 +495,7 @@ impl MonoType {
             | MonoType::Bytes => false,
             MonoType::Var(tvr) => tv == *tvr,
             MonoType::Arr(arr) => arr.contains(tv),
+            MonoType::Dict(dict) => dict.contains(tv),
             MonoType::Row(row) => row.contains(tv),
             MonoType::Fun(fun) => fun.contains(tv),
         }
@@ -640,6 +648,62 @@ impl Array {
     }
 }
 
+// Dictionary is a key-value data structure where all
+// keys share a single type and all values share a single type.
+#[derive(Debug, Clone, PartialEq, Serialize)]
+pub struct Dictionary {
+    pub key: MonoType,
+    pub val: MonoType,
+}
+
+impl fmt::Display for Dictionary {
+    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result {
+        write!(f, "[{}:{}]", self.key, self.val)
+    }
+}
+
+impl Substitutable for Dictionary {
+    fn apply(self, sub: &Substitution) -> Self {
+        Dictionary {
+            key: self.key.apply(sub),
+            val: self.val.apply(sub),
+        }
+    }
+    fn free_vars(&self) -> Vec<Tvar> {
+        union(self.key.free_vars(), self.val.free_vars())
+    }
+}
+
+impl MaxTvar for Dictionary {
+    fn max_tvar(&self) -> Tvar {
+        vec![self.key.max_tvar(), self.val.max_tvar()].max_tvar()
+    }
+}
+
+impl Dictionary {
+    // self represents the expected type.
+    fn unify(
+        self,
+        actual: Self,
+        cons: &mut TvarKinds,
+        f: &mut Fresher,
+    ) -> Result<Substitution, Error> {
+        let sub = self.key.unify(actual.key, cons, f)?;
+        apply_then_unify(self.val, actual.val, sub, cons, f)
+    }
+
+    fn constrain(self, with: Kind, _: &mut TvarKinds) -> Result<Substitution, Error> {
+        Err(Error::CannotConstrain {
+            act: MonoType::Dict(Box::new(self)),
+            exp: with,
+        })
+    }
+
+    fn contains(&self, tv: Tvar) -> bool {
+        self.key.contains(tv) || self.val.contains(tv)
+    }
+}
+
 // Row is an extensible record type.
 //
 // A row is either Empty meaning it has no properties,
@@ -1345,6 +1409,17 @@ mod tests {
         );
     }
     #[test]
+    fn display_type_dictionary() {
+        assert_eq!(
+            "[string:int]",
+            MonoType::Dict(Box::new(Dictionary {
+                key: MonoType::String,
+                val: MonoType::Int,
+            }))
+            .to_string()
+        );
+    }
+    #[test]
     fn display_type_row() {
         assert_eq!(
             "{a:int | b:string | t0}",
@@ -1863,6 +1938,33 @@ mod tests {
         assert_eq!(sub, Substitution::empty());
     }
     #[test]
+    fn unify_dictionaries() {
+        let sub = MonoType::Dict(Box::new(Dictionary {
+            key: MonoType::String,
+            val: MonoType::Var(Tvar(0)),
+        }))
+        .unify(
+            MonoType::Dict(Box::new(Dictionary {
+                key: MonoType::Var(Tvar(1)),
+                val: MonoType::Int,
+            })),
+            &mut TvarKinds::new(),
+            &mut Fresher::default(),
+        )
+        .unwrap();
+        assert_eq!(
+            MonoType::Dict(Box::new(Dictionary {
+                key: MonoType::Var(Tvar(1)),
+                val: MonoType::Var(Tvar(0)),
+            }))
+            .apply(&sub),
+            MonoType::Dict(Box::new(Dictionary {
+                key: MonoType::String,
+                val: MonoType::Int,
+            })),
+        );
+    }
+    #[test]
     fn constrain_ints() {
         let allowable_cons = vec![
             Kind::Addable,
@@ -201,6 +201,38 @@ pub unsafe extern "C" fn flux_ast_marshal_fb(
     None
 }
 
+/// flux_ast_format formats the files of the given AST package as Flux source
+/// and populates the supplied buffer with the result. Comments attached to
+/// the AST are preserved.
+///
+/// # Safety
+///
+/// This function is unsafe because it dereferences raw pointers passed
+/// in as parameters. For example, if that pointer is NULL, undefined behavior
+/// could occur.
+#[no_mangle]
+pub unsafe extern "C" fn flux_ast_format(
+    ast_pkg: *const ast::Package,
+    buf: *mut flux_buffer_t,
+) -> Option<Box<ErrorHandle>> {
+    let ast_pkg = &*ast_pkg;
+    let mut src = String::new();
+    for file in &ast_pkg.files {
+        match formatter::convert_to_string(file) {
+            Ok(s) => src.push_str(&s),
+            Err(err) => {
+                let errh = ErrorHandle { err: err.into() };
+                return Some(Box::new(errh));
+            }
+        }
+    }
+
+    let data = src.into_bytes();
+    (*buf).len = data.len();
+    (*buf).data = Box::into_raw(data.into_boxed_slice()) as *mut u8;
+    None
+}
+
 /// Frees a semantic package.
 #[no_mangle]
 pub extern "C" fn flux_free_semantic_pkg(_: Option<Box<semantic::nodes::Package>>) {}
@@ -634,6 +666,10 @@ mod tests {
                 MonoType::Arr(arr) => {
                     self.normalize(&mut arr.as_mut().0);
                 }
+                MonoType::Dict(dict) => {
+                    self.normalize(&mut dict.key);
+                    self.normalize(&mut dict.val);
+                }
                 MonoType::Row(r) => {
                     if let Row::Extension { head, tail } = r.as_mut() {
                         self.normalize(&mut head.v);
@@ -18,6 +18,8 @@ import (
 // The `_time` column contains the timestamps for when each `_value` has been read.
 // Strings in `_value` are obtained from the io.Reader passed to the Decode function.
 // ResultDecoder outputs one table once the reader reaches EOF.
+// A streaming ResultDecoder outputs a table each time it has consumed
+// all of the input that is currently available instead.
 type ResultDecoder struct {
 	reader *bufio.Reader
 	config *ResultDecoderConfig
@@ -37,24 +39,18 @@ type TimeProvider interface {
 type ResultDecoderConfig struct {
 	Separator    byte
 	TimeProvider TimeProvider
+	// Streaming outputs the rows as they are read
+	// rather than once the reader reaches EOF.
+	Streaming bool
 }
 
 func (rd *ResultDecoder) Do(f func(flux.Table) error) error {
-	timeCol := flux.ColMeta{Label: "_time", Type: flux.TTime}
-	valueCol := flux.ColMeta{Label: "_value", Type: flux.TString}
-	key := execute.NewGroupKey(nil, nil)
-	builder := execute.NewColListTableBuilder(key, &memory.Allocator{})
-	timeIdx, err := builder.AddCol(timeCol)
-	if err != nil {
-		return err
-	}
-	valueIdx, err := builder.AddCol(valueCol)
+	builder, err := newTableBuilder()
 	if err != nil {
 		return err
 	}
 
-	var eof bool
-	for !eof {
+	for {
 		s, err := rd.reader.ReadString(rd.config.Separator)
 		if err != nil && err == io.EOF {
 			break
@@ -72,8 +68,26 @@ func (rd *ResultDecoder) Do(f func(flux.Table) error) error {
 		if err != nil {
 			return err
 		}
+
+		// Output what has been read so far when the reader
+		// has no more data buffered and the next read would block.
+		if rd.config.Streaming && rd.reader.Buffered() == 0 {
+			tbl, err := builder.Table()
+			if err != nil {
+				return err
+			}
+			if err := f(tbl); err != nil {
+				return err
+			}
+			if builder, err = newTableBuilder(); err != nil {
+				return err
+			}
+		}
 	}
 
+	if rd.config.Streaming && builder.NRows() == 0 {
+		return nil
+	}
 	tbl, err := builder.Table()
 	if err != nil {
 		return err
@@ -81,6 +95,24 @@ func (rd *ResultDecoder) Do(f func(flux.Table) error) error {
 	return f(tbl)
 }
 
+const (
+	timeIdx = iota
+	valueIdx
+)
+
+// newTableBuilder creates a builder with the `_time` and `_value` columns.
+func newTableBuilder() (*execute.ColListTableBuilder, error) {
+	key := execute.NewGroupKey(nil, nil)
+	builder := execute.NewColListTableBuilder(key, &memory.Allocator{})
+	if _, err := builder.AddCol(flux.ColMeta{Label: "_time", Type: flux.TTime}); err != nil {
+		return nil, err
+	}
+	if _, err := builder.AddCol(flux.ColMeta{Label: "_value", Type: flux.TString}); err != nil {
+		return nil, err
+	}
+	return builder, nil
+}
+
 func (*ResultDecoder) Name() string {
 	return "_result"
 }
@@ -2,6 +2,7 @@ package line_test
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -121,3 +122,66 @@ empty line above
 		})
 	}
 }
+
+func TestResultDecoder_Streaming(t *testing.T) {
+	pr, pw := io.Pipe()
+	decoder := line.NewResultDecoder(&line.ResultDecoderConfig{
+		Separator:    '\n',
+		TimeProvider: &mock.AscendingTimeProvider{},
+		Streaming:    true,
+	})
+	r, err := decoder.Decode(pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each write is decoded into its own table before the next one is made.
+	writes := []string{"a\nb\n", "c\n", "d\n"}
+	go func() {
+		for _, w := range writes {
+			if _, err := pw.Write([]byte(w)); err != nil {
+				return
+			}
+		}
+		_ = pw.Close()
+	}()
+
+	var got []*executetest.Table
+	if err := r.Tables().Do(func(table flux.Table) error {
+		ct, err := executetest.ConvertTable(table)
+		if err != nil {
+			return err
+		}
+		got = append(got, ct)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TString},
+	}
+	want := []*executetest.Table{
+		{
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(0), "a"},
+				{execute.Time(1), "b"},
+			},
+		},
+		{
+			ColMeta: cols,
+			Data:    [][]interface{}{{execute.Time(2), "c"}},
+		},
+		{
+			ColMeta: cols,
+			Data:    [][]interface{}{{execute.Time(3), "d"}},
+		},
+	}
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,285 @@
+package lsp
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+)
+
+// document is an open document along with the result of its analysis.
+type document struct {
+	uri     string
+	version int
+	text    string
+	lines   []string
+
+	file *ast.File
+	// sem is the analyzed package. It is nil when the document has
+	// syntax errors or when it could not be analyzed.
+	sem    *semantic.Package
+	semErr error
+}
+
+func newDocument(uri string, version int, text string, analyze func(string) (*semantic.Package, error)) *document {
+	d := &document{
+		uri:     uri,
+		version: version,
+		text:    text,
+		lines:   splitLines(text),
+	}
+	pkg := parser.ParseSource(text)
+	if len(pkg.Files) > 0 {
+		d.file = pkg.Files[0]
+	}
+	if ast.Check(pkg) == 0 && analyze != nil {
+		d.sem, d.semErr = analyze(text)
+	}
+	return d
+}
+
+// errorLocation matches the location of a node in the errors
+// reported by the analyzer, e.g. "error @2:5-2:8: undefined identifier x".
+var errorLocation = regexp.MustCompile(`@(\d+):(\d+)-(\d+):(\d+)`)
+
+// diagnostics returns the syntax errors of the document or,
+// if there are none, the error reported by the analyzer.
+func (d *document) diagnostics() []Diagnostic {
+	diags := []Diagnostic{}
+	if d.file != nil {
+		ast.Walk(ast.CreateVisitor(func(n ast.Node) {
+			for _, err := range n.Errs() {
+				diags = append(diags, Diagnostic{
+					Range:    toRange(d.lines, n.Location()),
+					Severity: DiagnosticSeverityError,
+					Source:   "flux",
+					Message:  err.Msg,
+				})
+			}
+		}), d.file)
+	}
+	if len(diags) > 0 || d.semErr == nil {
+		return diags
+	}
+
+	msg := d.semErr.Error()
+	var loc ast.SourceLocation
+	if m := errorLocation.FindStringSubmatch(msg); m != nil {
+		loc.Start.Line, _ = strconv.Atoi(m[1])
+		loc.Start.Column, _ = strconv.Atoi(m[2])
+		loc.End.Line, _ = strconv.Atoi(m[3])
+		loc.End.Column, _ = strconv.Atoi(m[4])
+	} else {
+		// Without a location the error is reported on the first line.
+		loc.Start = ast.Position{Line: 1, Column: 1}
+		loc.End = ast.Position{Line: 1, Column: len(d.lines[0]) + 1}
+	}
+	return append(diags, Diagnostic{
+		Range:    toRange(d.lines, loc),
+		Severity: DiagnosticSeverityError,
+		Source:   "flux",
+		Message:  msg,
+	})
+}
+
+// imports returns the import paths of the document by the name
+// that they are referenced with.
+func (d *document) imports() map[string]string {
+	imports := make(map[string]string)
+	if d.file == nil {
+		return imports
+	}
+	for _, imp := range d.file.Imports {
+		if imp.Path == nil {
+			continue
+		}
+		imports[importName(imp)] = imp.Path.Value
+	}
+	return imports
+}
+
+// importName returns the name that the import is referenced with.
+func importName(imp *ast.ImportDeclaration) string {
+	if imp.As != nil {
+		return imp.As.Name
+	}
+	path := imp.Path.Value
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// pathTo returns the nodes that contain the position
+// from the file down to the innermost node.
+func (d *document) pathTo(pos ast.Position) []ast.Node {
+	if d.file == nil {
+		return nil
+	}
+	var path []ast.Node
+	ast.Walk(&pathVisitor{pos: pos, path: &path}, d.file)
+	return path
+}
+
+type pathVisitor struct {
+	pos  ast.Position
+	path *[]ast.Node
+}
+
+func (v *pathVisitor) Visit(n ast.Node) ast.Visitor {
+	loc := n.Location()
+	// Nodes without a location may still contain nodes that have one.
+	if loc.Start.Line == 0 {
+		return v
+	}
+	if !contains(loc, v.pos) {
+		return nil
+	}
+	*v.path = append(*v.path, n)
+	return v
+}
+
+func (v *pathVisitor) Done(n ast.Node) {}
+
+// reference is an identifier in the document that refers to a value.
+type reference struct {
+	ident *ast.Identifier
+	// pkg is the name of the package when the identifier
+	// is the property of a member expression on an import.
+	pkg string
+}
+
+// referenceAt returns the reference at the position.
+// The path is the result of pathTo for the position.
+func (d *document) referenceAt(path []ast.Node) (reference, bool) {
+	if len(path) < 2 {
+		return reference{}, false
+	}
+	ident, ok := path[len(path)-1].(*ast.Identifier)
+	if !ok {
+		return reference{}, false
+	}
+	switch parent := path[len(path)-2].(type) {
+	case *ast.MemberExpression:
+		if parent.Property != ast.PropertyKey(ident) {
+			return reference{ident: ident}, true
+		}
+		// Only the members of an import are known.
+		obj, ok := parent.Object.(*ast.Identifier)
+		if !ok {
+			return reference{}, false
+		}
+		if _, ok := d.imports()[obj.Name]; !ok {
+			return reference{}, false
+		}
+		return reference{ident: ident, pkg: obj.Name}, true
+	case *ast.Property:
+		// The key of a property refers to a value only
+		// when it is the parameter of a function.
+		if parent.Key == ast.PropertyKey(ident) {
+			if len(path) < 3 {
+				return reference{}, false
+			}
+			if _, ok := path[len(path)-3].(*ast.FunctionExpression); !ok {
+				return reference{}, false
+			}
+		}
+	}
+	return reference{ident: ident}, true
+}
+
+// definition returns the identifier that defines the name
+// within the scope of the innermost node of the path.
+// It returns nil if the name is not defined by the document.
+func (d *document) definition(path []ast.Node, name string) ast.Node {
+	for i := len(path) - 1; i >= 0; i-- {
+		switch n := path[i].(type) {
+		case *ast.FunctionExpression:
+			for _, p := range n.Params {
+				if key, ok := p.Key.(*ast.Identifier); ok && key.Name == name {
+					return key
+				}
+			}
+		case *ast.Block:
+			if def := findAssignment(n.Body, name); def != nil {
+				return def
+			}
+		case *ast.File:
+			if def := findAssignment(n.Body, name); def != nil {
+				return def
+			}
+			for _, imp := range n.Imports {
+				if imp.Path != nil && importName(imp) == name {
+					return imp
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findAssignment returns the identifier of the variable or option
+// assigned to the name in the statements.
+func findAssignment(body []ast.Statement, name string) *ast.Identifier {
+	for _, s := range body {
+		var a ast.Assignment
+		switch s := s.(type) {
+		case *ast.VariableAssignment:
+			a = s
+		case *ast.OptionStatement:
+			a = s.Assignment
+		}
+		if va, ok := a.(*ast.VariableAssignment); ok && va.ID != nil && va.ID.Name == name {
+			return va.ID
+		}
+	}
+	return nil
+}
+
+// definitions returns the names assigned at the top level of the document.
+func (d *document) definitions() []string {
+	if d.file == nil {
+		return nil
+	}
+	var names []string
+	for _, s := range d.file.Body {
+		var a ast.Assignment
+		switch s := s.(type) {
+		case *ast.VariableAssignment:
+			a = s
+		case *ast.OptionStatement:
+			a = s.Assignment
+		}
+		if va, ok := a.(*ast.VariableAssignment); ok && va.ID != nil {
+			names = append(names, va.ID.Name)
+		}
+	}
+	return names
+}
+
+// typeOf returns the type of the identifier at the location as
+// inferred by the analyzer. The polytype of an assignment is preferred
+// over the monotype of an identifier expression.
+func (d *document) typeOf(loc ast.SourceLocation) (string, bool) {
+	if d.sem == nil {
+		return "", false
+	}
+	var typ string
+	semantic.Walk(semantic.CreateVisitor(func(n semantic.Node) {
+		switch n := n.(type) {
+		case *semantic.NativeVariableAssignment:
+			if n.Identifier != nil && sameStart(n.Identifier.Location(), loc) {
+				typ = n.Typ.CanonicalString()
+			}
+		case *semantic.IdentifierExpression:
+			if typ == "" && sameStart(n.Location(), loc) {
+				typ = n.TypeOf().CanonicalString()
+			}
+		}
+	}), d.sem)
+	return typ, typ != ""
+}
+
+func sameStart(a, b ast.SourceLocation) bool {
+	return a.Start == b.Start
+}
@@ -0,0 +1,100 @@
+package lsp
+
+import (
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+)
+
+// The Flux parser reports one based lines and columns where the column
+// is a byte offset, while the protocol uses zero based lines and
+// characters counted in UTF-16 code units. The functions below convert
+// between the two using the lines of the document.
+
+// splitLines splits the text into lines without their line endings.
+func splitLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimSuffix(l, "\r")
+	}
+	return lines
+}
+
+// toPosition converts a position reported by the parser.
+func toPosition(lines []string, pos ast.Position) Position {
+	line := pos.Line - 1
+	if line < 0 {
+		return Position{}
+	}
+	if line >= len(lines) {
+		return endPosition(lines)
+	}
+	l := lines[line]
+	n := pos.Column - 1
+	if n > len(l) {
+		n = len(l)
+	} else if n < 0 {
+		n = 0
+	}
+	return Position{Line: line, Character: utf16Len(l[:n])}
+}
+
+// toRange converts a source location reported by the parser.
+func toRange(lines []string, loc ast.SourceLocation) Range {
+	return Range{
+		Start: toPosition(lines, loc.Start),
+		End:   toPosition(lines, loc.End),
+	}
+}
+
+// fromPosition converts a position sent by the client.
+func fromPosition(lines []string, pos Position) ast.Position {
+	if pos.Line >= len(lines) {
+		return ast.Position{Line: pos.Line + 1, Column: 1}
+	}
+	l, n := lines[pos.Line], 0
+	for i, r := range l {
+		if n >= pos.Character {
+			return ast.Position{Line: pos.Line + 1, Column: i + 1}
+		}
+		n += runeLen(r)
+	}
+	return ast.Position{Line: pos.Line + 1, Column: len(l) + 1}
+}
+
+// endPosition returns the position after the last character of the document.
+func endPosition(lines []string) Position {
+	if len(lines) == 0 {
+		return Position{}
+	}
+	last := len(lines) - 1
+	return Position{Line: last, Character: utf16Len(lines[last])}
+}
+
+func utf16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += runeLen(r)
+	}
+	return n
+}
+
+// runeLen returns the number of UTF-16 code units of the rune.
+func runeLen(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// contains reports whether the position is within the location.
+// A position right after the end of the location is contained as well
+// so that the identifier that is being typed can be found.
+func contains(loc ast.SourceLocation, pos ast.Position) bool {
+	return !before(pos, loc.Start) && !before(loc.End, pos)
+}
+
+// before reports whether a comes before b.
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
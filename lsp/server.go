@@ -0,0 +1,404 @@
+// Package lsp implements a Language Server Protocol server for Flux.
+//
+// The server communicates with an editor over a pair of streams,
+// typically the standard input and output of the process.
+// It offers diagnostics, completion, hover, go-to-definition and formatting
+// for the documents that the editor opens.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/complete"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/parser"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+// Config configures the server.
+type Config struct {
+	// Scope contains the values that are available to every document.
+	// This is usually the prelude.
+	Scope values.Scope
+	// Importer is used to look up the members of imported packages.
+	Importer interpreter.Importer
+	// Packages are the import paths that are offered for completion.
+	Packages []string
+	// Analyze type checks a document and returns its semantic graph.
+	// Documents are only checked for syntax errors when it is nil.
+	Analyze func(src string) (*semantic.Package, error)
+}
+
+// Server is a language server for Flux.
+type Server struct {
+	c    Config
+	conn *conn
+	docs map[string]*document
+
+	shutdown bool
+}
+
+// New creates a new Server.
+func New(c Config) *Server {
+	if c.Scope == nil {
+		c.Scope = values.NewScope()
+	}
+	return &Server{
+		c:    c,
+		docs: make(map[string]*document),
+	}
+}
+
+// errExit is returned by a handler when the client asked the server to exit.
+var errExit = fmt.Errorf("exit")
+
+// Serve reads requests from r and writes the responses to w.
+// It returns once the client sends the exit notification,
+// r is closed or the context is cancelled.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			if err := s.conn.reply(json.RawMessage("null"), nil, &rpcError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		result, err := s.handle(&req)
+		if err == errExit {
+			return nil
+		}
+		if req.isNotification() {
+			continue
+		}
+		if err := s.conn.reply(req.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(req *request) (interface{}, error) {
+	if s.shutdown && req.Method != "exit" {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+	switch req.Method {
+	case "initialize":
+		return s.initialize()
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "exit":
+		return nil, errExit
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		item := params.TextDocument
+		return nil, s.update(item.URI, item.Version, item.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// The document is synchronized in full so the
+		// last change contains the whole document.
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return nil, s.update(params.TextDocument.URI, params.TextDocument.Version, text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		d, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.completion(d, params.Position), nil
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		d, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.hover(d, params.Position), nil
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		d, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.definition(d, params.Position), nil
+	case "textDocument/formatting":
+		var params DocumentFormattingParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+		d, err := s.document(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+		return s.formatting(d)
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not supported: %s", req.Method)}
+	}
+}
+
+func unmarshalParams(req *request, v interface{}) error {
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) initialize() (interface{}, error) {
+	return InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncFull,
+			CompletionProvider: &CompletionOptions{
+				TriggerCharacters: []string{".", `"`},
+			},
+			HoverProvider:              true,
+			DefinitionProvider:         true,
+			DocumentFormattingProvider: true,
+		},
+		ServerInfo: &ServerInfo{Name: "flux"},
+	}, nil
+}
+
+func (s *Server) document(uri string) (*document, error) {
+	d, ok := s.docs[uri]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("document is not open: %s", uri)}
+	}
+	return d, nil
+}
+
+// update analyzes the new text of the document and publishes its diagnostics.
+func (s *Server) update(uri string, version int, text string) error {
+	d := newDocument(uri, version, text, s.c.Analyze)
+	s.docs[uri] = d
+	return s.conn.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Version:     version,
+		Diagnostics: d.diagnostics(),
+	})
+}
+
+var (
+	// importPrefix matches an import path that is being typed.
+	importPrefix = regexp.MustCompile(`^\s*import\s+(?:\w+\s+)?"[^"]*$`)
+	// memberPrefix matches a member of a package that is being typed.
+	memberPrefix = regexp.MustCompile(`(\w+)\.\w*$`)
+)
+
+// completion returns the import paths within an import declaration,
+// the members of a package after the name of an import or else the
+// values in scope along with the values defined by the document.
+func (s *Server) completion(d *document, pos Position) CompletionList {
+	items := []CompletionItem{}
+	var prefix string
+	if pos.Line < len(d.lines) {
+		line := d.lines[pos.Line]
+		prefix = line[:fromPosition(d.lines, pos).Column-1]
+	}
+
+	if importPrefix.MatchString(prefix) {
+		for _, path := range s.c.Packages {
+			items = append(items, CompletionItem{
+				Label: path,
+				Kind:  CompletionItemKindModule,
+			})
+		}
+		return CompletionList{Items: items}
+	}
+
+	if m := memberPrefix.FindStringSubmatch(prefix); m != nil {
+		if path, ok := d.imports()[m[1]]; ok {
+			if pkg, err := s.importPackage(path); err == nil {
+				pkg.Range(func(name string, v values.Value) {
+					items = append(items, completionItem(name, v))
+				})
+			}
+			sortItems(items)
+			return CompletionList{Items: items}
+		}
+	}
+
+	c := complete.NewCompleter(s.c.Scope)
+	for _, name := range c.Names() {
+		v, _ := c.Value(name)
+		items = append(items, completionItem(name, v))
+	}
+	for name := range d.imports() {
+		items = append(items, CompletionItem{
+			Label: name,
+			Kind:  CompletionItemKindModule,
+		})
+	}
+	for _, name := range d.definitions() {
+		items = append(items, CompletionItem{
+			Label: name,
+			Kind:  CompletionItemKindVariable,
+		})
+	}
+	sortItems(items)
+	return CompletionList{Items: items}
+}
+
+func completionItem(name string, v values.Value) CompletionItem {
+	item := CompletionItem{
+		Label:  name,
+		Kind:   CompletionItemKindVariable,
+		Detail: v.Type().CanonicalString(),
+	}
+	if v.Type().Nature() == semantic.Function {
+		item.Kind = CompletionItemKindFunction
+	}
+	return item
+}
+
+func sortItems(items []CompletionItem) {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Label < items[j].Label
+	})
+}
+
+func (s *Server) importPackage(path string) (*interpreter.Package, error) {
+	if s.c.Importer == nil {
+		return nil, fmt.Errorf("cannot import package %q", path)
+	}
+	return s.c.Importer.ImportPackageObject(path)
+}
+
+// hover returns the signature of the value at the position.
+// It returns nil if the type of the value is unknown.
+func (s *Server) hover(d *document, pos Position) *Hover {
+	ref, ok := d.referenceAt(d.pathTo(fromPosition(d.lines, pos)))
+	if !ok {
+		return nil
+	}
+	name := ref.ident.Name
+
+	var typ string
+	if ref.pkg != "" {
+		pkg, err := s.importPackage(d.imports()[ref.pkg])
+		if err != nil {
+			return nil
+		}
+		v, ok := pkg.Get(name)
+		if !ok {
+			return nil
+		}
+		name = ref.pkg + "." + name
+		typ = v.Type().CanonicalString()
+	} else if t, ok := d.typeOf(s.definitionLocation(d, ref)); ok {
+		typ = t
+	} else if t, ok := d.typeOf(ref.ident.Location()); ok {
+		typ = t
+	} else if v, ok := s.c.Scope.Lookup(name); ok {
+		typ = v.Type().CanonicalString()
+	} else {
+		return nil
+	}
+
+	r := toRange(d.lines, ref.ident.Location())
+	return &Hover{
+		Contents: MarkupContent{
+			Kind:  MarkupKindMarkdown,
+			Value: "```flux\n" + name + ": " + typ + "\n```",
+		},
+		Range: &r,
+	}
+}
+
+// definitionLocation returns the location of the identifier that
+// defines the reference or the location of the reference itself
+// when the document does not define it.
+func (s *Server) definitionLocation(d *document, ref reference) ast.SourceLocation {
+	path := d.pathTo(ref.ident.Location().Start)
+	if def := d.definition(path, ref.ident.Name); def != nil {
+		return def.Location()
+	}
+	return ref.ident.Location()
+}
+
+// definition returns the location where the identifier at the position
+// is defined. It returns nil if the identifier is not defined by the document.
+func (s *Server) definition(d *document, pos Position) *Location {
+	path := d.pathTo(fromPosition(d.lines, pos))
+	ref, ok := d.referenceAt(path)
+	if !ok || ref.pkg != "" {
+		return nil
+	}
+	def := d.definition(path, ref.ident.Name)
+	if def == nil {
+		return nil
+	}
+	return &Location{
+		URI:   d.uri,
+		Range: toRange(d.lines, def.Location()),
+	}
+}
+
+// formatting returns the edit that replaces the document with its formatted source.
+func (s *Server) formatting(d *document) ([]TextEdit, error) {
+	out, err := parser.FormatSource([]byte(d.text))
+	if err != nil {
+		return nil, &rpcError{Code: codeRequestFailed, Message: err.Error()}
+	}
+	formatted := string(out)
+	if !strings.HasSuffix(formatted, "\n") {
+		formatted += "\n"
+	}
+	if formatted == d.text {
+		return []TextEdit{}, nil
+	}
+	return []TextEdit{{
+		Range: Range{
+			Start: Position{},
+			End:   endPosition(d.lines),
+		},
+		NewText: formatted,
+	}}, nil
+}
@@ -0,0 +1,443 @@
+package lsp_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/lsp"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const uri = "file:///query.flux"
+
+var (
+	fromType = semantic.NewFunctionType(semantic.NewArrayType(semantic.BasicInt), []semantic.ArgumentType{
+		{Name: []byte("bucket"), Type: semantic.BasicString},
+	})
+	toUpperType = semantic.NewFunctionType(semantic.BasicString, []semantic.ArgumentType{
+		{Name: []byte("v"), Type: semantic.BasicString},
+	})
+)
+
+type importer map[string]*interpreter.Package
+
+func (imp importer) ImportPackageObject(path string) (*interpreter.Package, error) {
+	pkg, ok := imp[path]
+	if !ok {
+		return nil, fmt.Errorf("invalid import path %s", path)
+	}
+	return pkg, nil
+}
+
+// analyze reports an undefined identifier for every z in the source.
+func analyze(src string) (*semantic.Package, error) {
+	for i, l := range strings.Split(src, "\n") {
+		if j := strings.Index(l, "z"); j >= 0 {
+			return nil, fmt.Errorf("error @%d:%d-%d:%d: undefined identifier z", i+1, j+1, i+1, j+2)
+		}
+	}
+	return nil, nil
+}
+
+// client sends requests to a server that runs in its own goroutine.
+type client struct {
+	t  *testing.T
+	w  io.WriteCloser
+	r  *textproto.Reader
+	id int
+
+	done chan error
+}
+
+func newClient(t *testing.T) *client {
+	t.Helper()
+	scope := values.NewScope()
+	scope.Set("from", values.NewFunction("from", fromType, nil, false))
+	scope.Set("pi", values.NewFloat(3.14))
+	strs := interpreter.NewPackageWithValues("strings", "strings", values.NewObjectWithValues(map[string]values.Value{
+		"toUpper": values.NewFunction("toUpper", toUpperType, nil, false),
+	}))
+	s := lsp.New(lsp.Config{
+		Scope:    scope,
+		Importer: importer{"strings": strs},
+		Packages: []string{"csv", "strings"},
+		Analyze:  analyze,
+	})
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	c := &client{
+		t:    t,
+		w:    inW,
+		r:    textproto.NewReader(bufio.NewReader(outR)),
+		done: make(chan error, 1),
+	}
+	go func() {
+		c.done <- s.Serve(context.Background(), inR, outW)
+		_ = outW.Close()
+	}()
+	return c
+}
+
+// close closes the input of the server.
+func (c *client) close() {
+	_ = c.w.Close()
+}
+
+func (c *client) send(v interface{}) {
+	c.t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if _, err := fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n%s", len(data), data); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// message is a message sent by the server.
+type message struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *client) read() message {
+	c.t.Helper()
+	header, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	n, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(c.r.R, data); err != nil {
+		c.t.Fatal(err)
+	}
+	var m message
+	if err := json.Unmarshal(data, &m); err != nil {
+		c.t.Fatal(err)
+	}
+	return m
+}
+
+// request sends a request and decodes the result of the response into result.
+func (c *client) request(method string, params, result interface{}) error {
+	c.t.Helper()
+	c.id++
+	c.send(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      c.id,
+		"method":  method,
+		"params":  params,
+	})
+	m := c.read()
+	if m.ID == nil || *m.ID != c.id {
+		c.t.Fatalf("expected the response to request %d, got %+v", c.id, m)
+	}
+	if m.Error != nil {
+		return errors.New(m.Error.Message)
+	}
+	if result != nil {
+		if err := json.Unmarshal(m.Result, result); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+	return nil
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.t.Helper()
+	c.send(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+}
+
+// open opens a document and returns the diagnostics that were published for it.
+func (c *client) open(text string) lsp.PublishDiagnosticsParams {
+	c.t.Helper()
+	c.notify("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{URI: uri, LanguageID: "flux", Version: 1, Text: text},
+	})
+	return c.diagnostics()
+}
+
+func (c *client) diagnostics() lsp.PublishDiagnosticsParams {
+	c.t.Helper()
+	m := c.read()
+	if m.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatalf("expected diagnostics, got %+v", m)
+	}
+	var params lsp.PublishDiagnosticsParams
+	if err := json.Unmarshal(m.Params, &params); err != nil {
+		c.t.Fatal(err)
+	}
+	return params
+}
+
+func position(line, character int) lsp.TextDocumentPositionParams {
+	return lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
+
+func TestServer_Lifecycle(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+
+	var got lsp.InitializeResult
+	if err := c.request("initialize", map[string]interface{}{}, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Capabilities.HoverProvider || !got.Capabilities.DefinitionProvider ||
+		!got.Capabilities.DocumentFormattingProvider || got.Capabilities.CompletionProvider == nil {
+		t.Errorf("missing capabilities: %+v", got.Capabilities)
+	}
+	c.notify("initialized", map[string]interface{}{})
+
+	if err := c.request("workspace/symbol", map[string]interface{}{}, nil); err == nil {
+		t.Error("expected an error for an unsupported method")
+	}
+	if err := c.request("textDocument/hover", position(0, 0), nil); err == nil {
+		t.Error("expected an error for a document that is not open")
+	}
+
+	if err := c.request("shutdown", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	c.notify("exit", nil)
+	if err := <-c.done; err != nil {
+		t.Fatalf("unexpected error from server: %v", err)
+	}
+}
+
+func TestServer_Diagnostics(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+
+	// A syntax error.
+	if got := c.open("x = (1\n"); len(got.Diagnostics) == 0 {
+		t.Fatalf("expected a syntax error, got %+v", got)
+	} else if got.URI != uri || got.Diagnostics[0].Range.Start.Line != 0 {
+		t.Errorf("unexpected diagnostic: %+v", got)
+	}
+
+	// An error reported by the analyzer.
+	c.notify("textDocument/didChange", lsp.DidChangeTextDocumentParams{
+		TextDocument:   lsp.VersionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []lsp.TextDocumentContentChangeEvent{{Text: "x = 1\ny = z\n"}},
+	})
+	want := lsp.PublishDiagnosticsParams{
+		URI:     uri,
+		Version: 2,
+		Diagnostics: []lsp.Diagnostic{{
+			Range: lsp.Range{
+				Start: lsp.Position{Line: 1, Character: 4},
+				End:   lsp.Position{Line: 1, Character: 5},
+			},
+			Severity: lsp.DiagnosticSeverityError,
+			Source:   "flux",
+			Message:  "error @2:5-2:6: undefined identifier z",
+		}},
+	}
+	if got := c.diagnostics(); !cmp.Equal(want, got) {
+		t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// Fixing the error clears the diagnostics.
+	c.notify("textDocument/didChange", lsp.DidChangeTextDocumentParams{
+		TextDocument:   lsp.VersionedTextDocumentIdentifier{URI: uri, Version: 3},
+		ContentChanges: []lsp.TextDocumentContentChangeEvent{{Text: "x = 1\ny = x\n"}},
+	})
+	if got := c.diagnostics(); len(got.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %+v", got)
+	}
+
+	c.notify("textDocument/didClose", lsp.DidCloseTextDocumentParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+	})
+	if got := c.diagnostics(); len(got.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %+v", got)
+	}
+}
+
+func TestServer_Completion(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open(`import "strings"
+x = 1
+strings.
+import "s
+`)
+
+	labels := func(line, character int) []string {
+		t.Helper()
+		var got lsp.CompletionList
+		if err := c.request("textDocument/completion", position(line, character), &got); err != nil {
+			t.Fatal(err)
+		}
+		var labels []string
+		for _, item := range got.Items {
+			labels = append(labels, item.Label)
+		}
+		return labels
+	}
+
+	// The values in scope along with the imports and definitions.
+	if got, want := labels(1, 0), []string{"from", "pi", "strings", "x"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected completion -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	// The members of an import.
+	if got, want := labels(2, 8), []string{"toUpper"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected completion -want/+got:\n%s", cmp.Diff(want, got))
+	}
+	// The import paths.
+	if got, want := labels(3, 9), []string{"csv", "strings"}; !cmp.Equal(want, got) {
+		t.Errorf("unexpected completion -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestServer_Hover(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open(`import "strings"
+y = from(bucket: "b")
+z = strings.toUpper(v: "a")
+`)
+
+	hover := func(line, character int) *lsp.Hover {
+		t.Helper()
+		var got *lsp.Hover
+		if err := c.request("textDocument/hover", position(line, character), &got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	want := &lsp.Hover{
+		Contents: lsp.MarkupContent{
+			Kind:  lsp.MarkupKindMarkdown,
+			Value: "```flux\nfrom: " + fromType.CanonicalString() + "\n```",
+		},
+		Range: &lsp.Range{
+			Start: lsp.Position{Line: 1, Character: 4},
+			End:   lsp.Position{Line: 1, Character: 8},
+		},
+	}
+	if got := hover(1, 5); !cmp.Equal(want, got) {
+		t.Errorf("unexpected hover -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	want = &lsp.Hover{
+		Contents: lsp.MarkupContent{
+			Kind:  lsp.MarkupKindMarkdown,
+			Value: "```flux\nstrings.toUpper: " + toUpperType.CanonicalString() + "\n```",
+		},
+		Range: &lsp.Range{
+			Start: lsp.Position{Line: 2, Character: 12},
+			End:   lsp.Position{Line: 2, Character: 19},
+		},
+	}
+	if got := hover(2, 14); !cmp.Equal(want, got) {
+		t.Errorf("unexpected hover -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	// The key of an argument is not a value.
+	if got := hover(1, 10); got != nil {
+		t.Errorf("expected no hover, got %+v", got)
+	}
+}
+
+func TestServer_Definition(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open(`x = 1
+f = (x) => x + 1
+y = x + from(bucket: "b")
+`)
+
+	definition := func(line, character int) *lsp.Location {
+		t.Helper()
+		var got *lsp.Location
+		if err := c.request("textDocument/definition", position(line, character), &got); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	for _, tc := range []struct {
+		name      string
+		line, chr int
+		want      *lsp.Location
+	}{
+		{
+			name: "parameter",
+			line: 1, chr: 11,
+			want: &lsp.Location{URI: uri, Range: lsp.Range{
+				Start: lsp.Position{Line: 1, Character: 5},
+				End:   lsp.Position{Line: 1, Character: 6},
+			}},
+		},
+		{
+			name: "variable",
+			line: 2, chr: 4,
+			want: &lsp.Location{URI: uri, Range: lsp.Range{
+				Start: lsp.Position{Line: 0, Character: 0},
+				End:   lsp.Position{Line: 0, Character: 1},
+			}},
+		},
+		{
+			name: "builtin",
+			line: 2, chr: 9,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := definition(tc.line, tc.chr); !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected definition -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestServer_Formatting(t *testing.T) {
+	c := newClient(t)
+	defer c.close()
+	c.open("x=1")
+
+	var got []lsp.TextEdit
+	params := lsp.DocumentFormattingParams{TextDocument: lsp.TextDocumentIdentifier{URI: uri}}
+	if err := c.request("textDocument/formatting", params, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []lsp.TextEdit{{
+		Range: lsp.Range{
+			Start: lsp.Position{Line: 0, Character: 0},
+			End:   lsp.Position{Line: 0, Character: 3},
+		},
+		NewText: "x = 1\n",
+	}}
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected edits -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
@@ -58,7 +58,7 @@ func (a *Allocator) Allocate(size int) []byte {
 	}
 
 	// Account for the size requested.
-	if err := a.count(size); err != nil {
+	if err := a.Account(size); err != nil {
 		panic(err)
 	}
 
@@ -66,7 +66,7 @@ func (a *Allocator) Allocate(size int) []byte {
 	// TODO(jsternberg): It's technically possible for this to allocate
 	// more memory than we requested. How do we deal with that since we
 	// likely want to use that feature?
-	alloc := a.allocator()
+	alloc := a.base()
 	return alloc.Allocate(size)
 }
 
@@ -80,18 +80,32 @@ func (a *Allocator) Reallocate(size int, b []byte) []byte {
 		panic(err)
 	}
 
-	alloc := a.allocator()
+	alloc := a.base()
 	return alloc.Reallocate(size, b)
 }
 
 // Account will manually account for the amount of memory being used.
 // This is typically used for memory that is allocated outside of the
 // Allocator that must be recorded in some way.
+//
+// If the underlying memory allocator is also an *Allocator, the memory
+// is accounted for by that Allocator too and is subject to its limit.
 func (a *Allocator) Account(size int) error {
 	if size == 0 {
 		return nil
 	}
-	return a.count(size)
+	parent, ok := a.Allocator.(*Allocator)
+	if !ok || parent == nil {
+		return a.count(size)
+	}
+	if err := parent.Account(size); err != nil {
+		return err
+	}
+	if err := a.count(size); err != nil {
+		_ = parent.Account(-size)
+		return err
+	}
+	return nil
 }
 
 // Allocated returns the amount of currently allocated memory.
@@ -126,11 +140,11 @@ func (a *Allocator) Free(b []byte) {
 	size := len(b)
 
 	// Release the memory to the allocator first.
-	alloc := a.allocator()
+	alloc := a.base()
 	alloc.Free(b)
 
 	// Release the memory in our accounting.
-	atomic.AddInt64(&a.bytesAllocated, int64(-size))
+	_ = a.Account(-size)
 }
 
 func (a *Allocator) count(size int) error {
@@ -216,6 +230,21 @@ func (a *Allocator) allocator() memory.Allocator {
 	return a.Allocator
 }
 
+// base returns the memory.Allocator that memory is allocated from.
+// An Allocator whose underlying memory allocator is another Allocator
+// only accounts for the memory and allocates it from that Allocator's
+// underlying memory allocator.
+func (a *Allocator) base() memory.Allocator {
+	alloc := a.allocator()
+	for {
+		parent, ok := alloc.(*Allocator)
+		if !ok || parent == nil {
+			return alloc
+		}
+		alloc = parent.allocator()
+	}
+}
+
 // Manager will manage the memory allowed for the Allocator.
 // The Allocator may use the Manager to request additional memory or to
 // give back memory that is currently in use by the Allocator
@@ -249,3 +278,19 @@ type LimitExceededError struct {
 func (a LimitExceededError) Error() string {
 	return fmt.Sprintf("memory allocation limit reached: limit %d bytes, allocated: %d, wanted: %d", a.Limit, a.Allocated, a.Wanted)
 }
+
+// IsLimitExceeded reports whether err is a LimitExceededError
+// or a flux error that wraps one.
+func IsLimitExceeded(err error) bool {
+	for err != nil {
+		switch e := err.(type) {
+		case LimitExceededError:
+			return true
+		case *errors.Error:
+			err = e.Err
+		default:
+			return false
+		}
+	}
+	return false
+}
@@ -182,6 +182,60 @@ func TestAllocator_Limit(t *testing.T) {
 	}
 }
 
+func TestIsLimitExceeded(t *testing.T) {
+	maxLimit := int64(64)
+	allocator := &memory.Allocator{Limit: &maxLimit}
+	err := allocator.Account(128)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !memory.IsLimitExceeded(err) {
+		t.Errorf("expected limit exceeded error, got: %v", err)
+	}
+
+	if memory.IsLimitExceeded(errors.New(codes.ResourceExhausted, "not a memory error")) {
+		t.Error("unexpected limit exceeded error")
+	}
+	if memory.IsLimitExceeded(nil) {
+		t.Error("unexpected limit exceeded error for nil error")
+	}
+}
+
+func TestAllocator_Parent(t *testing.T) {
+	maxLimit := int64(64)
+	parent := &memory.Allocator{Limit: &maxLimit}
+	child := &memory.Allocator{Allocator: parent}
+
+	b := child.Allocate(16)
+	if err := child.Account(32); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, allocator := range []*memory.Allocator{parent, child} {
+		if want, got := int64(48), allocator.Allocated(); want != got {
+			t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+	}
+
+	// The limit of the parent applies to memory accounted by the child.
+	if err := child.Account(32); !memory.IsLimitExceeded(err) {
+		t.Fatalf("expected limit exceeded error, got: %v", err)
+	}
+	if want, got := int64(48), child.Allocated(); want != got {
+		t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+	}
+
+	child.Free(b)
+	_ = child.Account(-32)
+	for _, allocator := range []*memory.Allocator{parent, child} {
+		if want, got := int64(0), allocator.Allocated(); want != got {
+			t.Fatalf("unexpected allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+		if want, got := int64(48), allocator.MaxAllocated(); want != got {
+			t.Fatalf("unexpected max allocated count -want/+got\n\t- %d\n\t+ %d", want, got)
+		}
+	}
+}
+
 func TestAllocator_Free(t *testing.T) {
 	allocator := &memory.Allocator{}
 	if err := allocator.Account(64); err != nil {
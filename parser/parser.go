@@ -97,6 +97,23 @@ func ParseToHandle(src []byte) (*libflux.ASTPkg, error) {
 	return pkg, nil
 }
 
+// FormatSource formats the Flux source code and returns the formatted source.
+// Unlike ast.Format, the comments within the source are preserved.
+// An error is returned if the source cannot be parsed.
+func FormatSource(src []byte) ([]byte, error) {
+	pkg, err := ParseToHandle(src)
+	if err != nil {
+		return nil, err
+	}
+	defer pkg.Free()
+
+	out, err := pkg.Format()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out), nil
+}
+
 func packageName(f *ast.File) string {
 	if f.Package != nil && f.Package.Name != nil {
 		return f.Package.Name.Name
@@ -202,6 +202,22 @@ func TestHandleToJSON(t *testing.T) {
 	}
 }
 
+func TestFormatSource(t *testing.T) {
+	src := "// x is a number.\nx = 1+  2\n// y is also a number.\ny = x*2"
+	got, err := parser.FormatSource([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "// x is a number.\nx = 1 + 2\n// y is also a number.\ny = x * 2"
+	if want, got := want, string(got); want != got {
+		t.Errorf("unexpected formatted source: -want/+got:\n%v", cmp.Diff(want, got))
+	}
+
+	if _, err := parser.FormatSource([]byte("x = 1 + / 3")); err == nil {
+		t.Error("expected error formatting invalid source")
+	}
+}
+
 func TestParseTimeLiteral(t *testing.T) {
 	inputTime := "2018-01-01"
 	got, err := parser.ParseTime(inputTime)
@@ -1,8 +1,17 @@
 package plan
 
+import "math"
+
+// Statistics are the estimated properties of the data that a plan node produces.
+// A zero value means that nothing is known about the data.
 type Statistics struct {
-	Cardinality      int64
+	// Cardinality is the number of rows.
+	Cardinality int64
+	// GroupCardinality is the number of tables.
 	GroupCardinality int64
+	// Columns are the labels of the columns of the tables.
+	// They are nil when the columns are not known.
+	Columns []string
 }
 
 // Cost stores various dimensions of the cost of a query plan
@@ -25,9 +34,117 @@ func Add(a Cost, b Cost) Cost {
 	}
 }
 
+// Total returns the sum of the dimensions of the cost.
+func (c Cost) Total() int64 {
+	return c.Disk + c.CPU + c.GPU + c.MEM + c.NET
+}
+
+// Less reports whether the cost is lower than the other cost.
+func (c Cost) Less(o Cost) bool {
+	return c.Total() < o.Total()
+}
+
 type DefaultCost struct {
 }
 
 func (c DefaultCost) Cost(inStats []Statistics) (Cost, Statistics) {
 	return Cost{}, Statistics{}
 }
+
+// DefaultSelectivity is the estimated fraction of rows that
+// a predicate keeps when nothing is known about the predicate.
+const DefaultSelectivity = 1.0 / 3
+
+// SumStatistics returns the statistics of the union of the inputs.
+// The columns are only known when the columns of every input are known.
+func SumStatistics(inStats []Statistics) Statistics {
+	var stats Statistics
+	for i, s := range inStats {
+		stats.Cardinality += s.Cardinality
+		stats.GroupCardinality += s.GroupCardinality
+		if i == 0 {
+			stats.Columns = s.Columns
+		} else {
+			stats = stats.AddColumns(s.Columns...)
+			if s.Columns == nil {
+				stats.Columns = nil
+			}
+		}
+	}
+	return stats
+}
+
+// AddColumns returns the statistics with the columns that are not
+// already part of the known columns added to them.
+// Nothing is added if the columns are not known.
+func (s Statistics) AddColumns(columns ...string) Statistics {
+	if s.Columns == nil {
+		return s
+	}
+	cols := make([]string, len(s.Columns), len(s.Columns)+len(columns))
+	copy(cols, s.Columns)
+	for _, c := range columns {
+		if !hasColumn(cols, c) {
+			cols = append(cols, c)
+		}
+	}
+	s.Columns = cols
+	return s
+}
+
+func hasColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+// Scale returns the statistics with the cardinality multiplied by the selectivity.
+func (s Statistics) Scale(selectivity float64) Statistics {
+	s.Cardinality = int64(math.Ceil(float64(s.Cardinality) * selectivity))
+	return s
+}
+
+// EstimateCost returns the estimated cost of the node along with all of its
+// predecessors and the statistics of the data that the node produces.
+// A predecessor that is shared by several nodes is only counted once.
+func EstimateCost(node Node) (Cost, Statistics) {
+	e := costEstimator{stats: make(map[Node]Statistics)}
+	stats := e.estimate(node)
+	return e.cost, stats
+}
+
+// EstimateCost returns the estimated cost of the plan.
+func (plan *Spec) EstimateCost() Cost {
+	e := costEstimator{stats: make(map[Node]Statistics)}
+	for root := range plan.Roots {
+		e.estimate(root)
+	}
+	return e.cost
+}
+
+type costEstimator struct {
+	cost  Cost
+	stats map[Node]Statistics
+}
+
+func (e *costEstimator) estimate(node Node) Statistics {
+	if stats, ok := e.stats[node]; ok {
+		return stats
+	}
+	inStats := make([]Statistics, len(node.Predecessors()))
+	for i, pred := range node.Predecessors() {
+		inStats[i] = e.estimate(pred)
+	}
+
+	var stats Statistics
+	if s, ok := node.ProcedureSpec().(PhysicalProcedureSpec); ok {
+		var cost Cost
+		cost, stats = s.Cost(inStats)
+		e.cost = Add(e.cost, cost)
+	}
+	e.stats[node] = stats
+	return stats
+}
@@ -0,0 +1,426 @@
+package plan_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+)
+
+// costMockSpec is a procedure spec with a fixed cost per input row.
+type costMockSpec struct {
+	kind plan.ProcedureKind
+	// stats are the statistics of a source.
+	stats plan.Statistics
+	// rowCost is the CPU cost of each input row.
+	rowCost int64
+	// selectivity is the fraction of the input rows that are produced.
+	selectivity float64
+	// scanned is the number of rows that a source reads
+	// to produce its rows at a CPU cost of rowCost each.
+	scanned int64
+}
+
+func (s *costMockSpec) Kind() plan.ProcedureKind {
+	return s.kind
+}
+
+func (s *costMockSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func (s *costMockSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	if len(inStats) == 0 {
+		return plan.Cost{Disk: s.stats.Cardinality, CPU: s.rowCost * s.scanned}, s.stats
+	}
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: s.rowCost * in.Cardinality}, in.Scale(s.selectivity)
+}
+
+func source(id string, rows int64) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &costMockSpec{
+		kind:  "source",
+		stats: plan.Statistics{Cardinality: rows, GroupCardinality: 1},
+	})
+}
+
+func transform(id string, kind plan.ProcedureKind, rowCost int64, selectivity float64) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &costMockSpec{
+		kind:        kind,
+		rowCost:     rowCost,
+		selectivity: selectivity,
+	})
+}
+
+func TestEstimateCost(t *testing.T) {
+	//      3
+	//     / \
+	//    1   2
+	//     \ /
+	//      0
+	spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			source("0", 100),
+			transform("1", "filter", 1, 0.5),
+			transform("2", "map", 2, 1),
+			transform("3", "join", 1, 1),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{0, 2},
+			{1, 3},
+			{2, 3},
+		},
+	})
+
+	// The source is only read once.
+	want := plan.Cost{Disk: 100, CPU: 100 + 200 + 150}
+	if got := spec.EstimateCost(); !cmp.Equal(want, got) {
+		t.Errorf("unexpected plan cost -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	var root plan.Node
+	for r := range spec.Roots {
+		root = r
+	}
+	cost, stats := plan.EstimateCost(root)
+	if !cmp.Equal(want, cost) {
+		t.Errorf("unexpected node cost -want/+got:\n%s", cmp.Diff(want, cost))
+	}
+	wantStats := plan.Statistics{Cardinality: 150, GroupCardinality: 2}
+	if !cmp.Equal(wantStats, stats) {
+		t.Errorf("unexpected statistics -want/+got:\n%s", cmp.Diff(wantStats, stats))
+	}
+}
+
+// replaceRule replaces a node of a kind with a node that has the given cost.
+type replaceRule struct {
+	name        string
+	kind        plan.ProcedureKind
+	rowCost     int64
+	selectivity float64
+}
+
+func (r *replaceRule) Name() string {
+	return r.name
+}
+
+func (r *replaceRule) Pattern() plan.Pattern {
+	return plan.Pat(r.kind, plan.Any())
+}
+
+func (r *replaceRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	newNode := transform(r.name, plan.ProcedureKind(r.name), r.rowCost, r.selectivity)
+	plan.ReplaceNode(node, newNode)
+	return newNode, true, nil
+}
+
+func TestPhysicalPlanner_CostBased(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rules []plan.Rule
+		// kind is the kind of the node that is planned.
+		kind plan.ProcedureKind
+	}{
+		{
+			name: "cheapest rule",
+			rules: []plan.Rule{
+				&replaceRule{name: "cheap", kind: "op", rowCost: 2, selectivity: 1},
+				&replaceRule{name: "cheapest", kind: "op", rowCost: 1, selectivity: 1},
+			},
+			kind: "cheapest",
+		},
+		{
+			name: "expensive rule",
+			rules: []plan.Rule{
+				&replaceRule{name: "expensive", kind: "op", rowCost: 10, selectivity: 1},
+			},
+			kind: "op",
+		},
+		{
+			name: "same cost",
+			rules: []plan.Rule{
+				&replaceRule{name: "first", kind: "op", rowCost: 5, selectivity: 1},
+				&replaceRule{name: "second", kind: "op", rowCost: 5, selectivity: 1},
+			},
+			kind: "first",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					source("0", 100),
+					transform("1", "op", 5, 1),
+					transform("2", "sink", 1, 1),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			})
+			before := spec.EstimateCost()
+
+			planner := plan.NewPhysicalPlanner(
+				plan.OnlyPhysicalRules(tc.rules...),
+				plan.EnableCostBasedPlanning(),
+				plan.DisableValidation(),
+			)
+			spec, err := planner.Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := spec.CheckIntegrity(); err != nil {
+				t.Fatal(err)
+			}
+
+			var root plan.Node
+			for r := range spec.Roots {
+				root = r
+			}
+			if len(root.Predecessors()) != 1 {
+				t.Fatalf("unexpected predecessors of the root: %v", root.Predecessors())
+			}
+			op := root.Predecessors()[0]
+			if got := op.Kind(); got != tc.kind {
+				t.Errorf("unexpected kind of the planned node: want %s, got %s", tc.kind, got)
+			}
+			if preds := op.Predecessors(); len(preds) != 1 || preds[0].ID() != "0" {
+				t.Errorf("unexpected predecessors of the planned node: %v", preds)
+			}
+			if after := spec.EstimateCost(); before.Less(after) {
+				t.Errorf("the plan became more expensive: %v > %v", after, before)
+			}
+		})
+	}
+}
+
+// inPlaceRule changes the cost of the node that it rewrites
+// instead of replacing the node.
+type inPlaceRule struct {
+	kind    plan.ProcedureKind
+	rowCost int64
+}
+
+func (r *inPlaceRule) Name() string {
+	return "inPlace"
+}
+
+func (r *inPlaceRule) Pattern() plan.Pattern {
+	return plan.Pat(r.kind, plan.Any())
+}
+
+func (r *inPlaceRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	spec := node.ProcedureSpec().(*costMockSpec)
+	if spec.rowCost == r.rowCost {
+		return node, false, nil
+	}
+	spec.rowCost = r.rowCost
+	return node, true, nil
+}
+
+func TestPhysicalPlanner_CostBased_InPlace(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		rowCost int64
+		want    int64
+	}{
+		{
+			name:    "cheaper",
+			rowCost: 1,
+			want:    1,
+		},
+		{
+			name:    "more expensive",
+			rowCost: 10,
+			want:    5,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					source("0", 100),
+					transform("1", "op", 5, 1),
+					transform("2", "sink", 1, 1),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			})
+
+			planner := plan.NewPhysicalPlanner(
+				plan.OnlyPhysicalRules(&inPlaceRule{kind: "op", rowCost: tc.rowCost}),
+				plan.EnableCostBasedPlanning(),
+				plan.DisableValidation(),
+			)
+			spec, err := planner.Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var op plan.Node
+			for root := range spec.Roots {
+				op = root.Predecessors()[0]
+			}
+			// The rewrites that are not applied must not change the plan.
+			if got := op.ProcedureSpec().(*costMockSpec).rowCost; got != tc.want {
+				t.Errorf("unexpected row cost of the planned node: want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+// pushDownRule pushes a filter into the source that precedes it.
+// The source evaluates the filter at a CPU cost of rowCost for
+// each row that it reads.
+type pushDownRule struct {
+	rowCost int64
+}
+
+func (r *pushDownRule) Name() string {
+	return "pushDown"
+}
+
+func (r *pushDownRule) Pattern() plan.Pattern {
+	return plan.Pat("filter", plan.Pat("source"))
+}
+
+func (r *pushDownRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	filterSpec := node.ProcedureSpec().(*costMockSpec)
+	src := node.Predecessors()[0]
+	srcSpec := src.ProcedureSpec().(*costMockSpec)
+	merged, err := plan.MergeToPhysicalNode(node, src, &costMockSpec{
+		kind:    "source",
+		stats:   srcSpec.stats.Scale(filterSpec.selectivity),
+		rowCost: r.rowCost,
+		scanned: srcSpec.stats.Cardinality,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return merged, true, nil
+}
+
+func TestPhysicalPlanner_CostBased_PushDown(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		rowCost int64
+		// kind is the kind of the predecessor of the sink.
+		kind plan.ProcedureKind
+	}{
+		{
+			name:    "push down",
+			rowCost: 1,
+			kind:    "source",
+		},
+		{
+			name:    "evaluate in memory",
+			rowCost: 5,
+			kind:    "filter",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					source("0", 300),
+					transform("1", "filter", 1, 1.0/3),
+					transform("2", "sink", 1, 1),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			})
+
+			planner := plan.NewPhysicalPlanner(
+				plan.OnlyPhysicalRules(&pushDownRule{rowCost: tc.rowCost}),
+				plan.EnableCostBasedPlanning(),
+				plan.DisableValidation(),
+			)
+			spec, err := planner.Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := spec.CheckIntegrity(); err != nil {
+				t.Fatal(err)
+			}
+
+			var pred plan.Node
+			for root := range spec.Roots {
+				pred = root.Predecessors()[0]
+			}
+			if got := pred.Kind(); got != tc.kind {
+				t.Errorf("unexpected kind of the predecessor of the sink: want %s, got %s", tc.kind, got)
+			}
+		})
+	}
+}
+
+// costBasedRule is a replaceRule that is only applied by the cost-based planner.
+type costBasedRule struct {
+	replaceRule
+}
+
+func (costBasedRule) CostBased() {}
+
+func TestPhysicalPlanner_CostBasedRule(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		costBased bool
+		kind      plan.ProcedureKind
+	}{
+		{
+			name:      "cost-based planner",
+			costBased: true,
+			kind:      "cheap",
+		},
+		{
+			name: "heuristic planner",
+			kind: "op",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					source("0", 100),
+					transform("1", "op", 5, 1),
+					transform("2", "sink", 1, 1),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			})
+
+			opts := []plan.PhysicalOption{
+				plan.OnlyPhysicalRules(&costBasedRule{
+					replaceRule: replaceRule{name: "cheap", kind: "op", rowCost: 1, selectivity: 1},
+				}),
+				plan.DisableValidation(),
+			}
+			if tc.costBased {
+				opts = append(opts, plan.EnableCostBasedPlanning())
+			}
+			spec, err := plan.NewPhysicalPlanner(opts...).Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var op plan.Node
+			for root := range spec.Roots {
+				op = root.Predecessors()[0]
+			}
+			if got := op.Kind(); got != tc.kind {
+				t.Errorf("unexpected kind of the planned node: want %s, got %s", tc.kind, got)
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@ package plan
 import (
 	"context"
 	"sort"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
 )
 
 // heuristicPlanner applies a set of rules to the nodes in a Spec
@@ -10,6 +13,10 @@ import (
 type heuristicPlanner struct {
 	rules         map[ProcedureKind][]Rule
 	disabledRules map[string]bool
+
+	// costBased selects the cheapest of the rewrites
+	// of a node instead of applying all of them.
+	costBased bool
 }
 
 func newHeuristicPlanner() *heuristicPlanner {
@@ -41,10 +48,13 @@ func (p *heuristicPlanner) clearRules() {
 // matchRules applies any applicable rules to the given plan node,
 // and returns the rewritten plan node and whether or not any rewriting was done.
 func (p *heuristicPlanner) matchRules(ctx context.Context, node Node) (Node, bool, error) {
+	if p.costBased {
+		return p.matchCheapestRule(ctx, node)
+	}
 	anyChanged := false
 
 	for _, rule := range p.rules[AnyKind] {
-		if p.disabledRules[rule.Name()] {
+		if p.disabledRules[rule.Name()] || isCostBasedRule(rule) {
 			continue
 		}
 		if rule.Pattern().Match(node) {
@@ -58,7 +68,7 @@ func (p *heuristicPlanner) matchRules(ctx context.Context, node Node) (Node, boo
 	}
 
 	for _, rule := range p.rules[node.Kind()] {
-		if p.disabledRules[rule.Name()] {
+		if p.disabledRules[rule.Name()] || isCostBasedRule(rule) {
 			continue
 		}
 		if rule.Pattern().Match(node) {
@@ -74,6 +84,131 @@ func (p *heuristicPlanner) matchRules(ctx context.Context, node Node) (Node, boo
 	return node, anyChanged, nil
 }
 
+// matchingRules returns the enabled rules that match the node.
+func (p *heuristicPlanner) matchingRules(node Node) []Rule {
+	var rules []Rule
+	for _, kind := range []ProcedureKind{AnyKind, node.Kind()} {
+		for _, rule := range p.rules[kind] {
+			if !p.disabledRules[rule.Name()] && rule.Pattern().Match(node) {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	return rules
+}
+
+// matchCheapestRule estimates the cost of rewriting the node with each
+// of the rules that match it and applies the rule with the lowest cost.
+// A rewrite is applied when it costs no more than the node itself so that
+// rewrites that are not reflected by the cost model are still applied.
+//
+// Each rule rewrites a copy of the node and its predecessors to estimate
+// its cost, so the rewrites that are not applied leave the plan untouched.
+func (p *heuristicPlanner) matchCheapestRule(ctx context.Context, node Node) (Node, bool, error) {
+	rules := p.matchingRules(node)
+	if len(rules) == 0 {
+		return node, false, nil
+	}
+
+	best, _ := EstimateCost(node)
+	var bestRule Rule
+	for _, rule := range rules {
+		c, err := copySubgraph(node)
+		if err != nil {
+			return nil, false, err
+		}
+		newNode, changed, err := rule.Rewrite(ctx, c)
+		if err != nil {
+			return nil, false, err
+		}
+		if !changed {
+			continue
+		}
+		cost, _ := EstimateCost(newNode)
+		if (bestRule == nil && !best.Less(cost)) || cost.Less(best) {
+			best, bestRule = cost, rule
+		}
+	}
+	if bestRule == nil {
+		return node, false, nil
+	}
+	return bestRule.Rewrite(ctx, node)
+}
+
+// copySubgraph returns a copy of the node and all of its predecessors.
+// The procedure specs are copied as well so that rewriting the copy does
+// not modify the original nodes. The successors of the copied nodes that
+// are not part of the subgraph are the original nodes, so a rule sees
+// the same number of successors on the copy as on the original node.
+func copySubgraph(node Node) (Node, error) {
+	copies := make(map[Node]Node)
+	var cp func(n Node) (Node, error)
+	cp = func(n Node) (Node, error) {
+		if c, ok := copies[n]; ok {
+			return c, nil
+		}
+		c, err := copyNode(n)
+		if err != nil {
+			return nil, err
+		}
+		copies[n] = c
+		for _, pred := range n.Predecessors() {
+			pc, err := cp(pred)
+			if err != nil {
+				return nil, err
+			}
+			c.AddPredecessors(pc)
+		}
+		return c, nil
+	}
+	root, err := cp(node)
+	if err != nil {
+		return nil, err
+	}
+	for n, c := range copies {
+		for _, succ := range n.Successors() {
+			if sc, ok := copies[succ]; ok {
+				succ = sc
+			}
+			c.AddSuccessors(succ)
+		}
+	}
+	return root, nil
+}
+
+// copyNode returns a copy of a node without any edges.
+func copyNode(node Node) (Node, error) {
+	var b bounds
+	if bs := node.Bounds(); bs != nil {
+		nb := *bs
+		b.value = &nb
+	}
+	switch n := node.(type) {
+	case *LogicalNode:
+		return &LogicalNode{
+			bounds: b,
+			id:     n.id,
+			Spec:   n.Spec.Copy(),
+		}, nil
+	case *PhysicalPlanNode:
+		spec, ok := n.Spec.Copy().(PhysicalProcedureSpec)
+		if !ok {
+			return nil, errors.Newf(codes.Internal, "copy of physical procedure spec %s is not a physical procedure spec", n.Kind())
+		}
+		return &PhysicalPlanNode{
+			bounds:        b,
+			id:            n.id,
+			Spec:          spec,
+			TriggerSpec:   n.TriggerSpec,
+			Parallelism:   n.Parallelism,
+			RequiredAttrs: append([]PhysicalAttributes(nil), n.RequiredAttrs...),
+			OutputAttrs:   n.OutputAttrs,
+		}, nil
+	default:
+		return nil, errors.Newf(codes.Internal, "cannot copy plan node of type %T", node)
+	}
+}
+
 // Plan is a fixed-point query planning algorithm.
 // It traverses the DAG depth-first, attempting to apply rewrite rules at each node.
 // Traversal is repeated until a pass over the DAG results in no changes with the given rule set.
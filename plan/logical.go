@@ -116,9 +116,12 @@ func (l *logicalPlanner) Plan(ctx context.Context, logicalPlan *Spec) (*Spec, er
 
 type administration struct {
 	now time.Time
+	// nowRead is set when the procedure reads now.
+	nowRead bool
 }
 
-func (a administration) Now() time.Time {
+func (a *administration) Now() time.Time {
+	a.nowRead = true
 	return a.now
 }
 
@@ -162,14 +165,12 @@ func (lpn *LogicalNode) ShallowCopy() Node {
 // createLogicalPlan creates a logical query plan from a flux spec
 func createLogicalPlan(spec *flux.Spec) (*Spec, error) {
 	nodes := make(map[flux.OperationID]Node, len(spec.Operations))
-	admin := administration{now: spec.Now}
 
 	plan := NewPlanSpec()
 	plan.Resources = spec.Resources
 	plan.Now = spec.Now
 
 	v := &fluxSpecVisitor{
-		a:          admin,
 		spec:       spec,
 		plan:       plan,
 		nodes:      nodes,
@@ -185,7 +186,6 @@ func createLogicalPlan(spec *flux.Spec) (*Spec, error) {
 
 // fluxSpecVisitor visits a flux spec and constructs from it a logical plan DAG
 type fluxSpecVisitor struct {
-	a          Administration
 	spec       *flux.Spec
 	plan       *Spec
 	nodes      map[flux.OperationID]Node
@@ -228,10 +228,14 @@ func (v *fluxSpecVisitor) visitOperation(o *flux.Operation) error {
 	create := createFns[0]
 
 	// Create a ProcedureSpec from the query operation procedureSpec
-	procedureSpec, err := create(o.Spec, v.a)
+	a := &administration{now: v.spec.Now}
+	procedureSpec, err := create(o.Spec, a)
 	if err != nil {
 		return err
 	}
+	if _, ok := procedureSpec.(NowAwareProcedureSpec); a.nowRead && !ok {
+		v.plan.fixedNow = true
+	}
 
 	// Create a LogicalNode using the ProcedureSpec
 	logicalNode := CreateLogicalNode(NodeID(o.ID), procedureSpec)
@@ -0,0 +1,64 @@
+package plan
+
+// PartitionableProcedureSpec is implemented by procedure specs whose
+// transformation processes each table independently of the other tables
+// and produces tables with the group key of the table that it read.
+// The tables that such a node reads may be divided into partitions
+// by group key that are processed concurrently.
+type PartitionableProcedureSpec interface {
+	IsPartitionable() bool
+}
+
+// SetParallelism sets the number of partitions of each node in the plan
+// that can process its tables concurrently. A node is partitioned if its
+// procedure spec is partitionable, it reads from a single predecessor that
+// is not a streaming source and it does not have side effects.
+// The number of partitions is at most n and is limited by the estimated
+// number of tables that the node reads when that estimate is known.
+// A node that is not partitioned has a parallelism of zero.
+func SetParallelism(plan *Spec, n int) error {
+	e := costEstimator{stats: make(map[Node]Statistics)}
+	return plan.BottomUpWalk(func(node Node) error {
+		ppn, ok := node.(*PhysicalPlanNode)
+		if !ok {
+			return nil
+		}
+		ppn.Parallelism = 0
+		if n < 2 || !isPartitionable(node) {
+			return nil
+		}
+
+		partitions := n
+		if groups := e.estimate(node.Predecessors()[0]).GroupCardinality; groups > 0 && groups < int64(n) {
+			partitions = int(groups)
+		}
+		if partitions > 1 {
+			ppn.Parallelism = partitions
+		}
+		return nil
+	})
+}
+
+func isPartitionable(node Node) bool {
+	spec := node.ProcedureSpec()
+	if p, ok := spec.(PartitionableProcedureSpec); !ok || !p.IsPartitionable() {
+		return false
+	}
+	return len(node.Predecessors()) == 1 && !IsStreaming(node) && !HasSideEffect(spec)
+}
+
+// independentBranches returns the number of branches of the plan that
+// feed the nodes that read from more than one predecessor, such as
+// union and join. The branches do not depend on each other, so they
+// can be processed concurrently when each has its own worker.
+// A plan that does not have such nodes has a single branch.
+func independentBranches(plan *Spec) int {
+	n := 1
+	_ = plan.BottomUpWalk(func(node Node) error {
+		if preds := len(node.Predecessors()); preds > 1 {
+			n += preds - 1
+		}
+		return nil
+	})
+	return n
+}
@@ -0,0 +1,227 @@
+package plan_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+)
+
+// partitionMockSpec is a procedure spec that processes each table independently.
+type partitionMockSpec struct {
+	costMockSpec
+}
+
+func (s *partitionMockSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func (s *partitionMockSpec) IsPartitionable() bool {
+	return true
+}
+
+func groupedSource(id string, groups int64) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &costMockSpec{
+		kind:  "source",
+		stats: plan.Statistics{Cardinality: groups * 100, GroupCardinality: groups},
+	})
+}
+
+func partitionable(id string, kind plan.ProcedureKind) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &partitionMockSpec{
+		costMockSpec: costMockSpec{kind: kind, rowCost: 1, selectivity: 1},
+	})
+}
+
+func TestSetParallelism(t *testing.T) {
+	testCases := []struct {
+		name  string
+		spec  *plantest.PlanSpec
+		quota int
+		want  map[plan.NodeID]int
+	}{
+		{
+			name: "chain",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 0),
+					partitionable("1", "filter"),
+					partitionable("2", "sum"),
+					transform("3", "group", 1, 1),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}, {2, 3}},
+			},
+			quota: 4,
+			want:  map[plan.NodeID]int{"0": 0, "1": 4, "2": 4, "3": 0},
+		},
+		{
+			name: "limited by the number of tables",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 3),
+					partitionable("1", "filter"),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			quota: 8,
+			want:  map[plan.NodeID]int{"0": 0, "1": 3},
+		},
+		{
+			name: "single table",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 1),
+					partitionable("1", "filter"),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			quota: 8,
+			want:  map[plan.NodeID]int{"0": 0, "1": 0},
+		},
+		{
+			name: "multiple predecessors",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 0),
+					groupedSource("1", 0),
+					partitionable("2", "union"),
+				},
+				Edges: [][2]int{{0, 2}, {1, 2}},
+			},
+			quota: 4,
+			want:  map[plan.NodeID]int{"0": 0, "1": 0, "2": 0},
+		},
+		{
+			name: "no concurrency",
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 0),
+					partitionable("1", "filter"),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			quota: 1,
+			want:  map[plan.NodeID]int{"0": 0, "1": 0},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(tc.spec)
+			if err := plan.SetParallelism(spec, tc.quota); err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[plan.NodeID]int)
+			_ = spec.BottomUpWalk(func(node plan.Node) error {
+				got[node.ID()] = node.(*plan.PhysicalPlanNode).Parallelism
+				return nil
+			})
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected parallelism -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestPhysicalPlanner_ConcurrencyQuota(t *testing.T) {
+	testCases := []struct {
+		name        string
+		options     []plan.PhysicalOption
+		resources   flux.ResourceManagement
+		wantQuota   int
+		parallelism int
+	}{
+		{
+			name:      "default",
+			wantQuota: 1,
+		},
+		{
+			name:        "default option",
+			options:     []plan.PhysicalOption{plan.WithDefaultConcurrencyQuota(4)},
+			wantQuota:   4,
+			parallelism: 4,
+		},
+		{
+			name:        "requested quota",
+			options:     []plan.PhysicalOption{plan.WithDefaultConcurrencyQuota(4)},
+			resources:   flux.ResourceManagement{ConcurrencyQuota: 2},
+			wantQuota:   2,
+			parallelism: 2,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 0),
+					partitionable("1", "filter"),
+				},
+				Edges:     [][2]int{{0, 1}},
+				Resources: tc.resources,
+			})
+
+			options := append([]plan.PhysicalOption{plan.DisableValidation()}, tc.options...)
+			pp, err := plan.NewPhysicalPlanner(options...).Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := pp.Resources.ConcurrencyQuota; got != tc.wantQuota {
+				t.Errorf("unexpected concurrency quota: want %d, got %d", tc.wantQuota, got)
+			}
+			for root := range pp.Roots {
+				if got := root.(*plan.PhysicalPlanNode).Parallelism; got != tc.parallelism {
+					t.Errorf("unexpected parallelism: want %d, got %d", tc.parallelism, got)
+				}
+			}
+		})
+	}
+}
+
+func TestPhysicalPlanner_IndependentBranches(t *testing.T) {
+	testCases := []struct {
+		name      string
+		resources flux.ResourceManagement
+		wantQuota int
+	}{
+		{
+			name:      "derived quota",
+			wantQuota: 3,
+		},
+		{
+			name:      "requested quota",
+			resources: flux.ResourceManagement{ConcurrencyQuota: 1},
+			wantQuota: 1,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// Each of the three sources is a branch
+			// that feeds the union or the join.
+			spec := plantest.CreatePlanSpec(&plantest.PlanSpec{
+				Nodes: []plan.Node{
+					groupedSource("0", 0),
+					groupedSource("1", 0),
+					groupedSource("2", 0),
+					transform("3", "union", 1, 1),
+					transform("4", "join", 1, 1),
+				},
+				Edges:     [][2]int{{0, 3}, {1, 3}, {3, 4}, {2, 4}},
+				Resources: tc.resources,
+			})
+
+			pp, err := plan.NewPhysicalPlanner(plan.DisableValidation()).Plan(context.Background(), spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := pp.Resources.ConcurrencyQuota; got != tc.wantQuota {
+				t.Errorf("unexpected concurrency quota: want %d, got %d", tc.wantQuota, got)
+			}
+		})
+	}
+}
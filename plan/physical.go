@@ -76,7 +76,24 @@ func (pp *physicalPlanner) Plan(ctx context.Context, spec *Spec) (*Spec, error)
 
 	// Update concurrency quota
 	if transformedSpec.Resources.ConcurrencyQuota == 0 {
-		transformedSpec.Resources.ConcurrencyQuota = len(transformedSpec.Roots)
+		transformedSpec.Resources.ConcurrencyQuota = pp.defaultConcurrencyQuota
+	}
+
+	// Partition the nodes that can process their tables concurrently.
+	// The quota that is derived from the number of roots below is not
+	// used for partitioning so that the order of the tables only changes
+	// when concurrency was requested.
+	if err := SetParallelism(transformedSpec, transformedSpec.Resources.ConcurrencyQuota); err != nil {
+		return nil, err
+	}
+
+	if transformedSpec.Resources.ConcurrencyQuota == 0 {
+		// Each root and each independent branch may have a worker.
+		quota := len(transformedSpec.Roots)
+		if n := independentBranches(transformedSpec); n > quota {
+			quota = n
+		}
+		transformedSpec.Resources.ConcurrencyQuota = quota
 	}
 
 	return transformedSpec, nil
@@ -101,8 +118,9 @@ func validatePhysicalPlan(plan *Spec) error {
 
 type physicalPlanner struct {
 	*heuristicPlanner
-	defaultMemoryLimit int64
-	disableValidation  bool
+	defaultMemoryLimit      int64
+	defaultConcurrencyQuota int
+	disableValidation       bool
 }
 
 // PhysicalOption is an option to configure the behavior of the physical plan.
@@ -124,6 +142,18 @@ func WithDefaultMemoryLimit(memBytes int64) PhysicalOption {
 	})
 }
 
+// WithDefaultConcurrencyQuota sets the default number of workers that may process a query.
+// If the query spec explicitly sets a concurrency quota, that quota is used instead of the default.
+// When the quota is more than one, nodes that process each table independently
+// are partitioned by group key and the partitions are processed concurrently.
+// The tables of a partitioned node are then output one partition after
+// another, which is a different order than when the node is not partitioned.
+func WithDefaultConcurrencyQuota(n int) PhysicalOption {
+	return physicalOption(func(p *physicalPlanner) {
+		p.defaultConcurrencyQuota = n
+	})
+}
+
 // OnlyPhysicalRules produces a physical plan option that forces only a particular set of rules to be applied.
 func OnlyPhysicalRules(rules ...Rule) PhysicalOption {
 	return physicalOption(func(pp *physicalPlanner) {
@@ -141,6 +171,16 @@ func RemovePhysicalRules(rules ...string) PhysicalOption {
 	})
 }
 
+// EnableCostBasedPlanning produces a physical plan option that makes the planner
+// estimate the cost of each rewrite of a node and apply the cheapest one.
+// Rewrites that would make the plan more expensive are not applied.
+// A query enables it with the enableCostBasedPlanning option of the planner package.
+func EnableCostBasedPlanning() PhysicalOption {
+	return physicalOption(func(pp *physicalPlanner) {
+		pp.costBased = true
+	})
+}
+
 // DisableValidation disables validation in the physical planner.
 func DisableValidation() PhysicalOption {
 	return physicalOption(func(p *physicalPlanner) {
@@ -204,6 +244,11 @@ type PhysicalPlanNode struct {
 	// sends its tables to downstream operators
 	TriggerSpec TriggerSpec
 
+	// Parallelism is the number of partitions of the tables that
+	// are processed concurrently by separate transformations.
+	// A node with a parallelism of zero or one is not partitioned.
+	Parallelism int
+
 	// The attributes required from inputs to this node
 	RequiredAttrs []PhysicalAttributes
 
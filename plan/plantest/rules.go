@@ -180,6 +180,13 @@ func PhysicalRuleTestHelper(t *testing.T, tc *RuleTestCase) {
 		// Disable validation so that we can avoid having to push a range into every from
 		opts = append(opts, plan.DisableValidation())
 	}
+	for _, rule := range tc.Rules {
+		// Cost-based rules are only applied by the cost-based planner.
+		if _, ok := rule.(plan.CostBasedRule); ok {
+			opts = append(opts, plan.EnableCostBasedPlanning())
+			break
+		}
+	}
 	physicalPlanner := plan.NewPhysicalPlanner(opts...)
 
 	ctx := tc.Context
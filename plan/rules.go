@@ -15,3 +15,18 @@ type Rule interface {
 	// The boolean return value should be true if anything changed during the rewrite.
 	Rewrite(context.Context, Node) (Node, bool, error)
 }
+
+// CostBasedRule is a rule that chooses between alternative plans
+// by their estimated cost. A cost-based rule is only applied when
+// cost-based planning is enabled (see EnableCostBasedPlanning).
+type CostBasedRule interface {
+	Rule
+
+	// CostBased marks the rule as a cost-based rule.
+	CostBased()
+}
+
+func isCostBasedRule(rule Rule) bool {
+	_, ok := rule.(CostBasedRule)
+	return ok
+}
@@ -39,6 +39,28 @@ func SetTriggerSpec(node Node) error {
 	return nil
 }
 
+// StreamingProcedureSpec is implemented by sources that can produce
+// an unbounded stream of tables. A streaming source outputs tables
+// as its data arrives and advances the watermark as it goes
+// so that the triggers fire while the query is running.
+type StreamingProcedureSpec interface {
+	IsStreaming() bool
+}
+
+// IsStreaming reports whether the node or any of its
+// predecessors is a streaming source.
+func IsStreaming(node Node) bool {
+	if s, ok := node.ProcedureSpec().(StreamingProcedureSpec); ok && s.IsStreaming() {
+		return true
+	}
+	for _, pred := range node.Predecessors() {
+		if IsStreaming(pred) {
+			return true
+		}
+	}
+	return false
+}
+
 type NarrowTransformationTriggerSpec struct{}
 
 func (NarrowTransformationTriggerSpec) Kind() TriggerKind {
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
 )
 
 type Planner interface {
@@ -55,6 +57,21 @@ type Spec struct {
 	Roots     map[Node]struct{}
 	Resources flux.ResourceManagement
 	Now       time.Time
+
+	// fixedNow is set when a procedure was created with the value
+	// of now but cannot be changed to another value of now.
+	fixedNow bool
+}
+
+// NowAwareProcedureSpec is implemented by procedure specs that depend
+// on the value of now, such as the bounds of a range, so that a plan
+// can be run again at another time without planning it again.
+// A procedure spec that reads now when it is created, or that keeps
+// a value derived from now by a rule, must implement it.
+type NowAwareProcedureSpec interface {
+	ProcedureSpec
+	// SetNow changes the value of now that the procedure is run with.
+	SetNow(now time.Time)
 }
 
 // NewPlanSpec initializes a new query plan
@@ -70,6 +87,65 @@ func (plan *Spec) Replace(root, with Node) {
 	plan.Roots[with] = struct{}{}
 }
 
+// HasFixedNow reports whether the plan can only be run
+// at the value of now that it was created with.
+func (plan *Spec) HasFixedNow() bool {
+	return plan.fixedNow
+}
+
+// CopyAt returns a copy of a physical plan that is run at the given value of now.
+// The copy has its own nodes so that it can be executed while the plan is
+// executed, because the executor completes the nodes it runs. The procedure
+// specs are shared, except for the specs that depend on now when now changes.
+func (plan *Spec) CopyAt(now time.Time) (*Spec, error) {
+	changed := !now.Equal(plan.Now)
+	if changed && plan.fixedNow {
+		return nil, errors.New(codes.Internal, "the plan cannot be run at a different value of now")
+	}
+	nodes := make(map[Node]*PhysicalPlanNode)
+	if err := plan.BottomUpWalk(func(node Node) error {
+		ppn, ok := node.(*PhysicalPlanNode)
+		if !ok {
+			return errors.Newf(codes.Internal, "cannot copy the plan node %q that is not physical", node.ID())
+		}
+		n := *ppn
+		n.edges = edges{}
+		if spec, ok := n.Spec.(NowAwareProcedureSpec); ok && changed {
+			spec = spec.Copy().(NowAwareProcedureSpec)
+			spec.SetNow(now)
+			n.Spec = spec.(PhysicalProcedureSpec)
+		}
+		nodes[node] = &n
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	np := NewPlanSpec()
+	np.Resources = plan.Resources
+	np.Now = now
+	np.fixedNow = plan.fixedNow
+	// The edges are added in the order of the plan
+	// so that the tables are sent in the same order.
+	for node, n := range nodes {
+		for _, pred := range node.Predecessors() {
+			n.AddPredecessors(nodes[pred])
+		}
+		for _, succ := range node.Successors() {
+			n.AddSuccessors(nodes[succ])
+		}
+	}
+	for root := range plan.Roots {
+		np.Roots[nodes[root]] = struct{}{}
+	}
+	if changed {
+		if err := np.BottomUpWalk(ComputeBounds); err != nil {
+			return nil, err
+		}
+	}
+	return np, nil
+}
+
 // CheckIntegrity checks the integrity of the plan, i.e.:
 //  - node A is predecessor of B iff B is successor of A;
 //  - there is no cycle.
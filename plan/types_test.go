@@ -2,9 +2,12 @@ package plan_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/values"
 )
 
 func TestPlanSpec_CheckIntegrity(t *testing.T) {
@@ -78,3 +81,98 @@ func assertKO(t *testing.T, p *plan.Spec) {
 		t.Fatal("unexpected integrity check pass")
 	}
 }
+
+// nowProcedureSpec reads the hour before now.
+type nowProcedureSpec struct {
+	plan.DefaultCost
+	Now time.Time
+}
+
+func (s *nowProcedureSpec) Kind() plan.ProcedureKind {
+	return "now"
+}
+
+func (s *nowProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func (s *nowProcedureSpec) SetNow(now time.Time) {
+	s.Now = now
+}
+
+func (s *nowProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	return &plan.Bounds{
+		Start: values.ConvertTime(s.Now.Add(-time.Hour)),
+		Stop:  values.ConvertTime(s.Now),
+	}
+}
+
+func TestPlanSpec_CopyAt(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := plantest.CreatePlanSpec(&plantest.PlanSpec{
+		Nodes: []plan.Node{
+			plan.CreatePhysicalNode("0", &nowProcedureSpec{Now: now}),
+			plantest.CreatePhysicalMockNode("1"),
+			plantest.CreatePhysicalMockNode("2"),
+		},
+		Edges: [][2]int{
+			{0, 1},
+			{0, 2},
+		},
+		Now: now,
+	})
+	if err := p.BottomUpWalk(plan.ComputeBounds); err != nil {
+		t.Fatal(err)
+	}
+
+	later := now.Add(time.Hour)
+	cp, err := p.CopyAt(later)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cp.CheckIntegrity(); err != nil {
+		t.Fatal(err)
+	}
+	if !cp.Now.Equal(later) {
+		t.Errorf("unexpected now -want/+got:\n\t- %v\n\t+ %v", later, cp.Now)
+	}
+
+	// The copy has its own nodes with the same edges
+	// and the bounds that follow from the new value of now.
+	want := &plan.Bounds{Start: values.ConvertTime(now), Stop: values.ConvertTime(later)}
+	var ids []plan.NodeID
+	if err := cp.TopDownWalk(func(node plan.Node) error {
+		for n := range p.Roots {
+			if n == node {
+				t.Errorf("the copy shares the node %q with the plan", node.ID())
+			}
+		}
+		ids = append(ids, node.ID())
+		if got := node.Bounds(); !cmp.Equal(want, got) {
+			t.Errorf("unexpected bounds of %q -want/+got:\n%s", node.ID(), cmp.Diff(want, got))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(ids), 3; got != want {
+		t.Errorf("unexpected number of nodes -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	for node := range cp.Roots {
+		if got, want := node.Predecessors()[0].Successors(), 2; len(got) != want {
+			t.Errorf("unexpected number of successors -want/+got:\n\t- %d\n\t+ %d", want, len(got))
+		}
+	}
+
+	// The plan is left as it was.
+	if err := p.TopDownWalk(func(node plan.Node) error {
+		want := &plan.Bounds{Start: values.ConvertTime(now.Add(-time.Hour)), Stop: values.ConvertTime(now)}
+		if got := node.Bounds(); !cmp.Equal(want, got) {
+			t.Errorf("unexpected bounds of %q -want/+got:\n%s", node.ID(), cmp.Diff(want, got))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -33,6 +33,23 @@ type Runtime interface {
 	LookupBuiltinType(pkg, name string) (semantic.MonoType, error)
 }
 
+// AnalyzingRuntime is a Runtime that can type check a Flux AST once
+// and evaluate the resulting semantic graph any number of times.
+// It is kept separate from the Runtime so that existing
+// implementations are not required to support it.
+type AnalyzingRuntime interface {
+	Runtime
+
+	// Analyze type checks a Flux AST and produces a semantic graph.
+	// The AST handle cannot be used after it has been analyzed.
+	Analyze(astPkg ASTHandle) (*semantic.Package, error)
+
+	// EvalPackage evaluates a semantic graph produced by Analyze
+	// to produce a set of side effects and a scope.
+	// The same semantic graph may be evaluated any number of times.
+	EvalPackage(ctx context.Context, semPkg *semantic.Package, opts ...ScopeMutator) ([]interpreter.SideEffect, values.Scope, error)
+}
+
 // ASTHandle is an opaque type that represents an abstract syntax tree.
 type ASTHandle interface {
 	// ASTHandle is a no-op method whose purpose is to avoid types unintentionally
@@ -37,6 +37,11 @@ func StdLib() interpreter.Importer {
 	return Default.Stdlib()
 }
 
+// Packages returns the import paths of the packages in the Flux standard library.
+func Packages() []string {
+	return Default.Packages()
+}
+
 // Prelude returns a scope object representing the Flux universe block
 func Prelude() values.Scope {
 	return Default.Prelude()
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"encoding/json"
+	"sort"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
@@ -18,6 +19,8 @@ import (
 // required to execute a flux script.
 var Default = &runtime{}
 
+var _ flux.AnalyzingRuntime = (*runtime)(nil)
+
 // runtime contains the flux runtime for interpreting and
 // executing queries.
 type runtime struct {
@@ -118,11 +121,18 @@ func (r *runtime) Prelude() values.Scope {
 }
 
 func (r *runtime) Eval(ctx context.Context, astPkg flux.ASTHandle, opts ...flux.ScopeMutator) ([]interpreter.SideEffect, values.Scope, error) {
-	semPkg, err := AnalyzePackage(astPkg)
+	semPkg, err := r.Analyze(astPkg)
 	if err != nil {
 		return nil, nil, err
 	}
+	return r.EvalPackage(ctx, semPkg, opts...)
+}
 
+func (r *runtime) Analyze(astPkg flux.ASTHandle) (*semantic.Package, error) {
+	return AnalyzePackage(astPkg)
+}
+
+func (r *runtime) EvalPackage(ctx context.Context, semPkg *semantic.Package, opts ...flux.ScopeMutator) ([]interpreter.SideEffect, values.Scope, error) {
 	// Construct the initial scope for this package.
 	importer := &importer{r: r}
 	scope, err := r.newScopeFor("main", importer)
@@ -180,6 +190,19 @@ func (r *runtime) Stdlib() interpreter.Importer {
 	return &importer{r: r}
 }
 
+// Packages returns the sorted import paths of the packages in the standard library.
+func (r *runtime) Packages() []string {
+	if !r.finalized {
+		panic("builtins not finalized")
+	}
+	paths := make([]string, 0, len(r.pkgs))
+	for path := range r.pkgs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
 func (r *runtime) compilePackages() error {
 	pkgs := make(map[string]*semantic.Package)
 	for _, pkg := range r.astPkgs {
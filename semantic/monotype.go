@@ -39,6 +39,8 @@ func NewMonoType(tbl flatbuffers.Table, t fbsemantic.MonoType) (MonoType, error)
 		tbler = new(fbsemantic.Row)
 	case fbsemantic.MonoTypeFun:
 		tbler = new(fbsemantic.Fun)
+	case fbsemantic.MonoTypeDict:
+		tbler = new(fbsemantic.Dict)
 	default:
 		return MonoType{}, errors.Newf(codes.Internal, "unknown type (%v)", t)
 	}
@@ -78,6 +80,8 @@ func (mt MonoType) Nature() Nature {
 		return Object
 	case fbsemantic.MonoTypeFun:
 		return Function
+	case fbsemantic.MonoTypeDict:
+		return Dictionary
 	case fbsemantic.MonoTypeNONE,
 		fbsemantic.MonoTypeVar:
 		fallthrough
@@ -96,6 +100,7 @@ const (
 	Arr     = Kind(fbsemantic.MonoTypeArr)
 	Row     = Kind(fbsemantic.MonoTypeRow)
 	Fun     = Kind(fbsemantic.MonoTypeFun)
+	Dict    = Kind(fbsemantic.MonoTypeDict)
 )
 
 // Kind returns what kind of monotype the receiver is.
@@ -248,6 +253,40 @@ func (mt MonoType) ElemType() (MonoType, error) {
 	return NewMonoType(tbl, arr.TType())
 }
 
+func getDict(tbl fbTabler) (*fbsemantic.Dict, error) {
+	dict, ok := tbl.(*fbsemantic.Dict)
+	if !ok {
+		return nil, errors.New(codes.Internal, "MonoType is not a dictionary")
+	}
+	return dict, nil
+}
+
+// KeyType returns the key type if this monotype is a dictionary, and an error otherwise.
+func (mt MonoType) KeyType() (MonoType, error) {
+	dict, err := getDict(mt.tbl)
+	if err != nil {
+		return MonoType{}, err
+	}
+	var tbl flatbuffers.Table
+	if !dict.K(&tbl) {
+		return MonoType{}, errors.New(codes.Internal, "missing dictionary key type")
+	}
+	return NewMonoType(tbl, dict.KType())
+}
+
+// ValueType returns the value type if this monotype is a dictionary, and an error otherwise.
+func (mt MonoType) ValueType() (MonoType, error) {
+	dict, err := getDict(mt.tbl)
+	if err != nil {
+		return MonoType{}, err
+	}
+	var tbl flatbuffers.Table
+	if !dict.V(&tbl) {
+		return MonoType{}, errors.New(codes.Internal, "missing dictionary value type")
+	}
+	return NewMonoType(tbl, dict.VType())
+}
+
 func getRow(tbl fbTabler) (*fbsemantic.Row, error) {
 	row, ok := tbl.(*fbsemantic.Row)
 	if !ok {
@@ -396,6 +435,21 @@ func (mt MonoType) getCanonicalMapping(counter *uint64, tvm map[uint64]uint64) e
 		if err := et.getCanonicalMapping(counter, tvm); err != nil {
 			return err
 		}
+	case Dict:
+		kt, err := mt.KeyType()
+		if err != nil {
+			return err
+		}
+		if err := kt.getCanonicalMapping(counter, tvm); err != nil {
+			return err
+		}
+		vt, err := mt.ValueType()
+		if err != nil {
+			return err
+		}
+		if err := vt.getCanonicalMapping(counter, tvm); err != nil {
+			return err
+		}
 	case Row:
 		props, err := mt.SortedProperties()
 		if err != nil {
@@ -475,6 +529,16 @@ func (mt MonoType) string(m map[uint64]uint64) string {
 			return "<" + err.Error() + ">"
 		}
 		return "[" + et.string(m) + "]"
+	case Dict:
+		kt, err := mt.KeyType()
+		if err != nil {
+			return "<" + err.Error() + ">"
+		}
+		vt, err := mt.ValueType()
+		if err != nil {
+			return "<" + err.Error() + ">"
+		}
+		return "[" + kt.string(m) + ":" + vt.string(m) + "]"
 	case Row:
 		var sb strings.Builder
 		sb.WriteString("{")
@@ -580,6 +644,22 @@ func NewArrayType(elemType MonoType) MonoType {
 	return mt
 }
 
+// NewDictType will construct a new Dictionary MonoType
+// with the given key and value types.
+func NewDictType(keyType, valueType MonoType) MonoType {
+	builder := flatbuffers.NewBuilder(32)
+	offset := buildDictType(builder, keyType, valueType)
+	builder.Finish(offset)
+
+	buf := builder.FinishedBytes()
+	dict := fbsemantic.GetRootAsDict(buf, 0)
+	mt, err := NewMonoType(dict.Table(), fbsemantic.MonoTypeDict)
+	if err != nil {
+		panic(err)
+	}
+	return mt
+}
+
 type ArgumentType struct {
 	Name     []byte
 	Type     MonoType
@@ -663,6 +743,13 @@ func copyMonoType(builder *flatbuffers.Builder, t MonoType) flatbuffers.UOffsetT
 
 		elem := monoTypeFromFunc(arr.T, arr.TType())
 		return buildArrayType(builder, elem)
+	case fbsemantic.MonoTypeDict:
+		var dict fbsemantic.Dict
+		dict.Init(table.Bytes, table.Pos)
+
+		key := monoTypeFromFunc(dict.K, dict.KType())
+		value := monoTypeFromFunc(dict.V, dict.VType())
+		return buildDictType(builder, key, value)
 	case fbsemantic.MonoTypeRow:
 		var row fbsemantic.Row
 		row.Init(table.Bytes, table.Pos)
@@ -745,6 +832,19 @@ func buildArrayType(builder *flatbuffers.Builder, elemType MonoType) flatbuffers
 	return fbsemantic.ArrEnd(builder)
 }
 
+// buildDictType will construct a dict type in the builder
+// and return the offset for the type.
+func buildDictType(builder *flatbuffers.Builder, keyType, valueType MonoType) flatbuffers.UOffsetT {
+	keyOffset := copyMonoType(builder, keyType)
+	valueOffset := copyMonoType(builder, valueType)
+	fbsemantic.DictStart(builder)
+	fbsemantic.DictAddKType(builder, keyType.mt)
+	fbsemantic.DictAddK(builder, keyOffset)
+	fbsemantic.DictAddVType(builder, valueType.mt)
+	fbsemantic.DictAddV(builder, valueOffset)
+	return fbsemantic.DictEnd(builder)
+}
+
 // buildFunctionType will construct a fun type in the builder
 // and return the offset for the type.
 func buildFunctionType(builder *flatbuffers.Builder, retn MonoType, args []ArgumentType) flatbuffers.UOffsetT {
@@ -37,6 +37,23 @@ func TestNewArrayType(t *testing.T) {
 	}
 }
 
+func TestNewDictType(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.BasicString, semantic.NewArrayType(semantic.BasicInt))
+	if want, got := dictType.String(), "[string:[int]]"; want != got {
+		t.Errorf("unexpected monotype -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if want, got := dictType.Nature(), semantic.Dictionary; want != got {
+		t.Errorf("unexpected nature -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	keyType, err := dictType.KeyType()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := keyType, semantic.BasicString; !want.Equal(got) {
+		t.Errorf("unexpected key type -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
+
 func TestNewFunctionType(t *testing.T) {
 	functionType := semantic.NewFunctionType(
 		semantic.BasicString,
@@ -167,6 +167,19 @@ func TransformValue(v values.Value) map[string]interface{} {
 			"type":     semantic.Object.String(),
 			"elements": elements,
 		}
+	case semantic.Dictionary:
+		dict := v.(values.Dictionary)
+		elements := make([]map[string]interface{}, 0, dict.Len())
+		dict.Range(func(key, value values.Value) {
+			elements = append(elements, map[string]interface{}{
+				"key":   TransformValue(key),
+				"value": TransformValue(value),
+			})
+		})
+		return map[string]interface{}{
+			"type":     v.Type().String(),
+			"elements": elements,
+		}
 	case semantic.Function:
 		// Just use the function type when comparing functions
 		return map[string]interface{}{
@@ -21,22 +21,24 @@ const (
 	Array
 	Object
 	Function
+	Dictionary
 )
 
 var natureNames = []string{
-	Invalid:  "invalid",
-	String:   "string",
-	Bytes:    "bytes",
-	Int:      "int",
-	UInt:     "uint",
-	Float:    "float",
-	Bool:     "bool",
-	Time:     "time",
-	Duration: "duration",
-	Regexp:   "regexp",
-	Array:    "array",
-	Object:   "object",
-	Function: "function",
+	Invalid:    "invalid",
+	String:     "string",
+	Bytes:      "bytes",
+	Int:        "int",
+	UInt:       "uint",
+	Float:      "float",
+	Bool:       "bool",
+	Time:       "time",
+	Duration:   "duration",
+	Regexp:     "regexp",
+	Array:      "array",
+	Object:     "object",
+	Function:   "function",
+	Dictionary: "dictionary",
 }
 
 func (n Nature) String() string {
@@ -0,0 +1,145 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package array
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	runtime "github.com/influxdata/flux/runtime"
+)
+
+func init() {
+	runtime.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 15,
+					Line:   10,
+				},
+				File:   "array.flux",
+				Source: "package array\n\n// from will construct a table from an array of records.\n// Each record is a row of the table and each property\n// of the record becomes a column.\nbuiltin from\n\n// toRows will convert a table, such as one returned by tableFind,\n// into an array of records. It is the reverse of from.\nbuiltin toRows",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   6,
+					},
+					File:   "array.flux",
+					Source: "builtin from",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   6,
+						},
+						File:   "array.flux",
+						Source: "from",
+						Start: ast.Position{
+							Column: 9,
+							Line:   6,
+						},
+					},
+				},
+				Name: "from",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   10,
+					},
+					File:   "array.flux",
+					Source: "builtin toRows",
+					Start: ast.Position{
+						Column: 1,
+						Line:   10,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   10,
+						},
+						File:   "array.flux",
+						Source: "toRows",
+						Start: ast.Position{
+							Column: 9,
+							Line:   10,
+						},
+					},
+				},
+				Name: "toRows",
+			},
+		}},
+		Imports:  nil,
+		Metadata: "parser-type=rust",
+		Name:     "array.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   1,
+					},
+					File:   "array.flux",
+					Source: "package array",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   1,
+						},
+						File:   "array.flux",
+						Source: "array",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "array",
+			},
+		},
+	}},
+	Package: "array",
+	Path:    "array",
+}
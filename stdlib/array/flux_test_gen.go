@@ -0,0 +1,3109 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package array
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	parser "github.com/influxdata/flux/internal/parser"
+)
+
+var FluxTestPackages = []*ast.Package{&ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 4,
+					Line:   29,
+				},
+				File:   "from_test.flux",
+				Source: "package array_test\n\nimport \"testing\"\nimport \"array\"\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,1.72,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"\n\nt_from = (table=<-) =>\n\t(table\n\t\t|> map(fn: (r) => ({r with _value: r._value * 2.0})))\n\ntest _from = () =>\n\t({\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   14,
+					},
+					File:   "from_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,1.72,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   6,
+						},
+						File:   "from_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   6,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   14,
+						},
+						File:   "from_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,1.72,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   6,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,1.72,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 56,
+						Line:   18,
+					},
+					File:   "from_test.flux",
+					Source: "t_from = (table=<-) =>\n\t(table\n\t\t|> map(fn: (r) => ({r with _value: r._value * 2.0})))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   16,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   16,
+						},
+						File:   "from_test.flux",
+						Source: "t_from",
+						Start: ast.Position{
+							Column: 1,
+							Line:   16,
+						},
+					},
+				},
+				Name: "t_from",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 56,
+							Line:   18,
+						},
+						File:   "from_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> map(fn: (r) => ({r with _value: r._value * 2.0})))",
+						Start: ast.Position{
+							Column: 10,
+							Line:   16,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 56,
+								Line:   18,
+							},
+							File:   "from_test.flux",
+							Source: "(table\n\t\t|> map(fn: (r) => ({r with _value: r._value * 2.0})))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   17,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 8,
+										Line:   17,
+									},
+									File:   "from_test.flux",
+									Source: "table",
+									Start: ast.Position{
+										Column: 3,
+										Line:   17,
+									},
+								},
+							},
+							Name: "table",
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 55,
+									Line:   18,
+								},
+								File:   "from_test.flux",
+								Source: "table\n\t\t|> map(fn: (r) => ({r with _value: r._value * 2.0}))",
+								Start: ast.Position{
+									Column: 3,
+									Line:   17,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 54,
+											Line:   18,
+										},
+										File:   "from_test.flux",
+										Source: "fn: (r) => ({r with _value: r._value * 2.0})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   18,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 54,
+												Line:   18,
+											},
+											File:   "from_test.flux",
+											Source: "fn: (r) => ({r with _value: r._value * 2.0})",
+											Start: ast.Position{
+												Column: 10,
+												Line:   18,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 12,
+													Line:   18,
+												},
+												File:   "from_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 10,
+													Line:   18,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.FunctionExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 54,
+													Line:   18,
+												},
+												File:   "from_test.flux",
+												Source: "(r) => ({r with _value: r._value * 2.0})",
+												Start: ast.Position{
+													Column: 14,
+													Line:   18,
+												},
+											},
+										},
+										Body: &ast.ParenExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 54,
+														Line:   18,
+													},
+													File:   "from_test.flux",
+													Source: "({r with _value: r._value * 2.0})",
+													Start: ast.Position{
+														Column: 21,
+														Line:   18,
+													},
+												},
+											},
+											Expression: &ast.ObjectExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 53,
+															Line:   18,
+														},
+														File:   "from_test.flux",
+														Source: "{r with _value: r._value * 2.0}",
+														Start: ast.Position{
+															Column: 22,
+															Line:   18,
+														},
+													},
+												},
+												Properties: []*ast.Property{&ast.Property{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 52,
+																Line:   18,
+															},
+															File:   "from_test.flux",
+															Source: "_value: r._value * 2.0",
+															Start: ast.Position{
+																Column: 30,
+																Line:   18,
+															},
+														},
+													},
+													Key: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 36,
+																	Line:   18,
+																},
+																File:   "from_test.flux",
+																Source: "_value",
+																Start: ast.Position{
+																	Column: 30,
+																	Line:   18,
+																},
+															},
+														},
+														Name: "_value",
+													},
+													Value: &ast.BinaryExpression{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 52,
+																	Line:   18,
+																},
+																File:   "from_test.flux",
+																Source: "r._value * 2.0",
+																Start: ast.Position{
+																	Column: 38,
+																	Line:   18,
+																},
+															},
+														},
+														Left: &ast.MemberExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 46,
+																		Line:   18,
+																	},
+																	File:   "from_test.flux",
+																	Source: "r._value",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   18,
+																	},
+																},
+															},
+															Object: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 39,
+																			Line:   18,
+																		},
+																		File:   "from_test.flux",
+																		Source: "r",
+																		Start: ast.Position{
+																			Column: 38,
+																			Line:   18,
+																		},
+																	},
+																},
+																Name: "r",
+															},
+															Property: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 46,
+																			Line:   18,
+																		},
+																		File:   "from_test.flux",
+																		Source: "_value",
+																		Start: ast.Position{
+																			Column: 40,
+																			Line:   18,
+																		},
+																	},
+																},
+																Name: "_value",
+															},
+														},
+														Operator: 1,
+														Right: &ast.FloatLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 52,
+																		Line:   18,
+																	},
+																	File:   "from_test.flux",
+																	Source: "2.0",
+																	Start: ast.Position{
+																		Column: 49,
+																		Line:   18,
+																	},
+																},
+															},
+															Value: 2.0,
+														},
+													},
+												}},
+												With: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 24,
+																Line:   18,
+															},
+															File:   "from_test.flux",
+															Source: "r",
+															Start: ast.Position{
+																Column: 23,
+																Line:   18,
+															},
+														},
+													},
+													Name: "r",
+												},
+											},
+										},
+										Params: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   18,
+													},
+													File:   "from_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   18,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 16,
+															Line:   18,
+														},
+														File:   "from_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 15,
+															Line:   18,
+														},
+													},
+												},
+												Name: "r",
+											},
+											Value: nil,
+										}},
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 55,
+										Line:   18,
+									},
+									File:   "from_test.flux",
+									Source: "map(fn: (r) => ({r with _value: r._value * 2.0}))",
+									Start: ast.Position{
+										Column: 6,
+										Line:   18,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 9,
+											Line:   18,
+										},
+										File:   "from_test.flux",
+										Source: "map",
+										Start: ast.Position{
+											Column: 6,
+											Line:   18,
+										},
+									},
+								},
+								Name: "map",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 19,
+								Line:   16,
+							},
+							File:   "from_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 11,
+								Line:   16,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   16,
+								},
+								File:   "from_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 11,
+									Line:   16,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 19,
+								Line:   16,
+							},
+							File:   "from_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 17,
+								Line:   16,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   29,
+						},
+						File:   "from_test.flux",
+						Source: "_from = () =>\n\t({\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   20,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   20,
+							},
+							File:   "from_test.flux",
+							Source: "_from",
+							Start: ast.Position{
+								Column: 6,
+								Line:   20,
+							},
+						},
+					},
+					Name: "_from",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 4,
+								Line:   29,
+							},
+							File:   "from_test.flux",
+							Source: "() =>\n\t({\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t})",
+							Start: ast.Position{
+								Column: 14,
+								Line:   20,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 4,
+									Line:   29,
+								},
+								File:   "from_test.flux",
+								Source: "({\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   21,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 3,
+										Line:   29,
+									},
+									File:   "from_test.flux",
+									Source: "{\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   21,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 38,
+											Line:   22,
+										},
+										File:   "from_test.flux",
+										Source: "input: testing.loadMem(csv: inData)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   22,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   22,
+											},
+											File:   "from_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 3,
+												Line:   22,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 37,
+													Line:   22,
+												},
+												File:   "from_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 26,
+													Line:   22,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 37,
+														Line:   22,
+													},
+													File:   "from_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 26,
+														Line:   22,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 29,
+															Line:   22,
+														},
+														File:   "from_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 26,
+															Line:   22,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 37,
+															Line:   22,
+														},
+														File:   "from_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 31,
+															Line:   22,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 38,
+												Line:   22,
+											},
+											File:   "from_test.flux",
+											Source: "testing.loadMem(csv: inData)",
+											Start: ast.Position{
+												Column: 10,
+												Line:   22,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 25,
+													Line:   22,
+												},
+												File:   "from_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 10,
+													Line:   22,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   22,
+													},
+													File:   "from_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 10,
+														Line:   22,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 25,
+														Line:   22,
+													},
+													File:   "from_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 18,
+														Line:   22,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 5,
+											Line:   27,
+										},
+										File:   "from_test.flux",
+										Source: "want: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t])",
+										Start: ast.Position{
+											Column: 3,
+											Line:   23,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 7,
+												Line:   23,
+											},
+											File:   "from_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 3,
+												Line:   23,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 4,
+													Line:   27,
+												},
+												File:   "from_test.flux",
+												Source: "rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]",
+												Start: ast.Position{
+													Column: 20,
+													Line:   23,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 4,
+														Line:   27,
+													},
+													File:   "from_test.flux",
+													Source: "rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]",
+													Start: ast.Position{
+														Column: 20,
+														Line:   23,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 24,
+															Line:   23,
+														},
+														File:   "from_test.flux",
+														Source: "rows",
+														Start: ast.Position{
+															Column: 20,
+															Line:   23,
+														},
+													},
+												},
+												Name: "rows",
+											},
+											Value: &ast.ArrayExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 4,
+															Line:   27,
+														},
+														File:   "from_test.flux",
+														Source: "[\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]",
+														Start: ast.Position{
+															Column: 26,
+															Line:   23,
+														},
+													},
+												},
+												Elements: []ast.Expression{&ast.ObjectExpression{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 73,
+																Line:   24,
+															},
+															File:   "from_test.flux",
+															Source: "{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true}",
+															Start: ast.Position{
+																Column: 4,
+																Line:   24,
+															},
+														},
+													},
+													Properties: []*ast.Property{&ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 32,
+																	Line:   24,
+																},
+																File:   "from_test.flux",
+																Source: "_time: 2018-05-22T19:53:26Z",
+																Start: ast.Position{
+																	Column: 5,
+																	Line:   24,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 10,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_time",
+																	Start: ast.Position{
+																		Column: 5,
+																		Line:   24,
+																	},
+																},
+															},
+															Name: "_time",
+														},
+														Value: &ast.DateTimeLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 32,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "2018-05-22T19:53:26Z",
+																	Start: ast.Position{
+																		Column: 12,
+																		Line:   24,
+																	},
+																},
+															},
+															Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 48,
+																	Line:   24,
+																},
+																File:   "from_test.flux",
+																Source: "host: \"host.a\"",
+																Start: ast.Position{
+																	Column: 34,
+																	Line:   24,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 38,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "host",
+																	Start: ast.Position{
+																		Column: 34,
+																		Line:   24,
+																	},
+																},
+															},
+															Name: "host",
+														},
+														Value: &ast.StringLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 48,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "\"host.a\"",
+																	Start: ast.Position{
+																		Column: 40,
+																		Line:   24,
+																	},
+																},
+															},
+															Value: "host.a",
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 62,
+																	Line:   24,
+																},
+																File:   "from_test.flux",
+																Source: "_value: 3.66",
+																Start: ast.Position{
+																	Column: 50,
+																	Line:   24,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 56,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_value",
+																	Start: ast.Position{
+																		Column: 50,
+																		Line:   24,
+																	},
+																},
+															},
+															Name: "_value",
+														},
+														Value: &ast.FloatLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 62,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "3.66",
+																	Start: ast.Position{
+																		Column: 58,
+																		Line:   24,
+																	},
+																},
+															},
+															Value: 3.66,
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 72,
+																	Line:   24,
+																},
+																File:   "from_test.flux",
+																Source: "ok: true",
+																Start: ast.Position{
+																	Column: 64,
+																	Line:   24,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 66,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "ok",
+																	Start: ast.Position{
+																		Column: 64,
+																		Line:   24,
+																	},
+																},
+															},
+															Name: "ok",
+														},
+														Value: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 72,
+																		Line:   24,
+																	},
+																	File:   "from_test.flux",
+																	Source: "true",
+																	Start: ast.Position{
+																		Column: 68,
+																		Line:   24,
+																	},
+																},
+															},
+															Name: "true",
+														},
+													}},
+													With: nil,
+												}, &ast.ObjectExpression{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 74,
+																Line:   25,
+															},
+															File:   "from_test.flux",
+															Source: "{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false}",
+															Start: ast.Position{
+																Column: 4,
+																Line:   25,
+															},
+														},
+													},
+													Properties: []*ast.Property{&ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 32,
+																	Line:   25,
+																},
+																File:   "from_test.flux",
+																Source: "_time: 2018-05-22T19:53:36Z",
+																Start: ast.Position{
+																	Column: 5,
+																	Line:   25,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 10,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_time",
+																	Start: ast.Position{
+																		Column: 5,
+																		Line:   25,
+																	},
+																},
+															},
+															Name: "_time",
+														},
+														Value: &ast.DateTimeLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 32,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "2018-05-22T19:53:36Z",
+																	Start: ast.Position{
+																		Column: 12,
+																		Line:   25,
+																	},
+																},
+															},
+															Value: parser.MustParseTime("2018-05-22T19:53:36Z"),
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 48,
+																	Line:   25,
+																},
+																File:   "from_test.flux",
+																Source: "host: \"host.b\"",
+																Start: ast.Position{
+																	Column: 34,
+																	Line:   25,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 38,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "host",
+																	Start: ast.Position{
+																		Column: 34,
+																		Line:   25,
+																	},
+																},
+															},
+															Name: "host",
+														},
+														Value: &ast.StringLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 48,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "\"host.b\"",
+																	Start: ast.Position{
+																		Column: 40,
+																		Line:   25,
+																	},
+																},
+															},
+															Value: "host.b",
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 62,
+																	Line:   25,
+																},
+																File:   "from_test.flux",
+																Source: "_value: 3.44",
+																Start: ast.Position{
+																	Column: 50,
+																	Line:   25,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 56,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_value",
+																	Start: ast.Position{
+																		Column: 50,
+																		Line:   25,
+																	},
+																},
+															},
+															Name: "_value",
+														},
+														Value: &ast.FloatLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 62,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "3.44",
+																	Start: ast.Position{
+																		Column: 58,
+																		Line:   25,
+																	},
+																},
+															},
+															Value: 3.44,
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 73,
+																	Line:   25,
+																},
+																File:   "from_test.flux",
+																Source: "ok: false",
+																Start: ast.Position{
+																	Column: 64,
+																	Line:   25,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 66,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "ok",
+																	Start: ast.Position{
+																		Column: 64,
+																		Line:   25,
+																	},
+																},
+															},
+															Name: "ok",
+														},
+														Value: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 73,
+																		Line:   25,
+																	},
+																	File:   "from_test.flux",
+																	Source: "false",
+																	Start: ast.Position{
+																		Column: 68,
+																		Line:   25,
+																	},
+																},
+															},
+															Name: "false",
+														},
+													}},
+													With: nil,
+												}, &ast.ObjectExpression{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 72,
+																Line:   26,
+															},
+															File:   "from_test.flux",
+															Source: "{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true}",
+															Start: ast.Position{
+																Column: 4,
+																Line:   26,
+															},
+														},
+													},
+													Properties: []*ast.Property{&ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 32,
+																	Line:   26,
+																},
+																File:   "from_test.flux",
+																Source: "_time: 2018-05-22T19:53:46Z",
+																Start: ast.Position{
+																	Column: 5,
+																	Line:   26,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 10,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_time",
+																	Start: ast.Position{
+																		Column: 5,
+																		Line:   26,
+																	},
+																},
+															},
+															Name: "_time",
+														},
+														Value: &ast.DateTimeLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 32,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "2018-05-22T19:53:46Z",
+																	Start: ast.Position{
+																		Column: 12,
+																		Line:   26,
+																	},
+																},
+															},
+															Value: parser.MustParseTime("2018-05-22T19:53:46Z"),
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 48,
+																	Line:   26,
+																},
+																File:   "from_test.flux",
+																Source: "host: \"host.c\"",
+																Start: ast.Position{
+																	Column: 34,
+																	Line:   26,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 38,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "host",
+																	Start: ast.Position{
+																		Column: 34,
+																		Line:   26,
+																	},
+																},
+															},
+															Name: "host",
+														},
+														Value: &ast.StringLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 48,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "\"host.c\"",
+																	Start: ast.Position{
+																		Column: 40,
+																		Line:   26,
+																	},
+																},
+															},
+															Value: "host.c",
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 61,
+																	Line:   26,
+																},
+																File:   "from_test.flux",
+																Source: "_value: 4.3",
+																Start: ast.Position{
+																	Column: 50,
+																	Line:   26,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 56,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "_value",
+																	Start: ast.Position{
+																		Column: 50,
+																		Line:   26,
+																	},
+																},
+															},
+															Name: "_value",
+														},
+														Value: &ast.FloatLiteral{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 61,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "4.3",
+																	Start: ast.Position{
+																		Column: 58,
+																		Line:   26,
+																	},
+																},
+															},
+															Value: 4.3,
+														},
+													}, &ast.Property{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 71,
+																	Line:   26,
+																},
+																File:   "from_test.flux",
+																Source: "ok: true",
+																Start: ast.Position{
+																	Column: 63,
+																	Line:   26,
+																},
+															},
+														},
+														Key: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 65,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "ok",
+																	Start: ast.Position{
+																		Column: 63,
+																		Line:   26,
+																	},
+																},
+															},
+															Name: "ok",
+														},
+														Value: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 71,
+																		Line:   26,
+																	},
+																	File:   "from_test.flux",
+																	Source: "true",
+																	Start: ast.Position{
+																		Column: 67,
+																		Line:   26,
+																	},
+																},
+															},
+															Name: "true",
+														},
+													}},
+													With: nil,
+												}},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   27,
+											},
+											File:   "from_test.flux",
+											Source: "array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t])",
+											Start: ast.Position{
+												Column: 9,
+												Line:   23,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 19,
+													Line:   23,
+												},
+												File:   "from_test.flux",
+												Source: "array.from",
+												Start: ast.Position{
+													Column: 9,
+													Line:   23,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 14,
+														Line:   23,
+													},
+													File:   "from_test.flux",
+													Source: "array",
+													Start: ast.Position{
+														Column: 9,
+														Line:   23,
+													},
+												},
+											},
+											Name: "array",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 19,
+														Line:   23,
+													},
+													File:   "from_test.flux",
+													Source: "from",
+													Start: ast.Position{
+														Column: 15,
+														Line:   23,
+													},
+												},
+											},
+											Name: "from",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 13,
+											Line:   28,
+										},
+										File:   "from_test.flux",
+										Source: "fn: t_from",
+										Start: ast.Position{
+											Column: 3,
+											Line:   28,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   28,
+											},
+											File:   "from_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 3,
+												Line:   28,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 13,
+												Line:   28,
+											},
+											File:   "from_test.flux",
+											Source: "t_from",
+											Start: ast.Position{
+												Column: 7,
+												Line:   28,
+											},
+										},
+									},
+									Name: "t_from",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 4,
+						Line:   29,
+					},
+					File:   "from_test.flux",
+					Source: "test _from = () =>\n\t({\n\t\tinput: testing.loadMem(csv: inData),\n\t\twant: array.from(rows: [\n\t\t\t{_time: 2018-05-22T19:53:26Z, host: \"host.a\", _value: 3.66, ok: true},\n\t\t\t{_time: 2018-05-22T19:53:36Z, host: \"host.b\", _value: 3.44, ok: false},\n\t\t\t{_time: 2018-05-22T19:53:46Z, host: \"host.c\", _value: 4.3, ok: true},\n\t\t]),\n\t\tfn: t_from,\n\t})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   20,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "from_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "from_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   4,
+					},
+					File:   "from_test.flux",
+					Source: "import \"array\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   4,
+						},
+						File:   "from_test.flux",
+						Source: "\"array\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "array",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "from_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 19,
+						Line:   1,
+					},
+					File:   "from_test.flux",
+					Source: "package array_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 19,
+							Line:   1,
+						},
+						File:   "from_test.flux",
+						Source: "array_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "array_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 92,
+					Line:   31,
+				},
+				File:   "rows_test.flux",
+				Source: "package array_test\n\nimport \"testing\"\nimport \"array\"\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"\noutData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"\n\nt_toRows = (table=<-) =>\n\t(array.from(rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()))\n\ntest _toRows = () =>\n\t({input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   14,
+					},
+					File:   "rows_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   6,
+						},
+						File:   "rows_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   6,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   14,
+						},
+						File:   "rows_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   6,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   23,
+					},
+					File:   "rows_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   15,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   15,
+						},
+						File:   "rows_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   15,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   23,
+						},
+						File:   "rows_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   15,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,string,double,boolean\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,_time,host,_value,ok\n,,0,2018-05-22T19:53:26Z,host.a,1.83,true\n,,0,2018-05-22T19:53:36Z,host.b,,false\n,,0,2018-05-22T19:53:46Z,host.c,2.15,true\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 22,
+						Line:   28,
+					},
+					File:   "rows_test.flux",
+					Source: "t_toRows = (table=<-) =>\n\t(array.from(rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   25,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   25,
+						},
+						File:   "rows_test.flux",
+						Source: "t_toRows",
+						Start: ast.Position{
+							Column: 1,
+							Line:   25,
+						},
+					},
+				},
+				Name: "t_toRows",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 22,
+							Line:   28,
+						},
+						File:   "rows_test.flux",
+						Source: "(table=<-) =>\n\t(array.from(rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()))",
+						Start: ast.Position{
+							Column: 12,
+							Line:   25,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 22,
+								Line:   28,
+							},
+							File:   "rows_test.flux",
+							Source: "(array.from(rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   26,
+							},
+						},
+					},
+					Expression: &ast.CallExpression{
+						Arguments: []ast.Expression{&ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 20,
+										Line:   28,
+									},
+									File:   "rows_test.flux",
+									Source: "rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()",
+									Start: ast.Position{
+										Column: 14,
+										Line:   26,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 20,
+											Line:   28,
+										},
+										File:   "rows_test.flux",
+										Source: "rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()",
+										Start: ast.Position{
+											Column: 14,
+											Line:   26,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 18,
+												Line:   26,
+											},
+											File:   "rows_test.flux",
+											Source: "rows",
+											Start: ast.Position{
+												Column: 14,
+												Line:   26,
+											},
+										},
+									},
+									Name: "rows",
+								},
+								Value: &ast.PipeExpression{
+									Argument: &ast.PipeExpression{
+										Argument: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 25,
+														Line:   26,
+													},
+													File:   "rows_test.flux",
+													Source: "table",
+													Start: ast.Position{
+														Column: 20,
+														Line:   26,
+													},
+												},
+											},
+											Name: "table",
+										},
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 34,
+													Line:   27,
+												},
+												File:   "rows_test.flux",
+												Source: "table\n\t\t|> tableFind(fn: (key) => true)",
+												Start: ast.Position{
+													Column: 20,
+													Line:   26,
+												},
+											},
+										},
+										Call: &ast.CallExpression{
+											Arguments: []ast.Expression{&ast.ObjectExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 33,
+															Line:   27,
+														},
+														File:   "rows_test.flux",
+														Source: "fn: (key) => true",
+														Start: ast.Position{
+															Column: 16,
+															Line:   27,
+														},
+													},
+												},
+												Properties: []*ast.Property{&ast.Property{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 33,
+																Line:   27,
+															},
+															File:   "rows_test.flux",
+															Source: "fn: (key) => true",
+															Start: ast.Position{
+																Column: 16,
+																Line:   27,
+															},
+														},
+													},
+													Key: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 18,
+																	Line:   27,
+																},
+																File:   "rows_test.flux",
+																Source: "fn",
+																Start: ast.Position{
+																	Column: 16,
+																	Line:   27,
+																},
+															},
+														},
+														Name: "fn",
+													},
+													Value: &ast.FunctionExpression{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 33,
+																	Line:   27,
+																},
+																File:   "rows_test.flux",
+																Source: "(key) => true",
+																Start: ast.Position{
+																	Column: 20,
+																	Line:   27,
+																},
+															},
+														},
+														Body: &ast.Identifier{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 33,
+																		Line:   27,
+																	},
+																	File:   "rows_test.flux",
+																	Source: "true",
+																	Start: ast.Position{
+																		Column: 29,
+																		Line:   27,
+																	},
+																},
+															},
+															Name: "true",
+														},
+														Params: []*ast.Property{&ast.Property{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 24,
+																		Line:   27,
+																	},
+																	File:   "rows_test.flux",
+																	Source: "key",
+																	Start: ast.Position{
+																		Column: 21,
+																		Line:   27,
+																	},
+																},
+															},
+															Key: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 24,
+																			Line:   27,
+																		},
+																		File:   "rows_test.flux",
+																		Source: "key",
+																		Start: ast.Position{
+																			Column: 21,
+																			Line:   27,
+																		},
+																	},
+																},
+																Name: "key",
+															},
+															Value: nil,
+														}},
+													},
+												}},
+												With: nil,
+											}},
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 34,
+														Line:   27,
+													},
+													File:   "rows_test.flux",
+													Source: "tableFind(fn: (key) => true)",
+													Start: ast.Position{
+														Column: 6,
+														Line:   27,
+													},
+												},
+											},
+											Callee: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 15,
+															Line:   27,
+														},
+														File:   "rows_test.flux",
+														Source: "tableFind",
+														Start: ast.Position{
+															Column: 6,
+															Line:   27,
+														},
+													},
+												},
+												Name: "tableFind",
+											},
+										},
+									},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 20,
+												Line:   28,
+											},
+											File:   "rows_test.flux",
+											Source: "table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows()",
+											Start: ast.Position{
+												Column: 20,
+												Line:   26,
+											},
+										},
+									},
+									Call: &ast.CallExpression{
+										Arguments: nil,
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 20,
+													Line:   28,
+												},
+												File:   "rows_test.flux",
+												Source: "array.toRows()",
+												Start: ast.Position{
+													Column: 6,
+													Line:   28,
+												},
+											},
+										},
+										Callee: &ast.MemberExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   28,
+													},
+													File:   "rows_test.flux",
+													Source: "array.toRows",
+													Start: ast.Position{
+														Column: 6,
+														Line:   28,
+													},
+												},
+											},
+											Object: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 11,
+															Line:   28,
+														},
+														File:   "rows_test.flux",
+														Source: "array",
+														Start: ast.Position{
+															Column: 6,
+															Line:   28,
+														},
+													},
+												},
+												Name: "array",
+											},
+											Property: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 18,
+															Line:   28,
+														},
+														File:   "rows_test.flux",
+														Source: "toRows",
+														Start: ast.Position{
+															Column: 12,
+															Line:   28,
+														},
+													},
+												},
+												Name: "toRows",
+											},
+										},
+									},
+								},
+							}},
+							With: nil,
+						}},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 21,
+									Line:   28,
+								},
+								File:   "rows_test.flux",
+								Source: "array.from(rows: table\n\t\t|> tableFind(fn: (key) => true)\n\t\t|> array.toRows())",
+								Start: ast.Position{
+									Column: 3,
+									Line:   26,
+								},
+							},
+						},
+						Callee: &ast.MemberExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 13,
+										Line:   26,
+									},
+									File:   "rows_test.flux",
+									Source: "array.from",
+									Start: ast.Position{
+										Column: 3,
+										Line:   26,
+									},
+								},
+							},
+							Object: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 8,
+											Line:   26,
+										},
+										File:   "rows_test.flux",
+										Source: "array",
+										Start: ast.Position{
+											Column: 3,
+											Line:   26,
+										},
+									},
+								},
+								Name: "array",
+							},
+							Property: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 13,
+											Line:   26,
+										},
+										File:   "rows_test.flux",
+										Source: "from",
+										Start: ast.Position{
+											Column: 9,
+											Line:   26,
+										},
+									},
+								},
+								Name: "from",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 21,
+								Line:   25,
+							},
+							File:   "rows_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 13,
+								Line:   25,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 18,
+									Line:   25,
+								},
+								File:   "rows_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 13,
+									Line:   25,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 21,
+								Line:   25,
+							},
+							File:   "rows_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 19,
+								Line:   25,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 92,
+							Line:   31,
+						},
+						File:   "rows_test.flux",
+						Source: "_toRows = () =>\n\t({input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   30,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 13,
+								Line:   30,
+							},
+							File:   "rows_test.flux",
+							Source: "_toRows",
+							Start: ast.Position{
+								Column: 6,
+								Line:   30,
+							},
+						},
+					},
+					Name: "_toRows",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 92,
+								Line:   31,
+							},
+							File:   "rows_test.flux",
+							Source: "() =>\n\t({input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows})",
+							Start: ast.Position{
+								Column: 16,
+								Line:   30,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 92,
+									Line:   31,
+								},
+								File:   "rows_test.flux",
+								Source: "({input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   31,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 91,
+										Line:   31,
+									},
+									File:   "rows_test.flux",
+									Source: "{input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   31,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 39,
+											Line:   31,
+										},
+										File:   "rows_test.flux",
+										Source: "input: testing.loadMem(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   31,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   31,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 38,
+													Line:   31,
+												},
+												File:   "rows_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 27,
+													Line:   31,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 38,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 27,
+														Line:   31,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 30,
+															Line:   31,
+														},
+														File:   "rows_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 27,
+															Line:   31,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 38,
+															Line:   31,
+														},
+														File:   "rows_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 32,
+															Line:   31,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "testing.loadMem(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   31,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   31,
+												},
+												File:   "rows_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 11,
+													Line:   31,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   31,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 26,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 19,
+														Line:   31,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 76,
+											Line:   31,
+										},
+										File:   "rows_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 41,
+											Line:   31,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 41,
+												Line:   31,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 75,
+													Line:   31,
+												},
+												File:   "rows_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 63,
+													Line:   31,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 75,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 63,
+														Line:   31,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 66,
+															Line:   31,
+														},
+														File:   "rows_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 63,
+															Line:   31,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 75,
+															Line:   31,
+														},
+														File:   "rows_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 68,
+															Line:   31,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 76,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 47,
+												Line:   31,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 62,
+													Line:   31,
+												},
+												File:   "rows_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 47,
+													Line:   31,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 54,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 47,
+														Line:   31,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 62,
+														Line:   31,
+													},
+													File:   "rows_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 55,
+														Line:   31,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 90,
+											Line:   31,
+										},
+										File:   "rows_test.flux",
+										Source: "fn: t_toRows",
+										Start: ast.Position{
+											Column: 78,
+											Line:   31,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 78,
+												Line:   31,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 90,
+												Line:   31,
+											},
+											File:   "rows_test.flux",
+											Source: "t_toRows",
+											Start: ast.Position{
+												Column: 82,
+												Line:   31,
+											},
+										},
+									},
+									Name: "t_toRows",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 92,
+						Line:   31,
+					},
+					File:   "rows_test.flux",
+					Source: "test _toRows = () =>\n\t({input: testing.loadMem(csv: inData), want: testing.loadMem(csv: outData), fn: t_toRows})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   30,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "rows_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "rows_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   4,
+					},
+					File:   "rows_test.flux",
+					Source: "import \"array\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   4,
+						},
+						File:   "rows_test.flux",
+						Source: "\"array\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "array",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "rows_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 19,
+						Line:   1,
+					},
+					File:   "rows_test.flux",
+					Source: "package array_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 19,
+							Line:   1,
+						},
+						File:   "rows_test.flux",
+						Source: "array_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "array_test",
+			},
+		},
+	}},
+	Package: "array_test",
+	Path:    "array",
+}}
@@ -0,0 +1,219 @@
+package array
+
+import (
+	"context"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	pkgpath  = "array"
+	FromKind = "fromArray"
+)
+
+type FromOpSpec struct {
+	Rows values.Array
+}
+
+func init() {
+	fromSignature := runtime.MustLookupBuiltinType(pkgpath, "from")
+	runtime.RegisterPackageValue(pkgpath, "from", flux.MustValue(flux.FunctionValue(FromKind, createFromOpSpec, fromSignature)))
+	flux.RegisterOpSpec(FromKind, newFromOp)
+	plan.RegisterProcedureSpec(FromKind, newFromProcedure, FromKind)
+	execute.RegisterSource(FromKind, createFromSource)
+}
+
+func createFromOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	rows, err := args.GetRequiredArray("rows", semantic.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &FromOpSpec{Rows: rows}, nil
+}
+
+func newFromOp() flux.OperationSpec {
+	return new(FromOpSpec)
+}
+
+func (s *FromOpSpec) Kind() flux.OperationKind {
+	return FromKind
+}
+
+type FromProcedureSpec struct {
+	Rows values.Array
+}
+
+func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &FromProcedureSpec{Rows: spec.Rows}, nil
+}
+
+func (s *FromProcedureSpec) Kind() plan.ProcedureKind {
+	return FromKind
+}
+
+func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
+	// The rows are never modified so they can be shared.
+	ns := *s
+	return &ns
+}
+
+func (s *FromProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	n := int64(s.Rows.Len())
+	stats := plan.Statistics{
+		Cardinality:      n,
+		GroupCardinality: 1,
+	}
+	if cols, err := ColumnsFromType(s.Rows.Type()); err == nil {
+		stats.Columns = make([]string, len(cols))
+		for i, c := range cols {
+			stats.Columns[i] = c.Label
+		}
+	}
+	return plan.Cost{MEM: n}, stats
+}
+
+func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+
+	cols, err := ColumnsFromType(spec.Rows.Type())
+	if err != nil {
+		return nil, err
+	}
+	return &tableSource{
+		id:    dsid,
+		cols:  cols,
+		rows:  spec.Rows,
+		alloc: a.Allocator(),
+	}, nil
+}
+
+// ColumnsFromType infers the columns of a table from the type of
+// an array of records. Each property of the record type is a column
+// and the columns are sorted by their label.
+func ColumnsFromType(arrType semantic.MonoType) ([]flux.ColMeta, error) {
+	elemType, err := arrType.ElemType()
+	if err != nil {
+		return nil, err
+	}
+	if elemType.Nature() != semantic.Object {
+		return nil, errors.Newf(codes.Invalid, "rows must be an array of records, got %v", arrType)
+	}
+
+	props, err := elemType.SortedProperties()
+	if err != nil {
+		return nil, err
+	}
+	cols := make([]flux.ColMeta, len(props))
+	for i, prop := range props {
+		typ, err := prop.TypeOf()
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = flux.ColMeta{
+			Label: prop.Name(),
+			Type:  flux.ColumnType(typ),
+		}
+		if cols[i].Type == flux.TInvalid {
+			return nil, errors.Newf(codes.Invalid, "cannot create column %q from a value of type %v", prop.Name(), typ)
+		}
+	}
+	return cols, nil
+}
+
+// tableSource produces a single table from an array of records.
+type tableSource struct {
+	id    execute.DatasetID
+	ts    []execute.Transformation
+	cols  []flux.ColMeta
+	rows  values.Array
+	alloc *memory.Allocator
+}
+
+func (s *tableSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *tableSource) Run(ctx context.Context) {
+	err := s.run()
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *tableSource) run() error {
+	for _, t := range s.ts {
+		// Each transformation receives its own table because
+		// a table can only be consumed once.
+		tbl, err := s.table()
+		if err != nil {
+			return err
+		}
+		if err := t.Process(s.id, tbl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// table builds a table from the rows using arrow builders.
+func (s *tableSource) table() (flux.Table, error) {
+	mem := arrow.NewAllocator(s.alloc)
+	builders := make([]array.Builder, len(s.cols))
+	for j, col := range s.cols {
+		builders[j] = arrow.NewBuilder(col.Type, mem)
+		builders[j].Reserve(s.rows.Len())
+	}
+
+	var err error
+	s.rows.Range(func(i int, row values.Value) {
+		if err != nil {
+			return
+		}
+		for j, col := range s.cols {
+			v, ok := row.Object().Get(col.Label)
+			if !ok || v.IsNull() {
+				builders[j].AppendNull()
+				continue
+			}
+			if err = arrow.AppendValue(builders[j], v); err != nil {
+				return
+			}
+		}
+	})
+
+	buffer := &arrow.TableBuffer{
+		GroupKey: execute.NewGroupKey(nil, nil),
+		Columns:  s.cols,
+		Values:   make([]array.Interface, len(builders)),
+	}
+	for j, b := range builders {
+		buffer.Values[j] = b.NewArray()
+	}
+	if err != nil {
+		buffer.Release()
+		return nil, err
+	}
+	if err := buffer.Validate(); err != nil {
+		buffer.Release()
+		return nil, err
+	}
+	return table.FromBuffer(buffer), nil
+}
@@ -0,0 +1,79 @@
+package array
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestFrom_Table(t *testing.T) {
+	rowType := semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte("_value"), Value: semantic.BasicFloat},
+		{Key: []byte("host"), Value: semantic.BasicString},
+		{Key: []byte("_time"), Value: semantic.BasicTime},
+	})
+	newRow := func(ts int64, host string, v values.Value) values.Value {
+		return values.NewObjectWithValues(map[string]values.Value{
+			"_time":  values.NewTime(values.Time(ts)),
+			"host":   values.NewString(host),
+			"_value": v,
+		})
+	}
+	rows := values.NewArrayWithBacking(semantic.NewArrayType(rowType), []values.Value{
+		newRow(0, "a", values.NewFloat(1)),
+		newRow(10, "b", values.NewNull(semantic.BasicFloat)),
+		newRow(20, "c", values.NewFloat(3)),
+	})
+
+	cols, err := ColumnsFromType(rows.Type())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mem := &memory.Allocator{}
+	s := &tableSource{cols: cols, rows: rows, alloc: mem}
+	tbl, err := s.table()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "host", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{values.Time(0), 1.0, "a"},
+			{values.Time(10), nil, "b"},
+			{values.Time(20), 3.0, "c"},
+		},
+	}
+	want.Normalize()
+	got.Normalize()
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected table -want/+got:\n%s", cmp.Diff(want, got))
+	}
+
+	if n := mem.Allocated(); n != 0 {
+		t.Fatalf("expected all memory to be released, got %d bytes", n)
+	}
+}
+
+func TestFrom_InvalidColumnType(t *testing.T) {
+	rowType := semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte("d"), Value: semantic.BasicDuration},
+	})
+	if _, err := ColumnsFromType(semantic.NewArrayType(rowType)); err == nil {
+		t.Fatal("expected error for a duration column")
+	}
+}
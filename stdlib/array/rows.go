@@ -0,0 +1,67 @@
+package array
+
+import (
+	"context"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/flux/values/objects"
+)
+
+const toRowsTableArg = "table"
+
+func init() {
+	runtime.RegisterPackageValue(pkgpath, "toRows", NewToRowsFunction())
+}
+
+// NewToRowsFunction returns the function that converts a table,
+// such as one returned by tableFind, into an array of records.
+// It is the reverse of from.
+func NewToRowsFunction() values.Value {
+	return values.NewFunction("toRows",
+		runtime.MustLookupBuiltinType(pkgpath, "toRows"),
+		toRowsCall,
+		false)
+}
+
+func toRowsCall(ctx context.Context, args values.Object) (values.Value, error) {
+	arguments := interpreter.NewArguments(args)
+	var tbl flux.Table
+	if v, err := arguments.GetRequired(toRowsTableArg); err != nil {
+		return nil, err
+	} else if v.Type() != objects.TableMonoType {
+		return nil, errors.Newf(codes.Invalid, "unexpected type for %s: want %v, got %v", toRowsTableArg, objects.TableMonoType, v.Type())
+	} else {
+		tbl = v.(*objects.Table).Table()
+	}
+
+	properties := make([]semantic.PropertyType, len(tbl.Cols()))
+	for j, c := range tbl.Cols() {
+		properties[j] = semantic.PropertyType{
+			Key:   []byte(c.Label),
+			Value: flux.SemanticType(c.Type),
+		}
+	}
+	rowType := semantic.NewObjectType(properties)
+
+	var rows []values.Value
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		for i := 0; i < cr.Len(); i++ {
+			r := values.NewObject(rowType)
+			for j, c := range cr.Cols() {
+				r.Set(c.Label, execute.ValueForRow(cr, i, j))
+			}
+			rows = append(rows, r)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return values.NewArrayWithBacking(semantic.NewArrayType(rowType), rows), nil
+}
@@ -0,0 +1,82 @@
+package array
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/influxdata/flux/values/objects"
+)
+
+func TestToRows(t *testing.T) {
+	tbl, err := objects.NewTable(&executetest.Table{
+		ColMeta: []flux.ColMeta{
+			{Label: "_time", Type: flux.TTime},
+			{Label: "_value", Type: flux.TFloat},
+			{Label: "host", Type: flux.TString},
+		},
+		Data: [][]interface{}{
+			{values.Time(0), 1.0, "a"},
+			{values.Time(10), nil, "b"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := toRowsCall(context.Background(), values.NewObjectWithValues(map[string]values.Value{
+		"table": tbl,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := values.NewArrayWithBacking(
+		semantic.NewArrayType(semantic.NewObjectType([]semantic.PropertyType{
+			{Key: []byte("_time"), Value: semantic.BasicTime},
+			{Key: []byte("_value"), Value: semantic.BasicFloat},
+			{Key: []byte("host"), Value: semantic.BasicString},
+		})),
+		[]values.Value{
+			values.NewObjectWithValues(map[string]values.Value{
+				"_time":  values.NewTime(0),
+				"_value": values.NewFloat(1),
+				"host":   values.NewString("a"),
+			}),
+			values.NewObjectWithValues(map[string]values.Value{
+				"_time":  values.NewTime(10),
+				"_value": values.NewNull(semantic.BasicFloat),
+				"host":   values.NewString("b"),
+			}),
+		},
+	)
+	if !got.Type().Equal(want.Type()) {
+		t.Fatalf("unexpected type: want %v, got %v", want.Type(), got.Type())
+	}
+	rows := got.Array()
+	if rows.Len() != want.Len() {
+		t.Fatalf("unexpected number of rows: want %d, got %d", want.Len(), rows.Len())
+	}
+	want.Range(func(i int, row values.Value) {
+		row.Object().Range(func(k string, wv values.Value) {
+			gv, _ := rows.Get(i).Object().Get(k)
+			// Null values are never equal so they are compared separately.
+			if wv.IsNull() && gv.IsNull() {
+				return
+			}
+			if !wv.Equal(gv) {
+				t.Errorf("unexpected value for %s in row %d: want %v, got %v", k, i, wv, gv)
+			}
+		})
+	})
+}
+
+func TestToRows_InvalidTable(t *testing.T) {
+	if _, err := toRowsCall(context.Background(), values.NewObjectWithValues(map[string]values.Value{
+		"table": values.NewString("a"),
+	})); err == nil {
+		t.Fatal("expected error for a value that is not a table")
+	}
+}
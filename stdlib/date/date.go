@@ -17,421 +17,127 @@ import (
 
 var SpecialFns map[string]values.Function
 
-func init() {
-	SpecialFns = map[string]values.Function{
-		"second": values.NewFunction(
-			"second",
-			runtime.MustLookupBuiltinType("date", "second"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Second())), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					second := nowTime.Add(v1.Duration().Duration()).Second()
-					return values.NewInt(int64(second)), nil
-				}
-
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"minute": values.NewFunction(
-			"minute",
-			runtime.MustLookupBuiltinType("date", "minute"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Minute())), nil
-				}
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					minute := nowTime.Add(v1.Duration().Duration()).Minute()
-					return values.NewInt(int64(minute)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"hour": values.NewFunction(
-			"hour",
-			runtime.MustLookupBuiltinType("date", "hour"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Hour())), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					hour := nowTime.Add(v1.Duration().Duration()).Hour()
-					return values.NewInt(int64(hour)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"weekDay": values.NewFunction(
-			"weekDay",
-			runtime.MustLookupBuiltinType("date", "weekDay"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Weekday())), nil
-				}
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					weekDay := nowTime.Add(v1.Duration().Duration()).Weekday()
-					return values.NewInt(int64(weekDay)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"monthDay": values.NewFunction(
-			"monthDay",
-			runtime.MustLookupBuiltinType("date", "monthDay"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Day())), nil
-				}
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					day := nowTime.Add(v1.Duration().Duration()).Day()
-					return values.NewInt(int64(day)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"yearDay": values.NewFunction(
-			"yearDay",
-			runtime.MustLookupBuiltinType("date", "yearDay"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().YearDay())), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					yearDay := nowTime.Add(v1.Duration().Duration()).YearDay()
-					return values.NewInt(int64(yearDay)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"month": values.NewFunction(
-			"month",
-			runtime.MustLookupBuiltinType("date", "month"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Month())), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					month := nowTime.Add(v1.Duration().Duration()).Month()
-					return values.NewInt(int64(month)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"year": values.NewFunction(
-			"year",
-			runtime.MustLookupBuiltinType("date", "year"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Year())), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					year := nowTime.Add(v1.Duration().Duration()).Year()
-					return values.NewInt(int64(year)), nil
-				}
-
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"week": values.NewFunction(
-			"week",
-			runtime.MustLookupBuiltinType("date", "week"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					_, week := v1.Time().Time().ISOWeek()
-					return values.NewInt(int64(week)), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					_, week := nowTime.Add(v1.Duration().Duration()).ISOWeek()
-					return values.NewInt(int64(week)), nil
-				}
-
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"quarter": values.NewFunction(
-			"quarter",
-			runtime.MustLookupBuiltinType("date", "quarter"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					month := v1.Time().Time().Month()
-					return values.NewInt(int64(math.Ceil(float64(month) / 3.0))), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					month := nowTime.Add(v1.Duration().Duration()).Month()
-					return values.NewInt(int64(math.Ceil(float64(month) / 3.0))), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"millisecond": values.NewFunction(
-			"millisecond",
-			runtime.MustLookupBuiltinType("date", "millisecond"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					millisecond := int64(time.Nanosecond) * int64(v1.Time().Time().Nanosecond()) / int64(time.Millisecond)
-					return values.NewInt(millisecond), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					return values.NewInt(int64(nowTime.Add(v1.Duration().Duration()).Nanosecond()) / int64(time.Millisecond)), nil
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"microsecond": values.NewFunction(
-			"microsecond",
-			runtime.MustLookupBuiltinType("date", "microsecond"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					microsecond := int64(time.Nanosecond) * int64(v1.Time().Time().Nanosecond()) / int64(time.Microsecond)
-					return values.NewInt(microsecond), nil
-				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					return values.NewInt(int64(nowTime.Add(v1.Duration().Duration()).Nanosecond()) / int64(time.Microsecond)), nil
-				}
+// fieldFns extract a field from a time that has been
+// converted into the wall clock of a location.
+var fieldFns = []struct {
+	name string
+	fn   func(t time.Time) int64
+}{
+	{name: "second", fn: func(t time.Time) int64 { return int64(t.Second()) }},
+	{name: "minute", fn: func(t time.Time) int64 { return int64(t.Minute()) }},
+	{name: "hour", fn: func(t time.Time) int64 { return int64(t.Hour()) }},
+	{name: "weekDay", fn: func(t time.Time) int64 { return int64(t.Weekday()) }},
+	{name: "monthDay", fn: func(t time.Time) int64 { return int64(t.Day()) }},
+	{name: "yearDay", fn: func(t time.Time) int64 { return int64(t.YearDay()) }},
+	{name: "month", fn: func(t time.Time) int64 { return int64(t.Month()) }},
+	{name: "year", fn: func(t time.Time) int64 { return int64(t.Year()) }},
+	{name: "week", fn: func(t time.Time) int64 {
+		_, week := t.ISOWeek()
+		return int64(week)
+	}},
+	{name: "quarter", fn: func(t time.Time) int64 {
+		return int64(math.Ceil(float64(t.Month()) / 3.0))
+	}},
+	{name: "millisecond", fn: func(t time.Time) int64 {
+		return int64(t.Nanosecond()) / int64(time.Millisecond)
+	}},
+	{name: "microsecond", fn: func(t time.Time) int64 {
+		return int64(t.Nanosecond()) / int64(time.Microsecond)
+	}},
+	{name: "nanosecond", fn: func(t time.Time) int64 { return int64(t.Nanosecond()) }},
+}
 
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
-			}, false,
-		),
-		"nanosecond": values.NewFunction(
-			"nanosecond",
-			runtime.MustLookupBuiltinType("date", "nanosecond"),
+func init() {
+	SpecialFns = make(map[string]values.Function, len(fieldFns)+1)
+	for _, field := range fieldFns {
+		fn := field.fn
+		SpecialFns[field.name] = values.NewFunction(
+			field.name,
+			runtime.MustLookupBuiltinType("date", field.name),
 			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v1, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
+				t, err := getTime(ctx, args, "cannot convert argument t of type %v to time")
+				if err != nil {
+					return nil, err
 				}
-
-				if v1 == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				if v1.Type().Nature() == semantic.Time {
-					return values.NewInt(int64(v1.Time().Time().Nanosecond())), nil
+				loc, err := getLocation(ctx, args)
+				if err != nil {
+					return nil, err
 				}
-
-				if v1.Type().Nature() == semantic.Duration {
-					deps := execdeps.GetExecutionDependencies(ctx)
-					nowTime := *deps.Now
-
-					return values.NewInt(int64(nowTime.Add(v1.Duration().Duration()).Nanosecond())), nil
-				}
-
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot convert argument t of type %v to time", v1.Type().Nature()))
+				return values.NewInt(fn(loc.ToLocal(t).Time())), nil
 			}, false,
-		),
-		"truncate": values.NewFunction(
-			"truncate",
-			runtime.MustLookupBuiltinType("date", "truncate"),
-			func(ctx context.Context, args values.Object) (values.Value, error) {
-				v, ok := args.Get("t")
-				if !ok {
-					return nil, errors.New(codes.Invalid, "missing argument t")
-				}
-
-				if v == nil {
-					return nil, errors.New(codes.FailedPrecondition, "argument t was nil")
-				}
-
-				u, unitOk := args.Get("unit")
-				if !unitOk {
-					return nil, errors.New(codes.Invalid, "missing argument unit")
-				}
-
-				if values.IsTimeable(v) && u.Type().Nature() == semantic.Duration {
-					if v.Type().Nature() == semantic.Time {
-						w, err := execute.NewWindow(u.Duration(), u.Duration(), execute.Duration{})
-						if err != nil {
-							return nil, err
-						}
-						b := w.GetEarliestBounds(v.Time())
-						return values.NewTime(b.Start), nil
-					}
-
-					if v.Type().Nature() == semantic.Duration {
+		)
+	}
+	SpecialFns["truncate"] = values.NewFunction(
+		"truncate",
+		runtime.MustLookupBuiltinType("date", "truncate"),
+		func(ctx context.Context, args values.Object) (values.Value, error) {
+			t, err := getTime(ctx, args, "cannot truncate argument t of type %v")
+			if err != nil {
+				return nil, err
+			}
+
+			u, unitOk := args.Get("unit")
+			if !unitOk {
+				return nil, errors.New(codes.Invalid, "missing argument unit")
+			}
+			if u.Type().Nature() != semantic.Duration {
+				return nil, errors.Newf(codes.FailedPrecondition, "cannot truncate argument t to unit %v", u)
+			}
+
+			loc, err := getLocation(ctx, args)
+			if err != nil {
+				return nil, err
+			}
+			w, err := execute.NewWindowInLocation(u.Duration(), u.Duration(), execute.Duration{}, loc)
+			if err != nil {
+				return nil, err
+			}
+			b := w.GetEarliestBounds(t)
+			return values.NewTime(b.Start), nil
+		}, false,
+	)
+
+	for _, field := range fieldFns {
+		runtime.RegisterPackageValue("date", field.name, SpecialFns[field.name])
+	}
+	runtime.RegisterPackageValue("date", "truncate", SpecialFns["truncate"])
+}
 
-						w, err := execute.NewWindow(u.Duration(), u.Duration(), execute.Duration{})
-						if err != nil {
-							return nil, err
-						}
+// getTime reads the time argument t. A duration is interpreted
+// as relative to the current time.
+func getTime(ctx context.Context, args values.Object, invalidFormat string) (execute.Time, error) {
+	v, ok := args.Get("t")
+	if !ok {
+		return 0, errors.New(codes.Invalid, "missing argument t")
+	}
 
-						deps := execdeps.GetExecutionDependencies(ctx)
-						nowTime := *deps.Now
+	if v == nil {
+		return 0, errors.New(codes.FailedPrecondition, "argument t was nil")
+	}
 
-						b := w.GetEarliestBounds(values.ConvertTime(nowTime.Add(v.Duration().Duration())))
-						return values.NewTime(b.Start), nil
-					}
-				}
-				return nil, errors.New(codes.FailedPrecondition, fmt.Sprintf("cannot truncate argument t of type %v to unit %v", v.Type().Nature(), u))
-			}, false,
-		),
+	switch v.Type().Nature() {
+	case semantic.Time:
+		return v.Time(), nil
+	case semantic.Duration:
+		deps := execdeps.GetExecutionDependencies(ctx)
+		nowTime := deps.GetNow()
+		return values.ConvertTime(nowTime.Add(v.Duration().Duration())), nil
+	default:
+		return 0, errors.New(codes.FailedPrecondition, fmt.Sprintf(invalidFormat, v.Type().Nature()))
 	}
+}
 
-	runtime.RegisterPackageValue("date", "second", SpecialFns["second"])
-	runtime.RegisterPackageValue("date", "minute", SpecialFns["minute"])
-	runtime.RegisterPackageValue("date", "hour", SpecialFns["hour"])
-	runtime.RegisterPackageValue("date", "weekDay", SpecialFns["weekDay"])
-	runtime.RegisterPackageValue("date", "monthDay", SpecialFns["monthDay"])
-	runtime.RegisterPackageValue("date", "yearDay", SpecialFns["yearDay"])
-	runtime.RegisterPackageValue("date", "month", SpecialFns["month"])
-	runtime.RegisterPackageValue("date", "year", SpecialFns["year"])
-	runtime.RegisterPackageValue("date", "week", SpecialFns["week"])
-	runtime.RegisterPackageValue("date", "quarter", SpecialFns["quarter"])
-	runtime.RegisterPackageValue("date", "millisecond", SpecialFns["millisecond"])
-	runtime.RegisterPackageValue("date", "microsecond", SpecialFns["microsecond"])
-	runtime.RegisterPackageValue("date", "nanosecond", SpecialFns["nanosecond"])
-	runtime.RegisterPackageValue("date", "truncate", SpecialFns["truncate"])
+// getLocation reads the location argument. If it is not present,
+// the location option is used and the default is UTC.
+func getLocation(ctx context.Context, args values.Object) (execute.Location, error) {
+	if v, ok := args.Get("location"); ok && v != nil {
+		return execute.LocationFromObject(v.Object())
+	}
+	if execdeps.HaveExecutionDependencies(ctx) {
+		deps := execdeps.GetExecutionDependencies(ctx)
+		if deps.Location != nil && *deps.Location != nil {
+			return execute.LocationFromObject((*deps.Location).Object())
+		}
+	}
+	return execute.UTC, nil
 }
@@ -3,8 +3,10 @@ package date
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/lang/execdeps"
 	"github.com/influxdata/flux/values"
 )
 
@@ -221,3 +223,104 @@ func TestTruncateNilErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestLocation(t *testing.T) {
+	newLocation := func(zone string, offset string) values.Value {
+		d, err := values.ParseDuration(offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return values.NewObjectWithValues(map[string]values.Value{
+			"zone":   values.NewString(zone),
+			"offset": values.NewDuration(d),
+		})
+	}
+	testCases := []struct {
+		name     string
+		fn       string
+		time     string
+		unit     string
+		location values.Value
+		option   values.Value
+		want     values.Value
+	}{
+		{
+			name:     "hour",
+			fn:       "hour",
+			time:     "2020-03-08T12:00:00.000000000Z",
+			location: newLocation("America/New_York", "0s"),
+			want:     values.NewInt(8),
+		},
+		{
+			name:     "hour with offset",
+			fn:       "hour",
+			time:     "2020-03-08T12:00:00.000000000Z",
+			location: newLocation("UTC", "-8h"),
+			want:     values.NewInt(4),
+		},
+		{
+			name:   "month day from option",
+			fn:     "monthDay",
+			time:   "2020-03-01T03:00:00.000000000Z",
+			option: newLocation("America/New_York", "0s"),
+			want:   values.NewInt(29),
+		},
+		{
+			name:     "argument overrides option",
+			fn:       "monthDay",
+			time:     "2020-03-01T03:00:00.000000000Z",
+			location: newLocation("UTC", "0s"),
+			option:   newLocation("America/New_York", "0s"),
+			want:     values.NewInt(1),
+		},
+		{
+			name:     "truncate day after daylight saving time",
+			fn:       "truncate",
+			time:     "2020-03-08T12:00:00.000000000Z",
+			unit:     "1d",
+			location: newLocation("America/New_York", "0s"),
+			want:     values.NewTime(values.ConvertTime(time.Date(2020, 3, 8, 5, 0, 0, 0, time.UTC))),
+		},
+		{
+			name:     "truncate month",
+			fn:       "truncate",
+			time:     "2020-04-15T12:00:00.000000000Z",
+			unit:     "1mo",
+			location: newLocation("Europe/Berlin", "0s"),
+			want:     values.NewTime(values.ConvertTime(time.Date(2020, 3, 31, 22, 0, 0, 0, time.UTC))),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ts, err := values.ParseTime(tc.time)
+			if err != nil {
+				t.Fatal(err)
+			}
+			args := map[string]values.Value{"t": values.NewTime(ts)}
+			if tc.unit != "" {
+				unit, err := values.ParseDuration(tc.unit)
+				if err != nil {
+					t.Fatal(err)
+				}
+				args["unit"] = values.NewDuration(unit)
+			}
+			if tc.location != nil {
+				args["location"] = tc.location
+			}
+
+			ctx := dependenciestest.Default().Inject(context.Background())
+			deps := execdeps.DefaultExecutionDependencies()
+			*deps.Location = tc.option
+			ctx = deps.Inject(ctx)
+
+			got, err := SpecialFns[tc.fn].Call(ctx, values.NewObjectWithValues(args))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.want.Equal(got) {
+				t.Errorf("unexpected value -want/+got:\n\t- %v\n\t+ %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -3140,6 +3140,4646 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				Name: "date_test",
 			},
 		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 113,
+					Line:   35,
+				},
+				File:   "location_aggregate_window_test.flux",
+				Source: "package date_test\n\nimport \"testing\"\n\noption now = () => (2030-01-01T00:00:00Z)\noption location = {zone: \"America/New_York\", offset: 0h}\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,false,true,true,false\n#default,_result,,,,,,,\n,result,table,_start,_stop,_time,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"\naggregate_window_location = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> aggregateWindow(every: 1d, fn: sum))\n\ntest _aggregate_window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   5,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   5,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   5,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   5,
+							},
+						},
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   5,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   5,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   5,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   5,
+								},
+							},
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   5,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   5,
+									},
+								},
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   5,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+		}, &ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 57,
+							Line:   6,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "location = {zone: \"America/New_York\", offset: 0h}",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   6,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "location",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+					},
+					Name: "location",
+				},
+				Init: &ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 57,
+								Line:   6,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "{zone: \"America/New_York\", offset: 0h}",
+							Start: ast.Position{
+								Column: 19,
+								Line:   6,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 44,
+									Line:   6,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "zone: \"America/New_York\"",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 24,
+										Line:   6,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "zone",
+									Start: ast.Position{
+										Column: 20,
+										Line:   6,
+									},
+								},
+							},
+							Name: "zone",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   6,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "\"America/New_York\"",
+									Start: ast.Position{
+										Column: 26,
+										Line:   6,
+									},
+								},
+							},
+							Value: "America/New_York",
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 56,
+									Line:   6,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "offset: 0h",
+								Start: ast.Position{
+									Column: 46,
+									Line:   6,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 52,
+										Line:   6,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "offset",
+									Start: ast.Position{
+										Column: 46,
+										Line:   6,
+									},
+								},
+							},
+							Name: "offset",
+						},
+						Value: &ast.DurationLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 56,
+										Line:   6,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "0h",
+									Start: ast.Position{
+										Column: 54,
+										Line:   6,
+									},
+								},
+							},
+							Values: []ast.Duration{ast.Duration{
+								Magnitude: int64(0),
+								Unit:      "h",
+							}},
+						},
+					}},
+					With: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 57,
+						Line:   6,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "option location = {zone: \"America/New_York\", offset: 0h}",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   18,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   18,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   28,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,false,true,true,false\n#default,_result,,,,,,,\n,result,table,_start,_stop,_time,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   20,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   20,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   20,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   28,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,false,true,true,false\n#default,_result,,,,,,,\n,result,table,_start,_stop,_time,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   20,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,false,true,true,false\n#default,_result,,,,,,,\n,result,table,_start,_stop,_time,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,0,2020-03-07T05:00:00Z,2020-03-10T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   32,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "aggregate_window_location = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> aggregateWindow(every: 1d, fn: sum))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   29,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 26,
+							Line:   29,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "aggregate_window_location",
+						Start: ast.Position{
+							Column: 1,
+							Line:   29,
+						},
+					},
+				},
+				Name: "aggregate_window_location",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   32,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> aggregateWindow(every: 1d, fn: sum))",
+						Start: ast.Position{
+							Column: 29,
+							Line:   29,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   32,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> aggregateWindow(every: 1d, fn: sum))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   30,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 8,
+											Line:   30,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   30,
+										},
+									},
+								},
+								Name: "table",
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 68,
+										Line:   31,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)",
+									Start: ast.Position{
+										Column: 3,
+										Line:   30,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 67,
+												Line:   31,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   31,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   31,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "start: 2020-03-07T05:00:00Z",
+												Start: ast.Position{
+													Column: 12,
+													Line:   31,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   31,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "start",
+													Start: ast.Position{
+														Column: 12,
+														Line:   31,
+													},
+												},
+											},
+											Name: "start",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 39,
+														Line:   31,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "2020-03-07T05:00:00Z",
+													Start: ast.Position{
+														Column: 19,
+														Line:   31,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2020-03-07T05:00:00Z"),
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 67,
+													Line:   31,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "stop: 2020-03-10T04:00:00Z",
+												Start: ast.Position{
+													Column: 41,
+													Line:   31,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 45,
+														Line:   31,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "stop",
+													Start: ast.Position{
+														Column: 41,
+														Line:   31,
+													},
+												},
+											},
+											Name: "stop",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 67,
+														Line:   31,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "2020-03-10T04:00:00Z",
+													Start: ast.Position{
+														Column: 47,
+														Line:   31,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2020-03-10T04:00:00Z"),
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   31,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)",
+										Start: ast.Position{
+											Column: 6,
+											Line:   31,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 11,
+												Line:   31,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "range",
+											Start: ast.Position{
+												Column: 6,
+												Line:   31,
+											},
+										},
+									},
+									Name: "range",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 41,
+									Line:   32,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> aggregateWindow(every: 1d, fn: sum)",
+								Start: ast.Position{
+									Column: 3,
+									Line:   30,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 40,
+											Line:   32,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "every: 1d, fn: sum",
+										Start: ast.Position{
+											Column: 22,
+											Line:   32,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 31,
+												Line:   32,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "every: 1d",
+											Start: ast.Position{
+												Column: 22,
+												Line:   32,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 27,
+													Line:   32,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "every",
+												Start: ast.Position{
+													Column: 22,
+													Line:   32,
+												},
+											},
+										},
+										Name: "every",
+									},
+									Value: &ast.DurationLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 31,
+													Line:   32,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "1d",
+												Start: ast.Position{
+													Column: 29,
+													Line:   32,
+												},
+											},
+										},
+										Values: []ast.Duration{ast.Duration{
+											Magnitude: int64(1),
+											Unit:      "d",
+										}},
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 40,
+												Line:   32,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "fn: sum",
+											Start: ast.Position{
+												Column: 33,
+												Line:   32,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 35,
+													Line:   32,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 33,
+													Line:   32,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 40,
+													Line:   32,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "sum",
+												Start: ast.Position{
+													Column: 37,
+													Line:   32,
+												},
+											},
+										},
+										Name: "sum",
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   32,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "aggregateWindow(every: 1d, fn: sum)",
+									Start: ast.Position{
+										Column: 6,
+										Line:   32,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 21,
+											Line:   32,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "aggregateWindow",
+										Start: ast.Position{
+											Column: 6,
+											Line:   32,
+										},
+									},
+								},
+								Name: "aggregateWindow",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 38,
+								Line:   29,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 30,
+								Line:   29,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 35,
+									Line:   29,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 30,
+									Line:   29,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 38,
+								Line:   29,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 36,
+								Line:   29,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 113,
+							Line:   35,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "_aggregate_window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   34,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 32,
+								Line:   34,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "_aggregate_window_location",
+							Start: ast.Position{
+								Column: 6,
+								Line:   34,
+							},
+						},
+					},
+					Name: "_aggregate_window_location",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 113,
+								Line:   35,
+							},
+							File:   "location_aggregate_window_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location})",
+							Start: ast.Position{
+								Column: 35,
+								Line:   34,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 113,
+									Line:   35,
+								},
+								File:   "location_aggregate_window_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   35,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 112,
+										Line:   35,
+									},
+									File:   "location_aggregate_window_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   35,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   35,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   35,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   35,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   35,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   35,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   35,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   35,
+														},
+														File:   "location_aggregate_window_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   35,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   35,
+														},
+														File:   "location_aggregate_window_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   35,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   35,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   35,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   35,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   35,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   35,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   35,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   35,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   35,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   35,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   35,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   35,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   35,
+														},
+														File:   "location_aggregate_window_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   35,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   35,
+														},
+														File:   "location_aggregate_window_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   35,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   35,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   35,
+												},
+												File:   "location_aggregate_window_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   35,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   35,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   35,
+													},
+													File:   "location_aggregate_window_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   35,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 111,
+											Line:   35,
+										},
+										File:   "location_aggregate_window_test.flux",
+										Source: "fn: aggregate_window_location",
+										Start: ast.Position{
+											Column: 82,
+											Line:   35,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   35,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 111,
+												Line:   35,
+											},
+											File:   "location_aggregate_window_test.flux",
+											Source: "aggregate_window_location",
+											Start: ast.Position{
+												Column: 86,
+												Line:   35,
+											},
+										},
+									},
+									Name: "aggregate_window_location",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 113,
+						Line:   35,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "test _aggregate_window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: aggregate_window_location})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   34,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "location_aggregate_window_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "location_aggregate_window_test.flux",
+					Source: "package date_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "location_aggregate_window_test.flux",
+						Source: "date_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "date_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 103,
+					Line:   34,
+				},
+				File:   "location_hour_time_test.flux",
+				Source: "package date_test\n\nimport \"testing\"\nimport \"date\"\n\noption now = () => (2030-01-01T00:00:00Z)\noption location = {zone: \"America/New_York\", offset: 0h}\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,_result,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,2020-03-08T03:00:00Z,_m,FF,1\n,,0,2020-03-08T12:00:00Z,_m,FF,1\n,,0,2020-03-09T03:00:00Z,_m,FF,1\n\"\n\noutData = \"\n#group,false,false,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,dateTime:RFC3339,long\n#default,_result,,,,,,,\n,result,table,_start,_stop,_field,_measurement,_time,_value\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T03:00:00Z,22\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T12:00:00Z,8\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-09T03:00:00Z,23\n\"\nt_location_hour = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T00:00:00Z)\n\t\t|> map(fn: (r) => ({r with _value: date.hour(t: r._time)})))\n\ntest _location_hour = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   6,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   6,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   6,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   6,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   6,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   6,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   6,
+									},
+								},
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   6,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+		}, &ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 57,
+							Line:   7,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "location = {zone: \"America/New_York\", offset: 0h}",
+						Start: ast.Position{
+							Column: 8,
+							Line:   7,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   7,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "location",
+							Start: ast.Position{
+								Column: 8,
+								Line:   7,
+							},
+						},
+					},
+					Name: "location",
+				},
+				Init: &ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 57,
+								Line:   7,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "{zone: \"America/New_York\", offset: 0h}",
+							Start: ast.Position{
+								Column: 19,
+								Line:   7,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 44,
+									Line:   7,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "zone: \"America/New_York\"",
+								Start: ast.Position{
+									Column: 20,
+									Line:   7,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 24,
+										Line:   7,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "zone",
+									Start: ast.Position{
+										Column: 20,
+										Line:   7,
+									},
+								},
+							},
+							Name: "zone",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   7,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "\"America/New_York\"",
+									Start: ast.Position{
+										Column: 26,
+										Line:   7,
+									},
+								},
+							},
+							Value: "America/New_York",
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 56,
+									Line:   7,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "offset: 0h",
+								Start: ast.Position{
+									Column: 46,
+									Line:   7,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 52,
+										Line:   7,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "offset",
+									Start: ast.Position{
+										Column: 46,
+										Line:   7,
+									},
+								},
+							},
+							Name: "offset",
+						},
+						Value: &ast.DurationLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 56,
+										Line:   7,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "0h",
+									Start: ast.Position{
+										Column: 54,
+										Line:   7,
+									},
+								},
+							},
+							Values: []ast.Duration{ast.Duration{
+								Magnitude: int64(0),
+								Unit:      "h",
+							}},
+						},
+					}},
+					With: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 57,
+						Line:   7,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "option location = {zone: \"America/New_York\", offset: 0h}",
+					Start: ast.Position{
+						Column: 1,
+						Line:   7,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   17,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,_result,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,2020-03-08T03:00:00Z,_m,FF,1\n,,0,2020-03-08T12:00:00Z,_m,FF,1\n,,0,2020-03-09T03:00:00Z,_m,FF,1\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   9,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   9,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   17,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,_result,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,2020-03-08T03:00:00Z,_m,FF,1\n,,0,2020-03-08T12:00:00Z,_m,FF,1\n,,0,2020-03-09T03:00:00Z,_m,FF,1\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   9,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,string,string,double\n#group,false,false,false,true,true,false\n#default,_result,,,,,\n,result,table,_time,_measurement,_field,_value\n,,0,2020-03-08T03:00:00Z,_m,FF,1\n,,0,2020-03-08T12:00:00Z,_m,FF,1\n,,0,2020-03-09T03:00:00Z,_m,FF,1\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   27,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "outData = \"\n#group,false,false,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,dateTime:RFC3339,long\n#default,_result,,,,,,,\n,result,table,_start,_stop,_field,_measurement,_time,_value\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T03:00:00Z,22\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T12:00:00Z,8\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-09T03:00:00Z,23\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   19,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   19,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   19,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   27,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "\"\n#group,false,false,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,dateTime:RFC3339,long\n#default,_result,,,,,,,\n,result,table,_start,_stop,_field,_measurement,_time,_value\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T03:00:00Z,22\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T12:00:00Z,8\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-09T03:00:00Z,23\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   19,
+						},
+					},
+				},
+				Value: "\n#group,false,false,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,dateTime:RFC3339,long\n#default,_result,,,,,,,\n,result,table,_start,_stop,_field,_measurement,_time,_value\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T03:00:00Z,22\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-08T12:00:00Z,8\n,,0,2020-03-07T00:00:00Z,2030-01-01T00:00:00Z,FF,_m,2020-03-09T03:00:00Z,23\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 63,
+						Line:   31,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "t_location_hour = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T00:00:00Z)\n\t\t|> map(fn: (r) => ({r with _value: date.hour(t: r._time)})))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   28,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   28,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "t_location_hour",
+						Start: ast.Position{
+							Column: 1,
+							Line:   28,
+						},
+					},
+				},
+				Name: "t_location_hour",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 63,
+							Line:   31,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T00:00:00Z)\n\t\t|> map(fn: (r) => ({r with _value: date.hour(t: r._time)})))",
+						Start: ast.Position{
+							Column: 19,
+							Line:   28,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 63,
+								Line:   31,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "(table\n\t\t|> range(start: 2020-03-07T00:00:00Z)\n\t\t|> map(fn: (r) => ({r with _value: date.hour(t: r._time)})))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   29,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 8,
+											Line:   29,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   29,
+										},
+									},
+								},
+								Name: "table",
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 40,
+										Line:   30,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "table\n\t\t|> range(start: 2020-03-07T00:00:00Z)",
+									Start: ast.Position{
+										Column: 3,
+										Line:   29,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   30,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "start: 2020-03-07T00:00:00Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   30,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   30,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "start: 2020-03-07T00:00:00Z",
+												Start: ast.Position{
+													Column: 12,
+													Line:   30,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   30,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "start",
+													Start: ast.Position{
+														Column: 12,
+														Line:   30,
+													},
+												},
+											},
+											Name: "start",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 39,
+														Line:   30,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "2020-03-07T00:00:00Z",
+													Start: ast.Position{
+														Column: 19,
+														Line:   30,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2020-03-07T00:00:00Z"),
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 40,
+											Line:   30,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "range(start: 2020-03-07T00:00:00Z)",
+										Start: ast.Position{
+											Column: 6,
+											Line:   30,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 11,
+												Line:   30,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "range",
+											Start: ast.Position{
+												Column: 6,
+												Line:   30,
+											},
+										},
+									},
+									Name: "range",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 62,
+									Line:   31,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "table\n\t\t|> range(start: 2020-03-07T00:00:00Z)\n\t\t|> map(fn: (r) => ({r with _value: date.hour(t: r._time)}))",
+								Start: ast.Position{
+									Column: 3,
+									Line:   29,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 61,
+											Line:   31,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "fn: (r) => ({r with _value: date.hour(t: r._time)})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   31,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 61,
+												Line:   31,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "fn: (r) => ({r with _value: date.hour(t: r._time)})",
+											Start: ast.Position{
+												Column: 10,
+												Line:   31,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 12,
+													Line:   31,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 10,
+													Line:   31,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.FunctionExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 61,
+													Line:   31,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "(r) => ({r with _value: date.hour(t: r._time)})",
+												Start: ast.Position{
+													Column: 14,
+													Line:   31,
+												},
+											},
+										},
+										Body: &ast.ParenExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 61,
+														Line:   31,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "({r with _value: date.hour(t: r._time)})",
+													Start: ast.Position{
+														Column: 21,
+														Line:   31,
+													},
+												},
+											},
+											Expression: &ast.ObjectExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 60,
+															Line:   31,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "{r with _value: date.hour(t: r._time)}",
+														Start: ast.Position{
+															Column: 22,
+															Line:   31,
+														},
+													},
+												},
+												Properties: []*ast.Property{&ast.Property{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 59,
+																Line:   31,
+															},
+															File:   "location_hour_time_test.flux",
+															Source: "_value: date.hour(t: r._time)",
+															Start: ast.Position{
+																Column: 30,
+																Line:   31,
+															},
+														},
+													},
+													Key: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 36,
+																	Line:   31,
+																},
+																File:   "location_hour_time_test.flux",
+																Source: "_value",
+																Start: ast.Position{
+																	Column: 30,
+																	Line:   31,
+																},
+															},
+														},
+														Name: "_value",
+													},
+													Value: &ast.CallExpression{
+														Arguments: []ast.Expression{&ast.ObjectExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 58,
+																		Line:   31,
+																	},
+																	File:   "location_hour_time_test.flux",
+																	Source: "t: r._time",
+																	Start: ast.Position{
+																		Column: 48,
+																		Line:   31,
+																	},
+																},
+															},
+															Properties: []*ast.Property{&ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 58,
+																			Line:   31,
+																		},
+																		File:   "location_hour_time_test.flux",
+																		Source: "t: r._time",
+																		Start: ast.Position{
+																			Column: 48,
+																			Line:   31,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 49,
+																				Line:   31,
+																			},
+																			File:   "location_hour_time_test.flux",
+																			Source: "t",
+																			Start: ast.Position{
+																				Column: 48,
+																				Line:   31,
+																			},
+																		},
+																	},
+																	Name: "t",
+																},
+																Value: &ast.MemberExpression{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 58,
+																				Line:   31,
+																			},
+																			File:   "location_hour_time_test.flux",
+																			Source: "r._time",
+																			Start: ast.Position{
+																				Column: 51,
+																				Line:   31,
+																			},
+																		},
+																	},
+																	Object: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 52,
+																					Line:   31,
+																				},
+																				File:   "location_hour_time_test.flux",
+																				Source: "r",
+																				Start: ast.Position{
+																					Column: 51,
+																					Line:   31,
+																				},
+																			},
+																		},
+																		Name: "r",
+																	},
+																	Property: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 58,
+																					Line:   31,
+																				},
+																				File:   "location_hour_time_test.flux",
+																				Source: "_time",
+																				Start: ast.Position{
+																					Column: 53,
+																					Line:   31,
+																				},
+																			},
+																		},
+																		Name: "_time",
+																	},
+																},
+															}},
+															With: nil,
+														}},
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 59,
+																	Line:   31,
+																},
+																File:   "location_hour_time_test.flux",
+																Source: "date.hour(t: r._time)",
+																Start: ast.Position{
+																	Column: 38,
+																	Line:   31,
+																},
+															},
+														},
+														Callee: &ast.MemberExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 47,
+																		Line:   31,
+																	},
+																	File:   "location_hour_time_test.flux",
+																	Source: "date.hour",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   31,
+																	},
+																},
+															},
+															Object: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 42,
+																			Line:   31,
+																		},
+																		File:   "location_hour_time_test.flux",
+																		Source: "date",
+																		Start: ast.Position{
+																			Column: 38,
+																			Line:   31,
+																		},
+																	},
+																},
+																Name: "date",
+															},
+															Property: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 47,
+																			Line:   31,
+																		},
+																		File:   "location_hour_time_test.flux",
+																		Source: "hour",
+																		Start: ast.Position{
+																			Column: 43,
+																			Line:   31,
+																		},
+																	},
+																},
+																Name: "hour",
+															},
+														},
+													},
+												}},
+												With: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 24,
+																Line:   31,
+															},
+															File:   "location_hour_time_test.flux",
+															Source: "r",
+															Start: ast.Position{
+																Column: 23,
+																Line:   31,
+															},
+														},
+													},
+													Name: "r",
+												},
+											},
+										},
+										Params: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   31,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   31,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 16,
+															Line:   31,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 15,
+															Line:   31,
+														},
+													},
+												},
+												Name: "r",
+											},
+											Value: nil,
+										}},
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 62,
+										Line:   31,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "map(fn: (r) => ({r with _value: date.hour(t: r._time)}))",
+									Start: ast.Position{
+										Column: 6,
+										Line:   31,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 9,
+											Line:   31,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "map",
+										Start: ast.Position{
+											Column: 6,
+											Line:   31,
+										},
+									},
+								},
+								Name: "map",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   28,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 20,
+								Line:   28,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 25,
+									Line:   28,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 20,
+									Line:   28,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   28,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 26,
+								Line:   28,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 103,
+							Line:   34,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "_location_hour = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   33,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   33,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "_location_hour",
+							Start: ast.Position{
+								Column: 6,
+								Line:   33,
+							},
+						},
+					},
+					Name: "_location_hour",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 103,
+								Line:   34,
+							},
+							File:   "location_hour_time_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour})",
+							Start: ast.Position{
+								Column: 23,
+								Line:   33,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 103,
+									Line:   34,
+								},
+								File:   "location_hour_time_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   34,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 102,
+										Line:   34,
+									},
+									File:   "location_hour_time_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   34,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   34,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   34,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   34,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   34,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   34,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   34,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   34,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   34,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   34,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   34,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   34,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   34,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   34,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   34,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   34,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   34,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   34,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   34,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   34,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   34,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   34,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   34,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   34,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   34,
+														},
+														File:   "location_hour_time_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   34,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   34,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   34,
+												},
+												File:   "location_hour_time_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   34,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   34,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   34,
+													},
+													File:   "location_hour_time_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   34,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 101,
+											Line:   34,
+										},
+										File:   "location_hour_time_test.flux",
+										Source: "fn: t_location_hour",
+										Start: ast.Position{
+											Column: 82,
+											Line:   34,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   34,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 101,
+												Line:   34,
+											},
+											File:   "location_hour_time_test.flux",
+											Source: "t_location_hour",
+											Start: ast.Position{
+												Column: 86,
+												Line:   34,
+											},
+										},
+									},
+									Name: "t_location_hour",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 103,
+						Line:   34,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "test _location_hour = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_location_hour})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   33,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "import \"date\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "\"date\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "date",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "location_hour_time_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "location_hour_time_test.flux",
+					Source: "package date_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "location_hour_time_test.flux",
+						Source: "date_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "date_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 103,
+					Line:   36,
+				},
+				File:   "location_window_test.flux",
+				Source: "package date_test\n\nimport \"testing\"\n\noption now = () => (2030-01-01T00:00:00Z)\noption location = {zone: \"America/New_York\", offset: 0h}\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,true,true,false\n#default,_result,,,,,,\n,result,table,_start,_stop,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,1,2020-03-08T05:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,2,2020-03-09T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"\nwindow_location = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)\n\t\t|> sum())\n\ntest _window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   5,
+						},
+						File:   "location_window_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   5,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   5,
+							},
+							File:   "location_window_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   5,
+							},
+						},
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   5,
+							},
+							File:   "location_window_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   5,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   5,
+								},
+								File:   "location_window_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   5,
+								},
+							},
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   5,
+									},
+									File:   "location_window_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   5,
+									},
+								},
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   5,
+					},
+					File:   "location_window_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+		}, &ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 57,
+							Line:   6,
+						},
+						File:   "location_window_test.flux",
+						Source: "location = {zone: \"America/New_York\", offset: 0h}",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   6,
+							},
+							File:   "location_window_test.flux",
+							Source: "location",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+					},
+					Name: "location",
+				},
+				Init: &ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 57,
+								Line:   6,
+							},
+							File:   "location_window_test.flux",
+							Source: "{zone: \"America/New_York\", offset: 0h}",
+							Start: ast.Position{
+								Column: 19,
+								Line:   6,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 44,
+									Line:   6,
+								},
+								File:   "location_window_test.flux",
+								Source: "zone: \"America/New_York\"",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 24,
+										Line:   6,
+									},
+									File:   "location_window_test.flux",
+									Source: "zone",
+									Start: ast.Position{
+										Column: 20,
+										Line:   6,
+									},
+								},
+							},
+							Name: "zone",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 44,
+										Line:   6,
+									},
+									File:   "location_window_test.flux",
+									Source: "\"America/New_York\"",
+									Start: ast.Position{
+										Column: 26,
+										Line:   6,
+									},
+								},
+							},
+							Value: "America/New_York",
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 56,
+									Line:   6,
+								},
+								File:   "location_window_test.flux",
+								Source: "offset: 0h",
+								Start: ast.Position{
+									Column: 46,
+									Line:   6,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 52,
+										Line:   6,
+									},
+									File:   "location_window_test.flux",
+									Source: "offset",
+									Start: ast.Position{
+										Column: 46,
+										Line:   6,
+									},
+								},
+							},
+							Name: "offset",
+						},
+						Value: &ast.DurationLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 56,
+										Line:   6,
+									},
+									File:   "location_window_test.flux",
+									Source: "0h",
+									Start: ast.Position{
+										Column: 54,
+										Line:   6,
+									},
+								},
+							},
+							Values: []ast.Duration{ast.Duration{
+								Magnitude: int64(0),
+								Unit:      "h",
+							}},
+						},
+					}},
+					With: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 57,
+						Line:   6,
+					},
+					File:   "location_window_test.flux",
+					Source: "option location = {zone: \"America/New_York\", offset: 0h}",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   18,
+					},
+					File:   "location_window_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "location_window_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   18,
+						},
+						File:   "location_window_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string\n#group,false,false,false,false,true,true\n#default,_result,,,,,\n,result,table,_time,_value,_field,_measurement\n,,0,2020-03-07T12:00:00Z,1,usage,cpu\n,,0,2020-03-08T03:00:00Z,2,usage,cpu\n,,0,2020-03-08T12:00:00Z,3,usage,cpu\n,,0,2020-03-09T03:00:00Z,4,usage,cpu\n,,0,2020-03-09T12:00:00Z,5,usage,cpu\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   28,
+					},
+					File:   "location_window_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,true,true,false\n#default,_result,,,,,,\n,result,table,_start,_stop,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,1,2020-03-08T05:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,2,2020-03-09T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   20,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   20,
+						},
+						File:   "location_window_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   20,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   28,
+						},
+						File:   "location_window_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,true,true,false\n#default,_result,,,,,,\n,result,table,_start,_stop,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,1,2020-03-08T05:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,2,2020-03-09T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   20,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,double\n#group,false,false,true,true,true,true,false\n#default,_result,,,,,,\n,result,table,_start,_stop,_field,_measurement,_value\n,,0,2020-03-07T05:00:00Z,2020-03-08T05:00:00Z,usage,cpu,3\n,,1,2020-03-08T05:00:00Z,2020-03-09T04:00:00Z,usage,cpu,7\n,,2,2020-03-09T04:00:00Z,2020-03-10T04:00:00Z,usage,cpu,5\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 12,
+						Line:   33,
+					},
+					File:   "location_window_test.flux",
+					Source: "window_location = (table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)\n\t\t|> sum())",
+					Start: ast.Position{
+						Column: 1,
+						Line:   29,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   29,
+						},
+						File:   "location_window_test.flux",
+						Source: "window_location",
+						Start: ast.Position{
+							Column: 1,
+							Line:   29,
+						},
+					},
+				},
+				Name: "window_location",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   33,
+						},
+						File:   "location_window_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)\n\t\t|> sum())",
+						Start: ast.Position{
+							Column: 19,
+							Line:   29,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   33,
+							},
+							File:   "location_window_test.flux",
+							Source: "(table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)\n\t\t|> sum())",
+							Start: ast.Position{
+								Column: 2,
+								Line:   30,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.PipeExpression{
+								Argument: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   30,
+											},
+											File:   "location_window_test.flux",
+											Source: "table",
+											Start: ast.Position{
+												Column: 3,
+												Line:   30,
+											},
+										},
+									},
+									Name: "table",
+								},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 68,
+											Line:   31,
+										},
+										File:   "location_window_test.flux",
+										Source: "table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   30,
+										},
+									},
+								},
+								Call: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 67,
+													Line:   31,
+												},
+												File:   "location_window_test.flux",
+												Source: "start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z",
+												Start: ast.Position{
+													Column: 12,
+													Line:   31,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 39,
+														Line:   31,
+													},
+													File:   "location_window_test.flux",
+													Source: "start: 2020-03-07T05:00:00Z",
+													Start: ast.Position{
+														Column: 12,
+														Line:   31,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 17,
+															Line:   31,
+														},
+														File:   "location_window_test.flux",
+														Source: "start",
+														Start: ast.Position{
+															Column: 12,
+															Line:   31,
+														},
+													},
+												},
+												Name: "start",
+											},
+											Value: &ast.DateTimeLiteral{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 39,
+															Line:   31,
+														},
+														File:   "location_window_test.flux",
+														Source: "2020-03-07T05:00:00Z",
+														Start: ast.Position{
+															Column: 19,
+															Line:   31,
+														},
+													},
+												},
+												Value: parser.MustParseTime("2020-03-07T05:00:00Z"),
+											},
+										}, &ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 67,
+														Line:   31,
+													},
+													File:   "location_window_test.flux",
+													Source: "stop: 2020-03-10T04:00:00Z",
+													Start: ast.Position{
+														Column: 41,
+														Line:   31,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 45,
+															Line:   31,
+														},
+														File:   "location_window_test.flux",
+														Source: "stop",
+														Start: ast.Position{
+															Column: 41,
+															Line:   31,
+														},
+													},
+												},
+												Name: "stop",
+											},
+											Value: &ast.DateTimeLiteral{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 67,
+															Line:   31,
+														},
+														File:   "location_window_test.flux",
+														Source: "2020-03-10T04:00:00Z",
+														Start: ast.Position{
+															Column: 47,
+															Line:   31,
+														},
+													},
+												},
+												Value: parser.MustParseTime("2020-03-10T04:00:00Z"),
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 68,
+												Line:   31,
+											},
+											File:   "location_window_test.flux",
+											Source: "range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)",
+											Start: ast.Position{
+												Column: 6,
+												Line:   31,
+											},
+										},
+									},
+									Callee: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 11,
+													Line:   31,
+												},
+												File:   "location_window_test.flux",
+												Source: "range",
+												Start: ast.Position{
+													Column: 6,
+													Line:   31,
+												},
+											},
+										},
+										Name: "range",
+									},
+								},
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 23,
+										Line:   32,
+									},
+									File:   "location_window_test.flux",
+									Source: "table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)",
+									Start: ast.Position{
+										Column: 3,
+										Line:   30,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 22,
+												Line:   32,
+											},
+											File:   "location_window_test.flux",
+											Source: "every: 1d",
+											Start: ast.Position{
+												Column: 13,
+												Line:   32,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   32,
+												},
+												File:   "location_window_test.flux",
+												Source: "every: 1d",
+												Start: ast.Position{
+													Column: 13,
+													Line:   32,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   32,
+													},
+													File:   "location_window_test.flux",
+													Source: "every",
+													Start: ast.Position{
+														Column: 13,
+														Line:   32,
+													},
+												},
+											},
+											Name: "every",
+										},
+										Value: &ast.DurationLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   32,
+													},
+													File:   "location_window_test.flux",
+													Source: "1d",
+													Start: ast.Position{
+														Column: 20,
+														Line:   32,
+													},
+												},
+											},
+											Values: []ast.Duration{ast.Duration{
+												Magnitude: int64(1),
+												Unit:      "d",
+											}},
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 23,
+											Line:   32,
+										},
+										File:   "location_window_test.flux",
+										Source: "window(every: 1d)",
+										Start: ast.Position{
+											Column: 6,
+											Line:   32,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 12,
+												Line:   32,
+											},
+											File:   "location_window_test.flux",
+											Source: "window",
+											Start: ast.Position{
+												Column: 6,
+												Line:   32,
+											},
+										},
+									},
+									Name: "window",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 11,
+									Line:   33,
+								},
+								File:   "location_window_test.flux",
+								Source: "table\n\t\t|> range(start: 2020-03-07T05:00:00Z, stop: 2020-03-10T04:00:00Z)\n\t\t|> window(every: 1d)\n\t\t|> sum()",
+								Start: ast.Position{
+									Column: 3,
+									Line:   30,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: nil,
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 11,
+										Line:   33,
+									},
+									File:   "location_window_test.flux",
+									Source: "sum()",
+									Start: ast.Position{
+										Column: 6,
+										Line:   33,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 9,
+											Line:   33,
+										},
+										File:   "location_window_test.flux",
+										Source: "sum",
+										Start: ast.Position{
+											Column: 6,
+											Line:   33,
+										},
+									},
+								},
+								Name: "sum",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   29,
+							},
+							File:   "location_window_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 20,
+								Line:   29,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 25,
+									Line:   29,
+								},
+								File:   "location_window_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 20,
+									Line:   29,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   29,
+							},
+							File:   "location_window_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 26,
+								Line:   29,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 103,
+							Line:   36,
+						},
+						File:   "location_window_test.flux",
+						Source: "_window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   35,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 22,
+								Line:   35,
+							},
+							File:   "location_window_test.flux",
+							Source: "_window_location",
+							Start: ast.Position{
+								Column: 6,
+								Line:   35,
+							},
+						},
+					},
+					Name: "_window_location",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 103,
+								Line:   36,
+							},
+							File:   "location_window_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location})",
+							Start: ast.Position{
+								Column: 25,
+								Line:   35,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 103,
+									Line:   36,
+								},
+								File:   "location_window_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   36,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 102,
+										Line:   36,
+									},
+									File:   "location_window_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   36,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   36,
+										},
+										File:   "location_window_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   36,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   36,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   36,
+												},
+												File:   "location_window_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   36,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   36,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   36,
+														},
+														File:   "location_window_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   36,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   36,
+														},
+														File:   "location_window_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   36,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   36,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   36,
+												},
+												File:   "location_window_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   36,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   36,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   36,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   36,
+										},
+										File:   "location_window_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   36,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   36,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   36,
+												},
+												File:   "location_window_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   36,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   36,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   36,
+														},
+														File:   "location_window_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   36,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   36,
+														},
+														File:   "location_window_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   36,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   36,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   36,
+												},
+												File:   "location_window_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   36,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   36,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   36,
+													},
+													File:   "location_window_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   36,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 101,
+											Line:   36,
+										},
+										File:   "location_window_test.flux",
+										Source: "fn: window_location",
+										Start: ast.Position{
+											Column: 82,
+											Line:   36,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   36,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 101,
+												Line:   36,
+											},
+											File:   "location_window_test.flux",
+											Source: "window_location",
+											Start: ast.Position{
+												Column: 86,
+												Line:   36,
+											},
+										},
+									},
+									Name: "window_location",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 103,
+						Line:   36,
+					},
+					File:   "location_window_test.flux",
+					Source: "test _window_location = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: window_location})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   35,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "location_window_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "location_window_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "location_window_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "location_window_test.flux",
+					Source: "package date_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "location_window_test.flux",
+						Source: "date_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "date_test",
+			},
+		},
 	}, &ast.File{
 		BaseNode: ast.BaseNode{
 			Errors: nil,
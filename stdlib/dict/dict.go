@@ -0,0 +1,153 @@
+package dict
+
+import (
+	"context"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const pkgpath = "dict"
+
+func init() {
+	runtime.RegisterPackageValue(pkgpath, "fromList", fromList)
+	runtime.RegisterPackageValue(pkgpath, "get", get)
+	runtime.RegisterPackageValue(pkgpath, "insert", insert)
+	runtime.RegisterPackageValue(pkgpath, "remove", remove)
+}
+
+var fromList = values.NewFunction(
+	"fromList",
+	runtime.MustLookupBuiltinType(pkgpath, "fromList"),
+	func(ctx context.Context, args values.Object) (values.Value, error) {
+		a := interpreter.NewArguments(args)
+		pairs, err := a.GetRequiredArrayAllowEmpty("pairs", semantic.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		dictType, err := dictTypeFromPairs(pairs.Type())
+		if err != nil {
+			return nil, err
+		}
+
+		builder := values.NewDictBuilder(dictType)
+		pairs.Range(func(i int, pair values.Value) {
+			if err != nil {
+				return
+			}
+			key, ok := pair.Object().Get("key")
+			if !ok {
+				err = errors.Newf(codes.Invalid, "pair at index %d is missing the key", i)
+				return
+			}
+			value, ok := pair.Object().Get("value")
+			if !ok {
+				err = errors.Newf(codes.Invalid, "pair at index %d is missing the value", i)
+				return
+			}
+			err = builder.Insert(key, value)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return builder.Dict(), nil
+	},
+	false,
+)
+
+// dictTypeFromPairs determines the dictionary type from
+// the type of an array of key/value records.
+func dictTypeFromPairs(arrType semantic.MonoType) (semantic.MonoType, error) {
+	elemType, err := arrType.ElemType()
+	if err != nil {
+		return semantic.MonoType{}, err
+	}
+	var keyType, valueType semantic.MonoType
+	n, err := elemType.NumProperties()
+	if err != nil {
+		return semantic.MonoType{}, err
+	}
+	for i := 0; i < n; i++ {
+		prop, err := elemType.RowProperty(i)
+		if err != nil {
+			return semantic.MonoType{}, err
+		}
+		switch prop.Name() {
+		case "key":
+			keyType, err = prop.TypeOf()
+		case "value":
+			valueType, err = prop.TypeOf()
+		}
+		if err != nil {
+			return semantic.MonoType{}, err
+		}
+	}
+	return semantic.NewDictType(keyType, valueType), nil
+}
+
+var get = values.NewFunction(
+	"get",
+	runtime.MustLookupBuiltinType(pkgpath, "get"),
+	func(ctx context.Context, args values.Object) (values.Value, error) {
+		a := interpreter.NewArguments(args)
+		d, err := a.GetRequiredDictionary("dict")
+		if err != nil {
+			return nil, err
+		}
+		key, err := a.GetRequired("key")
+		if err != nil {
+			return nil, err
+		}
+		def, err := a.GetRequired("default")
+		if err != nil {
+			return nil, err
+		}
+		return d.Get(key, def), nil
+	},
+	false,
+)
+
+var insert = values.NewFunction(
+	"insert",
+	runtime.MustLookupBuiltinType(pkgpath, "insert"),
+	func(ctx context.Context, args values.Object) (values.Value, error) {
+		a := interpreter.NewArguments(args)
+		d, err := a.GetRequiredDictionary("dict")
+		if err != nil {
+			return nil, err
+		}
+		key, err := a.GetRequired("key")
+		if err != nil {
+			return nil, err
+		}
+		value, err := a.GetRequired("value")
+		if err != nil {
+			return nil, err
+		}
+		return d.Insert(key, value)
+	},
+	false,
+)
+
+var remove = values.NewFunction(
+	"remove",
+	runtime.MustLookupBuiltinType(pkgpath, "remove"),
+	func(ctx context.Context, args values.Object) (values.Value, error) {
+		a := interpreter.NewArguments(args)
+		d, err := a.GetRequiredDictionary("dict")
+		if err != nil {
+			return nil, err
+		}
+		key, err := a.GetRequired("key")
+		if err != nil {
+			return nil, err
+		}
+		return d.Remove(key), nil
+	},
+	false,
+)
@@ -0,0 +1,99 @@
+package dict
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestFromList(t *testing.T) {
+	pairType := semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte("key"), Value: semantic.BasicString},
+		{Key: []byte("value"), Value: semantic.BasicInt},
+	})
+	newPair := func(key string, value int64) values.Value {
+		return values.NewObjectWithValues(map[string]values.Value{
+			"key":   values.NewString(key),
+			"value": values.NewInt(value),
+		})
+	}
+	pairs := values.NewArrayWithBacking(semantic.NewArrayType(pairType), []values.Value{
+		newPair("b", 2),
+		newPair("a", 1),
+		newPair("b", 3),
+	})
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	v, err := fromList.Call(ctx, values.NewObjectWithValues(map[string]values.Value{
+		"pairs": pairs,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, got := "[string:int]", v.Type().String(); want != got {
+		t.Fatalf("unexpected type -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if want, got := "[a: 1, b: 3]", v.(values.ValueStringer).String(); want != got {
+		t.Fatalf("unexpected dictionary -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
+
+func TestFromList_MissingValue(t *testing.T) {
+	pairType := semantic.NewObjectType([]semantic.PropertyType{
+		{Key: []byte("key"), Value: semantic.BasicString},
+		{Key: []byte("value"), Value: semantic.BasicInt},
+	})
+	pairs := values.NewArrayWithBacking(semantic.NewArrayType(pairType), []values.Value{
+		values.NewObjectWithValues(map[string]values.Value{
+			"key":   values.NewString("a"),
+			"value": values.NewInt(1),
+		}),
+		values.NewObjectWithValues(map[string]values.Value{
+			"key": values.NewString("b"),
+		}),
+	})
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	_, err := fromList.Call(ctx, values.NewObjectWithValues(map[string]values.Value{
+		"pairs": pairs,
+	}))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, got := "pair at index 1 is missing the value", err.Error(); want != got {
+		t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+}
+
+func TestGet(t *testing.T) {
+	d, err := values.NewDict(semantic.NewDictType(semantic.BasicString, semantic.BasicInt)).
+		Insert(values.NewString("a"), values.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	for _, tc := range []struct {
+		key  string
+		want int64
+	}{
+		{key: "a", want: 1},
+		{key: "b", want: -1},
+	} {
+		v, err := get.Call(ctx, values.NewObjectWithValues(map[string]values.Value{
+			"dict":    d,
+			"key":     values.NewString(tc.key),
+			"default": values.NewInt(-1),
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want, got := tc.want, v.Int(); want != got {
+			t.Errorf("unexpected value for key %q -want/+got:\n\t- %d\n\t+ %d", tc.key, want, got)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package dict
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	runtime "github.com/influxdata/flux/runtime"
+)
+
+func init() {
+	runtime.RegisterPackage(pkgAST)
+}
+
+var pkgAST = &ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 15,
+					Line:   16,
+				},
+				File:   "dict.flux",
+				Source: "package dict\n\n// fromList will convert an array of key/value pairs\n// into a dictionary.\nbuiltin fromList\n\n// get will retrieve the value for a key in the dictionary.\n// If the key is not present, the default is returned.\nbuiltin get\n\n// insert will return a new dictionary with the key and value\n// inserted. If the key already exists, its value is replaced.\nbuiltin insert\n\n// remove will return a new dictionary with the key removed.\nbuiltin remove",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   5,
+					},
+					File:   "dict.flux",
+					Source: "builtin fromList",
+					Start: ast.Position{
+						Column: 1,
+						Line:   5,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   5,
+						},
+						File:   "dict.flux",
+						Source: "fromList",
+						Start: ast.Position{
+							Column: 9,
+							Line:   5,
+						},
+					},
+				},
+				Name: "fromList",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 12,
+						Line:   9,
+					},
+					File:   "dict.flux",
+					Source: "builtin get",
+					Start: ast.Position{
+						Column: 1,
+						Line:   9,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   9,
+						},
+						File:   "dict.flux",
+						Source: "get",
+						Start: ast.Position{
+							Column: 9,
+							Line:   9,
+						},
+					},
+				},
+				Name: "get",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   13,
+					},
+					File:   "dict.flux",
+					Source: "builtin insert",
+					Start: ast.Position{
+						Column: 1,
+						Line:   13,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   13,
+						},
+						File:   "dict.flux",
+						Source: "insert",
+						Start: ast.Position{
+							Column: 9,
+							Line:   13,
+						},
+					},
+				},
+				Name: "insert",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   16,
+					},
+					File:   "dict.flux",
+					Source: "builtin remove",
+					Start: ast.Position{
+						Column: 1,
+						Line:   16,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   16,
+						},
+						File:   "dict.flux",
+						Source: "remove",
+						Start: ast.Position{
+							Column: 9,
+							Line:   16,
+						},
+					},
+				},
+				Name: "remove",
+			},
+		}},
+		Imports:  nil,
+		Metadata: "parser-type=rust",
+		Name:     "dict.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 13,
+						Line:   1,
+					},
+					File:   "dict.flux",
+					Source: "package dict",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 13,
+							Line:   1,
+						},
+						File:   "dict.flux",
+						Source: "dict",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "dict",
+			},
+		},
+	}},
+	Package: "dict",
+	Path:    "dict",
+}
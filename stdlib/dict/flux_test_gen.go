@@ -0,0 +1,4558 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package dict
+
+import (
+	ast "github.com/influxdata/flux/ast"
+	parser "github.com/influxdata/flux/internal/parser"
+)
+
+var FluxTestPackages = []*ast.Package{&ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 93,
+					Line:   45,
+				},
+				File:   "get_test.flux",
+				Source: "package dict_test\n\nimport \"testing\"\nimport \"dict\"\n\noption now = () => (2030-01-01T00:00:00Z)\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a\n,,0,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a\n,,1,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b\n,,1,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b\n,,2,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c\n,,2,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,region\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a,us-west\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a,us-west\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b,eu-central\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b,eu-central\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c,unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c,unknown\n\"\n\nregions = dict.fromList(pairs: [\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n])\n\nt_get = (table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})))\n\ntest _get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   6,
+						},
+						File:   "get_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   6,
+							},
+							File:   "get_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   6,
+							},
+							File:   "get_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   6,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   6,
+								},
+								File:   "get_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   6,
+									},
+									File:   "get_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   6,
+									},
+								},
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   6,
+					},
+					File:   "get_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   19,
+					},
+					File:   "get_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a\n,,0,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a\n,,1,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b\n,,1,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b\n,,2,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c\n,,2,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "get_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   19,
+						},
+						File:   "get_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a\n,,0,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a\n,,1,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b\n,,1,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b\n,,2,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c\n,,2,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,host\n,,0,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a\n,,0,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a\n,,1,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b\n,,1,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b\n,,2,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c\n,,2,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   32,
+					},
+					File:   "get_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,region\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a,us-west\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a,us-west\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b,eu-central\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b,eu-central\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c,unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c,unknown\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   21,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   21,
+						},
+						File:   "get_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   21,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   32,
+						},
+						File:   "get_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,region\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a,us-west\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a,us-west\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b,eu-central\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b,eu-central\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c,unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c,unknown\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   21,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,region\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1.83,usage_user,cpu,host.a,us-west\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,1.72,usage_user,cpu,host.a,us-west\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,2.15,usage_user,cpu,host.b,eu-central\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,2.01,usage_user,cpu,host.b,eu-central\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,0.72,usage_user,cpu,host.c,unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:36Z,0.68,usage_user,cpu,host.c,unknown\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 3,
+						Line:   37,
+					},
+					File:   "get_test.flux",
+					Source: "regions = dict.fromList(pairs: [\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n])",
+					Start: ast.Position{
+						Column: 1,
+						Line:   34,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   34,
+						},
+						File:   "get_test.flux",
+						Source: "regions",
+						Start: ast.Position{
+							Column: 1,
+							Line:   34,
+						},
+					},
+				},
+				Name: "regions",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 2,
+								Line:   37,
+							},
+							File:   "get_test.flux",
+							Source: "pairs: [\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n]",
+							Start: ast.Position{
+								Column: 25,
+								Line:   34,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 2,
+									Line:   37,
+								},
+								File:   "get_test.flux",
+								Source: "pairs: [\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n]",
+								Start: ast.Position{
+									Column: 25,
+									Line:   34,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 30,
+										Line:   34,
+									},
+									File:   "get_test.flux",
+									Source: "pairs",
+									Start: ast.Position{
+										Column: 25,
+										Line:   34,
+									},
+								},
+							},
+							Name: "pairs",
+						},
+						Value: &ast.ArrayExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 2,
+										Line:   37,
+									},
+									File:   "get_test.flux",
+									Source: "[\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n]",
+									Start: ast.Position{
+										Column: 32,
+										Line:   34,
+									},
+								},
+							},
+							Elements: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 35,
+											Line:   35,
+										},
+										File:   "get_test.flux",
+										Source: "{key: \"host.a\", value: \"us-west\"}",
+										Start: ast.Position{
+											Column: 2,
+											Line:   35,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 16,
+												Line:   35,
+											},
+											File:   "get_test.flux",
+											Source: "key: \"host.a\"",
+											Start: ast.Position{
+												Column: 3,
+												Line:   35,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 6,
+													Line:   35,
+												},
+												File:   "get_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 3,
+													Line:   35,
+												},
+											},
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   35,
+												},
+												File:   "get_test.flux",
+												Source: "\"host.a\"",
+												Start: ast.Position{
+													Column: 8,
+													Line:   35,
+												},
+											},
+										},
+										Value: "host.a",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 34,
+												Line:   35,
+											},
+											File:   "get_test.flux",
+											Source: "value: \"us-west\"",
+											Start: ast.Position{
+												Column: 18,
+												Line:   35,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 23,
+													Line:   35,
+												},
+												File:   "get_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 18,
+													Line:   35,
+												},
+											},
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 34,
+													Line:   35,
+												},
+												File:   "get_test.flux",
+												Source: "\"us-west\"",
+												Start: ast.Position{
+													Column: 25,
+													Line:   35,
+												},
+											},
+										},
+										Value: "us-west",
+									},
+								}},
+								With: nil,
+							}, &ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 38,
+											Line:   36,
+										},
+										File:   "get_test.flux",
+										Source: "{key: \"host.b\", value: \"eu-central\"}",
+										Start: ast.Position{
+											Column: 2,
+											Line:   36,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 16,
+												Line:   36,
+											},
+											File:   "get_test.flux",
+											Source: "key: \"host.b\"",
+											Start: ast.Position{
+												Column: 3,
+												Line:   36,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 6,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 3,
+													Line:   36,
+												},
+											},
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "\"host.b\"",
+												Start: ast.Position{
+													Column: 8,
+													Line:   36,
+												},
+											},
+										},
+										Value: "host.b",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 37,
+												Line:   36,
+											},
+											File:   "get_test.flux",
+											Source: "value: \"eu-central\"",
+											Start: ast.Position{
+												Column: 18,
+												Line:   36,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 23,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 18,
+													Line:   36,
+												},
+											},
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 37,
+													Line:   36,
+												},
+												File:   "get_test.flux",
+												Source: "\"eu-central\"",
+												Start: ast.Position{
+													Column: 25,
+													Line:   36,
+												},
+											},
+										},
+										Value: "eu-central",
+									},
+								}},
+								With: nil,
+							}},
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 3,
+							Line:   37,
+						},
+						File:   "get_test.flux",
+						Source: "dict.fromList(pairs: [\n\t{key: \"host.a\", value: \"us-west\"},\n\t{key: \"host.b\", value: \"eu-central\"},\n])",
+						Start: ast.Position{
+							Column: 11,
+							Line:   34,
+						},
+					},
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 24,
+								Line:   34,
+							},
+							File:   "get_test.flux",
+							Source: "dict.fromList",
+							Start: ast.Position{
+								Column: 11,
+								Line:   34,
+							},
+						},
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 15,
+									Line:   34,
+								},
+								File:   "get_test.flux",
+								Source: "dict",
+								Start: ast.Position{
+									Column: 11,
+									Line:   34,
+								},
+							},
+						},
+						Name: "dict",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 24,
+									Line:   34,
+								},
+								File:   "get_test.flux",
+								Source: "fromList",
+								Start: ast.Position{
+									Column: 16,
+									Line:   34,
+								},
+							},
+						},
+						Name: "fromList",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 98,
+						Line:   42,
+					},
+					File:   "get_test.flux",
+					Source: "t_get = (table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   39,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   39,
+						},
+						File:   "get_test.flux",
+						Source: "t_get",
+						Start: ast.Position{
+							Column: 1,
+							Line:   39,
+						},
+					},
+				},
+				Name: "t_get",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 98,
+							Line:   42,
+						},
+						File:   "get_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})))",
+						Start: ast.Position{
+							Column: 9,
+							Line:   39,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 98,
+								Line:   42,
+							},
+							File:   "get_test.flux",
+							Source: "(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   40,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 8,
+											Line:   40,
+										},
+										File:   "get_test.flux",
+										Source: "table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   40,
+										},
+									},
+								},
+								Name: "table",
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 40,
+										Line:   41,
+									},
+									File:   "get_test.flux",
+									Source: "table\n\t\t|> range(start: 2018-05-22T19:53:26Z)",
+									Start: ast.Position{
+										Column: 3,
+										Line:   40,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   41,
+											},
+											File:   "get_test.flux",
+											Source: "start: 2018-05-22T19:53:26Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   41,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   41,
+												},
+												File:   "get_test.flux",
+												Source: "start: 2018-05-22T19:53:26Z",
+												Start: ast.Position{
+													Column: 12,
+													Line:   41,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   41,
+													},
+													File:   "get_test.flux",
+													Source: "start",
+													Start: ast.Position{
+														Column: 12,
+														Line:   41,
+													},
+												},
+											},
+											Name: "start",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 39,
+														Line:   41,
+													},
+													File:   "get_test.flux",
+													Source: "2018-05-22T19:53:26Z",
+													Start: ast.Position{
+														Column: 19,
+														Line:   41,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 40,
+											Line:   41,
+										},
+										File:   "get_test.flux",
+										Source: "range(start: 2018-05-22T19:53:26Z)",
+										Start: ast.Position{
+											Column: 6,
+											Line:   41,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 11,
+												Line:   41,
+											},
+											File:   "get_test.flux",
+											Source: "range",
+											Start: ast.Position{
+												Column: 6,
+												Line:   41,
+											},
+										},
+									},
+									Name: "range",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 97,
+									Line:   42,
+								},
+								File:   "get_test.flux",
+								Source: "table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")}))",
+								Start: ast.Position{
+									Column: 3,
+									Line:   40,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 96,
+											Line:   42,
+										},
+										File:   "get_test.flux",
+										Source: "fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   42,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 96,
+												Line:   42,
+											},
+											File:   "get_test.flux",
+											Source: "fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})",
+											Start: ast.Position{
+												Column: 10,
+												Line:   42,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 12,
+													Line:   42,
+												},
+												File:   "get_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 10,
+													Line:   42,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.FunctionExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 96,
+													Line:   42,
+												},
+												File:   "get_test.flux",
+												Source: "(r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})",
+												Start: ast.Position{
+													Column: 14,
+													Line:   42,
+												},
+											},
+										},
+										Body: &ast.ParenExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 96,
+														Line:   42,
+													},
+													File:   "get_test.flux",
+													Source: "({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")})",
+													Start: ast.Position{
+														Column: 21,
+														Line:   42,
+													},
+												},
+											},
+											Expression: &ast.ObjectExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 95,
+															Line:   42,
+														},
+														File:   "get_test.flux",
+														Source: "{r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")}",
+														Start: ast.Position{
+															Column: 22,
+															Line:   42,
+														},
+													},
+												},
+												Properties: []*ast.Property{&ast.Property{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 94,
+																Line:   42,
+															},
+															File:   "get_test.flux",
+															Source: "region: dict.get(dict: regions, key: r.host, default: \"unknown\")",
+															Start: ast.Position{
+																Column: 30,
+																Line:   42,
+															},
+														},
+													},
+													Key: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 36,
+																	Line:   42,
+																},
+																File:   "get_test.flux",
+																Source: "region",
+																Start: ast.Position{
+																	Column: 30,
+																	Line:   42,
+																},
+															},
+														},
+														Name: "region",
+													},
+													Value: &ast.CallExpression{
+														Arguments: []ast.Expression{&ast.ObjectExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 93,
+																		Line:   42,
+																	},
+																	File:   "get_test.flux",
+																	Source: "dict: regions, key: r.host, default: \"unknown\"",
+																	Start: ast.Position{
+																		Column: 47,
+																		Line:   42,
+																	},
+																},
+															},
+															Properties: []*ast.Property{&ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 60,
+																			Line:   42,
+																		},
+																		File:   "get_test.flux",
+																		Source: "dict: regions",
+																		Start: ast.Position{
+																			Column: 47,
+																			Line:   42,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 51,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "dict",
+																			Start: ast.Position{
+																				Column: 47,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Name: "dict",
+																},
+																Value: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 60,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "regions",
+																			Start: ast.Position{
+																				Column: 53,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Name: "regions",
+																},
+															}, &ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 73,
+																			Line:   42,
+																		},
+																		File:   "get_test.flux",
+																		Source: "key: r.host",
+																		Start: ast.Position{
+																			Column: 62,
+																			Line:   42,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 65,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "key",
+																			Start: ast.Position{
+																				Column: 62,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Name: "key",
+																},
+																Value: &ast.MemberExpression{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 73,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "r.host",
+																			Start: ast.Position{
+																				Column: 67,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Object: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 68,
+																					Line:   42,
+																				},
+																				File:   "get_test.flux",
+																				Source: "r",
+																				Start: ast.Position{
+																					Column: 67,
+																					Line:   42,
+																				},
+																			},
+																		},
+																		Name: "r",
+																	},
+																	Property: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 73,
+																					Line:   42,
+																				},
+																				File:   "get_test.flux",
+																				Source: "host",
+																				Start: ast.Position{
+																					Column: 69,
+																					Line:   42,
+																				},
+																			},
+																		},
+																		Name: "host",
+																	},
+																},
+															}, &ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 93,
+																			Line:   42,
+																		},
+																		File:   "get_test.flux",
+																		Source: "default: \"unknown\"",
+																		Start: ast.Position{
+																			Column: 75,
+																			Line:   42,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 82,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "default",
+																			Start: ast.Position{
+																				Column: 75,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Name: "default",
+																},
+																Value: &ast.StringLiteral{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 93,
+																				Line:   42,
+																			},
+																			File:   "get_test.flux",
+																			Source: "\"unknown\"",
+																			Start: ast.Position{
+																				Column: 84,
+																				Line:   42,
+																			},
+																		},
+																	},
+																	Value: "unknown",
+																},
+															}},
+															With: nil,
+														}},
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 94,
+																	Line:   42,
+																},
+																File:   "get_test.flux",
+																Source: "dict.get(dict: regions, key: r.host, default: \"unknown\")",
+																Start: ast.Position{
+																	Column: 38,
+																	Line:   42,
+																},
+															},
+														},
+														Callee: &ast.MemberExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 46,
+																		Line:   42,
+																	},
+																	File:   "get_test.flux",
+																	Source: "dict.get",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   42,
+																	},
+																},
+															},
+															Object: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 42,
+																			Line:   42,
+																		},
+																		File:   "get_test.flux",
+																		Source: "dict",
+																		Start: ast.Position{
+																			Column: 38,
+																			Line:   42,
+																		},
+																	},
+																},
+																Name: "dict",
+															},
+															Property: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 46,
+																			Line:   42,
+																		},
+																		File:   "get_test.flux",
+																		Source: "get",
+																		Start: ast.Position{
+																			Column: 43,
+																			Line:   42,
+																		},
+																	},
+																},
+																Name: "get",
+															},
+														},
+													},
+												}},
+												With: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 24,
+																Line:   42,
+															},
+															File:   "get_test.flux",
+															Source: "r",
+															Start: ast.Position{
+																Column: 23,
+																Line:   42,
+															},
+														},
+													},
+													Name: "r",
+												},
+											},
+										},
+										Params: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   42,
+													},
+													File:   "get_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   42,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 16,
+															Line:   42,
+														},
+														File:   "get_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 15,
+															Line:   42,
+														},
+													},
+												},
+												Name: "r",
+											},
+											Value: nil,
+										}},
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 97,
+										Line:   42,
+									},
+									File:   "get_test.flux",
+									Source: "map(fn: (r) => ({r with region: dict.get(dict: regions, key: r.host, default: \"unknown\")}))",
+									Start: ast.Position{
+										Column: 6,
+										Line:   42,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 9,
+											Line:   42,
+										},
+										File:   "get_test.flux",
+										Source: "map",
+										Start: ast.Position{
+											Column: 6,
+											Line:   42,
+										},
+									},
+								},
+								Name: "map",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   39,
+							},
+							File:   "get_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 10,
+								Line:   39,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 15,
+									Line:   39,
+								},
+								File:   "get_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 10,
+									Line:   39,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 18,
+								Line:   39,
+							},
+							File:   "get_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 16,
+								Line:   39,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 93,
+							Line:   45,
+						},
+						File:   "get_test.flux",
+						Source: "_get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   44,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 10,
+								Line:   44,
+							},
+							File:   "get_test.flux",
+							Source: "_get",
+							Start: ast.Position{
+								Column: 6,
+								Line:   44,
+							},
+						},
+					},
+					Name: "_get",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 93,
+								Line:   45,
+							},
+							File:   "get_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+							Start: ast.Position{
+								Column: 13,
+								Line:   44,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 93,
+									Line:   45,
+								},
+								File:   "get_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   45,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 92,
+										Line:   45,
+									},
+									File:   "get_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   45,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   45,
+										},
+										File:   "get_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   45,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   45,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   45,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   45,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   45,
+														},
+														File:   "get_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   45,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   45,
+														},
+														File:   "get_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   45,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   45,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   45,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   45,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   45,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   45,
+										},
+										File:   "get_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   45,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   45,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   45,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   45,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   45,
+														},
+														File:   "get_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   45,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   45,
+														},
+														File:   "get_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   45,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   45,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   45,
+												},
+												File:   "get_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   45,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   45,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   45,
+													},
+													File:   "get_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   45,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 91,
+											Line:   45,
+										},
+										File:   "get_test.flux",
+										Source: "fn: t_get",
+										Start: ast.Position{
+											Column: 82,
+											Line:   45,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   45,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 91,
+												Line:   45,
+											},
+											File:   "get_test.flux",
+											Source: "t_get",
+											Start: ast.Position{
+												Column: 86,
+												Line:   45,
+											},
+										},
+									},
+									Name: "t_get",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 93,
+						Line:   45,
+					},
+					File:   "get_test.flux",
+					Source: "test _get = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_get})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   44,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "get_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "get_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "get_test.flux",
+					Source: "import \"dict\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "get_test.flux",
+						Source: "\"dict\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "dict",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "get_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "get_test.flux",
+					Source: "package dict_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "get_test.flux",
+						Source: "dict_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "dict_test",
+			},
+		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 103,
+					Line:   37,
+				},
+				File:   "insert_remove_test.flux",
+				Source: "package dict_test\n\nimport \"testing\"\nimport \"dict\"\n\noption now = () => (2030-01-01T00:00:00Z)\n\ninData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,12,count,http,200\n,,1,2018-05-22T19:53:26Z,3,count,http,404\n,,2,2018-05-22T19:53:26Z,1,count,http,500\n\"\n\noutData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,code,status\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,12,count,http,200,OK\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,3,count,http,404,Unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1,count,http,500,Internal Server Error\n\"\n\ncodes = dict.fromList(pairs: [{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}])\nstatuses = dict.insert(dict: dict.remove(dict: codes, key: \"404\"), key: \"500\", value: \"Internal Server Error\")\n\nt_insert_remove = (table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})))\n\ntest _insert_remove = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 42,
+							Line:   6,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "now = () => (2030-01-01T00:00:00Z)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   6,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 11,
+								Line:   6,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "now",
+							Start: ast.Position{
+								Column: 8,
+								Line:   6,
+							},
+						},
+					},
+					Name: "now",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 42,
+								Line:   6,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "() => (2030-01-01T00:00:00Z)",
+							Start: ast.Position{
+								Column: 14,
+								Line:   6,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 42,
+									Line:   6,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "(2030-01-01T00:00:00Z)",
+								Start: ast.Position{
+									Column: 20,
+									Line:   6,
+								},
+							},
+						},
+						Expression: &ast.DateTimeLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 41,
+										Line:   6,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "2030-01-01T00:00:00Z",
+									Start: ast.Position{
+										Column: 21,
+										Line:   6,
+									},
+								},
+							},
+							Value: parser.MustParseTime("2030-01-01T00:00:00Z"),
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 42,
+						Line:   6,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "option now = () => (2030-01-01T00:00:00Z)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   16,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "inData = \"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,12,count,http,200\n,,1,2018-05-22T19:53:26Z,3,count,http,404\n,,2,2018-05-22T19:53:26Z,1,count,http,500\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   16,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,12,count,http,200\n,,1,2018-05-22T19:53:26Z,3,count,http,404\n,,2,2018-05-22T19:53:26Z,1,count,http,500\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   8,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,long,string,string,string\n#group,false,false,false,false,true,true,true\n#default,_result,,,,,,\n,result,table,_time,_value,_field,_measurement,code\n,,0,2018-05-22T19:53:26Z,12,count,http,200\n,,1,2018-05-22T19:53:26Z,3,count,http,404\n,,2,2018-05-22T19:53:26Z,1,count,http,500\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   26,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "outData = \"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,code,status\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,12,count,http,200,OK\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,3,count,http,404,Unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1,count,http,500,Internal Server Error\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   18,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   18,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   18,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   26,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,code,status\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,12,count,http,200,OK\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,3,count,http,404,Unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1,count,http,500,Internal Server Error\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   18,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#group,false,false,true,true,false,false,true,true,true,false\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,code,status\n,,0,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,12,count,http,200,OK\n,,1,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,3,count,http,404,Unknown\n,,2,2018-05-22T19:53:26Z,2030-01-01T00:00:00Z,2018-05-22T19:53:26Z,1,count,http,500,Internal Server Error\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 92,
+						Line:   28,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "codes = dict.fromList(pairs: [{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}])",
+					Start: ast.Position{
+						Column: 1,
+						Line:   28,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 6,
+							Line:   28,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "codes",
+						Start: ast.Position{
+							Column: 1,
+							Line:   28,
+						},
+					},
+				},
+				Name: "codes",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 91,
+								Line:   28,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "pairs: [{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}]",
+							Start: ast.Position{
+								Column: 23,
+								Line:   28,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 91,
+									Line:   28,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "pairs: [{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}]",
+								Start: ast.Position{
+									Column: 23,
+									Line:   28,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 28,
+										Line:   28,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "pairs",
+									Start: ast.Position{
+										Column: 23,
+										Line:   28,
+									},
+								},
+							},
+							Name: "pairs",
+						},
+						Value: &ast.ArrayExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 91,
+										Line:   28,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "[{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}]",
+									Start: ast.Position{
+										Column: 30,
+										Line:   28,
+									},
+								},
+							},
+							Elements: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 56,
+											Line:   28,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "{key: \"200\", value: \"OK\"}",
+										Start: ast.Position{
+											Column: 31,
+											Line:   28,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 42,
+												Line:   28,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "key: \"200\"",
+											Start: ast.Position{
+												Column: 32,
+												Line:   28,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 35,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 32,
+													Line:   28,
+												},
+											},
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "\"200\"",
+												Start: ast.Position{
+													Column: 37,
+													Line:   28,
+												},
+											},
+										},
+										Value: "200",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 55,
+												Line:   28,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "value: \"OK\"",
+											Start: ast.Position{
+												Column: 44,
+												Line:   28,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 49,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 44,
+													Line:   28,
+												},
+											},
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 55,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "\"OK\"",
+												Start: ast.Position{
+													Column: 51,
+													Line:   28,
+												},
+											},
+										},
+										Value: "OK",
+									},
+								}},
+								With: nil,
+							}, &ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 90,
+											Line:   28,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "{key: \"404\", value: \"Not Found\"}",
+										Start: ast.Position{
+											Column: 58,
+											Line:   28,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 69,
+												Line:   28,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "key: \"404\"",
+											Start: ast.Position{
+												Column: 59,
+												Line:   28,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 62,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 59,
+													Line:   28,
+												},
+											},
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 69,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "\"404\"",
+												Start: ast.Position{
+													Column: 64,
+													Line:   28,
+												},
+											},
+										},
+										Value: "404",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 89,
+												Line:   28,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "value: \"Not Found\"",
+											Start: ast.Position{
+												Column: 71,
+												Line:   28,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 76,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "value",
+												Start: ast.Position{
+													Column: 71,
+													Line:   28,
+												},
+											},
+										},
+										Name: "value",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 89,
+													Line:   28,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "\"Not Found\"",
+												Start: ast.Position{
+													Column: 78,
+													Line:   28,
+												},
+											},
+										},
+										Value: "Not Found",
+									},
+								}},
+								With: nil,
+							}},
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 92,
+							Line:   28,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "dict.fromList(pairs: [{key: \"200\", value: \"OK\"}, {key: \"404\", value: \"Not Found\"}])",
+						Start: ast.Position{
+							Column: 9,
+							Line:   28,
+						},
+					},
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 22,
+								Line:   28,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "dict.fromList",
+							Start: ast.Position{
+								Column: 9,
+								Line:   28,
+							},
+						},
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 13,
+									Line:   28,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "dict",
+								Start: ast.Position{
+									Column: 9,
+									Line:   28,
+								},
+							},
+						},
+						Name: "dict",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 22,
+									Line:   28,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "fromList",
+								Start: ast.Position{
+									Column: 14,
+									Line:   28,
+								},
+							},
+						},
+						Name: "fromList",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 111,
+						Line:   29,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "statuses = dict.insert(dict: dict.remove(dict: codes, key: \"404\"), key: \"500\", value: \"Internal Server Error\")",
+					Start: ast.Position{
+						Column: 1,
+						Line:   29,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   29,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "statuses",
+						Start: ast.Position{
+							Column: 1,
+							Line:   29,
+						},
+					},
+				},
+				Name: "statuses",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 110,
+								Line:   29,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "dict: dict.remove(dict: codes, key: \"404\"), key: \"500\", value: \"Internal Server Error\"",
+							Start: ast.Position{
+								Column: 24,
+								Line:   29,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 66,
+									Line:   29,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "dict: dict.remove(dict: codes, key: \"404\")",
+								Start: ast.Position{
+									Column: 24,
+									Line:   29,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 28,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "dict",
+									Start: ast.Position{
+										Column: 24,
+										Line:   29,
+									},
+								},
+							},
+							Name: "dict",
+						},
+						Value: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 65,
+											Line:   29,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "dict: codes, key: \"404\"",
+										Start: ast.Position{
+											Column: 42,
+											Line:   29,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 53,
+												Line:   29,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "dict: codes",
+											Start: ast.Position{
+												Column: 42,
+												Line:   29,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 46,
+													Line:   29,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "dict",
+												Start: ast.Position{
+													Column: 42,
+													Line:   29,
+												},
+											},
+										},
+										Name: "dict",
+									},
+									Value: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 53,
+													Line:   29,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "codes",
+												Start: ast.Position{
+													Column: 48,
+													Line:   29,
+												},
+											},
+										},
+										Name: "codes",
+									},
+								}, &ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 65,
+												Line:   29,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "key: \"404\"",
+											Start: ast.Position{
+												Column: 55,
+												Line:   29,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 58,
+													Line:   29,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "key",
+												Start: ast.Position{
+													Column: 55,
+													Line:   29,
+												},
+											},
+										},
+										Name: "key",
+									},
+									Value: &ast.StringLiteral{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 65,
+													Line:   29,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "\"404\"",
+												Start: ast.Position{
+													Column: 60,
+													Line:   29,
+												},
+											},
+										},
+										Value: "404",
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 66,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "dict.remove(dict: codes, key: \"404\")",
+									Start: ast.Position{
+										Column: 30,
+										Line:   29,
+									},
+								},
+							},
+							Callee: &ast.MemberExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 41,
+											Line:   29,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "dict.remove",
+										Start: ast.Position{
+											Column: 30,
+											Line:   29,
+										},
+									},
+								},
+								Object: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 34,
+												Line:   29,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "dict",
+											Start: ast.Position{
+												Column: 30,
+												Line:   29,
+											},
+										},
+									},
+									Name: "dict",
+								},
+								Property: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 41,
+												Line:   29,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "remove",
+											Start: ast.Position{
+												Column: 35,
+												Line:   29,
+											},
+										},
+									},
+									Name: "remove",
+								},
+							},
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 78,
+									Line:   29,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "key: \"500\"",
+								Start: ast.Position{
+									Column: 68,
+									Line:   29,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 71,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "key",
+									Start: ast.Position{
+										Column: 68,
+										Line:   29,
+									},
+								},
+							},
+							Name: "key",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 78,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "\"500\"",
+									Start: ast.Position{
+										Column: 73,
+										Line:   29,
+									},
+								},
+							},
+							Value: "500",
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 110,
+									Line:   29,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "value: \"Internal Server Error\"",
+								Start: ast.Position{
+									Column: 80,
+									Line:   29,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 85,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "value",
+									Start: ast.Position{
+										Column: 80,
+										Line:   29,
+									},
+								},
+							},
+							Name: "value",
+						},
+						Value: &ast.StringLiteral{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 110,
+										Line:   29,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "\"Internal Server Error\"",
+									Start: ast.Position{
+										Column: 87,
+										Line:   29,
+									},
+								},
+							},
+							Value: "Internal Server Error",
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 111,
+							Line:   29,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "dict.insert(dict: dict.remove(dict: codes, key: \"404\"), key: \"500\", value: \"Internal Server Error\")",
+						Start: ast.Position{
+							Column: 12,
+							Line:   29,
+						},
+					},
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 23,
+								Line:   29,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "dict.insert",
+							Start: ast.Position{
+								Column: 12,
+								Line:   29,
+							},
+						},
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 16,
+									Line:   29,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "dict",
+								Start: ast.Position{
+									Column: 12,
+									Line:   29,
+								},
+							},
+						},
+						Name: "dict",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 23,
+									Line:   29,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "insert",
+								Start: ast.Position{
+									Column: 17,
+									Line:   29,
+								},
+							},
+						},
+						Name: "insert",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 99,
+						Line:   34,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "t_insert_remove = (table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})))",
+					Start: ast.Position{
+						Column: 1,
+						Line:   31,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 16,
+							Line:   31,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "t_insert_remove",
+						Start: ast.Position{
+							Column: 1,
+							Line:   31,
+						},
+					},
+				},
+				Name: "t_insert_remove",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 99,
+							Line:   34,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "(table=<-) =>\n\t(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})))",
+						Start: ast.Position{
+							Column: 19,
+							Line:   31,
+						},
+					},
+				},
+				Body: &ast.ParenExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 99,
+								Line:   34,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "(table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})))",
+							Start: ast.Position{
+								Column: 2,
+								Line:   32,
+							},
+						},
+					},
+					Expression: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 8,
+											Line:   32,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   32,
+										},
+									},
+								},
+								Name: "table",
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 40,
+										Line:   33,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "table\n\t\t|> range(start: 2018-05-22T19:53:26Z)",
+									Start: ast.Position{
+										Column: 3,
+										Line:   32,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   33,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "start: 2018-05-22T19:53:26Z",
+											Start: ast.Position{
+												Column: 12,
+												Line:   33,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 39,
+													Line:   33,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "start: 2018-05-22T19:53:26Z",
+												Start: ast.Position{
+													Column: 12,
+													Line:   33,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   33,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "start",
+													Start: ast.Position{
+														Column: 12,
+														Line:   33,
+													},
+												},
+											},
+											Name: "start",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 39,
+														Line:   33,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "2018-05-22T19:53:26Z",
+													Start: ast.Position{
+														Column: 19,
+														Line:   33,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2018-05-22T19:53:26Z"),
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 40,
+											Line:   33,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "range(start: 2018-05-22T19:53:26Z)",
+										Start: ast.Position{
+											Column: 6,
+											Line:   33,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 11,
+												Line:   33,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "range",
+											Start: ast.Position{
+												Column: 6,
+												Line:   33,
+											},
+										},
+									},
+									Name: "range",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 98,
+									Line:   34,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "table\n\t\t|> range(start: 2018-05-22T19:53:26Z)\n\t\t|> map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")}))",
+								Start: ast.Position{
+									Column: 3,
+									Line:   32,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 97,
+											Line:   34,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})",
+										Start: ast.Position{
+											Column: 10,
+											Line:   34,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 97,
+												Line:   34,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})",
+											Start: ast.Position{
+												Column: 10,
+												Line:   34,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 12,
+													Line:   34,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 10,
+													Line:   34,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.FunctionExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 97,
+													Line:   34,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "(r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})",
+												Start: ast.Position{
+													Column: 14,
+													Line:   34,
+												},
+											},
+										},
+										Body: &ast.ParenExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 97,
+														Line:   34,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")})",
+													Start: ast.Position{
+														Column: 21,
+														Line:   34,
+													},
+												},
+											},
+											Expression: &ast.ObjectExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 96,
+															Line:   34,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "{r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")}",
+														Start: ast.Position{
+															Column: 22,
+															Line:   34,
+														},
+													},
+												},
+												Properties: []*ast.Property{&ast.Property{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 95,
+																Line:   34,
+															},
+															File:   "insert_remove_test.flux",
+															Source: "status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")",
+															Start: ast.Position{
+																Column: 30,
+																Line:   34,
+															},
+														},
+													},
+													Key: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 36,
+																	Line:   34,
+																},
+																File:   "insert_remove_test.flux",
+																Source: "status",
+																Start: ast.Position{
+																	Column: 30,
+																	Line:   34,
+																},
+															},
+														},
+														Name: "status",
+													},
+													Value: &ast.CallExpression{
+														Arguments: []ast.Expression{&ast.ObjectExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 94,
+																		Line:   34,
+																	},
+																	File:   "insert_remove_test.flux",
+																	Source: "dict: statuses, key: r.code, default: \"Unknown\"",
+																	Start: ast.Position{
+																		Column: 47,
+																		Line:   34,
+																	},
+																},
+															},
+															Properties: []*ast.Property{&ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 61,
+																			Line:   34,
+																		},
+																		File:   "insert_remove_test.flux",
+																		Source: "dict: statuses",
+																		Start: ast.Position{
+																			Column: 47,
+																			Line:   34,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 51,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "dict",
+																			Start: ast.Position{
+																				Column: 47,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Name: "dict",
+																},
+																Value: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 61,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "statuses",
+																			Start: ast.Position{
+																				Column: 53,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Name: "statuses",
+																},
+															}, &ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 74,
+																			Line:   34,
+																		},
+																		File:   "insert_remove_test.flux",
+																		Source: "key: r.code",
+																		Start: ast.Position{
+																			Column: 63,
+																			Line:   34,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 66,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "key",
+																			Start: ast.Position{
+																				Column: 63,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Name: "key",
+																},
+																Value: &ast.MemberExpression{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 74,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "r.code",
+																			Start: ast.Position{
+																				Column: 68,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Object: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 69,
+																					Line:   34,
+																				},
+																				File:   "insert_remove_test.flux",
+																				Source: "r",
+																				Start: ast.Position{
+																					Column: 68,
+																					Line:   34,
+																				},
+																			},
+																		},
+																		Name: "r",
+																	},
+																	Property: &ast.Identifier{
+																		BaseNode: ast.BaseNode{
+																			Errors: nil,
+																			Loc: &ast.SourceLocation{
+																				End: ast.Position{
+																					Column: 74,
+																					Line:   34,
+																				},
+																				File:   "insert_remove_test.flux",
+																				Source: "code",
+																				Start: ast.Position{
+																					Column: 70,
+																					Line:   34,
+																				},
+																			},
+																		},
+																		Name: "code",
+																	},
+																},
+															}, &ast.Property{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 94,
+																			Line:   34,
+																		},
+																		File:   "insert_remove_test.flux",
+																		Source: "default: \"Unknown\"",
+																		Start: ast.Position{
+																			Column: 76,
+																			Line:   34,
+																		},
+																	},
+																},
+																Key: &ast.Identifier{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 83,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "default",
+																			Start: ast.Position{
+																				Column: 76,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Name: "default",
+																},
+																Value: &ast.StringLiteral{
+																	BaseNode: ast.BaseNode{
+																		Errors: nil,
+																		Loc: &ast.SourceLocation{
+																			End: ast.Position{
+																				Column: 94,
+																				Line:   34,
+																			},
+																			File:   "insert_remove_test.flux",
+																			Source: "\"Unknown\"",
+																			Start: ast.Position{
+																				Column: 85,
+																				Line:   34,
+																			},
+																		},
+																	},
+																	Value: "Unknown",
+																},
+															}},
+															With: nil,
+														}},
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 95,
+																	Line:   34,
+																},
+																File:   "insert_remove_test.flux",
+																Source: "dict.get(dict: statuses, key: r.code, default: \"Unknown\")",
+																Start: ast.Position{
+																	Column: 38,
+																	Line:   34,
+																},
+															},
+														},
+														Callee: &ast.MemberExpression{
+															BaseNode: ast.BaseNode{
+																Errors: nil,
+																Loc: &ast.SourceLocation{
+																	End: ast.Position{
+																		Column: 46,
+																		Line:   34,
+																	},
+																	File:   "insert_remove_test.flux",
+																	Source: "dict.get",
+																	Start: ast.Position{
+																		Column: 38,
+																		Line:   34,
+																	},
+																},
+															},
+															Object: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 42,
+																			Line:   34,
+																		},
+																		File:   "insert_remove_test.flux",
+																		Source: "dict",
+																		Start: ast.Position{
+																			Column: 38,
+																			Line:   34,
+																		},
+																	},
+																},
+																Name: "dict",
+															},
+															Property: &ast.Identifier{
+																BaseNode: ast.BaseNode{
+																	Errors: nil,
+																	Loc: &ast.SourceLocation{
+																		End: ast.Position{
+																			Column: 46,
+																			Line:   34,
+																		},
+																		File:   "insert_remove_test.flux",
+																		Source: "get",
+																		Start: ast.Position{
+																			Column: 43,
+																			Line:   34,
+																		},
+																	},
+																},
+																Name: "get",
+															},
+														},
+													},
+												}},
+												With: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 24,
+																Line:   34,
+															},
+															File:   "insert_remove_test.flux",
+															Source: "r",
+															Start: ast.Position{
+																Column: 23,
+																Line:   34,
+															},
+														},
+													},
+													Name: "r",
+												},
+											},
+										},
+										Params: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   34,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 15,
+														Line:   34,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 16,
+															Line:   34,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 15,
+															Line:   34,
+														},
+													},
+												},
+												Name: "r",
+											},
+											Value: nil,
+										}},
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 98,
+										Line:   34,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "map(fn: (r) => ({r with status: dict.get(dict: statuses, key: r.code, default: \"Unknown\")}))",
+									Start: ast.Position{
+										Column: 6,
+										Line:   34,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 9,
+											Line:   34,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "map",
+										Start: ast.Position{
+											Column: 6,
+											Line:   34,
+										},
+									},
+								},
+								Name: "map",
+							},
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   31,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 20,
+								Line:   31,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 25,
+									Line:   31,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 20,
+									Line:   31,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 28,
+								Line:   31,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 26,
+								Line:   31,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 103,
+							Line:   37,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "_insert_remove = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   36,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 20,
+								Line:   36,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "_insert_remove",
+							Start: ast.Position{
+								Column: 6,
+								Line:   36,
+							},
+						},
+					},
+					Name: "_insert_remove",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 103,
+								Line:   37,
+							},
+							File:   "insert_remove_test.flux",
+							Source: "() =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove})",
+							Start: ast.Position{
+								Column: 23,
+								Line:   36,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 103,
+									Line:   37,
+								},
+								File:   "insert_remove_test.flux",
+								Source: "({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   37,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 102,
+										Line:   37,
+									},
+									File:   "insert_remove_test.flux",
+									Source: "{input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   37,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 43,
+											Line:   37,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 4,
+											Line:   37,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 9,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 4,
+												Line:   37,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 42,
+													Line:   37,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   37,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 42,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   37,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   37,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   37,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 42,
+															Line:   37,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   37,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 43,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 11,
+												Line:   37,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   37,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 11,
+													Line:   37,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 18,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 11,
+														Line:   37,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 19,
+														Line:   37,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 80,
+											Line:   37,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 45,
+											Line:   37,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 49,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 45,
+												Line:   37,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   37,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 67,
+													Line:   37,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 67,
+														Line:   37,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 70,
+															Line:   37,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 67,
+															Line:   37,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   37,
+														},
+														File:   "insert_remove_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 72,
+															Line:   37,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 51,
+												Line:   37,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 66,
+													Line:   37,
+												},
+												File:   "insert_remove_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 51,
+													Line:   37,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 51,
+														Line:   37,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 66,
+														Line:   37,
+													},
+													File:   "insert_remove_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 59,
+														Line:   37,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 101,
+											Line:   37,
+										},
+										File:   "insert_remove_test.flux",
+										Source: "fn: t_insert_remove",
+										Start: ast.Position{
+											Column: 82,
+											Line:   37,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 84,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 82,
+												Line:   37,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 101,
+												Line:   37,
+											},
+											File:   "insert_remove_test.flux",
+											Source: "t_insert_remove",
+											Start: ast.Position{
+												Column: 86,
+												Line:   37,
+											},
+										},
+									},
+									Name: "t_insert_remove",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 103,
+						Line:   37,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "test _insert_remove = () =>\n\t({input: testing.loadStorage(csv: inData), want: testing.loadMem(csv: outData), fn: t_insert_remove})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   36,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 14,
+						Line:   4,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "import \"dict\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 14,
+							Line:   4,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "\"dict\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "dict",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "insert_remove_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 18,
+						Line:   1,
+					},
+					File:   "insert_remove_test.flux",
+					Source: "package dict_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 18,
+							Line:   1,
+						},
+						File:   "insert_remove_test.flux",
+						Source: "dict_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "dict_test",
+			},
+		},
+	}},
+	Package: "dict_test",
+	Path:    "dict",
+}}
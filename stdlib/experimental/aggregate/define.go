@@ -0,0 +1,354 @@
+package aggregate
+
+import (
+	"context"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/compiler"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	pkgpath    = "experimental/aggregate"
+	DefineKind = "defineAggregate"
+)
+
+func init() {
+	runtime.RegisterPackageValue(pkgpath, "define", values.NewFunction(
+		"define",
+		runtime.MustLookupBuiltinType(pkgpath, "define"),
+		define,
+		false,
+	))
+	flux.RegisterOpSpec(DefineKind, newDefineOp)
+	plan.RegisterProcedureSpec(DefineKind, newDefineProcedure, DefineKind)
+	execute.RegisterTransformation(DefineKind, createDefineTransformation)
+}
+
+// Aggregate is an aggregate that is defined in flux.
+// The values of each table are folded into the state with update,
+// starting from init. Each buffer of the table is folded separately
+// and the partial states are combined with merge. The value of the
+// aggregate is the result of finalize on the state.
+type Aggregate struct {
+	Init     values.Value
+	Update   interpreter.ResolvedFunction
+	Merge    interpreter.ResolvedFunction
+	Finalize interpreter.ResolvedFunction
+}
+
+func (a Aggregate) Copy() Aggregate {
+	return Aggregate{
+		// The initial state is never modified so it can be shared.
+		Init:     a.Init,
+		Update:   a.Update.Copy(),
+		Merge:    a.Merge.Copy(),
+		Finalize: a.Finalize.Copy(),
+	}
+}
+
+// define returns a function that applies the aggregate to the tables
+// in the same way as the builtin aggregates.
+func define(ctx context.Context, args values.Object) (values.Value, error) {
+	a := interpreter.NewArguments(args)
+	init, err := a.GetRequired("init")
+	if err != nil {
+		return nil, err
+	}
+	agg := Aggregate{Init: init}
+	for _, fn := range []struct {
+		name string
+		f    *interpreter.ResolvedFunction
+	}{
+		{name: "update", f: &agg.Update},
+		{name: "merge", f: &agg.Merge},
+		{name: "finalize", f: &agg.Finalize},
+	} {
+		f, err := a.GetRequiredFunction(fn.name)
+		if err != nil {
+			return nil, err
+		}
+		if *fn.f, err = interpreter.ResolveFunction(f); err != nil {
+			return nil, err
+		}
+	}
+
+	defineType := runtime.MustLookupBuiltinType(pkgpath, "define")
+	aggType, err := defineType.ReturnType()
+	if err != nil {
+		return nil, err
+	}
+	return flux.FunctionValue(DefineKind, func(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+		if err := a.AddParentFromArgs(args); err != nil {
+			return nil, err
+		}
+		s := &DefineOpSpec{Aggregate: agg}
+		if err := s.AggregateConfig.ReadArgs(args); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}, aggType)
+}
+
+type DefineOpSpec struct {
+	execute.AggregateConfig
+	Aggregate
+}
+
+func newDefineOp() flux.OperationSpec {
+	return new(DefineOpSpec)
+}
+
+func (s *DefineOpSpec) Kind() flux.OperationKind {
+	return DefineKind
+}
+
+type DefineProcedureSpec struct {
+	execute.AggregateConfig
+	Aggregate
+}
+
+func newDefineProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*DefineOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &DefineProcedureSpec{
+		AggregateConfig: spec.AggregateConfig,
+		Aggregate:       spec.Aggregate,
+	}, nil
+}
+
+func (s *DefineProcedureSpec) Kind() plan.ProcedureKind {
+	return DefineKind
+}
+
+func (s *DefineProcedureSpec) Copy() plan.ProcedureSpec {
+	return &DefineProcedureSpec{
+		AggregateConfig: s.AggregateConfig.Copy(),
+		Aggregate:       s.Aggregate.Copy(),
+	}
+}
+
+// TriggerSpec implements plan.TriggerAwareProcedureSpec
+func (s *DefineProcedureSpec) TriggerSpec() plan.TriggerSpec {
+	return plan.NarrowTransformationTriggerSpec{}
+}
+
+func createDefineTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*DefineProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	agg := &definedAgg{
+		ctx:   a.Context(),
+		agg:   s.Aggregate,
+		funcs: make(map[flux.ColType]*aggregateFuncs),
+	}
+	t, d := execute.NewAggregateTransformationAndDataset(id, mode, agg, s.AggregateConfig, a.Allocator())
+	return t, d, nil
+}
+
+// definedAgg implements execute.Aggregate for an Aggregate.
+type definedAgg struct {
+	ctx context.Context
+	agg Aggregate
+	// funcs are the compiled functions for each column type.
+	funcs map[flux.ColType]*aggregateFuncs
+}
+
+func (a *definedAgg) NewBoolAgg() execute.DoBoolAgg {
+	return a.newState(flux.TBool)
+}
+func (a *definedAgg) NewIntAgg() execute.DoIntAgg {
+	return a.newState(flux.TInt)
+}
+func (a *definedAgg) NewUIntAgg() execute.DoUIntAgg {
+	return a.newState(flux.TUInt)
+}
+func (a *definedAgg) NewFloatAgg() execute.DoFloatAgg {
+	return a.newState(flux.TFloat)
+}
+func (a *definedAgg) NewStringAgg() execute.DoStringAgg {
+	return a.newState(flux.TString)
+}
+
+func (a *definedAgg) newState(typ flux.ColType) *aggregateState {
+	fns, ok := a.funcs[typ]
+	if !ok {
+		fns = compileAggregate(a.agg, flux.SemanticType(typ))
+		a.funcs[typ] = fns
+	}
+	return &aggregateState{
+		ctx:  a.ctx,
+		fns:  fns,
+		init: a.agg.Init,
+		err:  fns.err,
+	}
+}
+
+// aggregateFuncs are the functions of an aggregate compiled
+// for the type of the values.
+type aggregateFuncs struct {
+	update, merge, finalize compiler.Func
+	// typ is the column type of the value of the aggregate.
+	typ flux.ColType
+	err error
+}
+
+func compileAggregate(agg Aggregate, valueType semantic.MonoType) *aggregateFuncs {
+	stateType := agg.Init.Type()
+	compile := func(name string, fn interpreter.ResolvedFunction, params ...semantic.PropertyType) (compiler.Func, error) {
+		f, err := compiler.Compile(compiler.ToScope(fn.Scope), fn.Fn, semantic.NewObjectType(params))
+		if err != nil {
+			return nil, errors.Wrapf(err, codes.Inherit, "failed to compile %s function", name)
+		}
+		return f, nil
+	}
+
+	var (
+		fns aggregateFuncs
+		err error
+	)
+	if fns.update, err = compile("update", agg.Update,
+		semantic.PropertyType{Key: []byte("state"), Value: stateType},
+		semantic.PropertyType{Key: []byte("value"), Value: valueType},
+	); err != nil {
+		return &aggregateFuncs{err: err}
+	}
+	if fns.merge, err = compile("merge", agg.Merge,
+		semantic.PropertyType{Key: []byte("state"), Value: stateType},
+		semantic.PropertyType{Key: []byte("other"), Value: stateType},
+	); err != nil {
+		return &aggregateFuncs{err: err}
+	}
+	if fns.finalize, err = compile("finalize", agg.Finalize,
+		semantic.PropertyType{Key: []byte("state"), Value: stateType},
+	); err != nil {
+		return &aggregateFuncs{err: err}
+	}
+	if fns.typ = flux.ColumnType(fns.finalize.Type()); fns.typ == flux.TInvalid || fns.typ == flux.TTime {
+		return &aggregateFuncs{
+			err: errors.Newf(codes.Invalid, "finalize must return a boolean, integer, unsigned integer, float or string, got %v", fns.finalize.Type()),
+		}
+	}
+	return &fns
+}
+
+// aggregateState aggregates the values of a column.
+// It implements each of the execute.DoXAgg interfaces.
+type aggregateState struct {
+	ctx  context.Context
+	fns  *aggregateFuncs
+	init values.Value
+	// state is nil until a value has been aggregated.
+	state values.Value
+	// result is the value of the aggregate once it has been finished.
+	result values.Value
+	err    error
+}
+
+func (s *aggregateState) Type() flux.ColType {
+	return s.fns.typ
+}
+
+func (s *aggregateState) Err() error {
+	return s.err
+}
+
+// Finish computes the value of the aggregate with finalize.
+// If no values were aggregated, finalize is called with init.
+func (s *aggregateState) Finish() error {
+	if s.err != nil {
+		return s.err
+	}
+	state := s.state
+	if state == nil {
+		state = s.init
+	}
+	s.result, s.err = s.eval(s.fns.finalize, "finalize", map[string]values.Value{
+		"state": state,
+	})
+	return s.err
+}
+
+func (s *aggregateState) IsNull() bool {
+	return s.result == nil || s.result.IsNull()
+}
+
+func (s *aggregateState) ValueBool() bool {
+	return s.result.Bool()
+}
+func (s *aggregateState) ValueInt() int64 {
+	return s.result.Int()
+}
+func (s *aggregateState) ValueUInt() uint64 {
+	return s.result.UInt()
+}
+func (s *aggregateState) ValueFloat() float64 {
+	return s.result.Float()
+}
+func (s *aggregateState) ValueString() string {
+	return s.result.Str()
+}
+
+func (s *aggregateState) DoBool(vs *array.Boolean) {
+	s.do(vs, func(i int) values.Value { return values.NewBool(vs.Value(i)) })
+}
+func (s *aggregateState) DoInt(vs *array.Int64) {
+	s.do(vs, func(i int) values.Value { return values.NewInt(vs.Value(i)) })
+}
+func (s *aggregateState) DoUInt(vs *array.Uint64) {
+	s.do(vs, func(i int) values.Value { return values.NewUInt(vs.Value(i)) })
+}
+func (s *aggregateState) DoFloat(vs *array.Float64) {
+	s.do(vs, func(i int) values.Value { return values.NewFloat(vs.Value(i)) })
+}
+func (s *aggregateState) DoString(vs *array.Binary) {
+	s.do(vs, func(i int) values.Value { return values.NewString(vs.ValueString(i)) })
+}
+
+// do folds the values that are not null into a partial state
+// and merges it into the state.
+func (s *aggregateState) do(vs array.Interface, value func(i int) values.Value) {
+	if s.err != nil || vs.Len() == vs.NullN() {
+		return
+	}
+	partial := s.init
+	for i, l := 0, vs.Len(); i < l; i++ {
+		if vs.IsNull(i) {
+			continue
+		}
+		if partial, s.err = s.eval(s.fns.update, "update", map[string]values.Value{
+			"state": partial,
+			"value": value(i),
+		}); s.err != nil {
+			return
+		}
+	}
+	if s.state == nil {
+		s.state = partial
+		return
+	}
+	s.state, s.err = s.eval(s.fns.merge, "merge", map[string]values.Value{
+		"state": s.state,
+		"other": partial,
+	})
+}
+
+// eval calls the function with the arguments.
+func (s *aggregateState) eval(fn compiler.Func, name string, args map[string]values.Value) (values.Value, error) {
+	v, err := fn.Eval(s.ctx, values.NewObjectWithValues(args))
+	if err != nil {
+		return nil, errors.Wrapf(err, codes.Inherit, "failed to evaluate %s function", name)
+	}
+	return v, nil
+}
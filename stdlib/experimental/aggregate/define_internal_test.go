@@ -0,0 +1,144 @@
+package aggregate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/values"
+)
+
+func TestDefine_Process(t *testing.T) {
+	fn := func(source string) interpreter.ResolvedFunction {
+		return interpreter.ResolvedFunction{
+			Fn:    executetest.FunctionExpression(t, source),
+			Scope: runtime.Prelude(),
+		}
+	}
+	mean := Aggregate{
+		Init: values.NewObjectWithValues(map[string]values.Value{
+			"sum":   values.NewFloat(0),
+			"count": values.NewInt(0),
+		}),
+		Update:   fn(`(state, value) => ({sum: state.sum + value, count: state.count + 1})`),
+		Merge:    fn(`(state, other) => ({sum: state.sum + other.sum, count: state.count + other.count})`),
+		Finalize: fn(`(state) => if state.count == 0 then 0.0 else state.sum / float(v: state.count)`),
+	}
+	count := Aggregate{
+		Init:     values.NewInt(0),
+		Update:   fn(`(state, value) => state + 1`),
+		Merge:    fn(`(state, other) => state + other`),
+		Finalize: fn(`(state) => state`),
+	}
+
+	testCases := []struct {
+		name string
+		agg  Aggregate
+		data []flux.Table
+		want []*executetest.Table
+	}{
+		{
+			name: "mean",
+			agg:  mean,
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"a", 1.0},
+						{"a", nil},
+						{"a", 2.0},
+						{"a", 6.0},
+					},
+				},
+				&executetest.Table{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"b", nil},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"a", 3.0},
+					},
+				},
+				{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{"b", 0.0},
+					},
+				},
+			},
+		},
+		{
+			name: "count strings",
+			agg:  count,
+			data: []flux.Table{
+				&executetest.Table{
+					KeyCols: []string{"t0"},
+					ColMeta: []flux.ColMeta{
+						{Label: "t0", Type: flux.TString},
+						{Label: "_value", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{"a", "x"},
+						{"a", "y"},
+						{"a", nil},
+					},
+				},
+			},
+			want: []*executetest.Table{{
+				KeyCols: []string{"t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "t0", Type: flux.TString},
+					{Label: "_value", Type: flux.TInt},
+				},
+				Data: [][]interface{}{
+					{"a", int64(2)},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper2(
+				t,
+				tc.data,
+				tc.want,
+				nil,
+				func(id execute.DatasetID, alloc *memory.Allocator) (execute.Transformation, execute.Dataset) {
+					agg := &definedAgg{
+						ctx:   context.Background(),
+						agg:   tc.agg,
+						funcs: make(map[flux.ColType]*aggregateFuncs),
+					}
+					return execute.NewAggregateTransformationAndDataset(id, execute.DiscardingMode, agg, execute.DefaultAggregateConfig, alloc)
+				},
+			)
+		})
+	}
+}
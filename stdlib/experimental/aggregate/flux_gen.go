@@ -22,29 +22,63 @@ var pkgAST = &ast.Package{
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 10,
-					Line:   14,
+					Line:   21,
 				},
 				File:   "aggregate.flux",
-				Source: "package aggregate\n\nimport \"experimental\"\n\nrate = (tables=<-, every, groupColumns=[], unit=1s) =>\n    tables\n        |> derivative(nonNegative:true, unit:unit)\n        |> aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
+				Source: "package aggregate\n\nimport \"experimental\"\n\n// define creates an aggregate function from functions written in Flux.\n// The values of a column are folded into a state with update, starting\n// with init. Partial states are combined with merge and finalize returns\n// the value of the aggregate from the state.\n// The aggregate is used like the builtin aggregates such as sum().\nbuiltin define\n\nrate = (tables=<-, every, groupColumns=[], unit=1s) =>\n    tables\n        |> derivative(nonNegative:true, unit:unit)\n        |> aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
 				},
 			},
 		},
-		Body: []ast.Statement{&ast.VariableAssignment{
+		Body: []ast.Statement{&ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 15,
+						Line:   10,
+					},
+					File:   "aggregate.flux",
+					Source: "builtin define",
+					Start: ast.Position{
+						Column: 1,
+						Line:   10,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   10,
+						},
+						File:   "aggregate.flux",
+						Source: "define",
+						Start: ast.Position{
+							Column: 9,
+							Line:   10,
+						},
+					},
+				},
+				Name: "define",
+			},
+		}, &ast.VariableAssignment{
 			BaseNode: ast.BaseNode{
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
 						Column: 10,
-						Line:   14,
+						Line:   21,
 					},
 					File:   "aggregate.flux",
 					Source: "rate = (tables=<-, every, groupColumns=[], unit=1s) =>\n    tables\n        |> derivative(nonNegative:true, unit:unit)\n        |> aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
 					Start: ast.Position{
 						Column: 1,
-						Line:   5,
+						Line:   12,
 					},
 				},
 			},
@@ -54,13 +88,13 @@ var pkgAST = &ast.Package{
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 5,
-							Line:   5,
+							Line:   12,
 						},
 						File:   "aggregate.flux",
 						Source: "rate",
 						Start: ast.Position{
 							Column: 1,
-							Line:   5,
+							Line:   12,
 						},
 					},
 				},
@@ -72,13 +106,13 @@ var pkgAST = &ast.Package{
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
 							Column: 10,
-							Line:   14,
+							Line:   21,
 						},
 						File:   "aggregate.flux",
 						Source: "(tables=<-, every, groupColumns=[], unit=1s) =>\n    tables\n        |> derivative(nonNegative:true, unit:unit)\n        |> aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
 						Start: ast.Position{
 							Column: 8,
-							Line:   5,
+							Line:   12,
 						},
 					},
 				},
@@ -90,13 +124,13 @@ var pkgAST = &ast.Package{
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 11,
-										Line:   6,
+										Line:   13,
 									},
 									File:   "aggregate.flux",
 									Source: "tables",
 									Start: ast.Position{
 										Column: 5,
-										Line:   6,
+										Line:   13,
 									},
 								},
 							},
@@ -107,13 +141,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 51,
-									Line:   7,
+									Line:   14,
 								},
 								File:   "aggregate.flux",
 								Source: "tables\n        |> derivative(nonNegative:true, unit:unit)",
 								Start: ast.Position{
 									Column: 5,
-									Line:   6,
+									Line:   13,
 								},
 							},
 						},
@@ -124,13 +158,13 @@ var pkgAST = &ast.Package{
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 50,
-											Line:   7,
+											Line:   14,
 										},
 										File:   "aggregate.flux",
 										Source: "nonNegative:true, unit:unit",
 										Start: ast.Position{
 											Column: 23,
-											Line:   7,
+											Line:   14,
 										},
 									},
 								},
@@ -140,13 +174,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 39,
-												Line:   7,
+												Line:   14,
 											},
 											File:   "aggregate.flux",
 											Source: "nonNegative:true",
 											Start: ast.Position{
 												Column: 23,
-												Line:   7,
+												Line:   14,
 											},
 										},
 									},
@@ -156,13 +190,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 34,
-													Line:   7,
+													Line:   14,
 												},
 												File:   "aggregate.flux",
 												Source: "nonNegative",
 												Start: ast.Position{
 													Column: 23,
-													Line:   7,
+													Line:   14,
 												},
 											},
 										},
@@ -174,13 +208,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 39,
-													Line:   7,
+													Line:   14,
 												},
 												File:   "aggregate.flux",
 												Source: "true",
 												Start: ast.Position{
 													Column: 35,
-													Line:   7,
+													Line:   14,
 												},
 											},
 										},
@@ -192,13 +226,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 50,
-												Line:   7,
+												Line:   14,
 											},
 											File:   "aggregate.flux",
 											Source: "unit:unit",
 											Start: ast.Position{
 												Column: 41,
-												Line:   7,
+												Line:   14,
 											},
 										},
 									},
@@ -208,13 +242,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 45,
-													Line:   7,
+													Line:   14,
 												},
 												File:   "aggregate.flux",
 												Source: "unit",
 												Start: ast.Position{
 													Column: 41,
-													Line:   7,
+													Line:   14,
 												},
 											},
 										},
@@ -226,13 +260,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 50,
-													Line:   7,
+													Line:   14,
 												},
 												File:   "aggregate.flux",
 												Source: "unit",
 												Start: ast.Position{
 													Column: 46,
-													Line:   7,
+													Line:   14,
 												},
 											},
 										},
@@ -246,13 +280,13 @@ var pkgAST = &ast.Package{
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 51,
-										Line:   7,
+										Line:   14,
 									},
 									File:   "aggregate.flux",
 									Source: "derivative(nonNegative:true, unit:unit)",
 									Start: ast.Position{
 										Column: 12,
-										Line:   7,
+										Line:   14,
 									},
 								},
 							},
@@ -262,13 +296,13 @@ var pkgAST = &ast.Package{
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 22,
-											Line:   7,
+											Line:   14,
 										},
 										File:   "aggregate.flux",
 										Source: "derivative",
 										Start: ast.Position{
 											Column: 12,
-											Line:   7,
+											Line:   14,
 										},
 									},
 								},
@@ -281,13 +315,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 10,
-								Line:   14,
+								Line:   21,
 							},
 							File:   "aggregate.flux",
 							Source: "tables\n        |> derivative(nonNegative:true, unit:unit)\n        |> aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
 							Start: ast.Position{
 								Column: 5,
-								Line:   6,
+								Line:   13,
 							},
 						},
 					},
@@ -298,13 +332,13 @@ var pkgAST = &ast.Package{
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 25,
-										Line:   13,
+										Line:   20,
 									},
 									File:   "aggregate.flux",
 									Source: "every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()",
 									Start: ast.Position{
 										Column: 28,
-										Line:   8,
+										Line:   15,
 									},
 								},
 							},
@@ -314,13 +348,13 @@ var pkgAST = &ast.Package{
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 40,
-											Line:   8,
+											Line:   15,
 										},
 										File:   "aggregate.flux",
 										Source: "every: every",
 										Start: ast.Position{
 											Column: 28,
-											Line:   8,
+											Line:   15,
 										},
 									},
 								},
@@ -330,13 +364,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 33,
-												Line:   8,
+												Line:   15,
 											},
 											File:   "aggregate.flux",
 											Source: "every",
 											Start: ast.Position{
 												Column: 28,
-												Line:   8,
+												Line:   15,
 											},
 										},
 									},
@@ -348,13 +382,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 40,
-												Line:   8,
+												Line:   15,
 											},
 											File:   "aggregate.flux",
 											Source: "every",
 											Start: ast.Position{
 												Column: 35,
-												Line:   8,
+												Line:   15,
 											},
 										},
 									},
@@ -366,13 +400,13 @@ var pkgAST = &ast.Package{
 									Loc: &ast.SourceLocation{
 										End: ast.Position{
 											Column: 25,
-											Line:   13,
+											Line:   20,
 										},
 										File:   "aggregate.flux",
 										Source: "fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()",
 										Start: ast.Position{
 											Column: 42,
-											Line:   8,
+											Line:   15,
 										},
 									},
 								},
@@ -382,13 +416,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 44,
-												Line:   8,
+												Line:   15,
 											},
 											File:   "aggregate.flux",
 											Source: "fn",
 											Start: ast.Position{
 												Column: 42,
-												Line:   8,
+												Line:   15,
 											},
 										},
 									},
@@ -400,13 +434,13 @@ var pkgAST = &ast.Package{
 										Loc: &ast.SourceLocation{
 											End: ast.Position{
 												Column: 25,
-												Line:   13,
+												Line:   20,
 											},
 											File:   "aggregate.flux",
 											Source: "(tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()",
 											Start: ast.Position{
 												Column: 47,
-												Line:   8,
+												Line:   15,
 											},
 										},
 									},
@@ -420,13 +454,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 19,
-																	Line:   9,
+																	Line:   16,
 																},
 																File:   "aggregate.flux",
 																Source: "tables",
 																Start: ast.Position{
 																	Column: 13,
-																	Line:   9,
+																	Line:   16,
 																},
 															},
 														},
@@ -437,13 +471,13 @@ var pkgAST = &ast.Package{
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 40,
-																Line:   10,
+																Line:   17,
 															},
 															File:   "aggregate.flux",
 															Source: "tables\n                |> mean(column: column)",
 															Start: ast.Position{
 																Column: 13,
-																Line:   9,
+																Line:   16,
 															},
 														},
 													},
@@ -454,13 +488,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 39,
-																		Line:   10,
+																		Line:   17,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "column: column",
 																	Start: ast.Position{
 																		Column: 25,
-																		Line:   10,
+																		Line:   17,
 																	},
 																},
 															},
@@ -470,13 +504,13 @@ var pkgAST = &ast.Package{
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 39,
-																			Line:   10,
+																			Line:   17,
 																		},
 																		File:   "aggregate.flux",
 																		Source: "column: column",
 																		Start: ast.Position{
 																			Column: 25,
-																			Line:   10,
+																			Line:   17,
 																		},
 																	},
 																},
@@ -486,13 +520,13 @@ var pkgAST = &ast.Package{
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 31,
-																				Line:   10,
+																				Line:   17,
 																			},
 																			File:   "aggregate.flux",
 																			Source: "column",
 																			Start: ast.Position{
 																				Column: 25,
-																				Line:   10,
+																				Line:   17,
 																			},
 																		},
 																	},
@@ -504,13 +538,13 @@ var pkgAST = &ast.Package{
 																		Loc: &ast.SourceLocation{
 																			End: ast.Position{
 																				Column: 39,
-																				Line:   10,
+																				Line:   17,
 																			},
 																			File:   "aggregate.flux",
 																			Source: "column",
 																			Start: ast.Position{
 																				Column: 33,
-																				Line:   10,
+																				Line:   17,
 																			},
 																		},
 																	},
@@ -524,13 +558,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 40,
-																	Line:   10,
+																	Line:   17,
 																},
 																File:   "aggregate.flux",
 																Source: "mean(column: column)",
 																Start: ast.Position{
 																	Column: 20,
-																	Line:   10,
+																	Line:   17,
 																},
 															},
 														},
@@ -540,13 +574,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 24,
-																		Line:   10,
+																		Line:   17,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "mean",
 																	Start: ast.Position{
 																		Column: 20,
-																		Line:   10,
+																		Line:   17,
 																	},
 																},
 															},
@@ -559,13 +593,13 @@ var pkgAST = &ast.Package{
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 48,
-															Line:   11,
+															Line:   18,
 														},
 														File:   "aggregate.flux",
 														Source: "tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)",
 														Start: ast.Position{
 															Column: 13,
-															Line:   9,
+															Line:   16,
 														},
 													},
 												},
@@ -576,13 +610,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 47,
-																	Line:   11,
+																	Line:   18,
 																},
 																File:   "aggregate.flux",
 																Source: "columns: groupColumns",
 																Start: ast.Position{
 																	Column: 26,
-																	Line:   11,
+																	Line:   18,
 																},
 															},
 														},
@@ -592,13 +626,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 47,
-																		Line:   11,
+																		Line:   18,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "columns: groupColumns",
 																	Start: ast.Position{
 																		Column: 26,
-																		Line:   11,
+																		Line:   18,
 																	},
 																},
 															},
@@ -608,13 +642,13 @@ var pkgAST = &ast.Package{
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 33,
-																			Line:   11,
+																			Line:   18,
 																		},
 																		File:   "aggregate.flux",
 																		Source: "columns",
 																		Start: ast.Position{
 																			Column: 26,
-																			Line:   11,
+																			Line:   18,
 																		},
 																	},
 																},
@@ -626,13 +660,13 @@ var pkgAST = &ast.Package{
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 47,
-																			Line:   11,
+																			Line:   18,
 																		},
 																		File:   "aggregate.flux",
 																		Source: "groupColumns",
 																		Start: ast.Position{
 																			Column: 35,
-																			Line:   11,
+																			Line:   18,
 																		},
 																	},
 																},
@@ -646,13 +680,13 @@ var pkgAST = &ast.Package{
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 48,
-																Line:   11,
+																Line:   18,
 															},
 															File:   "aggregate.flux",
 															Source: "group(columns: groupColumns)",
 															Start: ast.Position{
 																Column: 20,
-																Line:   11,
+																Line:   18,
 															},
 														},
 													},
@@ -662,13 +696,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 25,
-																	Line:   11,
+																	Line:   18,
 																},
 																File:   "aggregate.flux",
 																Source: "group",
 																Start: ast.Position{
 																	Column: 20,
-																	Line:   11,
+																	Line:   18,
 																},
 															},
 														},
@@ -681,13 +715,13 @@ var pkgAST = &ast.Package{
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 83,
-														Line:   12,
+														Line:   19,
 													},
 													File:   "aggregate.flux",
 													Source: "tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")",
 													Start: ast.Position{
 														Column: 13,
-														Line:   9,
+														Line:   16,
 													},
 												},
 											},
@@ -698,13 +732,13 @@ var pkgAST = &ast.Package{
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 82,
-																Line:   12,
+																Line:   19,
 															},
 															File:   "aggregate.flux",
 															Source: "columns: [\"_start\", \"_stop\"], mode:\"extend\"",
 															Start: ast.Position{
 																Column: 39,
-																Line:   12,
+																Line:   19,
 															},
 														},
 													},
@@ -714,13 +748,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 67,
-																	Line:   12,
+																	Line:   19,
 																},
 																File:   "aggregate.flux",
 																Source: "columns: [\"_start\", \"_stop\"]",
 																Start: ast.Position{
 																	Column: 39,
-																	Line:   12,
+																	Line:   19,
 																},
 															},
 														},
@@ -730,13 +764,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 46,
-																		Line:   12,
+																		Line:   19,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "columns",
 																	Start: ast.Position{
 																		Column: 39,
-																		Line:   12,
+																		Line:   19,
 																	},
 																},
 															},
@@ -748,13 +782,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 67,
-																		Line:   12,
+																		Line:   19,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "[\"_start\", \"_stop\"]",
 																	Start: ast.Position{
 																		Column: 48,
-																		Line:   12,
+																		Line:   19,
 																	},
 																},
 															},
@@ -764,13 +798,13 @@ var pkgAST = &ast.Package{
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 57,
-																			Line:   12,
+																			Line:   19,
 																		},
 																		File:   "aggregate.flux",
 																		Source: "\"_start\"",
 																		Start: ast.Position{
 																			Column: 49,
-																			Line:   12,
+																			Line:   19,
 																		},
 																	},
 																},
@@ -781,13 +815,13 @@ var pkgAST = &ast.Package{
 																	Loc: &ast.SourceLocation{
 																		End: ast.Position{
 																			Column: 66,
-																			Line:   12,
+																			Line:   19,
 																		},
 																		File:   "aggregate.flux",
 																		Source: "\"_stop\"",
 																		Start: ast.Position{
 																			Column: 59,
-																			Line:   12,
+																			Line:   19,
 																		},
 																	},
 																},
@@ -800,13 +834,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 82,
-																	Line:   12,
+																	Line:   19,
 																},
 																File:   "aggregate.flux",
 																Source: "mode:\"extend\"",
 																Start: ast.Position{
 																	Column: 69,
-																	Line:   12,
+																	Line:   19,
 																},
 															},
 														},
@@ -816,13 +850,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 73,
-																		Line:   12,
+																		Line:   19,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "mode",
 																	Start: ast.Position{
 																		Column: 69,
-																		Line:   12,
+																		Line:   19,
 																	},
 																},
 															},
@@ -834,13 +868,13 @@ var pkgAST = &ast.Package{
 																Loc: &ast.SourceLocation{
 																	End: ast.Position{
 																		Column: 82,
-																		Line:   12,
+																		Line:   19,
 																	},
 																	File:   "aggregate.flux",
 																	Source: "\"extend\"",
 																	Start: ast.Position{
 																		Column: 74,
-																		Line:   12,
+																		Line:   19,
 																	},
 																},
 															},
@@ -854,13 +888,13 @@ var pkgAST = &ast.Package{
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 83,
-															Line:   12,
+															Line:   19,
 														},
 														File:   "aggregate.flux",
 														Source: "experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")",
 														Start: ast.Position{
 															Column: 20,
-															Line:   12,
+															Line:   19,
 														},
 													},
 												},
@@ -870,13 +904,13 @@ var pkgAST = &ast.Package{
 														Loc: &ast.SourceLocation{
 															End: ast.Position{
 																Column: 38,
-																Line:   12,
+																Line:   19,
 															},
 															File:   "aggregate.flux",
 															Source: "experimental.group",
 															Start: ast.Position{
 																Column: 20,
-																Line:   12,
+																Line:   19,
 															},
 														},
 													},
@@ -886,13 +920,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 32,
-																	Line:   12,
+																	Line:   19,
 																},
 																File:   "aggregate.flux",
 																Source: "experimental",
 																Start: ast.Position{
 																	Column: 20,
-																	Line:   12,
+																	Line:   19,
 																},
 															},
 														},
@@ -904,13 +938,13 @@ var pkgAST = &ast.Package{
 															Loc: &ast.SourceLocation{
 																End: ast.Position{
 																	Column: 38,
-																	Line:   12,
+																	Line:   19,
 																},
 																File:   "aggregate.flux",
 																Source: "group",
 																Start: ast.Position{
 																	Column: 33,
-																	Line:   12,
+																	Line:   19,
 																},
 															},
 														},
@@ -924,13 +958,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 25,
-													Line:   13,
+													Line:   20,
 												},
 												File:   "aggregate.flux",
 												Source: "tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()",
 												Start: ast.Position{
 													Column: 13,
-													Line:   9,
+													Line:   16,
 												},
 											},
 										},
@@ -941,13 +975,13 @@ var pkgAST = &ast.Package{
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 25,
-														Line:   13,
+														Line:   20,
 													},
 													File:   "aggregate.flux",
 													Source: "sum()",
 													Start: ast.Position{
 														Column: 20,
-														Line:   13,
+														Line:   20,
 													},
 												},
 											},
@@ -957,13 +991,13 @@ var pkgAST = &ast.Package{
 													Loc: &ast.SourceLocation{
 														End: ast.Position{
 															Column: 23,
-															Line:   13,
+															Line:   20,
 														},
 														File:   "aggregate.flux",
 														Source: "sum",
 														Start: ast.Position{
 															Column: 20,
-															Line:   13,
+															Line:   20,
 														},
 													},
 												},
@@ -977,13 +1011,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 57,
-													Line:   8,
+													Line:   15,
 												},
 												File:   "aggregate.flux",
 												Source: "tables=<-",
 												Start: ast.Position{
 													Column: 48,
-													Line:   8,
+													Line:   15,
 												},
 											},
 										},
@@ -993,13 +1027,13 @@ var pkgAST = &ast.Package{
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 54,
-														Line:   8,
+														Line:   15,
 													},
 													File:   "aggregate.flux",
 													Source: "tables",
 													Start: ast.Position{
 														Column: 48,
-														Line:   8,
+														Line:   15,
 													},
 												},
 											},
@@ -1010,13 +1044,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 57,
-													Line:   8,
+													Line:   15,
 												},
 												File:   "aggregate.flux",
 												Source: "<-",
 												Start: ast.Position{
 													Column: 55,
-													Line:   8,
+													Line:   15,
 												},
 											},
 										}},
@@ -1026,13 +1060,13 @@ var pkgAST = &ast.Package{
 											Loc: &ast.SourceLocation{
 												End: ast.Position{
 													Column: 65,
-													Line:   8,
+													Line:   15,
 												},
 												File:   "aggregate.flux",
 												Source: "column",
 												Start: ast.Position{
 													Column: 59,
-													Line:   8,
+													Line:   15,
 												},
 											},
 										},
@@ -1042,13 +1076,13 @@ var pkgAST = &ast.Package{
 												Loc: &ast.SourceLocation{
 													End: ast.Position{
 														Column: 65,
-														Line:   8,
+														Line:   15,
 													},
 													File:   "aggregate.flux",
 													Source: "column",
 													Start: ast.Position{
 														Column: 59,
-														Line:   8,
+														Line:   15,
 													},
 												},
 											},
@@ -1065,13 +1099,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 10,
-									Line:   14,
+									Line:   21,
 								},
 								File:   "aggregate.flux",
 								Source: "aggregateWindow(every: every, fn : (tables=<-, column) =>\n            tables\n                |> mean(column: column)\n                |> group(columns: groupColumns)\n                |> experimental.group(columns: [\"_start\", \"_stop\"], mode:\"extend\")\n                |> sum()\n        )",
 								Start: ast.Position{
 									Column: 12,
-									Line:   8,
+									Line:   15,
 								},
 							},
 						},
@@ -1081,13 +1115,13 @@ var pkgAST = &ast.Package{
 								Loc: &ast.SourceLocation{
 									End: ast.Position{
 										Column: 27,
-										Line:   8,
+										Line:   15,
 									},
 									File:   "aggregate.flux",
 									Source: "aggregateWindow",
 									Start: ast.Position{
 										Column: 12,
-										Line:   8,
+										Line:   15,
 									},
 								},
 							},
@@ -1101,13 +1135,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   5,
+								Line:   12,
 							},
 							File:   "aggregate.flux",
 							Source: "tables=<-",
 							Start: ast.Position{
 								Column: 9,
-								Line:   5,
+								Line:   12,
 							},
 						},
 					},
@@ -1117,13 +1151,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 15,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "tables",
 								Start: ast.Position{
 									Column: 9,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
@@ -1134,13 +1168,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 18,
-								Line:   5,
+								Line:   12,
 							},
 							File:   "aggregate.flux",
 							Source: "<-",
 							Start: ast.Position{
 								Column: 16,
-								Line:   5,
+								Line:   12,
 							},
 						},
 					}},
@@ -1150,13 +1184,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 25,
-								Line:   5,
+								Line:   12,
 							},
 							File:   "aggregate.flux",
 							Source: "every",
 							Start: ast.Position{
 								Column: 20,
-								Line:   5,
+								Line:   12,
 							},
 						},
 					},
@@ -1166,13 +1200,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 25,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "every",
 								Start: ast.Position{
 									Column: 20,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
@@ -1185,13 +1219,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 42,
-								Line:   5,
+								Line:   12,
 							},
 							File:   "aggregate.flux",
 							Source: "groupColumns=[]",
 							Start: ast.Position{
 								Column: 27,
-								Line:   5,
+								Line:   12,
 							},
 						},
 					},
@@ -1201,13 +1235,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 39,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "groupColumns",
 								Start: ast.Position{
 									Column: 27,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
@@ -1219,17 +1253,17 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 42,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "[]",
 								Start: ast.Position{
 									Column: 40,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
-						Elements: []ast.Expression{},
+						Elements: nil,
 					},
 				}, &ast.Property{
 					BaseNode: ast.BaseNode{
@@ -1237,13 +1271,13 @@ var pkgAST = &ast.Package{
 						Loc: &ast.SourceLocation{
 							End: ast.Position{
 								Column: 51,
-								Line:   5,
+								Line:   12,
 							},
 							File:   "aggregate.flux",
 							Source: "unit=1s",
 							Start: ast.Position{
 								Column: 44,
-								Line:   5,
+								Line:   12,
 							},
 						},
 					},
@@ -1253,13 +1287,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 48,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "unit",
 								Start: ast.Position{
 									Column: 44,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
@@ -1271,13 +1305,13 @@ var pkgAST = &ast.Package{
 							Loc: &ast.SourceLocation{
 								End: ast.Position{
 									Column: 51,
-									Line:   5,
+									Line:   12,
 								},
 								File:   "aggregate.flux",
 								Source: "1s",
 								Start: ast.Position{
 									Column: 49,
-									Line:   5,
+									Line:   12,
 								},
 							},
 						},
@@ -1733,6 +1733,3113 @@ var FluxTestPackages = []*ast.Package{&ast.Package{
 				Name: "aggregate_test",
 			},
 		},
+	}, &ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 3,
+					Line:   55,
+				},
+				File:   "define_test.flux",
+				Source: "package aggregate_test\n\nimport \"experimental/aggregate\"\nimport \"testing\"\n\ninData = \"\n#group,false,false,true,true,false,false,true,true,true,true\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,interface\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,1,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,2,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,3,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,4,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,5,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,6,bytes_recv,net,host.local,en7\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,10,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,20,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,30,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,40,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,50,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,60,bytes_recv,net,host.local,utun2\n\"\n\noutData = \"\n#group,false,false,true,true,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string,double,dateTime:RFC3339\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_field,_measurement,host,interface,_value,_time\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,1.5,2020-02-20T23:00:20Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,3.5,2020-02-20T23:00:40Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,5.5,2020-02-20T23:01:00Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,15,2020-02-20T23:00:20Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,35,2020-02-20T23:00:40Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,55,2020-02-20T23:01:00Z\n\"\n\nmean = aggregate.define(\n    init: {sum: 0, count: 0},\n    update: (state, value) => ({sum: state.sum + value, count: state.count + 1}),\n    merge: (state, other) => ({sum: state.sum + other.sum, count: state.count + other.count}),\n    finalize: (state) => float(v: state.sum) / float(v: state.count),\n)\n\nt_define = (table=<-) =>\n    table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)\n        |> filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")\n        |> aggregateWindow(every: 20s, fn: mean)\n\ntest define = () => ({\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   23,
+					},
+					File:   "define_test.flux",
+					Source: "inData = \"\n#group,false,false,true,true,false,false,true,true,true,true\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,interface\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,1,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,2,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,3,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,4,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,5,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,6,bytes_recv,net,host.local,en7\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,10,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,20,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,30,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,40,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,50,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,60,bytes_recv,net,host.local,utun2\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   6,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 7,
+							Line:   6,
+						},
+						File:   "define_test.flux",
+						Source: "inData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   6,
+						},
+					},
+				},
+				Name: "inData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   23,
+						},
+						File:   "define_test.flux",
+						Source: "\"\n#group,false,false,true,true,false,false,true,true,true,true\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,interface\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,1,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,2,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,3,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,4,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,5,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,6,bytes_recv,net,host.local,en7\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,10,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,20,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,30,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,40,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,50,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,60,bytes_recv,net,host.local,utun2\n\"",
+						Start: ast.Position{
+							Column: 10,
+							Line:   6,
+						},
+					},
+				},
+				Value: "\n#group,false,false,true,true,false,false,true,true,true,true\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,long,string,string,string,string\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_time,_value,_field,_measurement,host,interface\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,1,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,2,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,3,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,4,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,5,bytes_recv,net,host.local,en7\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,6,bytes_recv,net,host.local,en7\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:00Z,10,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:10Z,20,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:20Z,30,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:30Z,40,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:40Z,50,bytes_recv,net,host.local,utun2\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,2020-02-20T23:00:50Z,60,bytes_recv,net,host.local,utun2\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   36,
+					},
+					File:   "define_test.flux",
+					Source: "outData = \"\n#group,false,false,true,true,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string,double,dateTime:RFC3339\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_field,_measurement,host,interface,_value,_time\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,1.5,2020-02-20T23:00:20Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,3.5,2020-02-20T23:00:40Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,5.5,2020-02-20T23:01:00Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,15,2020-02-20T23:00:20Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,35,2020-02-20T23:00:40Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,55,2020-02-20T23:01:00Z\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   25,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 8,
+							Line:   25,
+						},
+						File:   "define_test.flux",
+						Source: "outData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   25,
+						},
+					},
+				},
+				Name: "outData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   36,
+						},
+						File:   "define_test.flux",
+						Source: "\"\n#group,false,false,true,true,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string,double,dateTime:RFC3339\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_field,_measurement,host,interface,_value,_time\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,1.5,2020-02-20T23:00:20Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,3.5,2020-02-20T23:00:40Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,5.5,2020-02-20T23:01:00Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,15,2020-02-20T23:00:20Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,35,2020-02-20T23:00:40Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,55,2020-02-20T23:01:00Z\n\"",
+						Start: ast.Position{
+							Column: 11,
+							Line:   25,
+						},
+					},
+				},
+				Value: "\n#group,false,false,true,true,true,true,true,true,false,false\n#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string,double,dateTime:RFC3339\n#default,_result,,,,,,,,,\n,result,table,_start,_stop,_field,_measurement,host,interface,_value,_time\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,1.5,2020-02-20T23:00:20Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,3.5,2020-02-20T23:00:40Z\n,,0,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,en7,5.5,2020-02-20T23:01:00Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,15,2020-02-20T23:00:20Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,35,2020-02-20T23:00:40Z\n,,1,2020-02-20T23:00:00Z,2020-02-20T23:01:00Z,bytes_recv,net,host.local,utun2,55,2020-02-20T23:01:00Z\n",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   43,
+					},
+					File:   "define_test.flux",
+					Source: "mean = aggregate.define(\n    init: {sum: 0, count: 0},\n    update: (state, value) => ({sum: state.sum + value, count: state.count + 1}),\n    merge: (state, other) => ({sum: state.sum + other.sum, count: state.count + other.count}),\n    finalize: (state) => float(v: state.sum) / float(v: state.count),\n)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   38,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 5,
+							Line:   38,
+						},
+						File:   "define_test.flux",
+						Source: "mean",
+						Start: ast.Position{
+							Column: 1,
+							Line:   38,
+						},
+					},
+				},
+				Name: "mean",
+			},
+			Init: &ast.CallExpression{
+				Arguments: []ast.Expression{&ast.ObjectExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 69,
+								Line:   42,
+							},
+							File:   "define_test.flux",
+							Source: "init: {sum: 0, count: 0},\n    update: (state, value) => ({sum: state.sum + value, count: state.count + 1}),\n    merge: (state, other) => ({sum: state.sum + other.sum, count: state.count + other.count}),\n    finalize: (state) => float(v: state.sum) / float(v: state.count)",
+							Start: ast.Position{
+								Column: 5,
+								Line:   39,
+							},
+						},
+					},
+					Properties: []*ast.Property{&ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 29,
+									Line:   39,
+								},
+								File:   "define_test.flux",
+								Source: "init: {sum: 0, count: 0}",
+								Start: ast.Position{
+									Column: 5,
+									Line:   39,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 9,
+										Line:   39,
+									},
+									File:   "define_test.flux",
+									Source: "init",
+									Start: ast.Position{
+										Column: 5,
+										Line:   39,
+									},
+								},
+							},
+							Name: "init",
+						},
+						Value: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 29,
+										Line:   39,
+									},
+									File:   "define_test.flux",
+									Source: "{sum: 0, count: 0}",
+									Start: ast.Position{
+										Column: 11,
+										Line:   39,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 18,
+											Line:   39,
+										},
+										File:   "define_test.flux",
+										Source: "sum: 0",
+										Start: ast.Position{
+											Column: 12,
+											Line:   39,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 15,
+												Line:   39,
+											},
+											File:   "define_test.flux",
+											Source: "sum",
+											Start: ast.Position{
+												Column: 12,
+												Line:   39,
+											},
+										},
+									},
+									Name: "sum",
+								},
+								Value: &ast.IntegerLiteral{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 18,
+												Line:   39,
+											},
+											File:   "define_test.flux",
+											Source: "0",
+											Start: ast.Position{
+												Column: 17,
+												Line:   39,
+											},
+										},
+									},
+									Value: int64(0),
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 28,
+											Line:   39,
+										},
+										File:   "define_test.flux",
+										Source: "count: 0",
+										Start: ast.Position{
+											Column: 20,
+											Line:   39,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 25,
+												Line:   39,
+											},
+											File:   "define_test.flux",
+											Source: "count",
+											Start: ast.Position{
+												Column: 20,
+												Line:   39,
+											},
+										},
+									},
+									Name: "count",
+								},
+								Value: &ast.IntegerLiteral{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 28,
+												Line:   39,
+											},
+											File:   "define_test.flux",
+											Source: "0",
+											Start: ast.Position{
+												Column: 27,
+												Line:   39,
+											},
+										},
+									},
+									Value: int64(0),
+								},
+							}},
+							With: nil,
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 81,
+									Line:   40,
+								},
+								File:   "define_test.flux",
+								Source: "update: (state, value) => ({sum: state.sum + value, count: state.count + 1})",
+								Start: ast.Position{
+									Column: 5,
+									Line:   40,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 11,
+										Line:   40,
+									},
+									File:   "define_test.flux",
+									Source: "update",
+									Start: ast.Position{
+										Column: 5,
+										Line:   40,
+									},
+								},
+							},
+							Name: "update",
+						},
+						Value: &ast.FunctionExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 81,
+										Line:   40,
+									},
+									File:   "define_test.flux",
+									Source: "(state, value) => ({sum: state.sum + value, count: state.count + 1})",
+									Start: ast.Position{
+										Column: 13,
+										Line:   40,
+									},
+								},
+							},
+							Body: &ast.ParenExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 81,
+											Line:   40,
+										},
+										File:   "define_test.flux",
+										Source: "({sum: state.sum + value, count: state.count + 1})",
+										Start: ast.Position{
+											Column: 31,
+											Line:   40,
+										},
+									},
+								},
+								Expression: &ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 80,
+												Line:   40,
+											},
+											File:   "define_test.flux",
+											Source: "{sum: state.sum + value, count: state.count + 1}",
+											Start: ast.Position{
+												Column: 32,
+												Line:   40,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 55,
+													Line:   40,
+												},
+												File:   "define_test.flux",
+												Source: "sum: state.sum + value",
+												Start: ast.Position{
+													Column: 33,
+													Line:   40,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 36,
+														Line:   40,
+													},
+													File:   "define_test.flux",
+													Source: "sum",
+													Start: ast.Position{
+														Column: 33,
+														Line:   40,
+													},
+												},
+											},
+											Name: "sum",
+										},
+										Value: &ast.BinaryExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 55,
+														Line:   40,
+													},
+													File:   "define_test.flux",
+													Source: "state.sum + value",
+													Start: ast.Position{
+														Column: 38,
+														Line:   40,
+													},
+												},
+											},
+											Left: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 47,
+															Line:   40,
+														},
+														File:   "define_test.flux",
+														Source: "state.sum",
+														Start: ast.Position{
+															Column: 38,
+															Line:   40,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 43,
+																Line:   40,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 38,
+																Line:   40,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 47,
+																Line:   40,
+															},
+															File:   "define_test.flux",
+															Source: "sum",
+															Start: ast.Position{
+																Column: 44,
+																Line:   40,
+															},
+														},
+													},
+													Name: "sum",
+												},
+											},
+											Operator: 5,
+											Right: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 55,
+															Line:   40,
+														},
+														File:   "define_test.flux",
+														Source: "value",
+														Start: ast.Position{
+															Column: 50,
+															Line:   40,
+														},
+													},
+												},
+												Name: "value",
+											},
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 79,
+													Line:   40,
+												},
+												File:   "define_test.flux",
+												Source: "count: state.count + 1",
+												Start: ast.Position{
+													Column: 57,
+													Line:   40,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 62,
+														Line:   40,
+													},
+													File:   "define_test.flux",
+													Source: "count",
+													Start: ast.Position{
+														Column: 57,
+														Line:   40,
+													},
+												},
+											},
+											Name: "count",
+										},
+										Value: &ast.BinaryExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 79,
+														Line:   40,
+													},
+													File:   "define_test.flux",
+													Source: "state.count + 1",
+													Start: ast.Position{
+														Column: 64,
+														Line:   40,
+													},
+												},
+											},
+											Left: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 75,
+															Line:   40,
+														},
+														File:   "define_test.flux",
+														Source: "state.count",
+														Start: ast.Position{
+															Column: 64,
+															Line:   40,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 69,
+																Line:   40,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 64,
+																Line:   40,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 75,
+																Line:   40,
+															},
+															File:   "define_test.flux",
+															Source: "count",
+															Start: ast.Position{
+																Column: 70,
+																Line:   40,
+															},
+														},
+													},
+													Name: "count",
+												},
+											},
+											Operator: 5,
+											Right: &ast.IntegerLiteral{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 79,
+															Line:   40,
+														},
+														File:   "define_test.flux",
+														Source: "1",
+														Start: ast.Position{
+															Column: 78,
+															Line:   40,
+														},
+													},
+												},
+												Value: int64(1),
+											},
+										},
+									}},
+									With: nil,
+								},
+							},
+							Params: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 19,
+											Line:   40,
+										},
+										File:   "define_test.flux",
+										Source: "state",
+										Start: ast.Position{
+											Column: 14,
+											Line:   40,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 19,
+												Line:   40,
+											},
+											File:   "define_test.flux",
+											Source: "state",
+											Start: ast.Position{
+												Column: 14,
+												Line:   40,
+											},
+										},
+									},
+									Name: "state",
+								},
+								Value: nil,
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 26,
+											Line:   40,
+										},
+										File:   "define_test.flux",
+										Source: "value",
+										Start: ast.Position{
+											Column: 21,
+											Line:   40,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   40,
+											},
+											File:   "define_test.flux",
+											Source: "value",
+											Start: ast.Position{
+												Column: 21,
+												Line:   40,
+											},
+										},
+									},
+									Name: "value",
+								},
+								Value: nil,
+							}},
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 94,
+									Line:   41,
+								},
+								File:   "define_test.flux",
+								Source: "merge: (state, other) => ({sum: state.sum + other.sum, count: state.count + other.count})",
+								Start: ast.Position{
+									Column: 5,
+									Line:   41,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 10,
+										Line:   41,
+									},
+									File:   "define_test.flux",
+									Source: "merge",
+									Start: ast.Position{
+										Column: 5,
+										Line:   41,
+									},
+								},
+							},
+							Name: "merge",
+						},
+						Value: &ast.FunctionExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 94,
+										Line:   41,
+									},
+									File:   "define_test.flux",
+									Source: "(state, other) => ({sum: state.sum + other.sum, count: state.count + other.count})",
+									Start: ast.Position{
+										Column: 12,
+										Line:   41,
+									},
+								},
+							},
+							Body: &ast.ParenExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 94,
+											Line:   41,
+										},
+										File:   "define_test.flux",
+										Source: "({sum: state.sum + other.sum, count: state.count + other.count})",
+										Start: ast.Position{
+											Column: 30,
+											Line:   41,
+										},
+									},
+								},
+								Expression: &ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 93,
+												Line:   41,
+											},
+											File:   "define_test.flux",
+											Source: "{sum: state.sum + other.sum, count: state.count + other.count}",
+											Start: ast.Position{
+												Column: 31,
+												Line:   41,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 58,
+													Line:   41,
+												},
+												File:   "define_test.flux",
+												Source: "sum: state.sum + other.sum",
+												Start: ast.Position{
+													Column: 32,
+													Line:   41,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 35,
+														Line:   41,
+													},
+													File:   "define_test.flux",
+													Source: "sum",
+													Start: ast.Position{
+														Column: 32,
+														Line:   41,
+													},
+												},
+											},
+											Name: "sum",
+										},
+										Value: &ast.BinaryExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 58,
+														Line:   41,
+													},
+													File:   "define_test.flux",
+													Source: "state.sum + other.sum",
+													Start: ast.Position{
+														Column: 37,
+														Line:   41,
+													},
+												},
+											},
+											Left: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 46,
+															Line:   41,
+														},
+														File:   "define_test.flux",
+														Source: "state.sum",
+														Start: ast.Position{
+															Column: 37,
+															Line:   41,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 42,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 37,
+																Line:   41,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 46,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "sum",
+															Start: ast.Position{
+																Column: 43,
+																Line:   41,
+															},
+														},
+													},
+													Name: "sum",
+												},
+											},
+											Operator: 5,
+											Right: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 58,
+															Line:   41,
+														},
+														File:   "define_test.flux",
+														Source: "other.sum",
+														Start: ast.Position{
+															Column: 49,
+															Line:   41,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 54,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "other",
+															Start: ast.Position{
+																Column: 49,
+																Line:   41,
+															},
+														},
+													},
+													Name: "other",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 58,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "sum",
+															Start: ast.Position{
+																Column: 55,
+																Line:   41,
+															},
+														},
+													},
+													Name: "sum",
+												},
+											},
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 92,
+													Line:   41,
+												},
+												File:   "define_test.flux",
+												Source: "count: state.count + other.count",
+												Start: ast.Position{
+													Column: 60,
+													Line:   41,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 65,
+														Line:   41,
+													},
+													File:   "define_test.flux",
+													Source: "count",
+													Start: ast.Position{
+														Column: 60,
+														Line:   41,
+													},
+												},
+											},
+											Name: "count",
+										},
+										Value: &ast.BinaryExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 92,
+														Line:   41,
+													},
+													File:   "define_test.flux",
+													Source: "state.count + other.count",
+													Start: ast.Position{
+														Column: 67,
+														Line:   41,
+													},
+												},
+											},
+											Left: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 78,
+															Line:   41,
+														},
+														File:   "define_test.flux",
+														Source: "state.count",
+														Start: ast.Position{
+															Column: 67,
+															Line:   41,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 72,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 67,
+																Line:   41,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 78,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "count",
+															Start: ast.Position{
+																Column: 73,
+																Line:   41,
+															},
+														},
+													},
+													Name: "count",
+												},
+											},
+											Operator: 5,
+											Right: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 92,
+															Line:   41,
+														},
+														File:   "define_test.flux",
+														Source: "other.count",
+														Start: ast.Position{
+															Column: 81,
+															Line:   41,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 86,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "other",
+															Start: ast.Position{
+																Column: 81,
+																Line:   41,
+															},
+														},
+													},
+													Name: "other",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 92,
+																Line:   41,
+															},
+															File:   "define_test.flux",
+															Source: "count",
+															Start: ast.Position{
+																Column: 87,
+																Line:   41,
+															},
+														},
+													},
+													Name: "count",
+												},
+											},
+										},
+									}},
+									With: nil,
+								},
+							},
+							Params: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 18,
+											Line:   41,
+										},
+										File:   "define_test.flux",
+										Source: "state",
+										Start: ast.Position{
+											Column: 13,
+											Line:   41,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 18,
+												Line:   41,
+											},
+											File:   "define_test.flux",
+											Source: "state",
+											Start: ast.Position{
+												Column: 13,
+												Line:   41,
+											},
+										},
+									},
+									Name: "state",
+								},
+								Value: nil,
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 25,
+											Line:   41,
+										},
+										File:   "define_test.flux",
+										Source: "other",
+										Start: ast.Position{
+											Column: 20,
+											Line:   41,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 25,
+												Line:   41,
+											},
+											File:   "define_test.flux",
+											Source: "other",
+											Start: ast.Position{
+												Column: 20,
+												Line:   41,
+											},
+										},
+									},
+									Name: "other",
+								},
+								Value: nil,
+							}},
+						},
+					}, &ast.Property{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 69,
+									Line:   42,
+								},
+								File:   "define_test.flux",
+								Source: "finalize: (state) => float(v: state.sum) / float(v: state.count)",
+								Start: ast.Position{
+									Column: 5,
+									Line:   42,
+								},
+							},
+						},
+						Key: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 13,
+										Line:   42,
+									},
+									File:   "define_test.flux",
+									Source: "finalize",
+									Start: ast.Position{
+										Column: 5,
+										Line:   42,
+									},
+								},
+							},
+							Name: "finalize",
+						},
+						Value: &ast.FunctionExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 69,
+										Line:   42,
+									},
+									File:   "define_test.flux",
+									Source: "(state) => float(v: state.sum) / float(v: state.count)",
+									Start: ast.Position{
+										Column: 15,
+										Line:   42,
+									},
+								},
+							},
+							Body: &ast.BinaryExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 69,
+											Line:   42,
+										},
+										File:   "define_test.flux",
+										Source: "float(v: state.sum) / float(v: state.count)",
+										Start: ast.Position{
+											Column: 26,
+											Line:   42,
+										},
+									},
+								},
+								Left: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 44,
+													Line:   42,
+												},
+												File:   "define_test.flux",
+												Source: "v: state.sum",
+												Start: ast.Position{
+													Column: 32,
+													Line:   42,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 44,
+														Line:   42,
+													},
+													File:   "define_test.flux",
+													Source: "v: state.sum",
+													Start: ast.Position{
+														Column: 32,
+														Line:   42,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 33,
+															Line:   42,
+														},
+														File:   "define_test.flux",
+														Source: "v",
+														Start: ast.Position{
+															Column: 32,
+															Line:   42,
+														},
+													},
+												},
+												Name: "v",
+											},
+											Value: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 44,
+															Line:   42,
+														},
+														File:   "define_test.flux",
+														Source: "state.sum",
+														Start: ast.Position{
+															Column: 35,
+															Line:   42,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 40,
+																Line:   42,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 35,
+																Line:   42,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 44,
+																Line:   42,
+															},
+															File:   "define_test.flux",
+															Source: "sum",
+															Start: ast.Position{
+																Column: 41,
+																Line:   42,
+															},
+														},
+													},
+													Name: "sum",
+												},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   42,
+											},
+											File:   "define_test.flux",
+											Source: "float(v: state.sum)",
+											Start: ast.Position{
+												Column: 26,
+												Line:   42,
+											},
+										},
+									},
+									Callee: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 31,
+													Line:   42,
+												},
+												File:   "define_test.flux",
+												Source: "float",
+												Start: ast.Position{
+													Column: 26,
+													Line:   42,
+												},
+											},
+										},
+										Name: "float",
+									},
+								},
+								Operator: 2,
+								Right: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 68,
+													Line:   42,
+												},
+												File:   "define_test.flux",
+												Source: "v: state.count",
+												Start: ast.Position{
+													Column: 54,
+													Line:   42,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 68,
+														Line:   42,
+													},
+													File:   "define_test.flux",
+													Source: "v: state.count",
+													Start: ast.Position{
+														Column: 54,
+														Line:   42,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 55,
+															Line:   42,
+														},
+														File:   "define_test.flux",
+														Source: "v",
+														Start: ast.Position{
+															Column: 54,
+															Line:   42,
+														},
+													},
+												},
+												Name: "v",
+											},
+											Value: &ast.MemberExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 68,
+															Line:   42,
+														},
+														File:   "define_test.flux",
+														Source: "state.count",
+														Start: ast.Position{
+															Column: 57,
+															Line:   42,
+														},
+													},
+												},
+												Object: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 62,
+																Line:   42,
+															},
+															File:   "define_test.flux",
+															Source: "state",
+															Start: ast.Position{
+																Column: 57,
+																Line:   42,
+															},
+														},
+													},
+													Name: "state",
+												},
+												Property: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 68,
+																Line:   42,
+															},
+															File:   "define_test.flux",
+															Source: "count",
+															Start: ast.Position{
+																Column: 63,
+																Line:   42,
+															},
+														},
+													},
+													Name: "count",
+												},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 69,
+												Line:   42,
+											},
+											File:   "define_test.flux",
+											Source: "float(v: state.count)",
+											Start: ast.Position{
+												Column: 48,
+												Line:   42,
+											},
+										},
+									},
+									Callee: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 53,
+													Line:   42,
+												},
+												File:   "define_test.flux",
+												Source: "float",
+												Start: ast.Position{
+													Column: 48,
+													Line:   42,
+												},
+											},
+										},
+										Name: "float",
+									},
+								},
+							},
+							Params: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 21,
+											Line:   42,
+										},
+										File:   "define_test.flux",
+										Source: "state",
+										Start: ast.Position{
+											Column: 16,
+											Line:   42,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 21,
+												Line:   42,
+											},
+											File:   "define_test.flux",
+											Source: "state",
+											Start: ast.Position{
+												Column: 16,
+												Line:   42,
+											},
+										},
+									},
+									Name: "state",
+								},
+								Value: nil,
+							}},
+						},
+					}},
+					With: nil,
+				}},
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   43,
+						},
+						File:   "define_test.flux",
+						Source: "aggregate.define(\n    init: {sum: 0, count: 0},\n    update: (state, value) => ({sum: state.sum + value, count: state.count + 1}),\n    merge: (state, other) => ({sum: state.sum + other.sum, count: state.count + other.count}),\n    finalize: (state) => float(v: state.sum) / float(v: state.count),\n)",
+						Start: ast.Position{
+							Column: 8,
+							Line:   38,
+						},
+					},
+				},
+				Callee: &ast.MemberExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 24,
+								Line:   38,
+							},
+							File:   "define_test.flux",
+							Source: "aggregate.define",
+							Start: ast.Position{
+								Column: 8,
+								Line:   38,
+							},
+						},
+					},
+					Object: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 17,
+									Line:   38,
+								},
+								File:   "define_test.flux",
+								Source: "aggregate",
+								Start: ast.Position{
+									Column: 8,
+									Line:   38,
+								},
+							},
+						},
+						Name: "aggregate",
+					},
+					Property: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 24,
+									Line:   38,
+								},
+								File:   "define_test.flux",
+								Source: "define",
+								Start: ast.Position{
+									Column: 18,
+									Line:   38,
+								},
+							},
+						},
+						Name: "define",
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 49,
+						Line:   49,
+					},
+					File:   "define_test.flux",
+					Source: "t_define = (table=<-) =>\n    table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)\n        |> filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")\n        |> aggregateWindow(every: 20s, fn: mean)",
+					Start: ast.Position{
+						Column: 1,
+						Line:   45,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   45,
+						},
+						File:   "define_test.flux",
+						Source: "t_define",
+						Start: ast.Position{
+							Column: 1,
+							Line:   45,
+						},
+					},
+				},
+				Name: "t_define",
+			},
+			Init: &ast.FunctionExpression{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 49,
+							Line:   49,
+						},
+						File:   "define_test.flux",
+						Source: "(table=<-) =>\n    table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)\n        |> filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")\n        |> aggregateWindow(every: 20s, fn: mean)",
+						Start: ast.Position{
+							Column: 12,
+							Line:   45,
+						},
+					},
+				},
+				Body: &ast.PipeExpression{
+					Argument: &ast.PipeExpression{
+						Argument: &ast.PipeExpression{
+							Argument: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 10,
+											Line:   46,
+										},
+										File:   "define_test.flux",
+										Source: "table",
+										Start: ast.Position{
+											Column: 5,
+											Line:   46,
+										},
+									},
+								},
+								Name: "table",
+							},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 74,
+										Line:   47,
+									},
+									File:   "define_test.flux",
+									Source: "table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)",
+									Start: ast.Position{
+										Column: 5,
+										Line:   46,
+									},
+								},
+							},
+							Call: &ast.CallExpression{
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 73,
+												Line:   47,
+											},
+											File:   "define_test.flux",
+											Source: "start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z",
+											Start: ast.Position{
+												Column: 18,
+												Line:   47,
+											},
+										},
+									},
+									Properties: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 45,
+													Line:   47,
+												},
+												File:   "define_test.flux",
+												Source: "start: 2020-02-20T23:00:00Z",
+												Start: ast.Position{
+													Column: 18,
+													Line:   47,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 23,
+														Line:   47,
+													},
+													File:   "define_test.flux",
+													Source: "start",
+													Start: ast.Position{
+														Column: 18,
+														Line:   47,
+													},
+												},
+											},
+											Name: "start",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 45,
+														Line:   47,
+													},
+													File:   "define_test.flux",
+													Source: "2020-02-20T23:00:00Z",
+													Start: ast.Position{
+														Column: 25,
+														Line:   47,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2020-02-20T23:00:00Z"),
+										},
+									}, &ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 73,
+													Line:   47,
+												},
+												File:   "define_test.flux",
+												Source: "stop: 2020-02-20T23:01:00Z",
+												Start: ast.Position{
+													Column: 47,
+													Line:   47,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 51,
+														Line:   47,
+													},
+													File:   "define_test.flux",
+													Source: "stop",
+													Start: ast.Position{
+														Column: 47,
+														Line:   47,
+													},
+												},
+											},
+											Name: "stop",
+										},
+										Value: &ast.DateTimeLiteral{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 73,
+														Line:   47,
+													},
+													File:   "define_test.flux",
+													Source: "2020-02-20T23:01:00Z",
+													Start: ast.Position{
+														Column: 53,
+														Line:   47,
+													},
+												},
+											},
+											Value: parser.MustParseTime("2020-02-20T23:01:00Z"),
+										},
+									}},
+									With: nil,
+								}},
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 74,
+											Line:   47,
+										},
+										File:   "define_test.flux",
+										Source: "range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)",
+										Start: ast.Position{
+											Column: 12,
+											Line:   47,
+										},
+									},
+								},
+								Callee: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 17,
+												Line:   47,
+											},
+											File:   "define_test.flux",
+											Source: "range",
+											Start: ast.Position{
+												Column: 12,
+												Line:   47,
+											},
+										},
+									},
+									Name: "range",
+								},
+							},
+						},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 83,
+									Line:   48,
+								},
+								File:   "define_test.flux",
+								Source: "table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)\n        |> filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")",
+								Start: ast.Position{
+									Column: 5,
+									Line:   46,
+								},
+							},
+						},
+						Call: &ast.CallExpression{
+							Arguments: []ast.Expression{&ast.ObjectExpression{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 82,
+											Line:   48,
+										},
+										File:   "define_test.flux",
+										Source: "fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\"",
+										Start: ast.Position{
+											Column: 19,
+											Line:   48,
+										},
+									},
+								},
+								Properties: []*ast.Property{&ast.Property{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 82,
+												Line:   48,
+											},
+											File:   "define_test.flux",
+											Source: "fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\"",
+											Start: ast.Position{
+												Column: 19,
+												Line:   48,
+											},
+										},
+									},
+									Key: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 21,
+													Line:   48,
+												},
+												File:   "define_test.flux",
+												Source: "fn",
+												Start: ast.Position{
+													Column: 19,
+													Line:   48,
+												},
+											},
+										},
+										Name: "fn",
+									},
+									Value: &ast.FunctionExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 82,
+													Line:   48,
+												},
+												File:   "define_test.flux",
+												Source: "(r) => r._measurement == \"net\" and r._field == \"bytes_recv\"",
+												Start: ast.Position{
+													Column: 23,
+													Line:   48,
+												},
+											},
+										},
+										Body: &ast.LogicalExpression{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 82,
+														Line:   48,
+													},
+													File:   "define_test.flux",
+													Source: "r._measurement == \"net\" and r._field == \"bytes_recv\"",
+													Start: ast.Position{
+														Column: 30,
+														Line:   48,
+													},
+												},
+											},
+											Left: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 53,
+															Line:   48,
+														},
+														File:   "define_test.flux",
+														Source: "r._measurement == \"net\"",
+														Start: ast.Position{
+															Column: 30,
+															Line:   48,
+														},
+													},
+												},
+												Left: &ast.MemberExpression{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 44,
+																Line:   48,
+															},
+															File:   "define_test.flux",
+															Source: "r._measurement",
+															Start: ast.Position{
+																Column: 30,
+																Line:   48,
+															},
+														},
+													},
+													Object: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 31,
+																	Line:   48,
+																},
+																File:   "define_test.flux",
+																Source: "r",
+																Start: ast.Position{
+																	Column: 30,
+																	Line:   48,
+																},
+															},
+														},
+														Name: "r",
+													},
+													Property: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 44,
+																	Line:   48,
+																},
+																File:   "define_test.flux",
+																Source: "_measurement",
+																Start: ast.Position{
+																	Column: 32,
+																	Line:   48,
+																},
+															},
+														},
+														Name: "_measurement",
+													},
+												},
+												Operator: 17,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 53,
+																Line:   48,
+															},
+															File:   "define_test.flux",
+															Source: "\"net\"",
+															Start: ast.Position{
+																Column: 48,
+																Line:   48,
+															},
+														},
+													},
+													Value: "net",
+												},
+											},
+											Operator: 1,
+											Right: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 82,
+															Line:   48,
+														},
+														File:   "define_test.flux",
+														Source: "r._field == \"bytes_recv\"",
+														Start: ast.Position{
+															Column: 58,
+															Line:   48,
+														},
+													},
+												},
+												Left: &ast.MemberExpression{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 66,
+																Line:   48,
+															},
+															File:   "define_test.flux",
+															Source: "r._field",
+															Start: ast.Position{
+																Column: 58,
+																Line:   48,
+															},
+														},
+													},
+													Object: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 59,
+																	Line:   48,
+																},
+																File:   "define_test.flux",
+																Source: "r",
+																Start: ast.Position{
+																	Column: 58,
+																	Line:   48,
+																},
+															},
+														},
+														Name: "r",
+													},
+													Property: &ast.Identifier{
+														BaseNode: ast.BaseNode{
+															Errors: nil,
+															Loc: &ast.SourceLocation{
+																End: ast.Position{
+																	Column: 66,
+																	Line:   48,
+																},
+																File:   "define_test.flux",
+																Source: "_field",
+																Start: ast.Position{
+																	Column: 60,
+																	Line:   48,
+																},
+															},
+														},
+														Name: "_field",
+													},
+												},
+												Operator: 17,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 82,
+																Line:   48,
+															},
+															File:   "define_test.flux",
+															Source: "\"bytes_recv\"",
+															Start: ast.Position{
+																Column: 70,
+																Line:   48,
+															},
+														},
+													},
+													Value: "bytes_recv",
+												},
+											},
+										},
+										Params: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 25,
+														Line:   48,
+													},
+													File:   "define_test.flux",
+													Source: "r",
+													Start: ast.Position{
+														Column: 24,
+														Line:   48,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 25,
+															Line:   48,
+														},
+														File:   "define_test.flux",
+														Source: "r",
+														Start: ast.Position{
+															Column: 24,
+															Line:   48,
+														},
+													},
+												},
+												Name: "r",
+											},
+											Value: nil,
+										}},
+									},
+								}},
+								With: nil,
+							}},
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 83,
+										Line:   48,
+									},
+									File:   "define_test.flux",
+									Source: "filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")",
+									Start: ast.Position{
+										Column: 12,
+										Line:   48,
+									},
+								},
+							},
+							Callee: &ast.Identifier{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 18,
+											Line:   48,
+										},
+										File:   "define_test.flux",
+										Source: "filter",
+										Start: ast.Position{
+											Column: 12,
+											Line:   48,
+										},
+									},
+								},
+								Name: "filter",
+							},
+						},
+					},
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 49,
+								Line:   49,
+							},
+							File:   "define_test.flux",
+							Source: "table\n        |> range(start: 2020-02-20T23:00:00Z, stop: 2020-02-20T23:01:00Z)\n        |> filter(fn: (r) => r._measurement == \"net\" and r._field == \"bytes_recv\")\n        |> aggregateWindow(every: 20s, fn: mean)",
+							Start: ast.Position{
+								Column: 5,
+								Line:   46,
+							},
+						},
+					},
+					Call: &ast.CallExpression{
+						Arguments: []ast.Expression{&ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 48,
+										Line:   49,
+									},
+									File:   "define_test.flux",
+									Source: "every: 20s, fn: mean",
+									Start: ast.Position{
+										Column: 28,
+										Line:   49,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 38,
+											Line:   49,
+										},
+										File:   "define_test.flux",
+										Source: "every: 20s",
+										Start: ast.Position{
+											Column: 28,
+											Line:   49,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 33,
+												Line:   49,
+											},
+											File:   "define_test.flux",
+											Source: "every",
+											Start: ast.Position{
+												Column: 28,
+												Line:   49,
+											},
+										},
+									},
+									Name: "every",
+								},
+								Value: &ast.DurationLiteral{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 38,
+												Line:   49,
+											},
+											File:   "define_test.flux",
+											Source: "20s",
+											Start: ast.Position{
+												Column: 35,
+												Line:   49,
+											},
+										},
+									},
+									Values: []ast.Duration{ast.Duration{
+										Magnitude: int64(20),
+										Unit:      "s",
+									}},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 48,
+											Line:   49,
+										},
+										File:   "define_test.flux",
+										Source: "fn: mean",
+										Start: ast.Position{
+											Column: 40,
+											Line:   49,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 42,
+												Line:   49,
+											},
+											File:   "define_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 40,
+												Line:   49,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 48,
+												Line:   49,
+											},
+											File:   "define_test.flux",
+											Source: "mean",
+											Start: ast.Position{
+												Column: 44,
+												Line:   49,
+											},
+										},
+									},
+									Name: "mean",
+								},
+							}},
+							With: nil,
+						}},
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 49,
+									Line:   49,
+								},
+								File:   "define_test.flux",
+								Source: "aggregateWindow(every: 20s, fn: mean)",
+								Start: ast.Position{
+									Column: 12,
+									Line:   49,
+								},
+							},
+						},
+						Callee: &ast.Identifier{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 27,
+										Line:   49,
+									},
+									File:   "define_test.flux",
+									Source: "aggregateWindow",
+									Start: ast.Position{
+										Column: 12,
+										Line:   49,
+									},
+								},
+							},
+							Name: "aggregateWindow",
+						},
+					},
+				},
+				Params: []*ast.Property{&ast.Property{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 21,
+								Line:   45,
+							},
+							File:   "define_test.flux",
+							Source: "table=<-",
+							Start: ast.Position{
+								Column: 13,
+								Line:   45,
+							},
+						},
+					},
+					Key: &ast.Identifier{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 18,
+									Line:   45,
+								},
+								File:   "define_test.flux",
+								Source: "table",
+								Start: ast.Position{
+									Column: 13,
+									Line:   45,
+								},
+							},
+						},
+						Name: "table",
+					},
+					Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 21,
+								Line:   45,
+							},
+							File:   "define_test.flux",
+							Source: "<-",
+							Start: ast.Position{
+								Column: 19,
+								Line:   45,
+							},
+						},
+					}},
+				}},
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 3,
+							Line:   55,
+						},
+						File:   "define_test.flux",
+						Source: "define = () => ({\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   51,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 12,
+								Line:   51,
+							},
+							File:   "define_test.flux",
+							Source: "define",
+							Start: ast.Position{
+								Column: 6,
+								Line:   51,
+							},
+						},
+					},
+					Name: "define",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 3,
+								Line:   55,
+							},
+							File:   "define_test.flux",
+							Source: "() => ({\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n})",
+							Start: ast.Position{
+								Column: 15,
+								Line:   51,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 3,
+									Line:   55,
+								},
+								File:   "define_test.flux",
+								Source: "({\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n})",
+								Start: ast.Position{
+									Column: 21,
+									Line:   51,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 2,
+										Line:   55,
+									},
+									File:   "define_test.flux",
+									Source: "{\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n}",
+									Start: ast.Position{
+										Column: 22,
+										Line:   51,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 48,
+											Line:   52,
+										},
+										File:   "define_test.flux",
+										Source: "input: testing.loadStorage(csv: inData)",
+										Start: ast.Position{
+											Column: 9,
+											Line:   52,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 14,
+												Line:   52,
+											},
+											File:   "define_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 9,
+												Line:   52,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 47,
+													Line:   52,
+												},
+												File:   "define_test.flux",
+												Source: "csv: inData",
+												Start: ast.Position{
+													Column: 36,
+													Line:   52,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 47,
+														Line:   52,
+													},
+													File:   "define_test.flux",
+													Source: "csv: inData",
+													Start: ast.Position{
+														Column: 36,
+														Line:   52,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 39,
+															Line:   52,
+														},
+														File:   "define_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 36,
+															Line:   52,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 47,
+															Line:   52,
+														},
+														File:   "define_test.flux",
+														Source: "inData",
+														Start: ast.Position{
+															Column: 41,
+															Line:   52,
+														},
+													},
+												},
+												Name: "inData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 48,
+												Line:   52,
+											},
+											File:   "define_test.flux",
+											Source: "testing.loadStorage(csv: inData)",
+											Start: ast.Position{
+												Column: 16,
+												Line:   52,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 35,
+													Line:   52,
+												},
+												File:   "define_test.flux",
+												Source: "testing.loadStorage",
+												Start: ast.Position{
+													Column: 16,
+													Line:   52,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 23,
+														Line:   52,
+													},
+													File:   "define_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 16,
+														Line:   52,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 35,
+														Line:   52,
+													},
+													File:   "define_test.flux",
+													Source: "loadStorage",
+													Start: ast.Position{
+														Column: 24,
+														Line:   52,
+													},
+												},
+											},
+											Name: "loadStorage",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 44,
+											Line:   53,
+										},
+										File:   "define_test.flux",
+										Source: "want: testing.loadMem(csv: outData)",
+										Start: ast.Position{
+											Column: 9,
+											Line:   53,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 13,
+												Line:   53,
+											},
+											File:   "define_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 9,
+												Line:   53,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 43,
+													Line:   53,
+												},
+												File:   "define_test.flux",
+												Source: "csv: outData",
+												Start: ast.Position{
+													Column: 31,
+													Line:   53,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 43,
+														Line:   53,
+													},
+													File:   "define_test.flux",
+													Source: "csv: outData",
+													Start: ast.Position{
+														Column: 31,
+														Line:   53,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 34,
+															Line:   53,
+														},
+														File:   "define_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 31,
+															Line:   53,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 43,
+															Line:   53,
+														},
+														File:   "define_test.flux",
+														Source: "outData",
+														Start: ast.Position{
+															Column: 36,
+															Line:   53,
+														},
+													},
+												},
+												Name: "outData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 44,
+												Line:   53,
+											},
+											File:   "define_test.flux",
+											Source: "testing.loadMem(csv: outData)",
+											Start: ast.Position{
+												Column: 15,
+												Line:   53,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 30,
+													Line:   53,
+												},
+												File:   "define_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 15,
+													Line:   53,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   53,
+													},
+													File:   "define_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 15,
+														Line:   53,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 30,
+														Line:   53,
+													},
+													File:   "define_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 23,
+														Line:   53,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 21,
+											Line:   54,
+										},
+										File:   "define_test.flux",
+										Source: "fn: t_define",
+										Start: ast.Position{
+											Column: 9,
+											Line:   54,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 11,
+												Line:   54,
+											},
+											File:   "define_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 9,
+												Line:   54,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 21,
+												Line:   54,
+											},
+											File:   "define_test.flux",
+											Source: "t_define",
+											Start: ast.Position{
+												Column: 13,
+												Line:   54,
+											},
+										},
+									},
+									Name: "t_define",
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 3,
+						Line:   55,
+					},
+					File:   "define_test.flux",
+					Source: "test define = () => ({\n        input: testing.loadStorage(csv: inData),\n        want: testing.loadMem(csv: outData),\n        fn: t_define\n})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   51,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 32,
+						Line:   3,
+					},
+					File:   "define_test.flux",
+					Source: "import \"experimental/aggregate\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 32,
+							Line:   3,
+						},
+						File:   "define_test.flux",
+						Source: "\"experimental/aggregate\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "experimental/aggregate",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   4,
+					},
+					File:   "define_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   4,
+						},
+						File:   "define_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "define_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 23,
+						Line:   1,
+					},
+					File:   "define_test.flux",
+					Source: "package aggregate_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 23,
+							Line:   1,
+						},
+						File:   "define_test.flux",
+						Source: "aggregate_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "aggregate_test",
+			},
+		},
 	}},
 	Package: "aggregate_test",
 	Path:    "experimental/aggregate",
@@ -0,0 +1,92 @@
+package error
+
+import (
+	"context"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const pkgpath = "experimental/error"
+
+func init() {
+	runtime.RegisterPackageValue(pkgpath, "catch", values.NewFunction(
+		"catch",
+		runtime.MustLookupBuiltinType(pkgpath, "catch"),
+		catch,
+		false,
+	))
+	runtime.RegisterPackageValue(pkgpath, "try", values.NewFunction(
+		"try",
+		runtime.MustLookupBuiltinType(pkgpath, "try"),
+		try,
+		false,
+	))
+}
+
+func catch(ctx context.Context, args values.Object) (values.Value, error) {
+	fn, def, err := readArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	v, err := fn.Call(ctx, noArgs)
+	if err != nil {
+		if !recoverable(ctx, err) {
+			return nil, err
+		}
+		return def, nil
+	}
+	return v, nil
+}
+
+func try(ctx context.Context, args values.Object) (values.Value, error) {
+	fn, def, err := readArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	v, err := fn.Call(ctx, noArgs)
+	if err != nil {
+		if !recoverable(ctx, err) {
+			return nil, err
+		}
+		return values.NewObjectWithValues(map[string]values.Value{
+			"value":   def,
+			"isError": values.NewBool(true),
+			"code":    values.NewString(errors.Code(err).String()),
+			"message": values.NewString(err.Error()),
+		}), nil
+	}
+	return values.NewObjectWithValues(map[string]values.Value{
+		"value":   v,
+		"isError": values.NewBool(false),
+		"code":    values.NewString(""),
+		"message": values.NewString(""),
+	}), nil
+}
+
+// noArgs are the arguments that fn is called with.
+var noArgs = values.NewObject(semantic.NewObjectType(nil))
+
+func readArgs(args values.Object) (values.Function, values.Value, error) {
+	a := interpreter.NewArguments(args)
+	fn, err := a.GetRequiredFunction("fn")
+	if err != nil {
+		return nil, nil, err
+	}
+	def, err := a.GetRequired("default")
+	if err != nil {
+		return nil, nil, err
+	}
+	return fn, def, nil
+}
+
+// recoverable reports whether the error from fn may be replaced
+// with the default value. The query is never continued once
+// it has been canceled.
+func recoverable(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && errors.Code(err) != codes.Canceled
+}
@@ -0,0 +1,55 @@
+package error_test
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/influxdata/flux/builtin"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
+	"github.com/influxdata/flux/runtime"
+)
+
+func TestCatch(t *testing.T) {
+	script := `
+import "experimental/error"
+import "internal/testutil"
+
+error.catch(fn: () => 1 + 1, default: 0) == 2 or testutil.fail()
+error.catch(fn: () => testutil.fail(), default: true) or testutil.fail()
+
+t = error.catch(
+    fn: () => if testutil.fail() then {a: 1} else {a: 2},
+    default: {a: 0},
+)
+t.a == 0 or testutil.fail()
+`
+	ctx := dependenciestest.Default().Inject(context.Background())
+	if _, _, err := runtime.Eval(ctx, script); err != nil {
+		t.Fatal("evaluation of catch failed: ", err)
+	}
+}
+
+func TestTry(t *testing.T) {
+	script := `
+import "experimental/error"
+import "internal/testutil"
+
+ok = error.try(fn: () => "a" + "b", default: "")
+ok.value == "ab" or testutil.fail()
+not ok.isError or testutil.fail()
+ok.code == "" or testutil.fail()
+
+failed = error.try(
+    fn: () => if testutil.fail() then {a: 1} else {a: 2},
+    default: {a: 0},
+)
+failed.value.a == 0 or testutil.fail()
+failed.isError or testutil.fail()
+failed.code == "aborted" or testutil.fail()
+failed.message == "error calling function \"fail\": fail" or testutil.fail()
+`
+	ctx := dependenciestest.Default().Inject(context.Background())
+	if _, _, err := runtime.Eval(ctx, script); err != nil {
+		t.Fatal("evaluation of try failed: ", err)
+	}
+}
@@ -0,0 +1,99 @@
+package mqtt
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/influxdata/flux"
+)
+
+// DefaultMQTTClientFactory makes the clients used by mqtt.to and mqtt.from and is injectable for testing
+var DefaultMQTTClientFactory = func(opts *MQTT.ClientOptions) MQTT.Client {
+	return MQTT.NewClient(opts)
+}
+
+// clientArgs are the options for connecting to a broker
+// that are shared by mqtt.to and mqtt.from.
+type clientArgs struct {
+	ClientID string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// readClientArgs reads the connection options from args.
+// The client id defaults to flux-mqtt and the timeout
+// defaults to DefaultToMQTTTimeout.
+func readClientArgs(args flux.Arguments) (clientArgs, error) {
+	var (
+		c   clientArgs
+		ok  bool
+		err error
+	)
+	c.ClientID, ok, err = args.GetString("clientid")
+	if err != nil {
+		return c, err
+	}
+	if !ok {
+		c.ClientID = "flux-mqtt"
+	}
+
+	c.Username, ok, err = args.GetString("username")
+	if err != nil {
+		return c, err
+	}
+	if ok {
+		c.Password, ok, err = args.GetString("password")
+		if err != nil {
+			return c, err
+		}
+		if !ok {
+			return c, fmt.Errorf("password required with username %s", c.Username)
+		}
+	}
+
+	timeout, ok, err := args.GetDuration("timeout")
+	if err != nil {
+		return c, err
+	}
+	if !ok {
+		c.Timeout = DefaultToMQTTTimeout
+	} else {
+		c.Timeout = timeout.Duration()
+	}
+	return c, nil
+}
+
+// newClientOptions returns the options for a client that connects to the broker.
+func newClientOptions(broker string, c clientArgs) *MQTT.ClientOptions {
+	opts := MQTT.NewClientOptions().AddBroker(broker)
+	if c.ClientID != "" {
+		opts.SetClientID(c.ClientID)
+	} else {
+		opts.SetClientID("flux-mqtt")
+	}
+	if c.Timeout > 0 {
+		opts.SetConnectTimeout(c.Timeout)
+	}
+	if c.Username != "" {
+		opts.SetUsername(c.Username)
+	}
+	if c.Password != "" {
+		opts.SetPassword(c.Password)
+	}
+	return opts
+}
+
+// validateBroker checks that the broker is a URL with a supported scheme.
+func validateBroker(broker string) error {
+	u, err := url.ParseRequestURI(broker)
+	if err != nil {
+		return err
+	}
+	if !(u.Scheme == "tcp" || u.Scheme == "ws" || u.Scheme == "tls") {
+		return fmt.Errorf("scheme must be tcp or ws or tls but was %s", u.Scheme)
+	}
+	return nil
+}
@@ -0,0 +1,441 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/metric"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	FromMQTTKind = "fromMQTT"
+
+	// TopicColLabel is the label of the column with the topic of a raw message.
+	TopicColLabel = "topic"
+)
+
+// The formats of the payloads that mqtt.from can decode.
+const (
+	FormatRaw          = "raw"
+	FormatLineProtocol = "lineprotocol"
+	FormatJSON         = "json"
+)
+
+func init() {
+	fromMQTTSignature := runtime.MustLookupBuiltinType("experimental/mqtt", "from")
+
+	runtime.RegisterPackageValue("experimental/mqtt", "from", flux.MustValue(flux.FunctionValue(FromMQTTKind, createFromMQTTOpSpec, fromMQTTSignature)))
+	flux.RegisterOpSpec(FromMQTTKind, func() flux.OperationSpec { return &FromMQTTOpSpec{} })
+	plan.RegisterProcedureSpec(FromMQTTKind, newFromMQTTProcedure, FromMQTTKind)
+	execute.RegisterSource(FromMQTTKind, createFromMQTTSource)
+}
+
+// this is used so we can get better validation on marshaling, innerFromMQTTOpSpec and FromMQTTOpSpec
+// need to have identical fields
+type innerFromMQTTOpSpec FromMQTTOpSpec
+
+type FromMQTTOpSpec struct {
+	Broker   string        `json:"broker"`
+	Topics   []string      `json:"topics"`
+	QoS      int           `json:"qos"`
+	ClientID string        `json:"clientid"`
+	Username string        `json:"username"`
+	Password string        `json:"password"`
+	Timeout  time.Duration `json:"timeout"`  // default to something reasonable if zero
+	Duration time.Duration `json:"duration"` // how long to stay subscribed, zero means until count messages arrive
+	Count    int64         `json:"count"`    // how many messages to read, zero means until duration has elapsed
+	Format   string        `json:"format"`
+}
+
+// ReadArgs loads a flux.Arguments into FromMQTTOpSpec. It sets several default values.
+// Either duration or count must be set so that the subscription ends.
+// If the format isn't set, it defaults to raw.
+func (o *FromMQTTOpSpec) ReadArgs(args flux.Arguments) error {
+	var err error
+	o.Broker, err = args.GetRequiredString("broker")
+	if err != nil {
+		return err
+	}
+
+	topics, err := args.GetRequiredArray("topics", semantic.String)
+	if err != nil {
+		return err
+	}
+	if topics.Len() == 0 {
+		return errors.New("at least one topic is required")
+	}
+	o.Topics = make([]string, topics.Len())
+	topics.Range(func(i int, v values.Value) {
+		o.Topics[i] = v.Str()
+	})
+
+	q, ok, err := args.GetInt("qos")
+	if err != nil {
+		return err
+	}
+	if ok {
+		if q < 0 || q > 2 {
+			return fmt.Errorf("qos must be 0, 1 or 2 but was %d", q)
+		}
+		o.QoS = int(q)
+	}
+
+	c, err := readClientArgs(args)
+	if err != nil {
+		return err
+	}
+	o.ClientID, o.Username, o.Password, o.Timeout = c.ClientID, c.Username, c.Password, c.Timeout
+
+	duration, hasDuration, err := args.GetDuration("duration")
+	if err != nil {
+		return err
+	}
+	if hasDuration {
+		o.Duration = duration.Duration()
+		if o.Duration <= 0 {
+			return errors.New("duration must be positive")
+		}
+	}
+	count, hasCount, err := args.GetInt("count")
+	if err != nil {
+		return err
+	}
+	if hasCount {
+		if count <= 0 {
+			return errors.New("count must be positive")
+		}
+		o.Count = count
+	}
+	if !hasDuration && !hasCount {
+		return errors.New("either duration or count is required")
+	}
+
+	o.Format, ok, err = args.GetString("format")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Format = FormatRaw
+	}
+	return o.validate()
+}
+
+func (o *FromMQTTOpSpec) validate() error {
+	switch o.Format {
+	case FormatRaw, FormatLineProtocol, FormatJSON:
+	default:
+		return fmt.Errorf("format must be %s, %s or %s but was %s", FormatRaw, FormatLineProtocol, FormatJSON, o.Format)
+	}
+	return validateBroker(o.Broker)
+}
+
+func createFromMQTTOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	s := new(FromMQTTOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalJSON unmarshals and validates FromMQTTOpSpec from JSON.
+func (o *FromMQTTOpSpec) UnmarshalJSON(b []byte) (err error) {
+	if err = json.Unmarshal(b, (*innerFromMQTTOpSpec)(o)); err != nil {
+		return err
+	}
+	return o.validate()
+}
+
+func (FromMQTTOpSpec) Kind() flux.OperationKind {
+	return FromMQTTKind
+}
+
+type FromMQTTProcedureSpec struct {
+	plan.DefaultCost
+	Spec *FromMQTTOpSpec
+}
+
+func (o *FromMQTTProcedureSpec) Kind() plan.ProcedureKind {
+	return FromMQTTKind
+}
+
+func (o *FromMQTTProcedureSpec) Copy() plan.ProcedureSpec {
+	s := *o.Spec
+	s.Topics = append([]string(nil), o.Spec.Topics...)
+	return &FromMQTTProcedureSpec{Spec: &s}
+}
+
+func newFromMQTTProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromMQTTOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+	return &FromMQTTProcedureSpec{Spec: spec}, nil
+}
+
+func createFromMQTTSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromMQTTProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+	return NewFromMQTTSource(spec, dsid, a.Allocator()), nil
+}
+
+// FromMQTTSource subscribes to topics and decodes the messages into tables.
+// The subscription ends when the duration has elapsed or when count
+// messages have arrived, whichever comes first.
+type FromMQTTSource struct {
+	id    execute.DatasetID
+	ts    []execute.Transformation
+	spec  *FromMQTTProcedureSpec
+	alloc *memory.Allocator
+}
+
+func NewFromMQTTSource(spec *FromMQTTProcedureSpec, id execute.DatasetID, alloc *memory.Allocator) *FromMQTTSource {
+	return &FromMQTTSource{
+		id:    id,
+		spec:  spec,
+		alloc: alloc,
+	}
+}
+
+func (s *FromMQTTSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *FromMQTTSource) Run(ctx context.Context) {
+	err := s.run(ctx)
+	if err != nil {
+		err = fmt.Errorf("error in mqtt.from(): %v", err)
+	}
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *FromMQTTSource) run(ctx context.Context) error {
+	var b tableBuilder
+	if s.spec.Spec.Format == FormatRaw {
+		b = newRawTableBuilder(s.alloc)
+	} else {
+		b = &metricTableBuilder{
+			TableBuilder: metric.NewTableBuilder(s.alloc),
+			json:         s.spec.Spec.Format == FormatJSON,
+		}
+	}
+	if err := s.receive(ctx, b); err != nil {
+		b.Release()
+		return err
+	}
+	tables, err := b.Tables()
+	if err != nil {
+		return err
+	}
+	for i, tbl := range tables {
+		for j, t := range s.ts {
+			// The last transformation receives the original table
+			// and every other transformation receives a copy.
+			in := tbl
+			if j < len(s.ts)-1 {
+				in = tbl.Copy()
+			}
+			if err := t.Process(s.id, in); err != nil {
+				for _, tbl := range tables[i+1:] {
+					tbl.Done()
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type receivedMessage struct {
+	msg  MQTT.Message
+	time time.Time
+}
+
+// receive subscribes to the topics and adds the messages to b
+// until the subscription ends.
+func (s *FromMQTTSource) receive(ctx context.Context, b tableBuilder) error {
+	spec := s.spec.Spec
+	client := DefaultMQTTClientFactory(newClientOptions(spec.Broker, clientArgs{
+		ClientID: spec.ClientID,
+		Username: spec.Username,
+		Password: spec.Password,
+		Timeout:  spec.Timeout,
+	}))
+	if err := wait(client.Connect(), spec.Timeout); err != nil {
+		return fmt.Errorf("failed to connect to broker: %v", err)
+	}
+	defer client.Disconnect(250)
+
+	if spec.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, spec.Duration)
+		defer cancel()
+	}
+
+	// The handler is called by the client, so it must
+	// not block once the subscription has ended.
+	done := make(chan struct{})
+	defer close(done)
+	messages := make(chan receivedMessage, 64)
+	filters := make(map[string]byte, len(spec.Topics))
+	for _, topic := range spec.Topics {
+		filters[topic] = byte(spec.QoS)
+	}
+	handler := func(_ MQTT.Client, msg MQTT.Message) {
+		select {
+		case messages <- receivedMessage{msg: msg, time: time.Now()}:
+		case <-done:
+		}
+	}
+	if err := wait(client.SubscribeMultiple(filters, handler), spec.Timeout); err != nil {
+		return fmt.Errorf("failed to subscribe: %v", err)
+	}
+	defer func() {
+		_ = wait(client.Unsubscribe(spec.Topics...), spec.Timeout)
+	}()
+
+	for n := int64(0); spec.Count == 0 || n < spec.Count; n++ {
+		select {
+		case m := <-messages:
+			if err := b.Add(m.msg.Topic(), m.msg.Payload(), m.time); err != nil {
+				return fmt.Errorf("failed to decode message on topic %s: %v", m.msg.Topic(), err)
+			}
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded && spec.Duration > 0 {
+				// The subscription window has ended.
+				return nil
+			}
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// wait waits for the token to complete. A timeout of zero waits indefinitely.
+func wait(token MQTT.Token, timeout time.Duration) error {
+	if timeout > 0 {
+		if !token.WaitTimeout(timeout) {
+			return errors.New("timed out")
+		}
+	} else {
+		token.Wait()
+	}
+	return token.Error()
+}
+
+// tableBuilder builds tables from the payloads of messages.
+type tableBuilder interface {
+	Add(topic string, payload []byte, received time.Time) error
+	Tables() ([]flux.BufferedTable, error)
+	Release()
+}
+
+// metricTableBuilder decodes payloads that contain line protocol or JSON metrics.
+// Metrics without a timestamp use the time the message was received.
+type metricTableBuilder struct {
+	*metric.TableBuilder
+	json bool
+}
+
+func (b *metricTableBuilder) Add(topic string, payload []byte, received time.Time) error {
+	parse := metric.ParseLineProtocol
+	if b.json {
+		parse = metric.ParseJSON
+	}
+	metrics, err := parse(payload)
+	if err != nil {
+		return err
+	}
+	for _, m := range metrics {
+		if err := b.TableBuilder.Add(m, received); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rawTableBuilder builds a table for each topic with the time each
+// message was received in _time and the payload as a string in _value.
+type rawTableBuilder struct {
+	alloc    *memory.Allocator
+	builders map[string]*execute.ColListTableBuilder
+	topics   []string
+}
+
+func newRawTableBuilder(alloc *memory.Allocator) *rawTableBuilder {
+	return &rawTableBuilder{
+		alloc:    alloc,
+		builders: make(map[string]*execute.ColListTableBuilder),
+	}
+}
+
+func (b *rawTableBuilder) Add(topic string, payload []byte, received time.Time) error {
+	tb, ok := b.builders[topic]
+	if !ok {
+		key := execute.NewGroupKey(
+			[]flux.ColMeta{{Label: TopicColLabel, Type: flux.TString}},
+			[]values.Value{values.NewString(topic)},
+		)
+		tb = execute.NewColListTableBuilder(key, b.alloc)
+		for _, col := range []flux.ColMeta{
+			{Label: execute.DefaultTimeColLabel, Type: flux.TTime},
+			{Label: execute.DefaultValueColLabel, Type: flux.TString},
+			{Label: TopicColLabel, Type: flux.TString},
+		} {
+			if _, err := tb.AddCol(col); err != nil {
+				return err
+			}
+		}
+		b.builders[topic] = tb
+		b.topics = append(b.topics, topic)
+	}
+	if err := tb.AppendTime(0, values.ConvertTime(received)); err != nil {
+		return err
+	}
+	if err := tb.AppendString(1, string(payload)); err != nil {
+		return err
+	}
+	return tb.AppendString(2, topic)
+}
+
+func (b *rawTableBuilder) Tables() ([]flux.BufferedTable, error) {
+	defer b.Release()
+	tables := make([]flux.BufferedTable, 0, len(b.topics))
+	for _, topic := range b.topics {
+		tbl, err := b.builders[topic].Table()
+		if err == nil {
+			var buffered flux.BufferedTable
+			if buffered, err = execute.CopyTable(tbl); err == nil {
+				tables = append(tables, buffered)
+				continue
+			}
+		}
+		for _, tbl := range tables {
+			tbl.Done()
+		}
+		return nil, err
+	}
+	return tables, nil
+}
+
+func (b *rawTableBuilder) Release() {
+	for _, tb := range b.builders {
+		tb.Release()
+	}
+	b.builders = make(map[string]*execute.ColListTableBuilder)
+	b.topics = nil
+}
@@ -0,0 +1,319 @@
+package mqtt_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	MQTT "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/stdlib/experimental/mqtt"
+)
+
+func TestFromMQTT_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw: `
+import "experimental/mqtt"
+mqtt.from(broker: "tcp://iot.eclipse.org:1883", topics: ["sensors/#"], count: 10)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromMQTT0",
+						Spec: &mqtt.FromMQTTOpSpec{
+							Broker:   "tcp://iot.eclipse.org:1883",
+							Topics:   []string{"sensors/#"},
+							ClientID: "flux-mqtt",
+							Timeout:  mqtt.DefaultToMQTTTimeout,
+							Count:    10,
+							Format:   mqtt.FormatRaw,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "all options",
+			Raw: `
+import "experimental/mqtt"
+mqtt.from(broker: "tcp://iot.eclipse.org:1883", topics: ["a", "b"], qos: 1, clientid: "c", username: "u", password: "p", timeout: 5s, duration: 1m, format: "lineprotocol")`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromMQTT0",
+						Spec: &mqtt.FromMQTTOpSpec{
+							Broker:   "tcp://iot.eclipse.org:1883",
+							Topics:   []string{"a", "b"},
+							QoS:      1,
+							ClientID: "c",
+							Username: "u",
+							Password: "p",
+							Timeout:  5 * time.Second,
+							Duration: time.Minute,
+							Format:   mqtt.FormatLineProtocol,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "unbounded",
+			Raw: `
+import "experimental/mqtt"
+mqtt.from(broker: "tcp://iot.eclipse.org:1883", topics: ["a"])`,
+			WantErr: true,
+		},
+		{
+			Name: "bad scheme",
+			Raw: `
+import "experimental/mqtt"
+mqtt.from(broker: "http://iot.eclipse.org:1883", topics: ["a"], count: 1)`,
+			WantErr: true,
+		},
+		{
+			Name: "password required",
+			Raw: `
+import "experimental/mqtt"
+mqtt.from(broker: "tcp://iot.eclipse.org:1883", topics: ["a"], count: 1, username: "u")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+type fakeToken struct {
+	err error
+}
+
+func (t fakeToken) Wait() bool                     { return true }
+func (t fakeToken) WaitTimeout(time.Duration) bool { return true }
+func (t fakeToken) Error() error                   { return t.err }
+
+type fakeMessage struct {
+	topic   string
+	payload []byte
+}
+
+func (m fakeMessage) Duplicate() bool   { return false }
+func (m fakeMessage) Qos() byte         { return 0 }
+func (m fakeMessage) Retained() bool    { return false }
+func (m fakeMessage) Topic() string     { return m.topic }
+func (m fakeMessage) MessageID() uint16 { return 0 }
+func (m fakeMessage) Payload() []byte   { return m.payload }
+func (m fakeMessage) Ack()              {}
+
+// fakeBroker is an in-process broker that publishes its messages
+// to a client as soon as the client subscribes.
+type fakeBroker struct {
+	MQTT.Client
+	mu         sync.Mutex
+	messages   []fakeMessage
+	connectErr error
+	subscribed map[string]byte
+}
+
+func (b *fakeBroker) NewClient(*MQTT.ClientOptions) MQTT.Client {
+	return b
+}
+
+func (b *fakeBroker) Connect() MQTT.Token {
+	return fakeToken{err: b.connectErr}
+}
+
+func (b *fakeBroker) Disconnect(uint) {}
+
+func (b *fakeBroker) SubscribeMultiple(filters map[string]byte, callback MQTT.MessageHandler) MQTT.Token {
+	b.mu.Lock()
+	b.subscribed = filters
+	b.mu.Unlock()
+	go func() {
+		for _, m := range b.messages {
+			if _, ok := filters[m.topic]; ok {
+				callback(b, m)
+			}
+		}
+	}()
+	return fakeToken{}
+}
+
+func (b *fakeBroker) Unsubscribe(...string) MQTT.Token {
+	return fakeToken{}
+}
+
+func runFromMQTT(t *testing.T, broker *fakeBroker, spec mqtt.FromMQTTOpSpec) ([]*executetest.Table, error) {
+	t.Helper()
+	defer func(factory func(*MQTT.ClientOptions) MQTT.Client) {
+		mqtt.DefaultMQTTClientFactory = factory
+	}(mqtt.DefaultMQTTClientFactory)
+	mqtt.DefaultMQTTClientFactory = broker.NewClient
+
+	spec.Broker = "tcp://localhost:1883"
+	spec.Timeout = time.Second
+	store := executetest.NewDataStore()
+	s := mqtt.NewFromMQTTSource(&mqtt.FromMQTTProcedureSpec{Spec: &spec}, executetest.RandomDatasetID(), &memory.Allocator{})
+	s.AddTransformation(store)
+	s.Run(context.Background())
+	if err := store.Err(); err != nil {
+		return nil, err
+	}
+	got, err := executetest.TablesFromCache(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	executetest.NormalizeTables(got)
+	sort.Sort(executetest.SortedTables(got))
+	return got, nil
+}
+
+func TestFromMQTT_Raw(t *testing.T) {
+	broker := &fakeBroker{messages: []fakeMessage{
+		{topic: "a", payload: []byte("1")},
+		{topic: "b", payload: []byte("2")},
+		{topic: "a", payload: []byte("3")},
+		{topic: "c", payload: []byte("4")},
+	}}
+	start := time.Now()
+	got, err := runFromMQTT(t, broker, mqtt.FromMQTTOpSpec{
+		Topics: []string{"a", "b"},
+		Count:  3,
+		Format: mqtt.FormatRaw,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]byte{"a": 0, "b": 0}; !cmp.Equal(want, broker.subscribed) {
+		t.Fatalf("unexpected subscription -want/+got:\n%s", cmp.Diff(want, broker.subscribed))
+	}
+
+	// The time of a raw message is the time it was received.
+	for _, tbl := range got {
+		for _, row := range tbl.Data {
+			if ts := row[0].(execute.Time).Time(); ts.Before(start.Truncate(time.Microsecond)) {
+				t.Fatalf("expected the receive time of the message, got %v", ts)
+			}
+			row[0] = execute.Time(0)
+		}
+	}
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TString},
+		{Label: "topic", Type: flux.TString},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"topic"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(0), "1", "a"},
+				{execute.Time(0), "3", "a"},
+			},
+		},
+		{
+			KeyCols: []string{"topic"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{execute.Time(0), "2", "b"},
+			},
+		},
+	}
+	executetest.NormalizeTables(want)
+	sort.Sort(executetest.SortedTables(want))
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFromMQTT_LineProtocol(t *testing.T) {
+	broker := &fakeBroker{messages: []fakeMessage{
+		{topic: "sensors", payload: []byte("temp,room=a value=21.5 1000\ntemp,room=b value=19 1000")},
+		{topic: "sensors", payload: []byte("temp,room=a value=22 2000")},
+	}}
+	// The subscription ends after the duration even
+	// though fewer messages than count have arrived.
+	got, err := runFromMQTT(t, broker, mqtt.FromMQTTOpSpec{
+		Topics:   []string{"sensors"},
+		Count:    10,
+		Duration: 50 * time.Millisecond,
+		Format:   mqtt.FormatLineProtocol,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cols := []flux.ColMeta{
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "room", Type: flux.TString},
+		{Label: "_field", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	want := []*executetest.Table{
+		{
+			KeyCols: []string{"_measurement", "room", "_field"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{"temp", "a", "value", execute.Time(1000), 21.5},
+				{"temp", "a", "value", execute.Time(2000), 22.0},
+			},
+		},
+		{
+			KeyCols: []string{"_measurement", "room", "_field"},
+			ColMeta: cols,
+			Data: [][]interface{}{
+				{"temp", "b", "value", execute.Time(1000), 19.0},
+			},
+		},
+	}
+	executetest.NormalizeTables(want)
+	sort.Sort(executetest.SortedTables(want))
+	if !cmp.Equal(want, got) {
+		t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestFromMQTT_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		broker  *fakeBroker
+		spec    mqtt.FromMQTTOpSpec
+		wantErr string
+	}{
+		{
+			name:    "connect",
+			broker:  &fakeBroker{connectErr: errors.New("connection refused")},
+			spec:    mqtt.FromMQTTOpSpec{Topics: []string{"a"}, Count: 1, Format: mqtt.FormatRaw},
+			wantErr: "error in mqtt.from(): failed to connect to broker: connection refused",
+		},
+		{
+			name:    "decode",
+			broker:  &fakeBroker{messages: []fakeMessage{{topic: "a", payload: []byte(`{"fields": {}}`)}}},
+			spec:    mqtt.FromMQTTOpSpec{Topics: []string{"a"}, Count: 1, Format: mqtt.FormatJSON},
+			wantErr: "error in mqtt.from(): failed to decode message on topic a: invalid JSON metric 0: missing name",
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runFromMQTT(t, tc.broker, tc.spec)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+			if got, want := err.Error(), tc.wantErr; got != want {
+				t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", want, got)
+			}
+		})
+	}
+}
@@ -5,12 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net/url"
 	"sort"
 	"strings"
 	"time"
 
-	MQTT "github.com/eclipse/paho.mqtt.golang"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/pkg/syncutil"
@@ -92,27 +90,11 @@ func (o *ToMQTTOpSpec) ReadArgs(args flux.Arguments) error {
 		}
 	}
 
-	o.ClientID, ok, err = args.GetString("clientid")
+	c, err := readClientArgs(args)
 	if err != nil {
 		return err
 	}
-	if !ok {
-		o.ClientID = "flux-mqtt"
-	}
-
-	o.Username, ok, err = args.GetString("username")
-	if err != nil {
-		return err
-	}
-	if ok {
-		o.Password, ok, err = args.GetString("password")
-		if err != nil {
-			return err
-		}
-		if !ok {
-			return fmt.Errorf("password required with username %s", o.Username)
-		}
-	}
+	o.ClientID, o.Username, o.Password, o.Timeout = c.ClientID, c.Username, c.Password, c.Timeout
 
 	q, ok, err := args.GetInt("qos")
 	if err != nil {
@@ -126,15 +108,6 @@ func (o *ToMQTTOpSpec) ReadArgs(args flux.Arguments) error {
 	if o.QoS < 0 || o.QoS > 3 {
 		o.QoS = 0 // default to 0 if some random value is passed
 	}
-	timeout, ok, err := args.GetDuration("timeout")
-	if err != nil {
-		return err
-	}
-	if !ok {
-		o.Timeout = DefaultToMQTTTimeout
-	} else {
-		o.Timeout = values.Duration(timeout).Duration()
-	}
 
 	o.TimeColumn, ok, err = args.GetString("timeColumn")
 	if err != nil {
@@ -190,14 +163,7 @@ func (o *ToMQTTOpSpec) UnmarshalJSON(b []byte) (err error) {
 	if err = json.Unmarshal(b, (*innerToMQTTOpSpec)(o)); err != nil {
 		return err
 	}
-	u, err := url.ParseRequestURI(o.Broker)
-	if err != nil {
-		return err
-	}
-	if !(u.Scheme == "tcp" || u.Scheme == "ws" || u.Scheme == "tls") {
-		return fmt.Errorf("scheme must be tcp or ws or tls but was %s", u.Scheme)
-	}
-	return nil
+	return validateBroker(o.Broker)
 }
 
 func (ToMQTTOpSpec) Kind() flux.OperationKind {
@@ -305,24 +271,15 @@ type idxType struct {
 
 func (t *ToMQTTTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
 	// set up the MQTT options.
-	opts := MQTT.NewClientOptions().AddBroker(t.spec.Spec.Broker)
-	if t.spec.Spec.ClientID != "" {
-		opts.SetClientID(t.spec.Spec.ClientID)
-	} else {
-		opts.SetClientID("flux-mqtt")
-	}
-	if t.spec.Spec.Timeout > 0 {
-		opts.SetConnectTimeout(t.spec.Spec.Timeout)
-	}
-	if t.spec.Spec.Username != "" {
-		opts.SetUsername(t.spec.Spec.Username)
-	}
-	if t.spec.Spec.Password != "" {
-		opts.SetPassword(t.spec.Spec.Password)
-	}
+	opts := newClientOptions(t.spec.Spec.Broker, clientArgs{
+		ClientID: t.spec.Spec.ClientID,
+		Username: t.spec.Spec.Username,
+		Password: t.spec.Spec.Password,
+		Timeout:  t.spec.Spec.Timeout,
+	})
 	mqttTopic := t.spec.Spec.Topic
 
-	client := MQTT.NewClient(opts)
+	client := DefaultMQTTClientFactory(opts)
 	if t.spec.Spec.Message != "" {
 		//create and start a client using the above ClientOptions
 		if token := client.Connect(); token.Wait() && token.Error() != nil {
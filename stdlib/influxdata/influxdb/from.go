@@ -5,6 +5,7 @@ package influxdb
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/ast"
@@ -205,6 +206,20 @@ func (s *FromRemoteProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// SetNow implements plan.NowAwareProcedureSpec
+func (s *FromRemoteProcedureSpec) SetNow(now time.Time) {
+	if s.Range != nil {
+		s.Range.SetNow(now)
+	}
+	for i, spec := range s.Transformations {
+		if spec, ok := spec.(plan.NowAwareProcedureSpec); ok {
+			spec = spec.Copy().(plan.NowAwareProcedureSpec)
+			spec.SetNow(now)
+			s.Transformations[i] = spec
+		}
+	}
+}
+
 func (s *FromRemoteProcedureSpec) PostPhysicalValidate(id plan.NodeID) error {
 	if s.Range == nil {
 		var bucket string
@@ -0,0 +1,414 @@
+package kafka_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	fkafka "github.com/influxdata/flux/stdlib/kafka"
+)
+
+// The kafka api keys that the protocol broker answers.
+const (
+	apiFetch      = 1
+	apiListOffset = 2
+	apiMetadata   = 3
+)
+
+// The kafka error codes that the protocol broker returns.
+const (
+	errOffsetOutOfRange = 1
+	errUnknownPartition = 3
+)
+
+// protocolBroker is a kafka broker that listens on a local port and speaks
+// enough of the kafka wire protocol for a kafka.Reader to read a single
+// partition of a single topic. It answers the metadata, list offsets and
+// fetch requests. Consumer groups are not supported.
+type protocolBroker struct {
+	ln       net.Listener
+	topic    string
+	messages [][]byte
+	// time is the timestamp of every message in milliseconds.
+	time int64
+
+	mu    sync.Mutex
+	conns map[net.Conn]bool
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+func newProtocolBroker(t *testing.T, topic string, values ...string) *protocolBroker {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &protocolBroker{
+		ln:    ln,
+		topic: topic,
+		time:  1,
+		conns: make(map[net.Conn]bool),
+		done:  make(chan struct{}),
+	}
+	for _, v := range values {
+		b.messages = append(b.messages, []byte(v))
+	}
+	b.wg.Add(1)
+	go b.serve()
+	return b
+}
+
+func (b *protocolBroker) Addr() string {
+	return b.ln.Addr().String()
+}
+
+// Close stops the broker and waits for its connections to finish.
+func (b *protocolBroker) Close() {
+	close(b.done)
+	_ = b.ln.Close()
+	b.mu.Lock()
+	for conn := range b.conns {
+		_ = conn.Close()
+	}
+	b.mu.Unlock()
+	b.wg.Wait()
+}
+
+func (b *protocolBroker) serve() {
+	defer b.wg.Done()
+	for {
+		conn, err := b.ln.Accept()
+		if err != nil {
+			return
+		}
+		b.mu.Lock()
+		b.conns[conn] = true
+		b.mu.Unlock()
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			b.handle(conn)
+			b.mu.Lock()
+			delete(b.conns, conn)
+			b.mu.Unlock()
+			_ = conn.Close()
+		}()
+	}
+}
+
+// handle answers the requests of a connection until it is closed
+// or a request is received that the broker does not understand.
+func (b *protocolBroker) handle(conn net.Conn) {
+	for {
+		var size int32
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		req := &decoder{buf: buf}
+		apiKey := req.int16()
+		_ = req.int16() // api version
+		correlationID := req.int32()
+		_ = req.string() // client id
+
+		res := new(encoder)
+		res.int32(correlationID)
+		switch apiKey {
+		case apiMetadata:
+			b.metadata(res)
+		case apiListOffset:
+			b.listOffset(req, res)
+		case apiFetch:
+			if !b.fetch(req, res) {
+				return
+			}
+		default:
+			return
+		}
+		if err := binary.Write(conn, binary.BigEndian, int32(res.Len())); err != nil {
+			return
+		}
+		if _, err := conn.Write(res.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+// metadata writes a metadata v0 response that
+// names this broker as the leader of partition 0.
+func (b *protocolBroker) metadata(res *encoder) {
+	addr := b.ln.Addr().(*net.TCPAddr)
+
+	res.int32(1) // brokers
+	res.int32(0) // node id
+	res.string(addr.IP.String())
+	res.int32(int32(addr.Port))
+
+	res.int32(1) // topics
+	res.int16(0) // error code
+	res.string(b.topic)
+	res.int32(1) // partitions
+	res.int16(0) // error code
+	res.int32(0) // partition id
+	res.int32(0) // leader
+	res.int32(1) // replicas
+	res.int32(0)
+	res.int32(1) // isr
+	res.int32(0)
+}
+
+// listOffset writes a list offsets v1 response for the first (-2)
+// or last (-1) offset of the partition.
+func (b *protocolBroker) listOffset(req *decoder, res *encoder) {
+	_ = req.int32() // replica id
+	_ = req.int32() // topics
+	_ = req.string()
+	_ = req.int32() // partitions
+	partition := req.int32()
+	timestamp := req.int64()
+
+	var errorCode int16
+	var offset int64
+	switch {
+	case partition != 0:
+		errorCode = errUnknownPartition
+	case timestamp == -2:
+		offset = 0
+	default:
+		offset = int64(len(b.messages))
+	}
+
+	res.int32(1) // topics
+	res.string(b.topic)
+	res.int32(1) // partitions
+	res.int32(partition)
+	res.int16(errorCode)
+	res.int64(-1) // timestamp
+	res.int64(offset)
+}
+
+// fetch writes a fetch v1 response with the messages from the requested
+// offset. If there are no messages, it waits for a short time like a
+// broker would before it responds. It reports false if the broker is closed.
+func (b *protocolBroker) fetch(req *decoder, res *encoder) bool {
+	_ = req.int32() // replica id
+	maxWait := time.Duration(req.int32()) * time.Millisecond
+	_ = req.int32() // min bytes
+	_ = req.int32() // topics
+	_ = req.string()
+	_ = req.int32() // partitions
+	partition := req.int32()
+	offset := req.int64()
+
+	var errorCode int16
+	switch {
+	case partition != 0:
+		errorCode = errUnknownPartition
+	case offset < 0 || offset > int64(len(b.messages)):
+		errorCode = errOffsetOutOfRange
+	case offset == int64(len(b.messages)):
+		// The reader fetches again as soon as it receives a response,
+		// so do not wait for the whole time that it allows.
+		if maxWait > 10*time.Millisecond {
+			maxWait = 10 * time.Millisecond
+		}
+		select {
+		case <-time.After(maxWait):
+		case <-b.done:
+			return false
+		}
+	}
+
+	set := new(encoder)
+	if errorCode == 0 {
+		for i := offset; i < int64(len(b.messages)); i++ {
+			b.writeMessage(set, i)
+		}
+	}
+
+	res.int32(0) // throttle time
+	res.int32(1) // topics
+	res.string(b.topic)
+	res.int32(1) // partitions
+	res.int32(partition)
+	res.int16(errorCode)
+	res.int64(int64(len(b.messages))) // high watermark
+	res.int32(int32(set.Len()))
+	res.Write(set.Bytes())
+	return true
+}
+
+// writeMessage writes the message at the offset
+// to a message set as a version 1 message.
+func (b *protocolBroker) writeMessage(set *encoder, offset int64) {
+	msg := new(encoder)
+	msg.int8(1) // magic
+	msg.int8(0) // attributes
+	msg.int64(b.time)
+	msg.int32(-1) // null key
+	msg.bytes(b.messages[offset])
+
+	set.int64(offset)
+	set.int32(int32(4 + msg.Len()))
+	set.int32(int32(crc32.ChecksumIEEE(msg.Bytes())))
+	set.Write(msg.Bytes())
+}
+
+// encoder writes the big endian types of the kafka protocol.
+type encoder struct {
+	bytes.Buffer
+}
+
+func (e *encoder) int8(v int8)   { e.WriteByte(byte(v)) }
+func (e *encoder) int16(v int16) { _ = binary.Write(e, binary.BigEndian, v) }
+func (e *encoder) int32(v int32) { _ = binary.Write(e, binary.BigEndian, v) }
+func (e *encoder) int64(v int64) { _ = binary.Write(e, binary.BigEndian, v) }
+
+func (e *encoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.WriteString(s)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.Write(b)
+}
+
+// decoder reads the big endian types of the kafka protocol from a request.
+type decoder struct {
+	buf []byte
+}
+
+func (d *decoder) int16() int16 {
+	v := int16(binary.BigEndian.Uint16(d.buf))
+	d.buf = d.buf[2:]
+	return v
+}
+
+func (d *decoder) int32() int32 {
+	v := int32(binary.BigEndian.Uint32(d.buf))
+	d.buf = d.buf[4:]
+	return v
+}
+
+func (d *decoder) int64() int64 {
+	v := int64(binary.BigEndian.Uint64(d.buf))
+	d.buf = d.buf[8:]
+	return v
+}
+
+func (d *decoder) string() string {
+	n := int(d.int16())
+	if n < 0 {
+		return ""
+	}
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
+
+func TestFromKafka_Protocol(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "host", Type: flux.TString},
+		{Label: "_field", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	key := []string{"_measurement", "host", "_field"}
+	lp := []string{
+		"cpu,host=a usage=1 1",
+		"cpu,host=b usage=2 2\ncpu,host=a usage=3 3",
+		"cpu,host=a usage=4",
+	}
+
+	testCases := []struct {
+		name string
+		spec fkafka.FromKafkaOpSpec
+		want []*executetest.Table
+	}{
+		{
+			name: "stop offset",
+			spec: fkafka.FromKafkaOpSpec{StartOffset: -1, StopOffset: 2},
+			want: []*executetest.Table{
+				{
+					KeyCols: key,
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{"cpu", "a", "usage", execute.Time(1), 1.0},
+						{"cpu", "a", "usage", execute.Time(3), 3.0},
+					},
+				},
+				{
+					KeyCols: key,
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{"cpu", "b", "usage", execute.Time(2), 2.0},
+					},
+				},
+			},
+		},
+		{
+			// The message time is used for the line without a timestamp.
+			name: "start offset and message time",
+			spec: fkafka.FromKafkaOpSpec{StartOffset: 2, MaxMessages: 1},
+			want: []*executetest.Table{{
+				KeyCols: key,
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{"cpu", "a", "usage", execute.Time(1e6), 4.0},
+				},
+			}},
+		},
+		{
+			name: "timeout",
+			spec: fkafka.FromKafkaOpSpec{StartOffset: 1, MaxMessages: 10},
+			want: []*executetest.Table{
+				{
+					KeyCols: key,
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{"cpu", "a", "usage", execute.Time(3), 3.0},
+						{"cpu", "a", "usage", execute.Time(1e6), 4.0},
+					},
+				},
+				{
+					KeyCols: key,
+					ColMeta: cols,
+					Data: [][]interface{}{
+						{"cpu", "b", "usage", execute.Time(2), 2.0},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			broker := newProtocolBroker(t, "cpu", lp...)
+			defer broker.Close()
+
+			spec := tc.spec
+			spec.Brokers = []string{broker.Addr()}
+			spec.Topic = "cpu"
+			spec.Format = fkafka.FormatLineProtocol
+			spec.Timeout = 500 * time.Millisecond
+			executetest.RunSourceHelper(t, tc.want, nil, func(id execute.DatasetID) execute.Source {
+				return fkafka.NewFromKafkaSource(&fkafka.FromKafkaProcedureSpec{Spec: &spec}, id, &memory.Allocator{})
+			})
+		})
+	}
+}
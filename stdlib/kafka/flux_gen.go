@@ -22,10 +22,10 @@ var pkgAST = &ast.Package{
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
 					Column: 11,
-					Line:   3,
+					Line:   4,
 				},
 				File:   "kafka.flux",
-				Source: "package kafka\n\nbuiltin to",
+				Source: "package kafka\n\nbuiltin from\nbuiltin to",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
@@ -37,11 +37,11 @@ var pkgAST = &ast.Package{
 				Errors: nil,
 				Loc: &ast.SourceLocation{
 					End: ast.Position{
-						Column: 11,
+						Column: 13,
 						Line:   3,
 					},
 					File:   "kafka.flux",
-					Source: "builtin to",
+					Source: "builtin from",
 					Start: ast.Position{
 						Column: 1,
 						Line:   3,
@@ -53,17 +53,51 @@ var pkgAST = &ast.Package{
 					Errors: nil,
 					Loc: &ast.SourceLocation{
 						End: ast.Position{
-							Column: 11,
+							Column: 13,
 							Line:   3,
 						},
 						File:   "kafka.flux",
-						Source: "to",
+						Source: "from",
 						Start: ast.Position{
 							Column: 9,
 							Line:   3,
 						},
 					},
 				},
+				Name: "from",
+			},
+		}, &ast.BuiltinStatement{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 11,
+						Line:   4,
+					},
+					File:   "kafka.flux",
+					Source: "builtin to",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 11,
+							Line:   4,
+						},
+						File:   "kafka.flux",
+						Source: "to",
+						Start: ast.Position{
+							Column: 9,
+							Line:   4,
+						},
+					},
+				},
 				Name: "to",
 			},
 		}},
@@ -0,0 +1,363 @@
+package kafka
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/metric"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// FromKafkaKind is the Kind for the FromKafka Flux function
+	FromKafkaKind = "fromKafka"
+
+	// DefaultFromKafkaTimeout is the default amount of time to
+	// wait for a message before kafka.from stops reading.
+	DefaultFromKafkaTimeout = 10 * time.Second
+
+	// firstOffset is the offset that seeks to the first message of a partition.
+	firstOffset = -1
+)
+
+// The formats of the messages that kafka.from can decode.
+const (
+	FormatLineProtocol = "lineprotocol"
+	FormatJSON         = "json"
+)
+
+type FromKafkaOpSpec struct {
+	Brokers     []string      `json:"brokers"`
+	Topic       string        `json:"topic"`
+	GroupID     string        `json:"groupID"`
+	Partition   int           `json:"partition"`
+	StartOffset int64         `json:"startOffset"`
+	StopOffset  int64         `json:"stopOffset"`
+	MaxMessages int64         `json:"maxMessages"`
+	Format      string        `json:"format"`
+	Timeout     time.Duration `json:"timeout"`
+}
+
+func init() {
+	fromKafkaSignature := runtime.MustLookupBuiltinType("kafka", "from")
+	runtime.RegisterPackageValue("kafka", "from", flux.MustValue(flux.FunctionValue(FromKafkaKind, createFromKafkaOpSpec, fromKafkaSignature)))
+	flux.RegisterOpSpec(FromKafkaKind, func() flux.OperationSpec { return &FromKafkaOpSpec{} })
+	plan.RegisterProcedureSpec(FromKafkaKind, newFromKafkaProcedure, FromKafkaKind)
+	execute.RegisterSource(FromKafkaKind, createFromKafkaSource)
+}
+
+// DefaultKafkaReaderFactory makes the KafkaReader used by kafka.from and is injectable for testing
+var DefaultKafkaReaderFactory = func(conf kafka.ReaderConfig) KafkaReader {
+	return kafka.NewReader(conf)
+}
+
+// KafkaReader is an interface for what we need from DefaultKafkaReaderFactory
+type KafkaReader interface {
+	io.Closer
+	ReadMessage(context.Context) (kafka.Message, error)
+	SetOffset(offset int64) error
+}
+
+// ReadArgs loads a flux.Arguments into FromKafkaOpSpec. It sets several default values.
+// Reading starts at the first offset of the partition unless a consumer group is used,
+// and either stopOffset or maxMessages must be set so that the source stops reading.
+func (o *FromKafkaOpSpec) ReadArgs(args flux.Arguments) error {
+	brokers, err := args.GetRequiredArray("brokers", semantic.String)
+	if err != nil {
+		return err
+	}
+	if brokers.Len() < 1 {
+		return errors.New(codes.Invalid, "at least one broker is required")
+	}
+	o.Brokers = make([]string, brokers.Len())
+	brokers.Range(func(i int, v values.Value) {
+		o.Brokers[i] = v.Str()
+	})
+
+	o.Topic, err = args.GetRequiredString("topic")
+	if err != nil {
+		return err
+	}
+	if len(o.Topic) == 0 {
+		return errors.New(codes.Invalid, "invalid topic name")
+	}
+
+	o.GroupID, _, err = args.GetString("groupID")
+	if err != nil {
+		return err
+	}
+
+	partition, ok, err := args.GetInt("partition")
+	if err != nil {
+		return err
+	}
+	if ok {
+		if o.GroupID != "" {
+			return errors.New(codes.Invalid, "partition cannot be used with groupID")
+		}
+		if partition < 0 {
+			return errors.New(codes.Invalid, "partition must not be negative")
+		}
+		o.Partition = int(partition)
+	}
+
+	o.StartOffset = firstOffset
+	if startOffset, ok, err := args.GetInt("startOffset"); err != nil {
+		return err
+	} else if ok {
+		if o.GroupID != "" {
+			return errors.New(codes.Invalid, "startOffset cannot be used with groupID, the committed offset of the group is used instead")
+		}
+		if startOffset < 0 {
+			return errors.New(codes.Invalid, "startOffset must not be negative")
+		}
+		o.StartOffset = startOffset
+	}
+
+	stopOffset, hasStop, err := args.GetInt("stopOffset")
+	if err != nil {
+		return err
+	}
+	if hasStop {
+		if o.GroupID != "" {
+			return errors.New(codes.Invalid, "stopOffset cannot be used with groupID, use maxMessages instead")
+		}
+		if stopOffset <= 0 || stopOffset <= o.StartOffset {
+			return errors.New(codes.Invalid, "stopOffset must be greater than startOffset")
+		}
+		o.StopOffset = stopOffset
+	}
+	maxMessages, hasMax, err := args.GetInt("maxMessages")
+	if err != nil {
+		return err
+	}
+	if hasMax {
+		if maxMessages <= 0 {
+			return errors.New(codes.Invalid, "maxMessages must be positive")
+		}
+		o.MaxMessages = maxMessages
+	}
+	if !hasStop && !hasMax {
+		return errors.New(codes.Invalid, "either stopOffset or maxMessages is required")
+	}
+
+	o.Format, ok, err = args.GetString("format")
+	if err != nil {
+		return err
+	}
+	switch {
+	case !ok:
+		o.Format = FormatLineProtocol
+	case o.Format != FormatLineProtocol && o.Format != FormatJSON:
+		return errors.Newf(codes.Invalid, "unsupported format %q, expected %q or %q", o.Format, FormatLineProtocol, FormatJSON)
+	}
+
+	timeout, ok, err := args.GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		o.Timeout = DefaultFromKafkaTimeout
+	} else {
+		o.Timeout = timeout.Duration()
+		if o.Timeout <= 0 {
+			return errors.New(codes.Invalid, "timeout must be positive")
+		}
+	}
+	return nil
+}
+
+func createFromKafkaOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	s := new(FromKafkaOpSpec)
+	if err := s.ReadArgs(args); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (FromKafkaOpSpec) Kind() flux.OperationKind {
+	return FromKafkaKind
+}
+
+type FromKafkaProcedureSpec struct {
+	plan.DefaultCost
+	Spec *FromKafkaOpSpec
+}
+
+func (o *FromKafkaProcedureSpec) Kind() plan.ProcedureKind {
+	return FromKafkaKind
+}
+
+func (o *FromKafkaProcedureSpec) Copy() plan.ProcedureSpec {
+	s := *o.Spec
+	s.Brokers = append([]string(nil), o.Spec.Brokers...)
+	return &FromKafkaProcedureSpec{Spec: &s}
+}
+
+func newFromKafkaProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromKafkaOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &FromKafkaProcedureSpec{Spec: spec}, nil
+}
+
+func createFromKafkaSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromKafkaProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+	deps := flux.GetDependencies(a.Context())
+	validator, err := deps.URLValidator()
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range spec.Spec.Brokers {
+		u, err := url.Parse(b)
+		if err != nil {
+			return nil, errors.Newf(codes.Invalid, "invalid kafka broker url: %v", err)
+		}
+		if err := validator.Validate(u); err != nil {
+			return nil, errors.Newf(codes.Invalid, "kafka broker url did not pass validation: %v", err)
+		}
+	}
+	return NewFromKafkaSource(spec, dsid, a.Allocator()), nil
+}
+
+// FromKafkaSource reads messages from a kafka topic and decodes them into tables.
+// It stops reading when it reaches the stop offset, when it has read the maximum
+// number of messages, or when no message arrives before the timeout.
+type FromKafkaSource struct {
+	id    execute.DatasetID
+	ts    []execute.Transformation
+	spec  *FromKafkaProcedureSpec
+	alloc *memory.Allocator
+}
+
+func NewFromKafkaSource(spec *FromKafkaProcedureSpec, id execute.DatasetID, alloc *memory.Allocator) *FromKafkaSource {
+	return &FromKafkaSource{
+		id:    id,
+		spec:  spec,
+		alloc: alloc,
+	}
+}
+
+func (s *FromKafkaSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *FromKafkaSource) Run(ctx context.Context) {
+	err := s.run(ctx)
+	if err != nil {
+		err = errors.Wrap(err, codes.Inherit, "error in kafka.from()")
+	}
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *FromKafkaSource) run(ctx context.Context) error {
+	tables, err := s.readTables(ctx)
+	if err != nil {
+		return err
+	}
+	for i, tbl := range tables {
+		for j, t := range s.ts {
+			// The last transformation receives the original table
+			// and every other transformation receives a copy.
+			in := tbl
+			if j < len(s.ts)-1 {
+				in = tbl.Copy()
+			}
+			if err := t.Process(s.id, in); err != nil {
+				for _, tbl := range tables[i+1:] {
+					tbl.Done()
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *FromKafkaSource) readTables(ctx context.Context) ([]flux.BufferedTable, error) {
+	spec := s.spec.Spec
+	r := DefaultKafkaReaderFactory(kafka.ReaderConfig{
+		Brokers:   spec.Brokers,
+		Topic:     spec.Topic,
+		GroupID:   spec.GroupID,
+		Partition: spec.Partition,
+	})
+	defer func() { _ = r.Close() }()
+	if spec.GroupID == "" {
+		if err := r.SetOffset(spec.StartOffset); err != nil {
+			return nil, err
+		}
+	}
+
+	decode := metric.ParseLineProtocol
+	if spec.Format == FormatJSON {
+		decode = metric.ParseJSON
+	}
+	b := metric.NewTableBuilder(s.alloc)
+	for n := int64(0); spec.MaxMessages == 0 || n < spec.MaxMessages; n++ {
+		msg, ok, err := s.readMessage(ctx, r)
+		if err != nil {
+			b.Release()
+			return nil, err
+		} else if !ok {
+			break
+		}
+		if spec.StopOffset > 0 && msg.Offset >= spec.StopOffset {
+			break
+		}
+		metrics, err := decode(msg.Value)
+		if err != nil {
+			b.Release()
+			return nil, errors.Wrapf(err, codes.Inherit, "failed to decode message at offset %d of partition %d", msg.Offset, msg.Partition)
+		}
+		defaultTime := msg.Time
+		if defaultTime.IsZero() {
+			defaultTime = time.Now()
+		}
+		for _, m := range metrics {
+			if err := b.Add(m, defaultTime); err != nil {
+				b.Release()
+				return nil, err
+			}
+		}
+		if spec.StopOffset > 0 && msg.Offset+1 >= spec.StopOffset {
+			break
+		}
+	}
+	return b.Tables()
+}
+
+// readMessage reads the next message. It reports false if
+// no message arrived before the timeout.
+func (s *FromKafkaSource) readMessage(ctx context.Context, r KafkaReader) (kafka.Message, bool, error) {
+	rctx, cancel := context.WithTimeout(ctx, s.spec.Spec.Timeout)
+	defer cancel()
+	msg, err := r.ReadMessage(rctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return msg, false, ctx.Err()
+		}
+		if rctx.Err() == context.DeadlineExceeded || err == io.EOF {
+			return msg, false, nil
+		}
+		return msg, false, errors.Wrap(err, codes.Unavailable, "failed to read message")
+	}
+	return msg, true, nil
+}
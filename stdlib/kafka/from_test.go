@@ -0,0 +1,292 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/querytest"
+	fkafka "github.com/influxdata/flux/stdlib/kafka"
+	"github.com/segmentio/kafka-go"
+)
+
+func TestFromKafka_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "defaults",
+			Raw:  `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"totallynotfaketopic", maxMessages: 100)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromKafka0",
+						Spec: &fkafka.FromKafkaOpSpec{
+							Brokers:     []string{"brokerurl:8989"},
+							Topic:       "totallynotfaketopic",
+							StartOffset: -1,
+							MaxMessages: 100,
+							Format:      fkafka.FormatLineProtocol,
+							Timeout:     fkafka.DefaultFromKafkaTimeout,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "partition with offsets",
+			Raw:  `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"t", partition: 2, startOffset: 10, stopOffset: 20, format: "json", timeout: 1s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromKafka0",
+						Spec: &fkafka.FromKafkaOpSpec{
+							Brokers:     []string{"brokerurl:8989"},
+							Topic:       "t",
+							Partition:   2,
+							StartOffset: 10,
+							StopOffset:  20,
+							Format:      fkafka.FormatJSON,
+							Timeout:     time.Second,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "unbounded",
+			Raw:     `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"t")`,
+			WantErr: true,
+		},
+		{
+			Name:    "stop offset with group",
+			Raw:     `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"t", groupID: "flux", stopOffset: 10)`,
+			WantErr: true,
+		},
+		{
+			Name:    "unknown format",
+			Raw:     `import "kafka" kafka.from(brokers:["brokerurl:8989"], topic:"t", maxMessages: 1, format: "csv")`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+// fakeBroker is an in-process broker with a single partition.
+// It keeps track of the committed offsets of consumer groups.
+type fakeBroker struct {
+	sync.Mutex
+	messages  []kafka.Message
+	committed map[string]int64
+}
+
+func newFakeBroker(values ...string) *fakeBroker {
+	b := &fakeBroker{committed: make(map[string]int64)}
+	for i, v := range values {
+		b.messages = append(b.messages, kafka.Message{
+			Offset: int64(i),
+			Value:  []byte(v),
+			Time:   time.Unix(0, 100).UTC(),
+		})
+	}
+	return b
+}
+
+func (b *fakeBroker) NewReader(conf kafka.ReaderConfig) fkafka.KafkaReader {
+	b.Lock()
+	defer b.Unlock()
+	return &fakeReader{b: b, groupID: conf.GroupID, offset: b.committed[conf.GroupID]}
+}
+
+type fakeReader struct {
+	b       *fakeBroker
+	groupID string
+	offset  int64
+}
+
+func (r *fakeReader) Close() error { return nil }
+
+func (r *fakeReader) SetOffset(offset int64) error {
+	if r.groupID != "" {
+		return errors.New("unavailable when GroupID is set")
+	}
+	if offset == -1 {
+		offset = 0
+	}
+	r.offset = offset
+	return nil
+}
+
+func (r *fakeReader) ReadMessage(ctx context.Context) (kafka.Message, error) {
+	r.b.Lock()
+	if r.offset >= int64(len(r.b.messages)) {
+		r.b.Unlock()
+		// Block like a broker would until a message arrives.
+		<-ctx.Done()
+		return kafka.Message{}, ctx.Err()
+	}
+	msg := r.b.messages[r.offset]
+	r.offset++
+	if r.groupID != "" {
+		r.b.committed[r.groupID] = r.offset
+	}
+	r.b.Unlock()
+	return msg, nil
+}
+
+func TestFromKafka_Run(t *testing.T) {
+	defer func(factory func(kafka.ReaderConfig) fkafka.KafkaReader) {
+		fkafka.DefaultKafkaReaderFactory = factory
+	}(fkafka.DefaultKafkaReaderFactory)
+
+	cpuCols := []flux.ColMeta{
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "host", Type: flux.TString},
+		{Label: "_field", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	cpuKey := []string{"_measurement", "host", "_field"}
+	lp := []string{
+		"cpu,host=a usage=1 1",
+		"cpu,host=b usage=2 2\ncpu,host=a usage=3 3",
+		"cpu,host=a usage=4",
+	}
+
+	testCases := []struct {
+		name    string
+		broker  *fakeBroker
+		spec    fkafka.FromKafkaOpSpec
+		want    []*executetest.Table
+		wantErr error
+	}{
+		{
+			name:   "stop offset",
+			broker: newFakeBroker(lp...),
+			spec:   fkafka.FromKafkaOpSpec{StartOffset: -1, StopOffset: 2},
+			want: []*executetest.Table{
+				{
+					KeyCols: cpuKey,
+					ColMeta: cpuCols,
+					Data: [][]interface{}{
+						{"cpu", "a", "usage", execute.Time(1), 1.0},
+						{"cpu", "a", "usage", execute.Time(3), 3.0},
+					},
+				},
+				{
+					KeyCols: cpuKey,
+					ColMeta: cpuCols,
+					Data: [][]interface{}{
+						{"cpu", "b", "usage", execute.Time(2), 2.0},
+					},
+				},
+			},
+		},
+		{
+			name:   "start offset and message time",
+			broker: newFakeBroker(lp...),
+			spec:   fkafka.FromKafkaOpSpec{StartOffset: 2, MaxMessages: 1},
+			want: []*executetest.Table{{
+				KeyCols: cpuKey,
+				ColMeta: cpuCols,
+				Data: [][]interface{}{
+					{"cpu", "a", "usage", execute.Time(100), 4.0},
+				},
+			}},
+		},
+		{
+			name:   "timeout",
+			broker: newFakeBroker(lp[0]),
+			spec:   fkafka.FromKafkaOpSpec{StartOffset: -1, MaxMessages: 10},
+			want: []*executetest.Table{{
+				KeyCols: cpuKey,
+				ColMeta: cpuCols,
+				Data: [][]interface{}{
+					{"cpu", "a", "usage", execute.Time(1), 1.0},
+				},
+			}},
+		},
+		{
+			name:   "json",
+			broker: newFakeBroker(`{"name": "cpu", "tags": {"host": "a"}, "fields": {"usage": 1}, "timestamp": 1}`),
+			spec:   fkafka.FromKafkaOpSpec{StartOffset: -1, MaxMessages: 1, Format: fkafka.FormatJSON},
+			want: []*executetest.Table{{
+				KeyCols: cpuKey,
+				ColMeta: cpuCols,
+				Data: [][]interface{}{
+					{"cpu", "a", "usage", execute.Time(1e9), 1.0},
+				},
+			}},
+		},
+		{
+			name:    "decode error",
+			broker:  newFakeBroker("cpu,host=a"),
+			spec:    fkafka.FromKafkaOpSpec{StartOffset: -1, MaxMessages: 1},
+			wantErr: errors.New("error in kafka.from(): failed to decode message at offset 0 of partition 0: invalid line protocol on line 1: missing fields"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fkafka.DefaultKafkaReaderFactory = tc.broker.NewReader
+			spec := tc.spec
+			spec.Brokers = []string{"brokerurl:8989"}
+			spec.Topic = "totallynotfaketopic"
+			if spec.Format == "" {
+				spec.Format = fkafka.FormatLineProtocol
+			}
+			spec.Timeout = 10 * time.Millisecond
+			executetest.RunSourceHelper(t, tc.want, tc.wantErr, func(id execute.DatasetID) execute.Source {
+				return fkafka.NewFromKafkaSource(&fkafka.FromKafkaProcedureSpec{Spec: &spec}, id, &memory.Allocator{})
+			})
+		})
+	}
+}
+
+func TestFromKafka_ConsumerGroup(t *testing.T) {
+	defer func(factory func(kafka.ReaderConfig) fkafka.KafkaReader) {
+		fkafka.DefaultKafkaReaderFactory = factory
+	}(fkafka.DefaultKafkaReaderFactory)
+
+	broker := newFakeBroker("m v=1 1", "m v=2 2", "m v=3 3")
+	fkafka.DefaultKafkaReaderFactory = broker.NewReader
+	spec := &fkafka.FromKafkaProcedureSpec{Spec: &fkafka.FromKafkaOpSpec{
+		Brokers:     []string{"brokerurl:8989"},
+		Topic:       "totallynotfaketopic",
+		GroupID:     "flux",
+		MaxMessages: 2,
+		Format:      fkafka.FormatLineProtocol,
+		Timeout:     10 * time.Millisecond,
+	}}
+	cols := []flux.ColMeta{
+		{Label: "_measurement", Type: flux.TString},
+		{Label: "_field", Type: flux.TString},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+
+	// The second query continues from the offset
+	// that was committed by the first query.
+	for _, want := range [][][]interface{}{
+		{{"m", "v", execute.Time(1), 1.0}, {"m", "v", execute.Time(2), 2.0}},
+		{{"m", "v", execute.Time(3), 3.0}},
+	} {
+		executetest.RunSourceHelper(t, []*executetest.Table{{
+			KeyCols: []string{"_measurement", "_field"},
+			ColMeta: cols,
+			Data:    want,
+		}}, nil, func(id execute.DatasetID) execute.Source {
+			return fkafka.NewFromKafkaSource(spec, id, &memory.Allocator{})
+		})
+	}
+}
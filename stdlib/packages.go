@@ -3,15 +3,18 @@
 package stdlib
 
 import (
+	_ "github.com/influxdata/flux/stdlib/array"
 	_ "github.com/influxdata/flux/stdlib/contrib/chobbs/discord"
 	_ "github.com/influxdata/flux/stdlib/contrib/sranka/teams"
 	_ "github.com/influxdata/flux/stdlib/contrib/sranka/telegram"
 	_ "github.com/influxdata/flux/stdlib/csv"
 	_ "github.com/influxdata/flux/stdlib/date"
+	_ "github.com/influxdata/flux/stdlib/dict"
 	_ "github.com/influxdata/flux/stdlib/experimental"
 	_ "github.com/influxdata/flux/stdlib/experimental/aggregate"
 	_ "github.com/influxdata/flux/stdlib/experimental/bigtable"
 	_ "github.com/influxdata/flux/stdlib/experimental/csv"
+	_ "github.com/influxdata/flux/stdlib/experimental/error"
 	_ "github.com/influxdata/flux/stdlib/experimental/geo"
 	_ "github.com/influxdata/flux/stdlib/experimental/http"
 	_ "github.com/influxdata/flux/stdlib/experimental/json"
@@ -33,6 +36,7 @@ import (
 	_ "github.com/influxdata/flux/stdlib/kafka"
 	_ "github.com/influxdata/flux/stdlib/math"
 	_ "github.com/influxdata/flux/stdlib/pagerduty"
+	_ "github.com/influxdata/flux/stdlib/parquet"
 	_ "github.com/influxdata/flux/stdlib/planner"
 	_ "github.com/influxdata/flux/stdlib/pushbullet"
 	_ "github.com/influxdata/flux/stdlib/regexp"
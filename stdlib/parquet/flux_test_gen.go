@@ -0,0 +1,2909 @@
+// DO NOT EDIT: This file is autogenerated via the builtin command.
+
+package parquet
+
+import ast "github.com/influxdata/flux/ast"
+
+var FluxTestPackages = []*ast.Package{&ast.Package{
+	BaseNode: ast.BaseNode{
+		Errors: nil,
+		Loc:    nil,
+	},
+	Files: []*ast.File{&ast.File{
+		BaseNode: ast.BaseNode{
+			Errors: nil,
+			Loc: &ast.SourceLocation{
+				End: ast.Position{
+					Column: 4,
+					Line:   86,
+				},
+				File:   "from_test.flux",
+				Source: "package parquet_test\n\nimport \"testing\"\nimport \"parquet\"\n\n// The files are written by internal/parquet/testdata/generate.py.\n// Their paths are relative to the stdlib directory where the tests run.\ndir = \"../internal/parquet/testdata/\"\n\ndictionaryData = \"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,,-2,7,3\n,,0,a,1.5,-3,4\n,,0,c,-2,,5\n,,0,b,-2,7,18446744073709551615\n\"\n\ntest _from_dictionary = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t})\n\ngroupedData = \"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,true,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,a,1.5,-3,4\n,,1,,-2,7,3\n,,1,c,-2,,5\n,,1,b,-2,7,18446744073709551615\n\"\n\ntest _from_group_columns = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t})\n\ndataPageV2Data = \"\n#datatype,string,long,dateTime:RFC3339,double,string\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,_time,_value,name\n,,0,2020-01-01T00:00:00Z,1,x\n,,0,2020-01-01T00:00:01Z,,y\n,,0,2020-01-01T00:00:02Z,3,y\n,,0,2020-01-01T00:00:03Z,,\n,,0,2020-01-01T00:00:04Z,5,x\n\"\n\ntest _from_data_page_v2 = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t})\n\ngzipData = \"\n#datatype,string,long,boolean,dateTime:RFC3339,long\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,ok,day,n\n,,0,true,2020-01-01T00:00:00Z,-1\n,,0,false,2020-01-02T00:00:00Z,0\n,,0,,2020-01-03T00:00:00Z,1\n,,0,true,2020-01-04T00:00:00Z,2147483647\n,,0,true,2020-01-05T00:00:00Z,-2147483648\n\"\n\ntest _from_gzip = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t})",
+				Start: ast.Position{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
+		Body: []ast.Statement{&ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 38,
+						Line:   8,
+					},
+					File:   "from_test.flux",
+					Source: "dir = \"../internal/parquet/testdata/\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   8,
+						},
+						File:   "from_test.flux",
+						Source: "dir",
+						Start: ast.Position{
+							Column: 1,
+							Line:   8,
+						},
+					},
+				},
+				Name: "dir",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 38,
+							Line:   8,
+						},
+						File:   "from_test.flux",
+						Source: "\"../internal/parquet/testdata/\"",
+						Start: ast.Position{
+							Column: 7,
+							Line:   8,
+						},
+					},
+				},
+				Value: "../internal/parquet/testdata/",
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   21,
+					},
+					File:   "from_test.flux",
+					Source: "dictionaryData = \"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,,-2,7,3\n,,0,a,1.5,-3,4\n,,0,c,-2,,5\n,,0,b,-2,7,18446744073709551615\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   10,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   10,
+						},
+						File:   "from_test.flux",
+						Source: "dictionaryData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   10,
+						},
+					},
+				},
+				Name: "dictionaryData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   21,
+						},
+						File:   "from_test.flux",
+						Source: "\"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,,-2,7,3\n,,0,a,1.5,-3,4\n,,0,c,-2,,5\n,,0,b,-2,7,18446744073709551615\n\"",
+						Start: ast.Position{
+							Column: 18,
+							Line:   10,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,false,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,,-2,7,3\n,,0,a,1.5,-3,4\n,,0,c,-2,,5\n,,0,b,-2,7,18446744073709551615\n",
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   28,
+						},
+						File:   "from_test.flux",
+						Source: "_from_dictionary = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   23,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 22,
+								Line:   23,
+							},
+							File:   "from_test.flux",
+							Source: "_from_dictionary",
+							Start: ast.Position{
+								Column: 6,
+								Line:   23,
+							},
+						},
+					},
+					Name: "_from_dictionary",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 4,
+								Line:   28,
+							},
+							File:   "from_test.flux",
+							Source: "() =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t})",
+							Start: ast.Position{
+								Column: 25,
+								Line:   23,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 4,
+									Line:   28,
+								},
+								File:   "from_test.flux",
+								Source: "({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   24,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 3,
+										Line:   28,
+									},
+									File:   "from_test.flux",
+									Source: "{\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   24,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 56,
+											Line:   25,
+										},
+										File:   "from_test.flux",
+										Source: "input: parquet.from(file: dir + \"dictionary.parquet\")",
+										Start: ast.Position{
+											Column: 3,
+											Line:   25,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   25,
+											},
+											File:   "from_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 3,
+												Line:   25,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 55,
+													Line:   25,
+												},
+												File:   "from_test.flux",
+												Source: "file: dir + \"dictionary.parquet\"",
+												Start: ast.Position{
+													Column: 23,
+													Line:   25,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 55,
+														Line:   25,
+													},
+													File:   "from_test.flux",
+													Source: "file: dir + \"dictionary.parquet\"",
+													Start: ast.Position{
+														Column: 23,
+														Line:   25,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 27,
+															Line:   25,
+														},
+														File:   "from_test.flux",
+														Source: "file",
+														Start: ast.Position{
+															Column: 23,
+															Line:   25,
+														},
+													},
+												},
+												Name: "file",
+											},
+											Value: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 55,
+															Line:   25,
+														},
+														File:   "from_test.flux",
+														Source: "dir + \"dictionary.parquet\"",
+														Start: ast.Position{
+															Column: 29,
+															Line:   25,
+														},
+													},
+												},
+												Left: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 32,
+																Line:   25,
+															},
+															File:   "from_test.flux",
+															Source: "dir",
+															Start: ast.Position{
+																Column: 29,
+																Line:   25,
+															},
+														},
+													},
+													Name: "dir",
+												},
+												Operator: 5,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 55,
+																Line:   25,
+															},
+															File:   "from_test.flux",
+															Source: "\"dictionary.parquet\"",
+															Start: ast.Position{
+																Column: 35,
+																Line:   25,
+															},
+														},
+													},
+													Value: "dictionary.parquet",
+												},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 56,
+												Line:   25,
+											},
+											File:   "from_test.flux",
+											Source: "parquet.from(file: dir + \"dictionary.parquet\")",
+											Start: ast.Position{
+												Column: 10,
+												Line:   25,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   25,
+												},
+												File:   "from_test.flux",
+												Source: "parquet.from",
+												Start: ast.Position{
+													Column: 10,
+													Line:   25,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   25,
+													},
+													File:   "from_test.flux",
+													Source: "parquet",
+													Start: ast.Position{
+														Column: 10,
+														Line:   25,
+													},
+												},
+											},
+											Name: "parquet",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   25,
+													},
+													File:   "from_test.flux",
+													Source: "from",
+													Start: ast.Position{
+														Column: 18,
+														Line:   25,
+													},
+												},
+											},
+											Name: "from",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 45,
+											Line:   26,
+										},
+										File:   "from_test.flux",
+										Source: "want: testing.loadMem(csv: dictionaryData)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   26,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 7,
+												Line:   26,
+											},
+											File:   "from_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 3,
+												Line:   26,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 44,
+													Line:   26,
+												},
+												File:   "from_test.flux",
+												Source: "csv: dictionaryData",
+												Start: ast.Position{
+													Column: 25,
+													Line:   26,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 44,
+														Line:   26,
+													},
+													File:   "from_test.flux",
+													Source: "csv: dictionaryData",
+													Start: ast.Position{
+														Column: 25,
+														Line:   26,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 28,
+															Line:   26,
+														},
+														File:   "from_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 25,
+															Line:   26,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 44,
+															Line:   26,
+														},
+														File:   "from_test.flux",
+														Source: "dictionaryData",
+														Start: ast.Position{
+															Column: 30,
+															Line:   26,
+														},
+													},
+												},
+												Name: "dictionaryData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   26,
+											},
+											File:   "from_test.flux",
+											Source: "testing.loadMem(csv: dictionaryData)",
+											Start: ast.Position{
+												Column: 9,
+												Line:   26,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 24,
+													Line:   26,
+												},
+												File:   "from_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 9,
+													Line:   26,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   26,
+													},
+													File:   "from_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 9,
+														Line:   26,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 24,
+														Line:   26,
+													},
+													File:   "from_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 17,
+														Line:   26,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 26,
+											Line:   27,
+										},
+										File:   "from_test.flux",
+										Source: "fn: (table=<-) => table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   27,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   27,
+											},
+											File:   "from_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 3,
+												Line:   27,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   27,
+											},
+											File:   "from_test.flux",
+											Source: "(table=<-) => table",
+											Start: ast.Position{
+												Column: 7,
+												Line:   27,
+											},
+										},
+									},
+									Body: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   27,
+												},
+												File:   "from_test.flux",
+												Source: "table",
+												Start: ast.Position{
+													Column: 21,
+													Line:   27,
+												},
+											},
+										},
+										Name: "table",
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   27,
+												},
+												File:   "from_test.flux",
+												Source: "table=<-",
+												Start: ast.Position{
+													Column: 8,
+													Line:   27,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 13,
+														Line:   27,
+													},
+													File:   "from_test.flux",
+													Source: "table",
+													Start: ast.Position{
+														Column: 8,
+														Line:   27,
+													},
+												},
+											},
+											Name: "table",
+										},
+										Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   27,
+												},
+												File:   "from_test.flux",
+												Source: "<-",
+												Start: ast.Position{
+													Column: 14,
+													Line:   27,
+												},
+											},
+										}},
+									}},
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 4,
+						Line:   28,
+					},
+					File:   "from_test.flux",
+					Source: "test _from_dictionary = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\"),\n\t\twant: testing.loadMem(csv: dictionaryData),\n\t\tfn: (table=<-) => table,\n\t})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   23,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   41,
+					},
+					File:   "from_test.flux",
+					Source: "groupedData = \"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,true,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,a,1.5,-3,4\n,,1,,-2,7,3\n,,1,c,-2,,5\n,,1,b,-2,7,18446744073709551615\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   30,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 12,
+							Line:   30,
+						},
+						File:   "from_test.flux",
+						Source: "groupedData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   30,
+						},
+					},
+				},
+				Name: "groupedData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   41,
+						},
+						File:   "from_test.flux",
+						Source: "\"\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,true,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,a,1.5,-3,4\n,,1,,-2,7,3\n,,1,c,-2,,5\n,,1,b,-2,7,18446744073709551615\n\"",
+						Start: ast.Position{
+							Column: 15,
+							Line:   30,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,string,double,long,unsignedLong\n#group,false,false,false,true,false,false\n#default,_result,,,,,\n,result,table,host,_value,n,count\n,,0,a,1.5,7,1\n,,0,b,1.5,,2\n,,0,a,1.5,-3,4\n,,1,,-2,7,3\n,,1,c,-2,,5\n,,1,b,-2,7,18446744073709551615\n",
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   48,
+						},
+						File:   "from_test.flux",
+						Source: "_from_group_columns = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   43,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 25,
+								Line:   43,
+							},
+							File:   "from_test.flux",
+							Source: "_from_group_columns",
+							Start: ast.Position{
+								Column: 6,
+								Line:   43,
+							},
+						},
+					},
+					Name: "_from_group_columns",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 4,
+								Line:   48,
+							},
+							File:   "from_test.flux",
+							Source: "() =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t})",
+							Start: ast.Position{
+								Column: 28,
+								Line:   43,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 4,
+									Line:   48,
+								},
+								File:   "from_test.flux",
+								Source: "({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   44,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 3,
+										Line:   48,
+									},
+									File:   "from_test.flux",
+									Source: "{\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   44,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 82,
+											Line:   45,
+										},
+										File:   "from_test.flux",
+										Source: "input: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"])",
+										Start: ast.Position{
+											Column: 3,
+											Line:   45,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   45,
+											},
+											File:   "from_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 3,
+												Line:   45,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 81,
+													Line:   45,
+												},
+												File:   "from_test.flux",
+												Source: "file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]",
+												Start: ast.Position{
+													Column: 23,
+													Line:   45,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 55,
+														Line:   45,
+													},
+													File:   "from_test.flux",
+													Source: "file: dir + \"dictionary.parquet\"",
+													Start: ast.Position{
+														Column: 23,
+														Line:   45,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 27,
+															Line:   45,
+														},
+														File:   "from_test.flux",
+														Source: "file",
+														Start: ast.Position{
+															Column: 23,
+															Line:   45,
+														},
+													},
+												},
+												Name: "file",
+											},
+											Value: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 55,
+															Line:   45,
+														},
+														File:   "from_test.flux",
+														Source: "dir + \"dictionary.parquet\"",
+														Start: ast.Position{
+															Column: 29,
+															Line:   45,
+														},
+													},
+												},
+												Left: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 32,
+																Line:   45,
+															},
+															File:   "from_test.flux",
+															Source: "dir",
+															Start: ast.Position{
+																Column: 29,
+																Line:   45,
+															},
+														},
+													},
+													Name: "dir",
+												},
+												Operator: 5,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 55,
+																Line:   45,
+															},
+															File:   "from_test.flux",
+															Source: "\"dictionary.parquet\"",
+															Start: ast.Position{
+																Column: 35,
+																Line:   45,
+															},
+														},
+													},
+													Value: "dictionary.parquet",
+												},
+											},
+										}, &ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 81,
+														Line:   45,
+													},
+													File:   "from_test.flux",
+													Source: "groupColumns: [\"_value\"]",
+													Start: ast.Position{
+														Column: 57,
+														Line:   45,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 69,
+															Line:   45,
+														},
+														File:   "from_test.flux",
+														Source: "groupColumns",
+														Start: ast.Position{
+															Column: 57,
+															Line:   45,
+														},
+													},
+												},
+												Name: "groupColumns",
+											},
+											Value: &ast.ArrayExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 81,
+															Line:   45,
+														},
+														File:   "from_test.flux",
+														Source: "[\"_value\"]",
+														Start: ast.Position{
+															Column: 71,
+															Line:   45,
+														},
+													},
+												},
+												Elements: []ast.Expression{&ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 80,
+																Line:   45,
+															},
+															File:   "from_test.flux",
+															Source: "\"_value\"",
+															Start: ast.Position{
+																Column: 72,
+																Line:   45,
+															},
+														},
+													},
+													Value: "_value",
+												}},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 82,
+												Line:   45,
+											},
+											File:   "from_test.flux",
+											Source: "parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"])",
+											Start: ast.Position{
+												Column: 10,
+												Line:   45,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   45,
+												},
+												File:   "from_test.flux",
+												Source: "parquet.from",
+												Start: ast.Position{
+													Column: 10,
+													Line:   45,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   45,
+													},
+													File:   "from_test.flux",
+													Source: "parquet",
+													Start: ast.Position{
+														Column: 10,
+														Line:   45,
+													},
+												},
+											},
+											Name: "parquet",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   45,
+													},
+													File:   "from_test.flux",
+													Source: "from",
+													Start: ast.Position{
+														Column: 18,
+														Line:   45,
+													},
+												},
+											},
+											Name: "from",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 42,
+											Line:   46,
+										},
+										File:   "from_test.flux",
+										Source: "want: testing.loadMem(csv: groupedData)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   46,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 7,
+												Line:   46,
+											},
+											File:   "from_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 3,
+												Line:   46,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 41,
+													Line:   46,
+												},
+												File:   "from_test.flux",
+												Source: "csv: groupedData",
+												Start: ast.Position{
+													Column: 25,
+													Line:   46,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 41,
+														Line:   46,
+													},
+													File:   "from_test.flux",
+													Source: "csv: groupedData",
+													Start: ast.Position{
+														Column: 25,
+														Line:   46,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 28,
+															Line:   46,
+														},
+														File:   "from_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 25,
+															Line:   46,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 41,
+															Line:   46,
+														},
+														File:   "from_test.flux",
+														Source: "groupedData",
+														Start: ast.Position{
+															Column: 30,
+															Line:   46,
+														},
+													},
+												},
+												Name: "groupedData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 42,
+												Line:   46,
+											},
+											File:   "from_test.flux",
+											Source: "testing.loadMem(csv: groupedData)",
+											Start: ast.Position{
+												Column: 9,
+												Line:   46,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 24,
+													Line:   46,
+												},
+												File:   "from_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 9,
+													Line:   46,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   46,
+													},
+													File:   "from_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 9,
+														Line:   46,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 24,
+														Line:   46,
+													},
+													File:   "from_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 17,
+														Line:   46,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 26,
+											Line:   47,
+										},
+										File:   "from_test.flux",
+										Source: "fn: (table=<-) => table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   47,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   47,
+											},
+											File:   "from_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 3,
+												Line:   47,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   47,
+											},
+											File:   "from_test.flux",
+											Source: "(table=<-) => table",
+											Start: ast.Position{
+												Column: 7,
+												Line:   47,
+											},
+										},
+									},
+									Body: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   47,
+												},
+												File:   "from_test.flux",
+												Source: "table",
+												Start: ast.Position{
+													Column: 21,
+													Line:   47,
+												},
+											},
+										},
+										Name: "table",
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   47,
+												},
+												File:   "from_test.flux",
+												Source: "table=<-",
+												Start: ast.Position{
+													Column: 8,
+													Line:   47,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 13,
+														Line:   47,
+													},
+													File:   "from_test.flux",
+													Source: "table",
+													Start: ast.Position{
+														Column: 8,
+														Line:   47,
+													},
+												},
+											},
+											Name: "table",
+										},
+										Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   47,
+												},
+												File:   "from_test.flux",
+												Source: "<-",
+												Start: ast.Position{
+													Column: 14,
+													Line:   47,
+												},
+											},
+										}},
+									}},
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 4,
+						Line:   48,
+					},
+					File:   "from_test.flux",
+					Source: "test _from_group_columns = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"dictionary.parquet\", groupColumns: [\"_value\"]),\n\t\twant: testing.loadMem(csv: groupedData),\n\t\tfn: (table=<-) => table,\n\t})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   43,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   60,
+					},
+					File:   "from_test.flux",
+					Source: "dataPageV2Data = \"\n#datatype,string,long,dateTime:RFC3339,double,string\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,_time,_value,name\n,,0,2020-01-01T00:00:00Z,1,x\n,,0,2020-01-01T00:00:01Z,,y\n,,0,2020-01-01T00:00:02Z,3,y\n,,0,2020-01-01T00:00:03Z,,\n,,0,2020-01-01T00:00:04Z,5,x\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   50,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 15,
+							Line:   50,
+						},
+						File:   "from_test.flux",
+						Source: "dataPageV2Data",
+						Start: ast.Position{
+							Column: 1,
+							Line:   50,
+						},
+					},
+				},
+				Name: "dataPageV2Data",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   60,
+						},
+						File:   "from_test.flux",
+						Source: "\"\n#datatype,string,long,dateTime:RFC3339,double,string\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,_time,_value,name\n,,0,2020-01-01T00:00:00Z,1,x\n,,0,2020-01-01T00:00:01Z,,y\n,,0,2020-01-01T00:00:02Z,3,y\n,,0,2020-01-01T00:00:03Z,,\n,,0,2020-01-01T00:00:04Z,5,x\n\"",
+						Start: ast.Position{
+							Column: 18,
+							Line:   50,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,dateTime:RFC3339,double,string\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,_time,_value,name\n,,0,2020-01-01T00:00:00Z,1,x\n,,0,2020-01-01T00:00:01Z,,y\n,,0,2020-01-01T00:00:02Z,3,y\n,,0,2020-01-01T00:00:03Z,,\n,,0,2020-01-01T00:00:04Z,5,x\n",
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   67,
+						},
+						File:   "from_test.flux",
+						Source: "_from_data_page_v2 = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   62,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 24,
+								Line:   62,
+							},
+							File:   "from_test.flux",
+							Source: "_from_data_page_v2",
+							Start: ast.Position{
+								Column: 6,
+								Line:   62,
+							},
+						},
+					},
+					Name: "_from_data_page_v2",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 4,
+								Line:   67,
+							},
+							File:   "from_test.flux",
+							Source: "() =>\n\t({\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t})",
+							Start: ast.Position{
+								Column: 27,
+								Line:   62,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 4,
+									Line:   67,
+								},
+								File:   "from_test.flux",
+								Source: "({\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   63,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 3,
+										Line:   67,
+									},
+									File:   "from_test.flux",
+									Source: "{\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   63,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 58,
+											Line:   64,
+										},
+										File:   "from_test.flux",
+										Source: "input: parquet.from(file: dir + \"data_page_v2.parquet\")",
+										Start: ast.Position{
+											Column: 3,
+											Line:   64,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   64,
+											},
+											File:   "from_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 3,
+												Line:   64,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 57,
+													Line:   64,
+												},
+												File:   "from_test.flux",
+												Source: "file: dir + \"data_page_v2.parquet\"",
+												Start: ast.Position{
+													Column: 23,
+													Line:   64,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 57,
+														Line:   64,
+													},
+													File:   "from_test.flux",
+													Source: "file: dir + \"data_page_v2.parquet\"",
+													Start: ast.Position{
+														Column: 23,
+														Line:   64,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 27,
+															Line:   64,
+														},
+														File:   "from_test.flux",
+														Source: "file",
+														Start: ast.Position{
+															Column: 23,
+															Line:   64,
+														},
+													},
+												},
+												Name: "file",
+											},
+											Value: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 57,
+															Line:   64,
+														},
+														File:   "from_test.flux",
+														Source: "dir + \"data_page_v2.parquet\"",
+														Start: ast.Position{
+															Column: 29,
+															Line:   64,
+														},
+													},
+												},
+												Left: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 32,
+																Line:   64,
+															},
+															File:   "from_test.flux",
+															Source: "dir",
+															Start: ast.Position{
+																Column: 29,
+																Line:   64,
+															},
+														},
+													},
+													Name: "dir",
+												},
+												Operator: 5,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 57,
+																Line:   64,
+															},
+															File:   "from_test.flux",
+															Source: "\"data_page_v2.parquet\"",
+															Start: ast.Position{
+																Column: 35,
+																Line:   64,
+															},
+														},
+													},
+													Value: "data_page_v2.parquet",
+												},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 58,
+												Line:   64,
+											},
+											File:   "from_test.flux",
+											Source: "parquet.from(file: dir + \"data_page_v2.parquet\")",
+											Start: ast.Position{
+												Column: 10,
+												Line:   64,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   64,
+												},
+												File:   "from_test.flux",
+												Source: "parquet.from",
+												Start: ast.Position{
+													Column: 10,
+													Line:   64,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   64,
+													},
+													File:   "from_test.flux",
+													Source: "parquet",
+													Start: ast.Position{
+														Column: 10,
+														Line:   64,
+													},
+												},
+											},
+											Name: "parquet",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   64,
+													},
+													File:   "from_test.flux",
+													Source: "from",
+													Start: ast.Position{
+														Column: 18,
+														Line:   64,
+													},
+												},
+											},
+											Name: "from",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 45,
+											Line:   65,
+										},
+										File:   "from_test.flux",
+										Source: "want: testing.loadMem(csv: dataPageV2Data)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   65,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 7,
+												Line:   65,
+											},
+											File:   "from_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 3,
+												Line:   65,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 44,
+													Line:   65,
+												},
+												File:   "from_test.flux",
+												Source: "csv: dataPageV2Data",
+												Start: ast.Position{
+													Column: 25,
+													Line:   65,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 44,
+														Line:   65,
+													},
+													File:   "from_test.flux",
+													Source: "csv: dataPageV2Data",
+													Start: ast.Position{
+														Column: 25,
+														Line:   65,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 28,
+															Line:   65,
+														},
+														File:   "from_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 25,
+															Line:   65,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 44,
+															Line:   65,
+														},
+														File:   "from_test.flux",
+														Source: "dataPageV2Data",
+														Start: ast.Position{
+															Column: 30,
+															Line:   65,
+														},
+													},
+												},
+												Name: "dataPageV2Data",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 45,
+												Line:   65,
+											},
+											File:   "from_test.flux",
+											Source: "testing.loadMem(csv: dataPageV2Data)",
+											Start: ast.Position{
+												Column: 9,
+												Line:   65,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 24,
+													Line:   65,
+												},
+												File:   "from_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 9,
+													Line:   65,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   65,
+													},
+													File:   "from_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 9,
+														Line:   65,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 24,
+														Line:   65,
+													},
+													File:   "from_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 17,
+														Line:   65,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 26,
+											Line:   66,
+										},
+										File:   "from_test.flux",
+										Source: "fn: (table=<-) => table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   66,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   66,
+											},
+											File:   "from_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 3,
+												Line:   66,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   66,
+											},
+											File:   "from_test.flux",
+											Source: "(table=<-) => table",
+											Start: ast.Position{
+												Column: 7,
+												Line:   66,
+											},
+										},
+									},
+									Body: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   66,
+												},
+												File:   "from_test.flux",
+												Source: "table",
+												Start: ast.Position{
+													Column: 21,
+													Line:   66,
+												},
+											},
+										},
+										Name: "table",
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   66,
+												},
+												File:   "from_test.flux",
+												Source: "table=<-",
+												Start: ast.Position{
+													Column: 8,
+													Line:   66,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 13,
+														Line:   66,
+													},
+													File:   "from_test.flux",
+													Source: "table",
+													Start: ast.Position{
+														Column: 8,
+														Line:   66,
+													},
+												},
+											},
+											Name: "table",
+										},
+										Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   66,
+												},
+												File:   "from_test.flux",
+												Source: "<-",
+												Start: ast.Position{
+													Column: 14,
+													Line:   66,
+												},
+											},
+										}},
+									}},
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 4,
+						Line:   67,
+					},
+					File:   "from_test.flux",
+					Source: "test _from_data_page_v2 = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"data_page_v2.parquet\"),\n\t\twant: testing.loadMem(csv: dataPageV2Data),\n\t\tfn: (table=<-) => table,\n\t})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   62,
+					},
+				},
+			},
+		}, &ast.VariableAssignment{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 2,
+						Line:   79,
+					},
+					File:   "from_test.flux",
+					Source: "gzipData = \"\n#datatype,string,long,boolean,dateTime:RFC3339,long\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,ok,day,n\n,,0,true,2020-01-01T00:00:00Z,-1\n,,0,false,2020-01-02T00:00:00Z,0\n,,0,,2020-01-03T00:00:00Z,1\n,,0,true,2020-01-04T00:00:00Z,2147483647\n,,0,true,2020-01-05T00:00:00Z,-2147483648\n\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   69,
+					},
+				},
+			},
+			ID: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 9,
+							Line:   69,
+						},
+						File:   "from_test.flux",
+						Source: "gzipData",
+						Start: ast.Position{
+							Column: 1,
+							Line:   69,
+						},
+					},
+				},
+				Name: "gzipData",
+			},
+			Init: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 2,
+							Line:   79,
+						},
+						File:   "from_test.flux",
+						Source: "\"\n#datatype,string,long,boolean,dateTime:RFC3339,long\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,ok,day,n\n,,0,true,2020-01-01T00:00:00Z,-1\n,,0,false,2020-01-02T00:00:00Z,0\n,,0,,2020-01-03T00:00:00Z,1\n,,0,true,2020-01-04T00:00:00Z,2147483647\n,,0,true,2020-01-05T00:00:00Z,-2147483648\n\"",
+						Start: ast.Position{
+							Column: 12,
+							Line:   69,
+						},
+					},
+				},
+				Value: "\n#datatype,string,long,boolean,dateTime:RFC3339,long\n#group,false,false,false,false,false\n#default,_result,,,,\n,result,table,ok,day,n\n,,0,true,2020-01-01T00:00:00Z,-1\n,,0,false,2020-01-02T00:00:00Z,0\n,,0,,2020-01-03T00:00:00Z,1\n,,0,true,2020-01-04T00:00:00Z,2147483647\n,,0,true,2020-01-05T00:00:00Z,-2147483648\n",
+			},
+		}, &ast.TestStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 4,
+							Line:   86,
+						},
+						File:   "from_test.flux",
+						Source: "_from_gzip = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t})",
+						Start: ast.Position{
+							Column: 6,
+							Line:   81,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 16,
+								Line:   81,
+							},
+							File:   "from_test.flux",
+							Source: "_from_gzip",
+							Start: ast.Position{
+								Column: 6,
+								Line:   81,
+							},
+						},
+					},
+					Name: "_from_gzip",
+				},
+				Init: &ast.FunctionExpression{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 4,
+								Line:   86,
+							},
+							File:   "from_test.flux",
+							Source: "() =>\n\t({\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t})",
+							Start: ast.Position{
+								Column: 19,
+								Line:   81,
+							},
+						},
+					},
+					Body: &ast.ParenExpression{
+						BaseNode: ast.BaseNode{
+							Errors: nil,
+							Loc: &ast.SourceLocation{
+								End: ast.Position{
+									Column: 4,
+									Line:   86,
+								},
+								File:   "from_test.flux",
+								Source: "({\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t})",
+								Start: ast.Position{
+									Column: 2,
+									Line:   82,
+								},
+							},
+						},
+						Expression: &ast.ObjectExpression{
+							BaseNode: ast.BaseNode{
+								Errors: nil,
+								Loc: &ast.SourceLocation{
+									End: ast.Position{
+										Column: 3,
+										Line:   86,
+									},
+									File:   "from_test.flux",
+									Source: "{\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t}",
+									Start: ast.Position{
+										Column: 3,
+										Line:   82,
+									},
+								},
+							},
+							Properties: []*ast.Property{&ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 50,
+											Line:   83,
+										},
+										File:   "from_test.flux",
+										Source: "input: parquet.from(file: dir + \"gzip.parquet\")",
+										Start: ast.Position{
+											Column: 3,
+											Line:   83,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 8,
+												Line:   83,
+											},
+											File:   "from_test.flux",
+											Source: "input",
+											Start: ast.Position{
+												Column: 3,
+												Line:   83,
+											},
+										},
+									},
+									Name: "input",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 49,
+													Line:   83,
+												},
+												File:   "from_test.flux",
+												Source: "file: dir + \"gzip.parquet\"",
+												Start: ast.Position{
+													Column: 23,
+													Line:   83,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 49,
+														Line:   83,
+													},
+													File:   "from_test.flux",
+													Source: "file: dir + \"gzip.parquet\"",
+													Start: ast.Position{
+														Column: 23,
+														Line:   83,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 27,
+															Line:   83,
+														},
+														File:   "from_test.flux",
+														Source: "file",
+														Start: ast.Position{
+															Column: 23,
+															Line:   83,
+														},
+													},
+												},
+												Name: "file",
+											},
+											Value: &ast.BinaryExpression{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 49,
+															Line:   83,
+														},
+														File:   "from_test.flux",
+														Source: "dir + \"gzip.parquet\"",
+														Start: ast.Position{
+															Column: 29,
+															Line:   83,
+														},
+													},
+												},
+												Left: &ast.Identifier{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 32,
+																Line:   83,
+															},
+															File:   "from_test.flux",
+															Source: "dir",
+															Start: ast.Position{
+																Column: 29,
+																Line:   83,
+															},
+														},
+													},
+													Name: "dir",
+												},
+												Operator: 5,
+												Right: &ast.StringLiteral{
+													BaseNode: ast.BaseNode{
+														Errors: nil,
+														Loc: &ast.SourceLocation{
+															End: ast.Position{
+																Column: 49,
+																Line:   83,
+															},
+															File:   "from_test.flux",
+															Source: "\"gzip.parquet\"",
+															Start: ast.Position{
+																Column: 35,
+																Line:   83,
+															},
+														},
+													},
+													Value: "gzip.parquet",
+												},
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 50,
+												Line:   83,
+											},
+											File:   "from_test.flux",
+											Source: "parquet.from(file: dir + \"gzip.parquet\")",
+											Start: ast.Position{
+												Column: 10,
+												Line:   83,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 22,
+													Line:   83,
+												},
+												File:   "from_test.flux",
+												Source: "parquet.from",
+												Start: ast.Position{
+													Column: 10,
+													Line:   83,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 17,
+														Line:   83,
+													},
+													File:   "from_test.flux",
+													Source: "parquet",
+													Start: ast.Position{
+														Column: 10,
+														Line:   83,
+													},
+												},
+											},
+											Name: "parquet",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 22,
+														Line:   83,
+													},
+													File:   "from_test.flux",
+													Source: "from",
+													Start: ast.Position{
+														Column: 18,
+														Line:   83,
+													},
+												},
+											},
+											Name: "from",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 39,
+											Line:   84,
+										},
+										File:   "from_test.flux",
+										Source: "want: testing.loadMem(csv: gzipData)",
+										Start: ast.Position{
+											Column: 3,
+											Line:   84,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 7,
+												Line:   84,
+											},
+											File:   "from_test.flux",
+											Source: "want",
+											Start: ast.Position{
+												Column: 3,
+												Line:   84,
+											},
+										},
+									},
+									Name: "want",
+								},
+								Value: &ast.CallExpression{
+									Arguments: []ast.Expression{&ast.ObjectExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 38,
+													Line:   84,
+												},
+												File:   "from_test.flux",
+												Source: "csv: gzipData",
+												Start: ast.Position{
+													Column: 25,
+													Line:   84,
+												},
+											},
+										},
+										Properties: []*ast.Property{&ast.Property{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 38,
+														Line:   84,
+													},
+													File:   "from_test.flux",
+													Source: "csv: gzipData",
+													Start: ast.Position{
+														Column: 25,
+														Line:   84,
+													},
+												},
+											},
+											Key: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 28,
+															Line:   84,
+														},
+														File:   "from_test.flux",
+														Source: "csv",
+														Start: ast.Position{
+															Column: 25,
+															Line:   84,
+														},
+													},
+												},
+												Name: "csv",
+											},
+											Value: &ast.Identifier{
+												BaseNode: ast.BaseNode{
+													Errors: nil,
+													Loc: &ast.SourceLocation{
+														End: ast.Position{
+															Column: 38,
+															Line:   84,
+														},
+														File:   "from_test.flux",
+														Source: "gzipData",
+														Start: ast.Position{
+															Column: 30,
+															Line:   84,
+														},
+													},
+												},
+												Name: "gzipData",
+											},
+										}},
+										With: nil,
+									}},
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 39,
+												Line:   84,
+											},
+											File:   "from_test.flux",
+											Source: "testing.loadMem(csv: gzipData)",
+											Start: ast.Position{
+												Column: 9,
+												Line:   84,
+											},
+										},
+									},
+									Callee: &ast.MemberExpression{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 24,
+													Line:   84,
+												},
+												File:   "from_test.flux",
+												Source: "testing.loadMem",
+												Start: ast.Position{
+													Column: 9,
+													Line:   84,
+												},
+											},
+										},
+										Object: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 16,
+														Line:   84,
+													},
+													File:   "from_test.flux",
+													Source: "testing",
+													Start: ast.Position{
+														Column: 9,
+														Line:   84,
+													},
+												},
+											},
+											Name: "testing",
+										},
+										Property: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 24,
+														Line:   84,
+													},
+													File:   "from_test.flux",
+													Source: "loadMem",
+													Start: ast.Position{
+														Column: 17,
+														Line:   84,
+													},
+												},
+											},
+											Name: "loadMem",
+										},
+									},
+								},
+							}, &ast.Property{
+								BaseNode: ast.BaseNode{
+									Errors: nil,
+									Loc: &ast.SourceLocation{
+										End: ast.Position{
+											Column: 26,
+											Line:   85,
+										},
+										File:   "from_test.flux",
+										Source: "fn: (table=<-) => table",
+										Start: ast.Position{
+											Column: 3,
+											Line:   85,
+										},
+									},
+								},
+								Key: &ast.Identifier{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 5,
+												Line:   85,
+											},
+											File:   "from_test.flux",
+											Source: "fn",
+											Start: ast.Position{
+												Column: 3,
+												Line:   85,
+											},
+										},
+									},
+									Name: "fn",
+								},
+								Value: &ast.FunctionExpression{
+									BaseNode: ast.BaseNode{
+										Errors: nil,
+										Loc: &ast.SourceLocation{
+											End: ast.Position{
+												Column: 26,
+												Line:   85,
+											},
+											File:   "from_test.flux",
+											Source: "(table=<-) => table",
+											Start: ast.Position{
+												Column: 7,
+												Line:   85,
+											},
+										},
+									},
+									Body: &ast.Identifier{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 26,
+													Line:   85,
+												},
+												File:   "from_test.flux",
+												Source: "table",
+												Start: ast.Position{
+													Column: 21,
+													Line:   85,
+												},
+											},
+										},
+										Name: "table",
+									},
+									Params: []*ast.Property{&ast.Property{
+										BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   85,
+												},
+												File:   "from_test.flux",
+												Source: "table=<-",
+												Start: ast.Position{
+													Column: 8,
+													Line:   85,
+												},
+											},
+										},
+										Key: &ast.Identifier{
+											BaseNode: ast.BaseNode{
+												Errors: nil,
+												Loc: &ast.SourceLocation{
+													End: ast.Position{
+														Column: 13,
+														Line:   85,
+													},
+													File:   "from_test.flux",
+													Source: "table",
+													Start: ast.Position{
+														Column: 8,
+														Line:   85,
+													},
+												},
+											},
+											Name: "table",
+										},
+										Value: &ast.PipeLiteral{BaseNode: ast.BaseNode{
+											Errors: nil,
+											Loc: &ast.SourceLocation{
+												End: ast.Position{
+													Column: 16,
+													Line:   85,
+												},
+												File:   "from_test.flux",
+												Source: "<-",
+												Start: ast.Position{
+													Column: 14,
+													Line:   85,
+												},
+											},
+										}},
+									}},
+								},
+							}},
+							With: nil,
+						},
+					},
+					Params: nil,
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 4,
+						Line:   86,
+					},
+					File:   "from_test.flux",
+					Source: "test _from_gzip = () =>\n\t({\n\t\tinput: parquet.from(file: dir + \"gzip.parquet\"),\n\t\twant: testing.loadMem(csv: gzipData),\n\t\tfn: (table=<-) => table,\n\t})",
+					Start: ast.Position{
+						Column: 1,
+						Line:   81,
+					},
+				},
+			},
+		}},
+		Imports: []*ast.ImportDeclaration{&ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   3,
+					},
+					File:   "from_test.flux",
+					Source: "import \"testing\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   3,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   3,
+						},
+						File:   "from_test.flux",
+						Source: "\"testing\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   3,
+						},
+					},
+				},
+				Value: "testing",
+			},
+		}, &ast.ImportDeclaration{
+			As: nil,
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 17,
+						Line:   4,
+					},
+					File:   "from_test.flux",
+					Source: "import \"parquet\"",
+					Start: ast.Position{
+						Column: 1,
+						Line:   4,
+					},
+				},
+			},
+			Path: &ast.StringLiteral{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 17,
+							Line:   4,
+						},
+						File:   "from_test.flux",
+						Source: "\"parquet\"",
+						Start: ast.Position{
+							Column: 8,
+							Line:   4,
+						},
+					},
+				},
+				Value: "parquet",
+			},
+		}},
+		Metadata: "parser-type=rust",
+		Name:     "from_test.flux",
+		Package: &ast.PackageClause{
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 21,
+						Line:   1,
+					},
+					File:   "from_test.flux",
+					Source: "package parquet_test",
+					Start: ast.Position{
+						Column: 1,
+						Line:   1,
+					},
+				},
+			},
+			Name: &ast.Identifier{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 21,
+							Line:   1,
+						},
+						File:   "from_test.flux",
+						Source: "parquet_test",
+						Start: ast.Position{
+							Column: 9,
+							Line:   1,
+						},
+					},
+				},
+				Name: "parquet_test",
+			},
+		},
+	}},
+	Package: "parquet_test",
+	Path:    "parquet",
+}}
@@ -0,0 +1,267 @@
+package parquet
+
+import (
+	"context"
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/parquet"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+const (
+	pkgpath  = "parquet"
+	FromKind = "fromParquet"
+)
+
+type FromOpSpec struct {
+	File         string   `json:"file"`
+	GroupColumns []string `json:"groupColumns"`
+}
+
+func init() {
+	fromSignature := runtime.MustLookupBuiltinType(pkgpath, "from")
+	runtime.RegisterPackageValue(pkgpath, "from", flux.MustValue(flux.FunctionValue(FromKind, createFromOpSpec, fromSignature)))
+	flux.RegisterOpSpec(FromKind, newFromOp)
+	plan.RegisterProcedureSpec(FromKind, newFromProcedure, FromKind)
+	execute.RegisterSource(FromKind, createFromSource)
+}
+
+func createFromOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	spec := new(FromOpSpec)
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "file must not be empty")
+	}
+	spec.File = file
+
+	if cols, ok, err := args.GetArray("groupColumns", semantic.String); err != nil {
+		return nil, err
+	} else if ok {
+		spec.GroupColumns = make([]string, cols.Len())
+		cols.Range(func(i int, v values.Value) {
+			spec.GroupColumns[i] = v.Str()
+		})
+	}
+	return spec, nil
+}
+
+func newFromOp() flux.OperationSpec {
+	return new(FromOpSpec)
+}
+
+func (s *FromOpSpec) Kind() flux.OperationKind {
+	return FromKind
+}
+
+type FromProcedureSpec struct {
+	plan.DefaultCost
+	File         string
+	GroupColumns []string
+}
+
+func newFromProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &FromProcedureSpec{
+		File:         spec.File,
+		GroupColumns: spec.GroupColumns,
+	}, nil
+}
+
+func (s *FromProcedureSpec) Kind() plan.ProcedureKind {
+	return FromKind
+}
+
+func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	ns.GroupColumns = append([]string(nil), s.GroupColumns...)
+	return &ns
+}
+
+func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromProcedureSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", prSpec)
+	}
+	return &source{
+		id:    dsid,
+		spec:  spec,
+		ctx:   a.Context(),
+		alloc: a.Allocator(),
+	}, nil
+}
+
+type source struct {
+	id    execute.DatasetID
+	ts    []execute.Transformation
+	spec  *FromProcedureSpec
+	ctx   context.Context
+	alloc *memory.Allocator
+}
+
+func (s *source) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *source) Run(ctx context.Context) {
+	err := s.run()
+	if err != nil {
+		err = errors.Wrap(err, codes.Inherit, "error in parquet.from()")
+	}
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *source) run() error {
+	fs, err := flux.GetDependencies(s.ctx).FilesystemService()
+	if err != nil {
+		return err
+	}
+	f, err := fs.Open(s.spec.File)
+	if err != nil {
+		return errors.Wrap(err, codes.Inherit, "failed to open file")
+	}
+	defer func() { _ = f.Close() }()
+
+	tables, err := readTables(f, s.spec.GroupColumns, s.alloc)
+	if err != nil {
+		return err
+	}
+	for i, tbl := range tables {
+		for j, t := range s.ts {
+			// The last transformation receives the original table
+			// and every other transformation receives a copy.
+			in := tbl
+			if j < len(s.ts)-1 {
+				in = tbl.Copy()
+			}
+			if err := t.Process(s.id, in); err != nil {
+				for _, tbl := range tables[i+1:] {
+					tbl.Done()
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readTables reads the rows of a parquet file and partitions
+// them into tables by the values of the group columns.
+func readTables(f io.ReadSeeker, groupColumns []string, alloc *memory.Allocator) ([]flux.BufferedTable, error) {
+	r, err := parquet.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	cols := r.Columns()
+	on := make(map[string]bool, len(groupColumns))
+	for _, label := range groupColumns {
+		if execute.ColIdx(label, cols) < 0 {
+			return nil, errors.Newf(codes.Invalid, "group column %q does not exist in the file", label)
+		}
+		on[label] = true
+	}
+
+	builders := execute.NewGroupLookup()
+	release := func() {
+		builders.Range(func(key flux.GroupKey, value interface{}) {
+			value.(execute.TableBuilder).Release()
+		})
+	}
+	mem := arrow.NewAllocator(alloc)
+	for i := 0; i < r.NumRowGroups(); i++ {
+		arrs, err := r.ReadRowGroup(i, mem)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		buffer := &arrow.TableBuffer{
+			GroupKey: execute.NewGroupKey(nil, nil),
+			Columns:  cols,
+			Values:   arrs,
+		}
+		err = appendRowGroup(builders, buffer, on, alloc)
+		buffer.Release()
+		if err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	var tables []flux.BufferedTable
+	builders.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		var tbl flux.Table
+		if tbl, err = value.(execute.TableBuilder).Table(); err != nil {
+			return
+		}
+		var buffered flux.BufferedTable
+		if buffered, err = execute.CopyTable(tbl); err != nil {
+			return
+		}
+		tables = append(tables, buffered)
+	})
+	// The tables contain a copy of the data so
+	// the builders are no longer needed.
+	release()
+	if err != nil {
+		for _, tbl := range tables {
+			tbl.Done()
+		}
+		return nil, err
+	}
+	return tables, nil
+}
+
+// appendRowGroup appends the rows of a row group
+// to the table builder for their group key.
+func appendRowGroup(builders *execute.GroupLookup, cr flux.ColReader, on map[string]bool, alloc *memory.Allocator) error {
+	builder := func(key flux.GroupKey) (execute.TableBuilder, error) {
+		if b, ok := builders.Lookup(key); ok {
+			return b.(execute.TableBuilder), nil
+		}
+		b := execute.NewColListTableBuilder(key, alloc)
+		for _, col := range cr.Cols() {
+			if _, err := b.AddCol(col); err != nil {
+				return nil, err
+			}
+		}
+		builders.Set(key, b)
+		return b, nil
+	}
+
+	if len(on) == 0 {
+		b, err := builder(execute.NewGroupKey(nil, nil))
+		if err != nil {
+			return err
+		}
+		return execute.AppendCols(cr, b)
+	}
+	for i, l := 0, cr.Len(); i < l; i++ {
+		b, err := builder(execute.GroupKeyForRowOn(i, cr, on))
+		if err != nil {
+			return err
+		}
+		if err := execute.AppendRecord(i, cr, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
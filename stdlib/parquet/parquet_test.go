@@ -0,0 +1,128 @@
+package parquet
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/memory"
+)
+
+type bufferCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *bufferCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestToFrom_RoundTrip(t *testing.T) {
+	input := []*executetest.Table{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), "a", 1.0},
+				{execute.Time(10), "a", nil},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(0), "b", 3.0},
+			},
+		},
+	}
+
+	var f bufferCloser
+	tx := NewToTransformation(execute.NewPassthroughDataset(executetest.RandomDatasetID()), &f)
+	parentID := executetest.RandomDatasetID()
+	for _, tbl := range input {
+		if err := tx.Process(parentID, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tx.Finish(parentID, nil)
+	if !f.closed {
+		t.Fatal("expected the file to be closed")
+	}
+
+	for _, tc := range []struct {
+		name         string
+		groupColumns []string
+		want         []*executetest.Table
+	}{
+		{
+			name:         "grouped",
+			groupColumns: []string{"host"},
+			want:         input,
+		},
+		{
+			name: "ungrouped",
+			want: []*executetest.Table{{
+				ColMeta: input[0].ColMeta,
+				Data: [][]interface{}{
+					{execute.Time(0), "a", 1.0},
+					{execute.Time(10), "a", nil},
+					{execute.Time(0), "b", 3.0},
+				},
+			}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mem := &memory.Allocator{}
+			tables, err := readTables(bytes.NewReader(f.Bytes()), tc.groupColumns, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]*executetest.Table, 0, len(tables))
+			for _, tbl := range tables {
+				et, err := executetest.ConvertTable(tbl)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, et)
+			}
+			executetest.NormalizeTables(got)
+			executetest.NormalizeTables(tc.want)
+			sort.Sort(executetest.SortedTables(got))
+			sort.Sort(executetest.SortedTables(tc.want))
+			if !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected tables -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestFrom_MissingGroupColumn(t *testing.T) {
+	var f bufferCloser
+	tx := NewToTransformation(execute.NewPassthroughDataset(executetest.RandomDatasetID()), &f)
+	parentID := executetest.RandomDatasetID()
+	if err := tx.Process(parentID, &executetest.Table{
+		ColMeta: []flux.ColMeta{{Label: "_value", Type: flux.TInt}},
+		Data:    [][]interface{}{{int64(1)}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	tx.Finish(parentID, nil)
+
+	if _, err := readTables(bytes.NewReader(f.Bytes()), []string{"host"}, &memory.Allocator{}); err == nil {
+		t.Fatal("expected error for a missing group column")
+	}
+}
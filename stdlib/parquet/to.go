@@ -0,0 +1,152 @@
+package parquet
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/parquet"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/runtime"
+)
+
+const ToKind = "toParquet"
+
+type ToOpSpec struct {
+	File string `json:"file"`
+}
+
+func init() {
+	toSignature := runtime.MustLookupBuiltinType(pkgpath, "to")
+	runtime.RegisterPackageValue(pkgpath, "to", flux.MustValue(flux.FunctionValueWithSideEffect(ToKind, createToOpSpec, toSignature)))
+	flux.RegisterOpSpec(ToKind, func() flux.OperationSpec { return &ToOpSpec{} })
+	plan.RegisterProcedureSpecWithSideEffect(ToKind, newToProcedure, ToKind)
+	execute.RegisterTransformation(ToKind, createToTransformation)
+}
+
+func createToOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
+	if err := a.AddParentFromArgs(args); err != nil {
+		return nil, err
+	}
+	file, err := args.GetRequiredString("file")
+	if err != nil {
+		return nil, err
+	}
+	if file == "" {
+		return nil, errors.New(codes.Invalid, "file must not be empty")
+	}
+	return &ToOpSpec{File: file}, nil
+}
+
+func (ToOpSpec) Kind() flux.OperationKind {
+	return ToKind
+}
+
+type ToProcedureSpec struct {
+	plan.DefaultCost
+	File string
+}
+
+func newToProcedure(qs flux.OperationSpec, a plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*ToOpSpec)
+	if !ok {
+		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
+	}
+	return &ToProcedureSpec{File: spec.File}, nil
+}
+
+func (s *ToProcedureSpec) Kind() plan.ProcedureKind {
+	return ToKind
+}
+
+func (s *ToProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func createToTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*ToProcedureSpec)
+	if !ok {
+		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
+	}
+	fs, err := flux.GetDependencies(a.Context()).FilesystemService()
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := fs.Create(s.File)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, codes.Inherit, "parquet.to() failed to create file")
+	}
+	d := execute.NewPassthroughDataset(id)
+	return NewToTransformation(d, f), d, nil
+}
+
+// ToTransformation writes each table to a parquet file
+// and passes the table to the next transformation.
+type ToTransformation struct {
+	d   *execute.PassthroughDataset
+	f   io.WriteCloser
+	buf *bufio.Writer
+	w   *parquet.Writer
+}
+
+// NewToTransformation creates a transformation that writes
+// a parquet file to f. The file is closed when the
+// transformation is finished.
+func NewToTransformation(d *execute.PassthroughDataset, f io.WriteCloser) *ToTransformation {
+	buf := bufio.NewWriter(f)
+	return &ToTransformation{
+		d:   d,
+		f:   f,
+		buf: buf,
+		w:   parquet.NewWriter(buf),
+	}
+}
+
+func (t *ToTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return t.d.RetractTable(key)
+}
+
+func (t *ToTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	buffered, err := execute.CopyTable(tbl)
+	if err != nil {
+		return err
+	}
+	if err := t.w.WriteTable(buffered.Copy()); err != nil {
+		buffered.Done()
+		return err
+	}
+	return t.d.Process(buffered)
+}
+
+func (t *ToTransformation) UpdateWatermark(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateWatermark(pt)
+}
+
+func (t *ToTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+
+func (t *ToTransformation) Finish(id execute.DatasetID, err error) {
+	if err != nil {
+		_ = t.f.Close()
+	} else if err = t.close(); err != nil {
+		err = errors.Wrap(err, codes.Inherit, "error in parquet.to()")
+	}
+	t.d.Finish(err)
+}
+
+// close writes the metadata of the parquet file and closes it.
+func (t *ToTransformation) close() error {
+	err := t.w.Close()
+	if err == nil {
+		err = t.buf.Flush()
+	}
+	if cerr := t.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -21,11 +21,11 @@ var pkgAST = &ast.Package{
 			Errors: nil,
 			Loc: &ast.SourceLocation{
 				End: ast.Position{
-					Column: 35,
-					Line:   4,
+					Column: 39,
+					Line:   8,
 				},
 				File:   "planner.flux",
-				Source: "package planner\n\noption disableLogicalRules = [\"\"]\noption disablePhysicalRules = [\"\"]",
+				Source: "package planner\n\noption disableLogicalRules = [\"\"]\noption disablePhysicalRules = [\"\"]\n\n// enableCostBasedPlanning makes the physical planner compare the\n// estimated cost of the rewrites of a node and apply the cheapest one.\noption enableCostBasedPlanning = false",
 				Start: ast.Position{
 					Column: 1,
 					Line:   1,
@@ -204,6 +204,75 @@ var pkgAST = &ast.Package{
 					},
 				},
 			},
+		}, &ast.OptionStatement{
+			Assignment: &ast.VariableAssignment{
+				BaseNode: ast.BaseNode{
+					Errors: nil,
+					Loc: &ast.SourceLocation{
+						End: ast.Position{
+							Column: 39,
+							Line:   8,
+						},
+						File:   "planner.flux",
+						Source: "enableCostBasedPlanning = false",
+						Start: ast.Position{
+							Column: 8,
+							Line:   8,
+						},
+					},
+				},
+				ID: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 31,
+								Line:   8,
+							},
+							File:   "planner.flux",
+							Source: "enableCostBasedPlanning",
+							Start: ast.Position{
+								Column: 8,
+								Line:   8,
+							},
+						},
+					},
+					Name: "enableCostBasedPlanning",
+				},
+				Init: &ast.Identifier{
+					BaseNode: ast.BaseNode{
+						Errors: nil,
+						Loc: &ast.SourceLocation{
+							End: ast.Position{
+								Column: 39,
+								Line:   8,
+							},
+							File:   "planner.flux",
+							Source: "false",
+							Start: ast.Position{
+								Column: 34,
+								Line:   8,
+							},
+						},
+					},
+					Name: "false",
+				},
+			},
+			BaseNode: ast.BaseNode{
+				Errors: nil,
+				Loc: &ast.SourceLocation{
+					End: ast.Position{
+						Column: 39,
+						Line:   8,
+					},
+					File:   "planner.flux",
+					Source: "option enableCostBasedPlanning = false",
+					Start: ast.Position{
+						Column: 1,
+						Line:   8,
+					},
+				},
+			},
 		}},
 		Imports:  nil,
 		Metadata: "parser-type=rust",
@@ -1,6 +1,7 @@
 // Package socket implements a source that gets input from a socket connection and produces tables given a decoder.
-// This is a good candidate for streaming use cases. For now, it produces a single table for everything
-// that it receives from the start to the end of the connection.
+// In batch mode, it produces the tables for everything that it receives from the start to the end of the connection.
+// In stream mode, it produces tables as data arrives and advances the watermark so that windows and aggregates
+// downstream output their results continuously until the query is cancelled or the connection is closed.
 package socket
 
 import (
@@ -22,11 +23,24 @@ import (
 	"github.com/influxdata/flux/values"
 )
 
-const FromSocketKind = "fromSocket"
+const (
+	FromSocketKind = "fromSocket"
+
+	// BatchMode produces the tables once the connection is closed.
+	BatchMode = "batch"
+	// StreamMode produces tables as data arrives on the connection.
+	StreamMode = "stream"
+
+	// DefaultFlushInterval is the interval at which a stream advances
+	// the processing time when no data arrives.
+	DefaultFlushInterval = time.Second
+)
 
 type FromSocketOpSpec struct {
-	URL     string `json:"url"`
-	Decoder string `json:"decoder"`
+	URL           string        `json:"url"`
+	Decoder       string        `json:"decoder"`
+	Mode          string        `json:"mode,omitempty"`
+	FlushInterval flux.Duration `json:"flushInterval,omitempty"`
 }
 
 func init() {
@@ -48,6 +62,7 @@ func (a *nowTimeProvider) CurrentTime() values.Time {
 var (
 	decoders = []string{"csv", "line"}
 	schemes  = []string{"tcp", "unix"}
+	modes    = []string{BatchMode, StreamMode}
 )
 
 func contains(ss []string, s string) bool {
@@ -80,6 +95,27 @@ func createFromSocketOpSpec(args flux.Arguments, a *flux.Administration) (flux.O
 		return nil, errors.Newf(codes.Invalid, "invalid decoder %s, must be one of %v", spec.Decoder, decoders)
 	}
 
+	if m, ok, err := args.GetString("mode"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.Mode = m
+	} else {
+		spec.Mode = BatchMode
+	}
+
+	if !contains(modes, spec.Mode) {
+		return nil, errors.Newf(codes.Invalid, "invalid mode %s, must be one of %v", spec.Mode, modes)
+	}
+
+	if d, ok, err := args.GetDuration("flushInterval"); err != nil {
+		return nil, err
+	} else if ok {
+		if d.IsNegative() || d.IsZero() {
+			return nil, errors.New(codes.Invalid, "flushInterval must be positive")
+		}
+		spec.FlushInterval = d
+	}
+
 	return spec, nil
 }
 
@@ -93,8 +129,10 @@ func (s *FromSocketOpSpec) Kind() flux.OperationKind {
 
 type FromSocketProcedureSpec struct {
 	plan.DefaultCost
-	URL     string
-	Decoder string
+	URL           string
+	Decoder       string
+	Mode          string
+	FlushInterval time.Duration
 }
 
 func newFromSocketProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -103,9 +141,15 @@ func newFromSocketProcedure(qs flux.OperationSpec, pa plan.Administration) (plan
 		return nil, errors.Newf(codes.Internal, "invalid spec type %T", qs)
 	}
 
+	flushInterval := DefaultFlushInterval
+	if !spec.FlushInterval.IsZero() {
+		flushInterval = spec.FlushInterval.Duration()
+	}
 	return &FromSocketProcedureSpec{
-		URL:     spec.URL,
-		Decoder: spec.Decoder,
+		URL:           spec.URL,
+		Decoder:       spec.Decoder,
+		Mode:          spec.Mode,
+		FlushInterval: flushInterval,
 	}, nil
 }
 
@@ -117,9 +161,28 @@ func (s *FromSocketProcedureSpec) Copy() plan.ProcedureSpec {
 	ns := new(FromSocketProcedureSpec)
 	ns.URL = s.URL
 	ns.Decoder = s.Decoder
+	ns.Mode = s.Mode
+	ns.FlushInterval = s.FlushInterval
 	return ns
 }
 
+// IsStreaming implements plan.StreamingProcedureSpec.
+func (s *FromSocketProcedureSpec) IsStreaming() bool {
+	return s.Mode == StreamMode
+}
+
+// TimeBounds implements plan.BoundsAwareProcedureSpec.
+// A stream has no end so its tables span all time.
+func (s *FromSocketProcedureSpec) TimeBounds(predecessorBounds *plan.Bounds) *plan.Bounds {
+	if !s.IsStreaming() {
+		return predecessorBounds
+	}
+	return &plan.Bounds{
+		Start: execute.MinTime,
+		Stop:  execute.MaxTime,
+	}
+}
+
 func createFromSocketSource(s plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := s.(*FromSocketProcedureSpec)
 	if !ok {
@@ -167,6 +230,7 @@ func NewSocketSource(spec *FromSocketProcedureSpec, rc io.ReadCloser, tp line.Ti
 		decoder = line.NewResultDecoder(&line.ResultDecoderConfig{
 			Separator:    '\n',
 			TimeProvider: tp,
+			Streaming:    spec.IsStreaming(),
 		})
 	}
 
@@ -174,10 +238,19 @@ func NewSocketSource(spec *FromSocketProcedureSpec, rc io.ReadCloser, tp line.Ti
 		return nil, errors.Newf(codes.Invalid, "unknown decoder type: %v", spec.Decoder)
 	}
 
+	flushInterval := spec.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = DefaultFlushInterval
+	}
 	return &socketSource{
-		d:       dsid,
-		rc:      rc,
-		decoder: decoder,
+		d:             dsid,
+		rc:            rc,
+		decoder:       decoder,
+		tp:            tp,
+		streaming:     spec.IsStreaming(),
+		arrivalTime:   spec.Decoder == "line",
+		flushInterval: flushInterval,
+		watermark:     execute.MinTime,
 	}, nil
 }
 
@@ -186,6 +259,13 @@ type socketSource struct {
 	rc      io.ReadCloser
 	decoder flux.ResultDecoder
 	ts      []execute.Transformation
+
+	tp        line.TimeProvider
+	streaming bool
+	// arrivalTime is set when the rows are timestamped as they are read.
+	arrivalTime   bool
+	flushInterval time.Duration
+	watermark     execute.Time
 }
 
 func (ss *socketSource) AddTransformation(t execute.Transformation) {
@@ -193,6 +273,11 @@ func (ss *socketSource) AddTransformation(t execute.Transformation) {
 }
 
 func (ss *socketSource) Run(ctx context.Context) {
+	if ss.streaming {
+		ss.stream(ctx)
+		return
+	}
+
 	defer ss.rc.Close()
 	result, err := ss.decoder.Decode(ss.rc)
 	if err != nil {
@@ -212,3 +297,150 @@ func (ss *socketSource) Run(ctx context.Context) {
 		t.Finish(ss.d, err)
 	}
 }
+
+// stream decodes the connection in its own goroutine and processes
+// the tables as they arrive. The watermark follows the time column of
+// the tables and the processing time is advanced on every flush interval.
+// The stream ends when the connection is closed or the context is cancelled.
+func (ss *socketSource) stream(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tables := make(chan flux.BufferedTable)
+	decodeErr := make(chan error, 1)
+	go func() {
+		defer close(tables)
+		decodeErr <- ss.decode(ctx, tables)
+	}()
+	go func() {
+		// Closing the connection unblocks the decoder.
+		<-ctx.Done()
+		_ = ss.rc.Close()
+	}()
+
+	ticker := time.NewTicker(ss.flushInterval)
+	defer ticker.Stop()
+
+	err := func() error {
+		for {
+			select {
+			case tbl, ok := <-tables:
+				if !ok {
+					return <-decodeErr
+				}
+				if err := ss.processTable(tbl); err != nil {
+					return err
+				}
+			case <-ticker.C:
+				if err := ss.advance(); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}()
+
+	for _, t := range ss.ts {
+		t.Finish(ss.d, err)
+	}
+}
+
+// decode sends the decoded tables on the channel.
+// The tables are buffered because the decoder reuses its buffers once
+// a table has been read.
+func (ss *socketSource) decode(ctx context.Context, tables chan<- flux.BufferedTable) error {
+	result, err := ss.decoder.Decode(ss.rc)
+	if err != nil {
+		return errors.Wrap(err, codes.Inherit, "decode error")
+	}
+	err = result.Tables().Do(func(tbl flux.Table) error {
+		buffered, err := execute.CopyTable(tbl)
+		if err != nil {
+			return err
+		}
+		select {
+		case tables <- buffered:
+			return nil
+		case <-ctx.Done():
+			buffered.Done()
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		// The decoder fails when the connection is closed
+		// because the stream was cancelled.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.Wrap(err, codes.Inherit, "decode error")
+	}
+	return nil
+}
+
+// processTable sends the table to the transformations and advances
+// the watermark to the latest time in the table.
+func (ss *socketSource) processTable(tbl flux.BufferedTable) error {
+	mark := maxTime(tbl)
+	for _, t := range ss.ts {
+		if err := t.Process(ss.d, tbl.Copy()); err != nil {
+			return err
+		}
+	}
+	tbl.Done()
+	if mark <= ss.watermark {
+		return nil
+	}
+	ss.watermark = mark
+	for _, t := range ss.ts {
+		if err := t.UpdateWatermark(ss.d, mark); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advance updates the processing time. When the rows are timestamped
+// as they are read, no row older than the current time can arrive later,
+// so the watermark is advanced too. It trails the current time by one flush
+// interval to leave room for the rows that are still being decoded.
+func (ss *socketSource) advance() error {
+	now := ss.tp.CurrentTime()
+	for _, t := range ss.ts {
+		if err := t.UpdateProcessingTime(ss.d, now); err != nil {
+			return err
+		}
+	}
+	if !ss.arrivalTime {
+		return nil
+	}
+	if mark := now.Add(values.ConvertDuration(-ss.flushInterval)); mark > ss.watermark {
+		ss.watermark = mark
+		for _, t := range ss.ts {
+			if err := t.UpdateWatermark(ss.d, mark); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// maxTime returns the latest time in the time column of the table.
+func maxTime(tbl flux.BufferedTable) execute.Time {
+	mark := execute.Time(execute.MinTime)
+	j := execute.ColIdx(execute.DefaultTimeColLabel, tbl.Cols())
+	if j < 0 || tbl.Cols()[j].Type != flux.TTime {
+		return mark
+	}
+	for i, n := 0, tbl.BufferN(); i < n; i++ {
+		times := tbl.Buffer(i).Times(j)
+		for k, l := 0, times.Len(); k < l; k++ {
+			if times.IsValid(k) {
+				if t := execute.Time(times.Value(k)); t > mark {
+					mark = t
+				}
+			}
+		}
+	}
+	return mark
+}
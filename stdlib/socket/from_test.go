@@ -3,20 +3,29 @@ package socket_test
 import (
 	"bytes"
 	"context"
+	"io"
 	"io/ioutil"
+	"net"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/lang"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/mock"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
+	"github.com/influxdata/flux/runtime"
 	"github.com/influxdata/flux/stdlib/socket"
 	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
 
 	_ "github.com/influxdata/flux/builtin" // We need to import the builtins for the tests to work.
 )
@@ -46,6 +55,7 @@ socket.from(url: "url", decoder: "line") |> range(start:-4h, stop:-2h) |> sum()`
 						Spec: &socket.FromSocketOpSpec{
 							URL:     "url",
 							Decoder: "line",
+							Mode:    socket.BatchMode,
 						},
 					},
 					{
@@ -77,6 +87,36 @@ socket.from(url: "url", decoder: "line") |> range(start:-4h, stop:-2h) |> sum()`
 				},
 			},
 		},
+		{
+			Name: "from stream",
+			Raw: `import "socket"
+socket.from(url: "url", decoder: "line", mode: "stream", flushInterval: 5s)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "fromSocket0",
+						Spec: &socket.FromSocketOpSpec{
+							URL:           "url",
+							Decoder:       "line",
+							Mode:          socket.StreamMode,
+							FlushInterval: flux.ConvertDuration(5 * time.Second),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "from wrong mode",
+			Raw: `import "socket"
+socket.from(url: "url", mode: "wrong")`,
+			WantErr: true,
+		},
+		{
+			Name: "from zero flush interval",
+			Raw: `import "socket"
+socket.from(url: "url", mode: "stream", flushInterval: 0s)`,
+			WantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -243,3 +283,235 @@ source
 		})
 	}
 }
+
+// timeProvider provides the time that was last set.
+type timeProvider struct {
+	mu  sync.Mutex
+	now execute.Time
+}
+
+func (tp *timeProvider) CurrentTime() values.Time {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.now
+}
+
+func (tp *timeProvider) set(now execute.Time) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.now = now
+}
+
+// event is a call that was made to a recordingTransformation.
+type event struct {
+	table     *executetest.Table
+	watermark execute.Time
+	procTime  execute.Time
+	finished  bool
+	err       error
+}
+
+// recordingTransformation sends every call it receives on a channel.
+type recordingTransformation struct {
+	events chan event
+}
+
+func (t *recordingTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return nil
+}
+
+func (t *recordingTransformation) Process(id execute.DatasetID, tbl flux.Table) error {
+	ct, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		return err
+	}
+	ct.Normalize()
+	t.events <- event{table: ct}
+	return nil
+}
+
+func (t *recordingTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	t.events <- event{watermark: mark}
+	return nil
+}
+
+func (t *recordingTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	t.events <- event{procTime: pt}
+	return nil
+}
+
+func (t *recordingTransformation) Finish(id execute.DatasetID, err error) {
+	t.events <- event{finished: true, err: err}
+}
+
+func nextEvent(t *testing.T, events <-chan event) event {
+	t.Helper()
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the source")
+	}
+	return event{}
+}
+
+func TestFromSocketSource_Stream(t *testing.T) {
+	pr, pw := io.Pipe()
+	tp := &timeProvider{}
+	spec := &socket.FromSocketProcedureSpec{
+		Decoder:       "line",
+		Mode:          socket.StreamMode,
+		FlushInterval: time.Hour,
+	}
+	id := executetest.RandomDatasetID()
+	ss, err := socket.NewSocketSource(spec, pr, tp, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &recordingTransformation{events: make(chan event)}
+	ss.AddTransformation(rt)
+	go ss.Run(context.Background())
+
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TString},
+	}
+	// Each write produces a table followed by a watermark
+	// before the connection is closed.
+	for _, w := range []struct {
+		now   execute.Time
+		lines string
+		want  [][]interface{}
+	}{
+		{now: 10, lines: "a\nb\n", want: [][]interface{}{{execute.Time(10), "a"}, {execute.Time(10), "b"}}},
+		{now: 20, lines: "c\n", want: [][]interface{}{{execute.Time(20), "c"}}},
+	} {
+		tp.set(w.now)
+		if _, err := pw.Write([]byte(w.lines)); err != nil {
+			t.Fatal(err)
+		}
+		want := &executetest.Table{ColMeta: cols, Data: w.want}
+		want.Normalize()
+		if got := nextEvent(t, rt.events); !cmp.Equal(want, got.table) {
+			t.Fatalf("unexpected table -want/+got\n%s", cmp.Diff(want, got.table))
+		}
+		if got := nextEvent(t, rt.events); got.watermark != w.now {
+			t.Fatalf("unexpected watermark -want/+got\n\t- %v\n\t+ %v", w.now, got.watermark)
+		}
+	}
+
+	_ = pw.Close()
+	if got := nextEvent(t, rt.events); !got.finished || got.err != nil {
+		t.Fatalf("expected the source to finish without error, got %+v", got)
+	}
+}
+
+func TestFromSocketSource_StreamAdvance(t *testing.T) {
+	pr, _ := io.Pipe()
+	now := execute.Time(time.Hour)
+	spec := &socket.FromSocketProcedureSpec{
+		Decoder:       "line",
+		Mode:          socket.StreamMode,
+		FlushInterval: time.Millisecond,
+	}
+	id := executetest.RandomDatasetID()
+	ss, err := socket.NewSocketSource(spec, pr, &timeProvider{now: now}, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &recordingTransformation{events: make(chan event)}
+	ss.AddTransformation(rt)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go ss.Run(ctx)
+
+	// Without any data the processing time and the watermark still advance.
+	if got := nextEvent(t, rt.events); got.procTime != now {
+		t.Fatalf("unexpected processing time -want/+got\n\t- %v\n\t+ %v", now, got.procTime)
+	}
+	if got, want := nextEvent(t, rt.events).watermark, now-execute.Time(time.Millisecond); got != want {
+		t.Fatalf("unexpected watermark -want/+got\n\t- %v\n\t+ %v", want, got)
+	}
+
+	// The stream runs until it is cancelled.
+	cancel()
+	for {
+		if got := nextEvent(t, rt.events); got.finished {
+			if got.err != context.Canceled {
+				t.Fatalf("unexpected error -want/+got\n\t- %v\n\t+ %v", context.Canceled, got.err)
+			}
+			break
+		}
+	}
+}
+
+func TestFromSocket_StreamAggregateWindow(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// Keep writing so the connection stays open while the query runs.
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := conn.Write([]byte("a\n")); err != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	script := `import "socket"
+socket.from(url: "tcp://` + l.Addr().String() + `", decoder: "line", mode: "stream", flushInterval: 10ms)
+	|> aggregateWindow(every: 100ms, fn: count)`
+	prog, err := lang.Compile(script, runtime.Default, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = flux.NewDefaultDependencies().Inject(ctx)
+	q, err := prog.Start(ctx, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Done()
+
+	// The stream never finishes on its own, so any table that
+	// is received was output before the query was finished.
+	res, ok := <-q.Results()
+	if !ok {
+		t.Fatalf("query finished without a result: %v", q.Err())
+	}
+	errStop := errors.New(codes.Canceled, "stop")
+	err = res.Tables().Do(func(tbl flux.Table) error {
+		ct, err := executetest.ConvertTable(tbl)
+		if err != nil {
+			return err
+		}
+		for _, c := range []string{"_start", "_stop", "_time", "_value"} {
+			if execute.ColIdx(c, ct.ColMeta) < 0 {
+				t.Errorf("expected a %s column in the windowed table", c)
+			}
+		}
+		return errStop
+	})
+	if err != errStop {
+		t.Fatalf("expected a table before the query finished, got %v", err)
+	}
+	q.Cancel()
+}
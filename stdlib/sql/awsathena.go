@@ -2,6 +2,7 @@ package sql
 
 import (
 	"database/sql"
+	"strconv"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -83,7 +84,16 @@ func (m *AwsAthenaRowReader) GetNextRow() ([]values.Value, error) {
 		case float32:
 			row[i] = values.NewFloat(float64(value))
 		case string:
-			row[i] = values.New(value)
+			// DECIMAL is scanned to string by the driver
+			if m.columnTypes[i] == flux.TFloat {
+				f, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					return nil, err
+				}
+				row[i] = values.NewFloat(f)
+			} else {
+				row[i] = values.NewString(value)
+			}
 		case time.Time:
 			switch m.sqlTypes[i].DatabaseTypeName() {
 			case "date":
@@ -98,7 +108,7 @@ func (m *AwsAthenaRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("Athena", m.columnNames[i], value)
 		}
 	}
 	return row, nil
@@ -114,12 +124,16 @@ func (m *AwsAthenaRowReader) InitColumnTypes(types []*sql.ColumnType) {
 		switch types[i].DatabaseTypeName() {
 		case "tinyint", "smallint", "int", "integer", "bigint":
 			fluxTypes[i] = flux.TInt
-		case "float", "double", "real":
+		case "float", "double", "real", "decimal":
 			fluxTypes[i] = flux.TFloat
 		case "boolean":
 			fluxTypes[i] = flux.TBool
 		case "timestamp with time zone": // "timestamp", "date" and "time" will be represented as string
 			fluxTypes[i] = flux.TTime
+		case "char", "varchar", "string", "json":
+			fluxTypes[i] = flux.TString
+		case "binary", "varbinary":
+			fluxTypes[i] = flux.TInvalid
 		default:
 			fluxTypes[i] = flux.TString
 		}
@@ -169,6 +183,9 @@ func NewAwsAthenaRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("Athena", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 
 	return reader, nil
 }
@@ -3,18 +3,30 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/arrow"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
 	_ "github.com/lib/pq"
 )
 
-const FromSQLKind = "fromSQL"
+const (
+	FromSQLKind = "fromSQL"
+
+	// DefaultFromBatchSize is the maximum number of rows that are read
+	// into each buffer of the table when batchSize is not supplied.
+	DefaultFromBatchSize = 10000
+)
 
 // For SQL DATETIME parsing
 const layout = "2006-01-02 15:04:05.999999999"
@@ -23,6 +35,7 @@ type FromSQLOpSpec struct {
 	DriverName     string `json:"driverName,omitempty"`
 	DataSourceName string `json:"dataSourceName,omitempty"`
 	Query          string `json:"query,omitempty"`
+	BatchSize      int    `json:"batchSize,omitempty"`
 }
 
 func init() {
@@ -31,6 +44,12 @@ func init() {
 	flux.RegisterOpSpec(FromSQLKind, newFromSQLOp)
 	plan.RegisterProcedureSpec(FromSQLKind, newFromSQLProcedure, FromSQLKind)
 	execute.RegisterSource(FromSQLKind, createFromSQLSource)
+	plan.RegisterPhysicalRules(
+		SQLFilterRewriteRule{},
+		SQLRangeRewriteRule{},
+		SQLLimitRewriteRule{},
+		SQLKeepRewriteRule{},
+	)
 }
 
 func createFromSQLOpSpec(args flux.Arguments, administration *flux.Administration) (flux.OperationSpec, error) {
@@ -51,6 +70,15 @@ func createFromSQLOpSpec(args flux.Arguments, administration *flux.Administratio
 	} else {
 		spec.Query = query
 	}
+	if batchSize, ok, err := args.GetInt("batchSize"); err != nil {
+		return nil, err
+	} else if !ok {
+		spec.BatchSize = DefaultFromBatchSize
+	} else if batchSize <= 0 {
+		return nil, errors.Newf(codes.Invalid, "batchSize must be greater than zero, got %d", batchSize)
+	} else {
+		spec.BatchSize = int(batchSize)
+	}
 	return spec, nil
 }
 
@@ -67,6 +95,24 @@ type FromSQLProcedureSpec struct {
 	DriverName     string
 	DataSourceName string
 	Query          string
+	BatchSize      int
+
+	// The following are pushed into the query by the rules in from_rewrite.go.
+
+	// Where are the conditions that the rows of the query must match.
+	Where []*sqlCondition
+	// Columns are the columns that are selected. All of the columns
+	// are selected when it is empty.
+	Columns []string
+	// Limit is the maximum number of rows when it is positive
+	// and Offset is the number of rows that are skipped.
+	Limit, Offset int64
+	// Range is the range whose bounds are added to the conditions
+	// when the query is run, because the bounds depend on now.
+	Range *universe.RangeProcedureSpec
+	// DropEmpty is set when a filter would drop the table
+	// if no rows match the conditions.
+	DropEmpty bool
 }
 
 func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -79,6 +125,7 @@ func newFromSQLProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pr
 		DriverName:     spec.DriverName,
 		DataSourceName: spec.DataSourceName,
 		Query:          spec.Query,
+		BatchSize:      spec.BatchSize,
 	}, nil
 }
 
@@ -91,9 +138,25 @@ func (s *FromSQLProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.DriverName = s.DriverName
 	ns.DataSourceName = s.DataSourceName
 	ns.Query = s.Query
+	ns.BatchSize = s.BatchSize
+	ns.Where = append([]*sqlCondition(nil), s.Where...)
+	ns.Columns = append([]string(nil), s.Columns...)
+	ns.Limit = s.Limit
+	ns.Offset = s.Offset
+	ns.Range = s.Range
+	ns.DropEmpty = s.DropEmpty
 	return ns
 }
 
+// SetNow implements plan.NowAwareProcedureSpec
+func (s *FromSQLProcedureSpec) SetNow(now time.Time) {
+	if s.Range != nil {
+		r := s.Range.Copy().(*universe.RangeProcedureSpec)
+		r.SetNow(now)
+		s.Range = r
+	}
+}
+
 func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec, ok := prSpec.(*FromSQLProcedureSpec)
 	if !ok {
@@ -135,7 +198,7 @@ func createFromSQLSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a ex
 			_ = rows.Close()
 			return nil, err
 		}
-		return read(ctx, reader, a.Allocator())
+		return read(ctx, reader, spec.BatchSize, a.Allocator())
 	}
 	iterator := &sqlIterator{spec: spec, id: dsid, read: readFn}
 	return execute.CreateSourceFromIterator(iterator, dsid)
@@ -169,49 +232,128 @@ func (c *sqlIterator) Do(ctx context.Context, f func(flux.Table) error) error {
 	}
 	defer func() { _ = db.Close() }()
 
-	rows, err := db.QueryContext(ctx, c.spec.Query)
+	query, args, err := c.spec.query()
+	if err != nil {
+		return err
+	}
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = rows.Close() }()
 
-	table, err := c.read(ctx, rows)
+	tbl, err := c.read(ctx, rows)
 	if err != nil {
 		return err
 	}
-	return f(table)
+	// The table streams the rows from the cursor so wait for it
+	// to finish before the cursor and connection are closed.
+	defer tbl.Done()
+	if c.spec.DropEmpty && tbl.Empty() {
+		return nil
+	}
+	return f(tbl)
 }
 
 // read will use the RowReader to construct a flux.Table.
-func read(ctx context.Context, reader execute.RowReader, alloc *memory.Allocator) (flux.Table, error) {
-	// Ensure that the reader is always freed so the underlying
-	// cursor can be returned.
-	defer func() { _ = reader.Close() }()
-
-	groupKey := execute.NewGroupKey(nil, nil)
-	builder := execute.NewColListTableBuilder(groupKey, alloc)
-	for i, dataType := range reader.ColumnTypes() {
-		if _, err := builder.AddCol(flux.ColMeta{Label: reader.ColumnNames()[i], Type: dataType}); err != nil {
-			return nil, err
+// The rows are read from the reader while the table is consumed
+// and each buffer of the table holds at most batchSize rows.
+func read(ctx context.Context, reader execute.RowReader, batchSize int, alloc *memory.Allocator) (flux.Table, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultFromBatchSize
+	}
+
+	names, types := reader.ColumnNames(), reader.ColumnTypes()
+	cols := make([]flux.ColMeta, len(types))
+	for i, typ := range types {
+		if execute.ColIdx(names[i], cols[:i]) >= 0 {
+			_ = reader.Close()
+			return nil, errors.Newf(codes.Invalid, "query returns more than one column with label %s", names[i])
 		}
+		cols[i] = flux.ColMeta{Label: names[i], Type: typ}
 	}
-	for reader.Next() {
-		row, err := reader.GetNextRow()
-		if err != nil {
-			return nil, err
+
+	key := execute.NewGroupKey(nil, nil)
+	return table.StreamWithContext(ctx, key, cols, func(ctx context.Context, w *table.StreamWriter) error {
+		// Ensure that the reader is always freed so the underlying
+		// cursor can be returned.
+		defer func() { _ = reader.Close() }()
+
+		mem := arrow.NewAllocator(alloc)
+		builders := make([]array.Builder, len(cols))
+		for j, c := range cols {
+			builders[j] = arrow.NewBuilder(c.Type, mem)
+			builders[j].Reserve(batchSize)
 		}
+		defer func() {
+			for _, b := range builders {
+				b.Release()
+			}
+		}()
 
-		for i, col := range row {
-			if err := builder.AppendValue(i, col); err != nil {
-				return nil, err
+		flush := func() error {
+			vs := make([]array.Interface, len(builders))
+			for j, b := range builders {
+				vs[j] = b.NewArray()
+				b.Reserve(batchSize)
 			}
+			return w.Write(vs)
+		}
+
+		n := 0
+		for reader.Next() {
+			row, err := reader.GetNextRow()
+			if err != nil {
+				return err
+			}
+			for j, v := range row {
+				if err := appendValue(builders[j], cols[j], v); err != nil {
+					return err
+				}
+			}
+			if n++; n == batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+				n = 0
+			}
+		}
+
+		// An error may have been encountered while reading.
+		// This will get reported when we go to close the reader.
+		if err := reader.Close(); err != nil {
+			return err
+		}
+		if n > 0 {
+			return flush()
 		}
+		return nil
+	})
+}
+
+// appendValue appends a value read from the database to the builder
+// for the column.
+func appendValue(b array.Builder, col flux.ColMeta, v values.Value) error {
+	if !v.IsNull() && v.Type().Nature() != flux.SemanticType(col.Type).Nature() {
+		return errors.Newf(codes.Invalid, "value of type %s cannot be stored in column %q of type %s", v.Type(), col.Label, col.Type)
 	}
+	return arrow.AppendValue(b, v)
+}
 
-	// An error may have been encountered while reading.
-	// This will get reported when we go to close the reader.
-	if err := reader.Close(); err != nil {
-		return nil, err
+// checkColumnTypes returns an error for the first column with a database
+// type that cannot be represented in flux. The row readers map these
+// database types to flux.TInvalid.
+func checkColumnTypes(driver string, names []string, sqlTypes []*sql.ColumnType, types []flux.ColType) error {
+	for i, typ := range types {
+		if typ == flux.TInvalid {
+			return errors.Newf(codes.Invalid, "%s column %q has unsupported type %s", driver, names[i], sqlTypes[i].DatabaseTypeName())
+		}
 	}
-	return builder.Table()
+	return nil
+}
+
+// unsupportedValueError returns the error for a value that was scanned
+// from the database but has no flux counterpart.
+func unsupportedValueError(driver, column string, v interface{}) error {
+	return errors.Newf(codes.Invalid, "%s column %q has a value of unsupported type %T", driver, column, v)
 }
@@ -0,0 +1,589 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/influxdata/flux/ast"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/stdlib/universe"
+	"github.com/influxdata/flux/values"
+)
+
+// The rules in this file push operations that follow sql.from into
+// the query that is sent to the database. The query of the user is
+// wrapped as a subquery and the pushed operations become the clauses
+// of the outer query:
+//
+//     SELECT <columns> FROM (<query>) AS flux_query WHERE <conditions> LIMIT <n>
+//
+// An operation is only pushed into the query when the database produces
+// the same rows as the operation would. Operations that follow a pushed
+// limit() are never pushed because they must be applied after it.
+// Nothing is pushed into a query with an ORDER BY clause because the order
+// of a subquery is not kept by the outer query, and operations that refer
+// to columns are only pushed when the columns are in the select list of the query.
+
+// sqlCondition is a condition of the WHERE clause of a query.
+// It is either a logical AND or OR of two conditions,
+// or it compares a column with a value.
+type sqlCondition struct {
+	Op          string
+	Left, Right *sqlCondition
+	Column      string
+	// Value is nil for the IS NULL and IS NOT NULL operators.
+	Value interface{}
+}
+
+func (c *sqlCondition) write(sb *strings.Builder, driverName string, quote quoteIdentFunc, args *[]interface{}) {
+	switch c.Op {
+	case "AND", "OR":
+		sb.WriteString("(")
+		c.Left.write(sb, driverName, quote, args)
+		sb.WriteString(" " + c.Op + " ")
+		c.Right.write(sb, driverName, quote, args)
+		sb.WriteString(")")
+	case "IS NULL", "IS NOT NULL":
+		sb.WriteString(quote(c.Column) + " " + c.Op)
+	default:
+		*args = append(*args, c.Value)
+		sb.WriteString(quote(c.Column) + " " + c.Op + " " + placeholder(driverName, len(*args)))
+	}
+}
+
+// hasColumns reports whether every column the condition refers to is one of the columns.
+func (c *sqlCondition) hasColumns(columns []string) bool {
+	if c.Left != nil {
+		return c.Left.hasColumns(columns) && c.Right.hasColumns(columns)
+	}
+	return isKeyColumn(columns, c.Column)
+}
+
+// orderByRegexp matches the ORDER BY clauses of a query.
+var orderByRegexp = regexp.MustCompile(`(?i)\border\s+by\b`)
+
+// selectListRegexp matches the select list of a query.
+var selectListRegexp = regexp.MustCompile(`(?is)^\s*select\s+(.+?)\s+from\s`)
+
+// identPattern matches an unquoted or a quoted identifier.
+const identPattern = "(?:[A-Za-z_][A-Za-z0-9_$]*|\"(?:[^\"]|\"\")+\"|`[^`]+`|\\[[^\\]]+\\])"
+
+var (
+	// columnRegexp matches a column that may be qualified with its table.
+	columnRegexp = regexp.MustCompile(`^(?:` + identPattern + `\s*\.\s*)*(` + identPattern + `)$`)
+	// aliasRegexp matches an expression with an alias.
+	aliasRegexp = regexp.MustCompile(`(?is)^.+\s+as\s+(` + identPattern + `)$`)
+)
+
+// selectColumns returns the names of the columns that are returned by the query.
+// The columns are only known when every item of the select list is
+// a column or an expression with an alias.
+func selectColumns(driverName, query string) ([]string, bool) {
+	m := selectListRegexp.FindStringSubmatch(query)
+	if m == nil {
+		return nil, false
+	}
+	items, ok := splitSelectList(m[1])
+	if !ok {
+		return nil, false
+	}
+	columns := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		var name []string
+		if name = aliasRegexp.FindStringSubmatch(item); name == nil {
+			if name = columnRegexp.FindStringSubmatch(item); name == nil {
+				return nil, false
+			}
+		}
+		columns = append(columns, unquoteIdent(driverName, name[1]))
+	}
+	return columns, true
+}
+
+// splitSelectList splits a select list at the commas that are not
+// within parentheses or strings. It fails if the parentheses are not
+// balanced, which happens when the select list contains a subquery.
+func splitSelectList(list string) ([]string, bool) {
+	var (
+		items    []string
+		depth    int
+		inString bool
+		start    int
+	)
+	for i, c := range list {
+		switch {
+		case c == '\'':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			items = append(items, list[start:i])
+			start = i + 1
+		}
+	}
+	if depth != 0 || inString {
+		return nil, false
+	}
+	return append(items, list[start:]), true
+}
+
+// unquoteIdent returns the name of the column for an identifier.
+// The database changes the case of an unquoted identifier.
+func unquoteIdent(driverName, ident string) string {
+	switch ident[0] {
+	case '"':
+		return strings.Replace(ident[1:len(ident)-1], `""`, `"`, -1)
+	case '`', '[':
+		return ident[1 : len(ident)-1]
+	}
+	switch driverName {
+	case "postgres", "awsathena":
+		return strings.ToLower(ident)
+	case "snowflake":
+		return strings.ToUpper(ident)
+	}
+	return ident
+}
+
+// columns returns the columns that the query of the spec is known to return.
+func (s *FromSQLProcedureSpec) columns() ([]string, bool) {
+	if len(s.Columns) > 0 {
+		return s.Columns, true
+	}
+	return selectColumns(s.DriverName, s.Query)
+}
+
+// query returns the query that is sent to the database
+// along with the arguments of its placeholders.
+func (s *FromSQLProcedureSpec) query() (string, []interface{}, error) {
+	if len(s.Where) == 0 && s.Range == nil && len(s.Columns) == 0 && s.Limit <= 0 {
+		return s.Query, nil, nil
+	}
+	quote, err := getQuoteIdentFunc(s.DriverName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT ")
+	if s.Limit > 0 && isMssqlDriver(s.DriverName) {
+		// SQL Server has no LIMIT clause
+		fmt.Fprintf(&sb, "TOP %d ", s.Limit)
+	}
+	if len(s.Columns) == 0 {
+		sb.WriteString("*")
+	} else {
+		for i, col := range s.Columns {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(quote(col))
+		}
+	}
+
+	// A trailing semicolon would end the statement inside of the subquery.
+	query := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(s.Query), ";"))
+	fmt.Fprintf(&sb, " FROM (%s) AS flux_query", query)
+
+	where := s.Where
+	if s.Range != nil {
+		bounds := s.Range.Bounds
+		where = append([]*sqlCondition{
+			{Op: ">=", Column: s.Range.TimeColumn, Value: bounds.Start.Time(bounds.Now).UTC()},
+			{Op: "<", Column: s.Range.TimeColumn, Value: bounds.Stop.Time(bounds.Now).UTC()},
+		}, where...)
+	}
+	var args []interface{}
+	for i, c := range where {
+		if i == 0 {
+			sb.WriteString(" WHERE ")
+		} else {
+			sb.WriteString(" AND ")
+		}
+		c.write(&sb, s.DriverName, quote, &args)
+	}
+	if s.Limit > 0 && !isMssqlDriver(s.DriverName) {
+		fmt.Fprintf(&sb, " LIMIT %d", s.Limit)
+		if s.Offset > 0 {
+			fmt.Fprintf(&sb, " OFFSET %d", s.Offset)
+		}
+	}
+	return sb.String(), args, nil
+}
+
+// supportsStringPushdown reports whether strings are compared with the operator
+// in the database the same way as in flux. MySQL and SQL Server use case-insensitive
+// collations by default, and PostgreSQL orders strings by the collation of the database.
+func supportsStringPushdown(driverName, op string) bool {
+	switch {
+	case driverName == "mysql" || isMssqlDriver(driverName):
+		return false
+	case driverName == "postgres":
+		return op == "=" || op == "<>"
+	default:
+		return true
+	}
+}
+
+// supportsTimePushdown reports whether times are compared in the database
+// the same way as in flux. SQLite stores times as text.
+func supportsTimePushdown(driverName string) bool {
+	return driverName != "sqlite3"
+}
+
+// supportsOffsetPushdown reports whether the query can skip rows before its limit.
+func supportsOffsetPushdown(driverName string) bool {
+	return driverName != "awsathena" && !isMssqlDriver(driverName)
+}
+
+// canPushdown reports whether the query of the spec can be wrapped
+// as a subquery.
+func canPushdown(spec *FromSQLProcedureSpec) bool {
+	if _, err := getQuoteIdentFunc(spec.DriverName); err != nil {
+		return false
+	}
+	return !orderByRegexp.MatchString(spec.Query)
+}
+
+// pushdownSpec returns a copy of the spec of the sql.from node
+// if operations can be pushed into its query.
+func pushdownSpec(fromNode plan.Node) (*FromSQLProcedureSpec, bool) {
+	spec := fromNode.ProcedureSpec().(*FromSQLProcedureSpec)
+	// The other successors still need all of the rows.
+	if len(fromNode.Successors()) != 1 || spec.Limit > 0 || !canPushdown(spec) {
+		return nil, false
+	}
+	return spec.Copy().(*FromSQLProcedureSpec), true
+}
+
+// conditionTranslator translates the body of a filter function
+// into a condition.
+type conditionTranslator struct {
+	driverName string
+	// param is the name of the record parameter of the function.
+	param string
+	scope values.Scope
+}
+
+var sqlComparisonOperators = map[ast.OperatorKind]string{
+	ast.EqualOperator:            "=",
+	ast.NotEqualOperator:         "<>",
+	ast.LessThanOperator:         "<",
+	ast.LessThanEqualOperator:    "<=",
+	ast.GreaterThanOperator:      ">",
+	ast.GreaterThanEqualOperator: ">=",
+}
+
+// flippedOperators are the operators for comparisons with the operands swapped.
+var flippedOperators = map[string]string{
+	"=":  "=",
+	"<>": "<>",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// translate returns the condition for the expression or false
+// if the database does not filter the rows in the same way.
+// Conditions are combined with AND and OR only so that a comparison
+// with a null value excludes the row in both flux and the database.
+func (t *conditionTranslator) translate(e semantic.Expression) (*sqlCondition, bool) {
+	switch e := e.(type) {
+	case *semantic.LogicalExpression:
+		left, ok := t.translate(e.Left)
+		if !ok {
+			return nil, false
+		}
+		right, ok := t.translate(e.Right)
+		if !ok {
+			return nil, false
+		}
+		op := "AND"
+		if e.Operator == ast.OrOperator {
+			op = "OR"
+		}
+		return &sqlCondition{Op: op, Left: left, Right: right}, true
+	case *semantic.UnaryExpression:
+		op := "IS NOT NULL"
+		if e.Operator == ast.NotOperator {
+			// Only not exists is translated because the negation
+			// of a comparison with a null value differs.
+			op = "IS NULL"
+			arg, ok := e.Argument.(*semantic.UnaryExpression)
+			if !ok {
+				return nil, false
+			}
+			e = arg
+		}
+		if e.Operator != ast.ExistsOperator {
+			return nil, false
+		}
+		col, ok := t.column(e.Argument)
+		if !ok {
+			return nil, false
+		}
+		return &sqlCondition{Op: op, Column: col}, true
+	case *semantic.BinaryExpression:
+		op, ok := sqlComparisonOperators[e.Operator]
+		if !ok {
+			return nil, false
+		}
+		left, right := e.Left, e.Right
+		if _, ok := t.column(left); !ok {
+			left, right, op = right, left, flippedOperators[op]
+		}
+		col, ok := t.column(left)
+		if !ok {
+			return nil, false
+		}
+		v, ok := t.value(right)
+		if !ok {
+			return nil, false
+		}
+		if _, ok := v.(string); ok && !supportsStringPushdown(t.driverName, op) {
+			return nil, false
+		}
+		return &sqlCondition{Op: op, Column: col, Value: v}, true
+	default:
+		return nil, false
+	}
+}
+
+// column returns the column of a member expression of the record.
+func (t *conditionTranslator) column(e semantic.Expression) (string, bool) {
+	m, ok := e.(*semantic.MemberExpression)
+	if !ok {
+		return "", false
+	}
+	id, ok := m.Object.(*semantic.IdentifierExpression)
+	if !ok || id.Name != t.param {
+		return "", false
+	}
+	return m.Property, true
+}
+
+// value returns the argument of the query for a literal
+// or a value from the scope of the function.
+func (t *conditionTranslator) value(e semantic.Expression) (interface{}, bool) {
+	switch e := e.(type) {
+	case *semantic.IntegerLiteral:
+		return e.Value, true
+	case *semantic.UnsignedIntegerLiteral:
+		return t.uintValue(e.Value)
+	case *semantic.FloatLiteral:
+		return e.Value, true
+	case *semantic.StringLiteral:
+		return e.Value, true
+	case *semantic.DateTimeLiteral:
+		return e.Value.UTC(), supportsTimePushdown(t.driverName)
+	case *semantic.IdentifierExpression:
+		if t.scope == nil {
+			return nil, false
+		}
+		v, ok := t.scope.Lookup(e.Name)
+		if !ok || v.IsNull() {
+			return nil, false
+		}
+		switch v.Type().Nature() {
+		case semantic.Int:
+			return v.Int(), true
+		case semantic.UInt:
+			return t.uintValue(v.UInt())
+		case semantic.Float:
+			return v.Float(), true
+		case semantic.String:
+			return v.Str(), true
+		case semantic.Time:
+			return v.Time().Time().UTC(), supportsTimePushdown(t.driverName)
+		}
+	}
+	return nil, false
+}
+
+// uintValue returns an unsigned integer as a signed integer
+// because the drivers do not accept unsigned integers that
+// do not fit into one.
+func (t *conditionTranslator) uintValue(v uint64) (interface{}, bool) {
+	if v > math.MaxInt64 {
+		return nil, false
+	}
+	return int64(v), true
+}
+
+// SQLFilterRewriteRule pushes the predicate of a filter into the query.
+type SQLFilterRewriteRule struct{}
+
+func (r SQLFilterRewriteRule) Name() string {
+	return "SQLFilterRewriteRule"
+}
+
+func (r SQLFilterRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.FilterKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLFilterRewriteRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec, ok := pushdownSpec(fromNode)
+	if !ok {
+		return node, false, nil
+	}
+
+	filterSpec := node.ProcedureSpec().(*universe.FilterProcedureSpec)
+	fn := filterSpec.Fn.Fn
+	body, ok := fn.GetFunctionBodyExpression()
+	if !ok || fn.Parameters == nil || len(fn.Parameters.List) != 1 {
+		return node, false, nil
+	}
+	t := &conditionTranslator{
+		driverName: fromSpec.DriverName,
+		param:      fn.Parameters.List[0].Key.Name,
+		scope:      filterSpec.Fn.Scope,
+	}
+	cond, ok := t.translate(body)
+	if !ok {
+		return node, false, nil
+	}
+	// A column that was not selected is null in flux
+	// but an error in the database.
+	if columns, ok := fromSpec.columns(); !ok || !cond.hasColumns(columns) {
+		return node, false, nil
+	}
+
+	fromSpec.Where = append(fromSpec.Where, cond)
+	fromSpec.DropEmpty = fromSpec.DropEmpty || !filterSpec.KeepEmptyTables
+	n, err := plan.MergeToPhysicalNode(node, fromNode, fromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// SQLRangeRewriteRule pushes the bounds of a range into the query.
+// The range is not removed because it adds the start and stop columns
+// to the table, but it no longer filters out any rows.
+type SQLRangeRewriteRule struct{}
+
+func (r SQLRangeRewriteRule) Name() string {
+	return "SQLRangeRewriteRule"
+}
+
+func (r SQLRangeRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.RangeKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLRangeRewriteRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec, ok := pushdownSpec(fromNode)
+	if !ok || fromSpec.Range != nil || !supportsTimePushdown(fromSpec.DriverName) {
+		return node, false, nil
+	}
+
+	rangeSpec := node.ProcedureSpec().(*universe.RangeProcedureSpec)
+	if columns, ok := fromSpec.columns(); !ok || !isKeyColumn(columns, rangeSpec.TimeColumn) {
+		return node, false, nil
+	}
+	fromSpec.Range = rangeSpec
+	merged, err := plan.MergeToPhysicalNode(node, fromNode, fromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+
+	newNode := plan.CreatePhysicalNode(node.ID(), rangeSpec.Copy().(*universe.RangeProcedureSpec))
+	newNode.AddPredecessors(merged)
+	merged.AddSuccessors(newNode)
+	return newNode, true, nil
+}
+
+// SQLLimitRewriteRule pushes a limit into the query.
+type SQLLimitRewriteRule struct{}
+
+func (r SQLLimitRewriteRule) Name() string {
+	return "SQLLimitRewriteRule"
+}
+
+func (r SQLLimitRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.LimitKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLLimitRewriteRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec, ok := pushdownSpec(fromNode)
+	if !ok {
+		return node, false, nil
+	}
+
+	limitSpec := node.ProcedureSpec().(*universe.LimitProcedureSpec)
+	if limitSpec.N <= 0 || (limitSpec.Offset != 0 && !supportsOffsetPushdown(fromSpec.DriverName)) {
+		return node, false, nil
+	}
+	fromSpec.Limit = limitSpec.N
+	fromSpec.Offset = limitSpec.Offset
+	n, err := plan.MergeToPhysicalNode(node, fromNode, fromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// SQLKeepRewriteRule pushes the columns of a keep into the query.
+// The columns must be returned by the query and are selected in
+// the order in which they are listed.
+type SQLKeepRewriteRule struct{}
+
+func (r SQLKeepRewriteRule) Name() string {
+	return "SQLKeepRewriteRule"
+}
+
+func (r SQLKeepRewriteRule) Pattern() plan.Pattern {
+	return plan.Pat(universe.SchemaMutationKind, plan.Pat(FromSQLKind))
+}
+
+func (r SQLKeepRewriteRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	fromNode := node.Predecessors()[0]
+	fromSpec := fromNode.ProcedureSpec().(*FromSQLProcedureSpec)
+	// A keep does not change the rows so it may follow a limit.
+	if len(fromNode.Successors()) != 1 || !canPushdown(fromSpec) {
+		return node, false, nil
+	}
+	selected, ok := fromSpec.columns()
+	if !ok {
+		return node, false, nil
+	}
+
+	mutations := node.ProcedureSpec().(*universe.SchemaMutationProcedureSpec).Mutations
+	if len(mutations) != 1 {
+		return node, false, nil
+	}
+	keep, ok := mutations[0].(*universe.KeepOpSpec)
+	if !ok || keep.Predicate.Fn != nil {
+		return node, false, nil
+	}
+
+	var columns []string
+	for _, col := range keep.Columns {
+		if isKeyColumn(columns, col) || !isKeyColumn(selected, col) {
+			continue
+		}
+		columns = append(columns, col)
+	}
+	if len(columns) == 0 {
+		return node, false, nil
+	}
+
+	fromSpec = fromSpec.Copy().(*FromSQLProcedureSpec)
+	fromSpec.Columns = columns
+	n, err := plan.MergeToPhysicalNode(node, fromNode, fromSpec)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
@@ -0,0 +1,531 @@
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
+	"github.com/influxdata/flux/stdlib/universe"
+)
+
+func TestFromSQLRewriteRules(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	stop := start.Add(time.Hour)
+
+	fromQuery := func(driverName, query string) *FromSQLProcedureSpec {
+		return &FromSQLProcedureSpec{
+			DriverName: driverName,
+			Query:      query,
+			BatchSize:  DefaultFromBatchSize,
+		}
+	}
+	from := func(driverName string) *FromSQLProcedureSpec {
+		return fromQuery(driverName, "SELECT time, a, b, c, f FROM t")
+	}
+	filter := func(fn string) *universe.FilterProcedureSpec {
+		return &universe.FilterProcedureSpec{
+			Fn: interpreter.ResolvedFunction{
+				Fn: executetest.FunctionExpression(t, fn),
+			},
+		}
+	}
+	rangeSpec := &universe.RangeProcedureSpec{
+		Bounds: flux.Bounds{
+			Start: flux.Time{Absolute: start},
+			Stop:  flux.Time{Absolute: stop},
+		},
+		TimeColumn:  "time",
+		StartColumn: "_start",
+		StopColumn:  "_stop",
+	}
+	keep := func(columns ...string) *universe.SchemaMutationProcedureSpec {
+		return &universe.SchemaMutationProcedureSpec{
+			Mutations: []universe.SchemaMutation{
+				&universe.KeepOpSpec{Columns: columns},
+			},
+		}
+	}
+	rules := []plan.Rule{
+		SQLFilterRewriteRule{},
+		SQLRangeRewriteRule{},
+		SQLLimitRewriteRule{},
+		SQLKeepRewriteRule{},
+	}
+
+	tcs := []plantest.RuleTestCase{
+		{
+			Name:  "filter",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1 and (r.b == "x" or not exists r.c)`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_filter", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Where = []*sqlCondition{{
+							Op:   "AND",
+							Left: &sqlCondition{Op: ">", Column: "a", Value: int64(1)},
+							Right: &sqlCondition{
+								Op:    "OR",
+								Left:  &sqlCondition{Op: "=", Column: "b", Value: "x"},
+								Right: &sqlCondition{Op: "IS NULL", Column: "c"},
+							},
+						}}
+						spec.DropEmpty = true
+						return spec
+					}()),
+				},
+			},
+		},
+		{
+			Name:  "filter with literal on the left",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => 1.5 <= r.f`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_filter", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Where = []*sqlCondition{{Op: ">=", Column: "f", Value: 1.5}}
+						spec.DropEmpty = true
+						return spec
+					}()),
+				},
+			},
+		},
+		{
+			Name:  "filter with string comparison for mysql",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("mysql")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.b == "x"`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "filter with string ordering for postgres",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.b > "x"`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "filter on a column that is not selected",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.d > 1`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "filter with unknown columns",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", fromQuery("postgres", "SELECT * FROM t")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "limit with unknown columns",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", fromQuery("postgres", "SELECT * FROM t")),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10}),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_limit", func() *FromSQLProcedureSpec {
+						spec := fromQuery("postgres", "SELECT * FROM t")
+						spec.Limit = 10
+						return spec
+					}()),
+				},
+			},
+		},
+		{
+			Name:  "limit of an ordered query",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", fromQuery("sqlserver", "SELECT a FROM t ORDER BY a")),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10}),
+					plan.CreatePhysicalNode("keep", keep("a")),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "filter with negated comparison",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => not r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "range",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("range", rangeSpec),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_range", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Range = rangeSpec
+						return spec
+					}()),
+					plan.CreatePhysicalNode("range", rangeSpec),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "range for sqlite",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("sqlite3")),
+					plan.CreatePhysicalNode("range", rangeSpec),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+			NoChange: true,
+		},
+		{
+			Name:  "limit and keep",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10, Offset: 5}),
+					plan.CreatePhysicalNode("keep", keep("b", "a", "b")),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_limit_keep", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Limit = 10
+						spec.Offset = 5
+						spec.Columns = []string{"b", "a"}
+						return spec
+					}()),
+				},
+			},
+		},
+		{
+			Name:  "filter after limit",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10}),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_limit", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Limit = 10
+						return spec
+					}()),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "filter on a column that is not kept",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("keep", keep("b")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}, {1, 2}},
+			},
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("merged_fromSQL_keep", func() *FromSQLProcedureSpec {
+						spec := from("postgres")
+						spec.Columns = []string{"b"}
+						return spec
+					}()),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+				},
+				Edges: [][2]int{{0, 1}},
+			},
+		},
+		{
+			Name:  "shared source",
+			Rules: rules,
+			Before: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("fromSQL", from("postgres")),
+					plan.CreatePhysicalNode("filter", filter(`(r) => r.a > 1`)),
+					plan.CreatePhysicalNode("limit", &universe.LimitProcedureSpec{N: 10}),
+				},
+				Edges: [][2]int{{0, 1}, {0, 2}},
+			},
+			NoChange: true,
+		},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
+
+func TestSelectColumns(t *testing.T) {
+	testCases := []struct {
+		name       string
+		driverName string
+		query      string
+		want       []string
+		wantOK     bool
+	}{
+		{
+			name:       "columns",
+			driverName: "sqlite3",
+			query:      "SELECT a, t.b,c FROM t WHERE a > 1",
+			want:       []string{"a", "b", "c"},
+			wantOK:     true,
+		},
+		{
+			name:       "aliases",
+			driverName: "mysql",
+			query:      "select coalesce(a, 'x,y') as `a b`, count(*) AS n\nfrom t group by a",
+			want:       []string{"a b", "n"},
+			wantOK:     true,
+		},
+		{
+			name:       "case of unquoted identifiers",
+			driverName: "postgres",
+			query:      `SELECT Name, "Value" FROM t`,
+			want:       []string{"name", "Value"},
+			wantOK:     true,
+		},
+		{
+			name:       "case of unquoted identifiers for snowflake",
+			driverName: "snowflake",
+			query:      `SELECT Name, "Value" FROM t`,
+			want:       []string{"NAME", "Value"},
+			wantOK:     true,
+		},
+		{
+			name:       "all columns",
+			driverName: "postgres",
+			query:      "SELECT t.* FROM t",
+		},
+		{
+			name:       "expression without an alias",
+			driverName: "postgres",
+			query:      "SELECT a + 1 FROM t",
+		},
+		{
+			name:       "subquery",
+			driverName: "postgres",
+			query:      "SELECT a, (SELECT max(b) FROM u) AS b FROM t",
+		},
+		{
+			name:       "not a select",
+			driverName: "postgres",
+			query:      "WITH u AS (SELECT a FROM t) SELECT a FROM u",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := selectColumns(tc.driverName, tc.query)
+			if ok != tc.wantOK {
+				t.Fatalf("unexpected result -want/+got:\n\t- %v\n\t+ %v", tc.wantOK, ok)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected columns -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestFromSQLQuery(t *testing.T) {
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	where := []*sqlCondition{
+		{Op: ">=", Column: "time", Value: ts},
+		{
+			Op:    "OR",
+			Left:  &sqlCondition{Op: "=", Column: `a"b`, Value: int64(1)},
+			Right: &sqlCondition{Op: "IS NOT NULL", Column: "c"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		spec      *FromSQLProcedureSpec
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name: "no pushdown",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM t;",
+			},
+			wantQuery: "SELECT * FROM t;",
+		},
+		{
+			name: "postgres",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM t;",
+				Where:      where,
+				Columns:    []string{"time", `a"b`},
+				Limit:      10,
+				Offset:     5,
+			},
+			wantQuery: `SELECT "time","a""b" FROM (SELECT * FROM t) AS flux_query WHERE "time" >= $1 AND ("a""b" = $2 OR "c" IS NOT NULL) LIMIT 10 OFFSET 5`,
+			wantArgs:  []interface{}{ts, int64(1)},
+		},
+		{
+			name: "range",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "postgres",
+				Query:      "SELECT * FROM t",
+				Where:      where[1:],
+				Range: &universe.RangeProcedureSpec{
+					Bounds: flux.Bounds{
+						Start: flux.Time{Relative: -time.Hour, IsRelative: true},
+						Stop:  flux.Time{IsRelative: true},
+						Now:   ts,
+					},
+					TimeColumn: "time",
+				},
+			},
+			wantQuery: `SELECT * FROM (SELECT * FROM t) AS flux_query WHERE "time" >= $1 AND "time" < $2 AND ("a""b" = $3 OR "c" IS NOT NULL)`,
+			wantArgs:  []interface{}{ts.Add(-time.Hour), ts, int64(1)},
+		},
+		{
+			name: "mysql",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "mysql",
+				Query:      "SELECT * FROM t",
+				Where:      where,
+				Limit:      10,
+			},
+			wantQuery: "SELECT * FROM (SELECT * FROM t) AS flux_query WHERE `time` >= ? AND (`a\"b` = ? OR `c` IS NOT NULL) LIMIT 10",
+			wantArgs:  []interface{}{ts, int64(1)},
+		},
+		{
+			name: "sqlserver",
+			spec: &FromSQLProcedureSpec{
+				DriverName: "sqlserver",
+				Query:      "SELECT * FROM t",
+				Where:      where,
+				Columns:    []string{"c]"},
+				Limit:      10,
+			},
+			wantQuery: `SELECT TOP 10 [c]]] FROM (SELECT * FROM t) AS flux_query WHERE [time] >= @p1 AND ([a"b] = @p2 OR [c] IS NOT NULL)`,
+			wantArgs:  []interface{}{ts, int64(1)},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			query, args, err := tc.spec.query()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if query != tc.wantQuery {
+				t.Errorf("unexpected query -want/+got:\n%s", cmp.Diff(tc.wantQuery, query))
+			}
+			if !cmp.Equal(tc.wantArgs, args) {
+				t.Errorf("unexpected arguments -want/+got:\n%s", cmp.Diff(tc.wantArgs, args))
+			}
+		})
+	}
+}
+
+func TestFromSQLProcedureSpec_SetNow(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	spec := &FromSQLProcedureSpec{
+		DriverName: "postgres",
+		Query:      "SELECT * FROM t",
+		Range: &universe.RangeProcedureSpec{
+			Bounds: flux.Bounds{
+				Start: flux.Time{Relative: -time.Hour, IsRelative: true},
+				Stop:  flux.Time{IsRelative: true},
+				Now:   now,
+			},
+			TimeColumn: "time",
+		},
+	}
+
+	// The copy is run later while the spec keeps its own bounds.
+	later := spec.Copy().(*FromSQLProcedureSpec)
+	later.SetNow(now.Add(time.Hour))
+	for _, tc := range []struct {
+		spec *FromSQLProcedureSpec
+		want []interface{}
+	}{
+		{spec: spec, want: []interface{}{now.Add(-time.Hour), now}},
+		{spec: later, want: []interface{}{now, now.Add(time.Hour)}},
+	} {
+		_, args, err := tc.spec.query()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(tc.want, args) {
+			t.Errorf("unexpected arguments -want/+got:\n%s", cmp.Diff(tc.want, args))
+		}
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"strings"
 	"time"
 
-	_ "github.com/denisenkom/go-mssqldb"
+	mssql "github.com/denisenkom/go-mssqldb"
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
@@ -66,7 +66,16 @@ func (m *MssqlRowReader) GetNextRow() ([]values.Value, error) {
 				}
 				row[i] = values.NewFloat(newFloat)
 			default:
-				row[i] = values.NewString(string(value))
+				if m.sqlTypes[i].DatabaseTypeName() == "UNIQUEIDENTIFIER" {
+					// UNIQUEIDENTIFIER is scanned to its raw bytes in mixed byte order
+					var uuid mssql.UniqueIdentifier
+					if err := uuid.Scan(value); err != nil {
+						return nil, err
+					}
+					row[i] = values.NewString(uuid.String())
+				} else {
+					row[i] = values.NewString(string(value))
+				}
 			}
 		case time.Time:
 			// DATETIME, DATETIME2, DATE, TIME and others types get scanned to time.Time by the driver,
@@ -84,7 +93,7 @@ func (m *MssqlRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("SQL Server", m.columnNames[i], value)
 		}
 	}
 	return row, nil
@@ -100,12 +109,16 @@ func (m *MssqlRowReader) InitColumnTypes(types []*sql.ColumnType) {
 		switch types[i].DatabaseTypeName() {
 		case "INT", "TINYINT", "SMALLINT", "BIGINT":
 			fluxTypes[i] = flux.TInt
-		case "DECIMAL", "REAL", "FLOAT", "MONEY", "SMALLMONEY":
+		case "DECIMAL", "NUMERIC", "REAL", "FLOAT", "MONEY", "SMALLMONEY":
 			fluxTypes[i] = flux.TFloat
 		case "BIT":
 			fluxTypes[i] = flux.TBool
 		case "DATETIMEOFFSET": // other date/time types will be represented as string because they do not have tz
 			fluxTypes[i] = flux.TTime
+		case "CHAR", "VARCHAR", "NCHAR", "NVARCHAR", "TEXT", "NTEXT", "XML", "UNIQUEIDENTIFIER":
+			fluxTypes[i] = flux.TString
+		case "BINARY", "VARBINARY", "IMAGE", "SQL_VARIANT":
+			fluxTypes[i] = flux.TInvalid
 		default:
 			fluxTypes[i] = flux.TString
 		}
@@ -155,6 +168,9 @@ func NewMssqlRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("SQL Server", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 
 	return reader, nil
 }
@@ -272,3 +288,8 @@ func mssqlCheckParameter(dsn string, option string) bool {
 	}
 	return strings.Contains(strings.ToLower(raw), option)
 }
+
+// mssqlUpsert creates a MERGE statement that matches the rows on the key columns.
+func mssqlUpsert(table string, colNames, keyColumns []string, valueStrings string, quote quoteIdentFunc) string {
+	return mergeStatement(table, fmt.Sprintf("(VALUES %s) AS source (%s)", valueStrings, quoteIdents(quote, colNames)), colNames, keyColumns, quote) + ";"
+}
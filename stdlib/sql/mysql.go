@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -83,7 +84,7 @@ func (m *MySQLRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("MySQL", m.columnNames[i], col)
 		}
 	}
 	return row, nil
@@ -99,10 +100,14 @@ func (m *MySQLRowReader) InitColumnTypes(types []*sql.ColumnType) {
 		switch types[i].DatabaseTypeName() {
 		case "INT", "BIGINT", "SMALLINT", "TINYINT":
 			stringTypes[i] = flux.TInt
-		case "FLOAT", "DOUBLE":
+		case "FLOAT", "DOUBLE", "DECIMAL": // DECIMAL is scanned to []uint8 by the driver
 			stringTypes[i] = flux.TFloat
-		case "DATETIME":
+		case "DATETIME", "TIMESTAMP":
 			stringTypes[i] = flux.TTime
+		case "CHAR", "VARCHAR", "TEXT", "JSON":
+			stringTypes[i] = flux.TString
+		case "BINARY", "VARBINARY", "BLOB", "BIT", "GEOMETRY":
+			stringTypes[i] = flux.TInvalid
 		default:
 			stringTypes[i] = flux.TString
 		}
@@ -169,6 +174,9 @@ func NewMySQLRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("MySQL", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 
 	return reader, nil
 }
@@ -193,3 +201,18 @@ func MysqlColumnTranslateFunc() translationFunc {
 		return colName + " " + s, nil
 	}
 }
+
+// mysqlUpsert creates an INSERT statement that updates the rows
+// with a duplicate primary key or unique index.
+func mysqlUpsert(table string, colNames, keyColumns []string, valueStrings string, quote quoteIdentFunc) string {
+	cols := nonKeyColumns(colNames, keyColumns)
+	if len(cols) == 0 {
+		// Assigning a key column to itself leaves the duplicate row unchanged.
+		cols = keyColumns[:1]
+	}
+	updates := make([]string, len(cols))
+	for i, col := range cols {
+		updates[i] = fmt.Sprintf("%s = VALUES(%s)", quote(col), quote(col))
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s", table, quoteIdents(quote, colNames), valueStrings, strings.Join(updates, ","))
+}
@@ -3,6 +3,7 @@ package sql
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -76,7 +77,7 @@ func (m *PostgresRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("PostgreSQL", m.columnNames[i], col)
 		}
 	}
 	return row, nil
@@ -89,19 +90,27 @@ func (m *PostgresRowReader) InitColumnNames(n []string) {
 func (m *PostgresRowReader) InitColumnTypes(types []*sql.ColumnType) {
 	stringTypes := make([]flux.ColType, len(types))
 	for i := 0; i < len(types); i++ {
-		switch types[i].DatabaseTypeName() {
+		typeName := types[i].DatabaseTypeName()
+		switch typeName {
 		case "INT", "BIGINT", "SMALLINT", "TINYINT", "INT2", "INT4", "INT8", "SERIAL2", "SERIAL4", "SERIAL8":
 			stringTypes[i] = flux.TInt
-		case "FLOAT4", "FLOAT8":
+		case "FLOAT4", "FLOAT8", "NUMERIC", "DECIMAL": // NUMERIC is scanned to []uint8 by the driver
 			stringTypes[i] = flux.TFloat
-		case "DATE", "TIME", "TIMESTAMP":
+		case "DATE", "TIME", "TIMESTAMP", "TIMETZ", "TIMESTAMPTZ":
 			stringTypes[i] = flux.TTime
 		case "BOOL":
 			stringTypes[i] = flux.TBool
-		case "TEXT":
+		case "TEXT", "VARCHAR", "BPCHAR", "CHAR", "NAME", "UUID", "JSON", "JSONB":
 			stringTypes[i] = flux.TString
+		case "BYTEA", "INTERVAL":
+			stringTypes[i] = flux.TInvalid
 		default:
-			stringTypes[i] = flux.TString
+			// Array types are reported with a leading underscore.
+			if strings.HasPrefix(typeName, "_") {
+				stringTypes[i] = flux.TInvalid
+			} else {
+				stringTypes[i] = flux.TString
+			}
 		}
 	}
 	m.columnTypes = stringTypes
@@ -141,6 +150,9 @@ func NewPostgresRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("PostgreSQL", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 	return reader, nil
 }
 
@@ -163,3 +175,17 @@ func PostgresColumnTranslateFunc() translationFunc {
 	}
 
 }
+
+// postgresUpsert creates an INSERT statement that updates the rows
+// that conflict with the key columns.
+func postgresUpsert(table string, colNames, keyColumns []string, valueStrings string, quote quoteIdentFunc) string {
+	action := "DO NOTHING"
+	if cols := nonKeyColumns(colNames, keyColumns); len(cols) > 0 {
+		updates := make([]string, len(cols))
+		for i, col := range cols {
+			updates[i] = fmt.Sprintf("%s = EXCLUDED.%s", quote(col), quote(col))
+		}
+		action = "DO UPDATE SET " + strings.Join(updates, ",")
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON CONFLICT (%s) %s", table, quoteIdents(quote, colNames), valueStrings, quoteIdents(quote, keyColumns), action)
+}
@@ -2,6 +2,7 @@ package sql
 
 import (
 	"database/sql"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -102,7 +103,7 @@ func (m *SnowflakeRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("Snowflake", m.columnNames[i], value)
 		}
 	}
 	return row, nil
@@ -129,6 +130,10 @@ func (m *SnowflakeRowReader) InitColumnTypes(types []*sql.ColumnType) {
 			fluxTypes[i] = flux.TBool
 		case "TIMESTAMP_TZ", "TIMESTAMP_LTZ": // "TIMESTAMP_NTZ", "DATE" and "TIME" will be represented as string
 			fluxTypes[i] = flux.TTime
+		case "TEXT", "VARIANT", "OBJECT", "ARRAY": // semi-structured types are reported as JSON text
+			fluxTypes[i] = flux.TString
+		case "BINARY":
+			fluxTypes[i] = flux.TInvalid
 		default:
 			fluxTypes[i] = flux.TString
 		}
@@ -178,6 +183,9 @@ func NewSnowflakeRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("Snowflake", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 
 	return reader, nil
 }
@@ -200,3 +208,8 @@ func SnowflakeColumnTranslateFunc() translationFunc {
 		return colName + " " + s, nil
 	}
 }
+
+// snowflakeUpsert creates a MERGE statement that matches the rows on the key columns.
+func snowflakeUpsert(table string, colNames, keyColumns []string, valueStrings string, quote quoteIdentFunc) string {
+	return mergeStatement(table, fmt.Sprintf("(SELECT * FROM VALUES %s AS v (%s)) AS source", valueStrings, quoteIdents(quote, colNames)), colNames, keyColumns, quote)
+}
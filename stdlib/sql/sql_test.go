@@ -76,7 +76,7 @@ func TestFromRowReader(t *testing.T) {
 		var rr execute.RowReader = &MockRowReader{row: 0}
 		rr.(*MockRowReader).InitColumnTypes(nil)
 		alloc := &memory.Allocator{}
-		table, err := read(context.Background(), rr, alloc)
+		table, err := read(context.Background(), rr, DefaultFromBatchSize, alloc)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -3,6 +3,7 @@ package sql
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/influxdata/flux"
@@ -46,7 +47,14 @@ func (m *SqliteRowReader) GetNextRow() ([]values.Value, error) {
 	row := make([]values.Value, len(m.columns))
 	for i, col := range m.columns {
 		switch col := col.(type) {
-		case bool, int64, uint64, float64, string:
+		case int64:
+			// Columns with a numeric affinity may contain integers.
+			if m.columnTypes[i] == flux.TFloat {
+				row[i] = values.NewFloat(float64(col))
+			} else {
+				row[i] = values.NewInt(col)
+			}
+		case bool, uint64, float64, string:
 			row[i] = values.New(col)
 		case []uint8:
 			// this allows easier testing using existing methods
@@ -77,7 +85,7 @@ func (m *SqliteRowReader) GetNextRow() ([]values.Value, error) {
 		case nil:
 			row[i] = values.NewNull(flux.SemanticType(m.columnTypes[i]))
 		default:
-			execute.PanicUnknownType(flux.TInvalid)
+			return nil, unsupportedValueError("SQLite", m.columnNames[i], col)
 		}
 	}
 	return row, nil
@@ -90,17 +98,21 @@ func (m *SqliteRowReader) InitColumnNames(n []string) {
 func (m *SqliteRowReader) InitColumnTypes(types []*sql.ColumnType) {
 	stringTypes := make([]flux.ColType, len(types))
 	for i := 0; i < len(types); i++ {
-		switch types[i].DatabaseTypeName() {
+		// SQLite reports the declared type which may include a size, e.g. DECIMAL(10,2).
+		typeName := strings.TrimSpace(strings.SplitN(types[i].DatabaseTypeName(), "(", 2)[0])
+		switch typeName {
 		case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT":
 			stringTypes[i] = flux.TInt
-		case "FLOAT", "DOUBLE":
+		case "FLOAT", "DOUBLE", "REAL", "DECIMAL", "NUMERIC":
 			stringTypes[i] = flux.TFloat
 		case "DATETIME", "TIMESTAMP", "DATE":
 			stringTypes[i] = flux.TTime
-		case "TEXT":
+		case "TEXT", "VARCHAR", "CHAR", "UUID", "JSON":
 			stringTypes[i] = flux.TString
 		case "BOOL", "BOOLEAN":
 			stringTypes[i] = flux.TInt
+		case "BLOB":
+			stringTypes[i] = flux.TInvalid
 		default:
 			stringTypes[i] = flux.TString
 		}
@@ -146,6 +158,9 @@ func NewSqliteRowReader(r *sql.Rows) (execute.RowReader, error) {
 		return nil, err
 	}
 	reader.InitColumnTypes(types)
+	if err := checkColumnTypes("SQLite", cols, types, reader.columnTypes); err != nil {
+		return nil, err
+	}
 	return reader, nil
 }
 
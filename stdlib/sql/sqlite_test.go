@@ -1,12 +1,14 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	flux "github.com/influxdata/flux"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/values"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -135,3 +137,130 @@ func TestNulTranslation(t *testing.T) {
 	}
 
 }
+
+func TestSqliteColumnTypes(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	q := "CREATE TABLE prices (id UUID, price DECIMAL(10,2), total NUMERIC, attrs JSON, name VARCHAR(32))"
+	if _, err := db.Exec(q); err != nil {
+		t.Fatal(err)
+	}
+	q = `INSERT INTO prices VALUES ("0a3f5c2e-7f4b-4c61-9a51-2b1d4e1c0f4e", 12.5, 42, '{"a":1}', "book")`
+	if _, err := db.Exec(q); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("SELECT * FROM prices")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	reader, err := NewSqliteRowReader(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTypes := []flux.ColType{flux.TString, flux.TFloat, flux.TFloat, flux.TString, flux.TString}
+	if !cmp.Equal(wantTypes, reader.ColumnTypes()) {
+		t.Fatalf("unexpected column types -want/+got\n\n%s\n\n", cmp.Diff(wantTypes, reader.ColumnTypes()))
+	}
+
+	if !reader.Next() {
+		t.Fatal("expected a row")
+	}
+	row, err := reader.GetNextRow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []values.Value{
+		values.NewString("0a3f5c2e-7f4b-4c61-9a51-2b1d4e1c0f4e"),
+		values.NewFloat(12.5),
+		values.NewFloat(42),
+		values.NewString(`{"a":1}`),
+		values.NewString("book"),
+	}
+	if !cmp.Equal(want, row) {
+		t.Fatalf("unexpected row -want/+got\n\n%s\n\n", cmp.Diff(want, row))
+	}
+}
+
+func TestSqliteUnsupportedColumnType(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE files (name TEXT, content BLOB)"); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := db.Query("SELECT * FROM files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	_, err = NewSqliteRowReader(rows)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, got := `SQLite column "content" has unsupported type BLOB`, err.Error(); want != got {
+		t.Fatalf("unexpected error -want/+got\n\n%s\n\n", cmp.Diff(want, got))
+	}
+}
+
+func TestSqliteReadBatches(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE counts (n INT)"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 25; i++ {
+		if _, err := db.Exec("INSERT INTO counts (n) VALUES (?)", i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rows, err := db.Query("SELECT n FROM counts ORDER BY n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	reader, err := NewSqliteRowReader(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl, err := read(context.Background(), reader, 10, &memory.Allocator{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		sizes []int
+		next  int64
+	)
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		sizes = append(sizes, cr.Len())
+		vs := cr.Ints(0)
+		for i := 0; i < vs.Len(); i++ {
+			if got := vs.Value(i); got != next {
+				t.Fatalf("unexpected value -want/+got\n\n%s\n\n", cmp.Diff(next, got))
+			}
+			next++
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{10, 10, 5}; !cmp.Equal(want, sizes) {
+		t.Fatalf("unexpected buffer sizes -want/+got\n\n%s\n\n", cmp.Diff(want, sizes))
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/runtime"
+	"github.com/influxdata/flux/semantic"
 	"github.com/influxdata/flux/values"
 )
 
@@ -19,11 +21,26 @@ const (
 	DefaultBatchSize = 10000 //TODO: decide if this should be kept low enough for the lowest (SQLite), or not.
 )
 
+// The modes used by sql.to to write rows.
+const (
+	// InsertMode inserts every row.
+	InsertMode = "insert"
+	// UpsertMode inserts rows and updates the existing rows
+	// that have the same key columns.
+	UpsertMode = "upsert"
+	// ReplaceMode deletes the existing rows that have the same
+	// key columns before the rows are inserted.
+	ReplaceMode = "replace"
+)
+
 type ToSQLOpSpec struct {
-	DriverName     string `json:"driverName,omitempty"`
-	DataSourceName string `json:"dataSourcename,omitempty"`
-	Table          string `json:"table,omitempty"`
-	BatchSize      int    `json:"batchSize,omitempty"`
+	DriverName     string   `json:"driverName,omitempty"`
+	DataSourceName string   `json:"dataSourcename,omitempty"`
+	Table          string   `json:"table,omitempty"`
+	BatchSize      int      `json:"batchSize,omitempty"`
+	Mode           string   `json:"mode,omitempty"`
+	KeyColumns     []string `json:"keyColumns,omitempty"`
+	CreateTable    bool     `json:"createTable,omitempty"`
 }
 
 func init() {
@@ -72,7 +89,43 @@ func (o *ToSQLOpSpec) ReadArgs(args flux.Arguments) error {
 		o.BatchSize = int(b)
 	}
 
-	return err
+	mode, ok, err := args.GetString("mode")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		mode = InsertMode
+	}
+	switch mode {
+	case InsertMode, UpsertMode, ReplaceMode:
+		o.Mode = mode
+	default:
+		return errors.Newf(codes.Invalid, "invalid mode %q, must be one of %q, %q or %q", mode, InsertMode, UpsertMode, ReplaceMode)
+	}
+
+	if keys, ok, err := args.GetArray("keyColumns", semantic.String); err != nil {
+		return err
+	} else if ok {
+		o.KeyColumns, err = interpreter.ToStringArray(keys)
+		if err != nil {
+			return err
+		}
+	}
+	if o.Mode != InsertMode && len(o.KeyColumns) == 0 {
+		return errors.Newf(codes.Invalid, "keyColumns must be supplied with mode %q", o.Mode)
+	}
+	if err := validateMode(o.DriverName, o.Mode); err != nil {
+		return err
+	}
+
+	// The table is created by default.
+	createTable, ok, err := args.GetBool("createTable")
+	if err != nil {
+		return err
+	}
+	o.CreateTable = !ok || createTable
+
+	return nil
 }
 
 func createToSQLOpSpec(args flux.Arguments, a *flux.Administration) (flux.OperationSpec, error) {
@@ -107,6 +160,9 @@ func (o *ToSQLProcedureSpec) Copy() plan.ProcedureSpec {
 			DataSourceName: s.DataSourceName,
 			Table:          s.Table,
 			BatchSize:      s.BatchSize,
+			Mode:           s.Mode,
+			KeyColumns:     append([]string(nil), s.KeyColumns...),
+			CreateTable:    s.CreateTable,
 		},
 	}
 	return res
@@ -277,6 +333,46 @@ func getTranslationFunc(driverName string) (func() translationFunc, error) {
 	}
 }
 
+// quoteIdentFunc quotes an identifier so that it can be used in a query.
+type quoteIdentFunc func(name string) string
+
+func getQuoteIdentFunc(driverName string) (quoteIdentFunc, error) {
+	// return the quoting of identifiers that corresponds to the driver type
+	switch driverName {
+	case "sqlite3", "postgres", "sqlmock", "snowflake", "awsathena":
+		return quoteIdentWith(`"`, `"`), nil
+	case "mysql":
+		return quoteIdentWith("`", "`"), nil
+	case "mssql", "sqlserver":
+		return quoteIdentWith("[", "]"), nil
+	default:
+		return nil, errors.Newf(codes.Internal, "invalid driverName: %s", driverName)
+	}
+}
+
+// quoteIdentWith returns a quoteIdentFunc that encloses the identifier
+// in the open and close characters and escapes the close character
+// in the identifier by doubling it.
+func quoteIdentWith(open, close string) quoteIdentFunc {
+	return func(name string) string {
+		return open + strings.Replace(name, close, close+close, -1) + close
+	}
+}
+
+// validateMode checks that the driver can write rows with the mode.
+func validateMode(driverName, mode string) error {
+	switch mode {
+	case UpsertMode:
+		_, err := getUpsertFunc(driverName)
+		return err
+	case ReplaceMode:
+		if driverName == "awsathena" {
+			return errors.Newf(codes.Invalid, "mode %q is not supported for %s", mode, driverName)
+		}
+	}
+	return nil
+}
+
 func supportsTx(driverName string) bool {
 	return driverName != "sqlmock" && driverName != "awsathena"
 }
@@ -285,6 +381,17 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 	cols := tbl.Cols()
 	batchSize := correctBatchSize(t.spec.Spec.BatchSize, len(cols))
 
+	for _, key := range t.spec.Spec.KeyColumns {
+		if execute.ColIdx(key, cols) < 0 {
+			return nil, nil, nil, errors.Newf(codes.Invalid, "key column %q is not in the table", key)
+		}
+	}
+
+	quote, err := getQuoteIdentFunc(t.spec.Spec.DriverName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	labels := make(map[string]idxType, len(cols))
 	var questionMarks, newSQLTableCols []string
 	for i, col := range cols {
@@ -301,10 +408,13 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 		switch col.Type {
 		case flux.TFloat, flux.TInt, flux.TUInt, flux.TString, flux.TBool, flux.TTime:
 			// each type is handled within the function - precise mapping is handled within each driver's implementation
-			v, err := translateColumn()(col.Type, col.Label)
+			v, err := translateColumn()(col.Type, quote(col.Label))
 			if err != nil {
 				return nil, nil, nil, err
 			}
+			if typ, ok := stringKeyColumnType(driverName); ok && col.Type == flux.TString && isKeyColumn(t.spec.Spec.KeyColumns, col.Label) {
+				v = quote(col.Label) + " " + typ
+			}
 			newSQLTableCols = append(newSQLTableCols, v)
 		default:
 			return nil, nil, nil, errors.Newf(codes.Internal, "invalid type for column %s", col.Label)
@@ -322,6 +432,22 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 		}
 	}
 
+	if t.spec.Spec.CreateTable && t.spec.Spec.DriverName != "sqlmock" {
+		if len(t.spec.Spec.KeyColumns) > 0 {
+			// The key columns must be unique for upserts to find the existing rows.
+			newSQLTableCols = append(newSQLTableCols, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdents(quote, t.spec.Spec.KeyColumns)))
+		}
+		var q string
+		if !isMssqlDriver(t.spec.Spec.DriverName) {
+			q = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
+		} else { // SQL Server does not support IF NOT EXIST
+			q = fmt.Sprintf("IF OBJECT_ID('%s', 'U') IS NULL BEGIN CREATE TABLE %s (%s) END", t.spec.Spec.Table, t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
+		}
+		if _, err := t.tx.Exec(q); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
 	if err := tbl.Do(func(er flux.ColReader) error {
 		l := er.Len()
 
@@ -330,19 +456,6 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 		// valueArgs holds all the values to pass into the query
 		valueArgs := make([]interface{}, 0, l*len(cols))
 
-		if t.spec.Spec.DriverName != "sqlmock" {
-			var q string
-			if !isMssqlDriver(t.spec.Spec.DriverName) {
-				q = fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
-			} else { // SQL Server does not support IF NOT EXIST
-				q = fmt.Sprintf("IF OBJECT_ID('%s', 'U') IS NULL BEGIN CREATE TABLE %s (%s) END", t.spec.Spec.Table, t.spec.Spec.Table, strings.Join(newSQLTableCols, ","))
-			}
-			_, err = t.tx.Exec(q)
-			if err != nil {
-				return err
-			}
-		}
-
 		for i := 0; i < l; i++ {
 			valueStrings = append(valueStrings, valuePlaceHolders)
 			for j, col := range er.Cols() {
@@ -413,37 +526,235 @@ func CreateInsertComponents(t *ToSQLTransformation, tbl flux.Table) (colNames []
 }
 
 func ExecuteQueries(tx *sql.Tx, s *ToSQLOpSpec, colNames []string, valueStrings *[]string, valueArgs *[]interface{}) (err error) {
-	concatValueStrings := strings.Join(*valueStrings, ",")
-
-	// PostgreSQL uses $n instead of ? for placeholders
-	if s.DriverName == "postgres" {
-		for pqCounter := 1; strings.Contains(concatValueStrings, "?"); pqCounter++ {
-			concatValueStrings = strings.Replace(concatValueStrings, "?", fmt.Sprintf("$%v", pqCounter), 1)
-		}
+	quote, err := getQuoteIdentFunc(s.DriverName)
+	if err != nil {
+		return err
 	}
-	// SQLServer uses @p instead of ? for placeholders
-	if isMssqlDriver(s.DriverName) {
-		for pqCounter := 1; strings.Contains(concatValueStrings, "?"); pqCounter++ {
-			concatValueStrings = strings.Replace(concatValueStrings, "?", fmt.Sprintf("@p%v", pqCounter), 1)
-		}
+	if s.Mode != InsertMode {
+		// A statement cannot update or replace the same row twice.
+		*valueStrings, *valueArgs = dedupRows(s.KeyColumns, colNames, *valueStrings, *valueArgs)
 	}
+	concatValueStrings := bindPlaceholders(s.DriverName, strings.Join(*valueStrings, ","))
 
-	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", s.Table, strings.Join(colNames, ","), concatValueStrings)
+	var query string
+	switch s.Mode {
+	case UpsertMode:
+		upsert, err := getUpsertFunc(s.DriverName)
+		if err != nil {
+			return err
+		}
+		query = upsert(s.Table, colNames, s.KeyColumns, concatValueStrings, quote)
+	default:
+		query = fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", s.Table, quoteIdents(quote, colNames), concatValueStrings)
+	}
 	if isMssqlDriver(s.DriverName) && mssqlCheckParameter(s.DataSourceName, mssqlIdentityInsertEnabled) {
 		prologue := fmt.Sprintf("DECLARE @tableHasIdentity INT = OBJECTPROPERTY(OBJECT_ID('%s'), 'TableHasIdentity'); IF @tableHasIdentity = 1 BEGIN SET IDENTITY_INSERT %s ON END", s.Table, s.Table)
 		epilogue := fmt.Sprintf("IF @tableHasIdentity = 1 BEGIN SET IDENTITY_INSERT %s OFF END", s.Table)
-		query = strings.Join([]string{prologue, query, epilogue}, "; ")
+		query = strings.Join([]string{prologue, strings.TrimSuffix(query, ";"), epilogue}, "; ")
 	}
 	if s.DriverName != "sqlmock" {
-		_, err := tx.Exec(query, *valueArgs...)
-		if err != nil {
-			// this err which is extremely helpful as it comes from the SQL driver should be
-			// bubbled up further up the stack so user can see the issue
-			if rbErr := tx.Rollback(); rbErr != nil {
-				return errors.Newf(codes.Aborted, "transaction failed (%s) while recovering from %s", err, rbErr)
+		if s.Mode == ReplaceMode {
+			// Remove the rows that are replaced by the inserted rows.
+			deleteQuery, deleteArgs := createDeleteComponents(s, colNames, len(*valueStrings), *valueArgs, quote)
+			if err := execQuery(tx, deleteQuery, deleteArgs); err != nil {
+				return err
 			}
+		}
+		if err := execQuery(tx, query, *valueArgs); err != nil {
 			return err
 		}
 	}
 	return err
 }
+
+// execQuery executes the query in the transaction and rolls back the
+// transaction if the query fails.
+func execQuery(tx *sql.Tx, query string, args []interface{}) error {
+	_, err := tx.Exec(query, args...)
+	if err != nil {
+		// this err which is extremely helpful as it comes from the SQL driver should be
+		// bubbled up further up the stack so user can see the issue
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Newf(codes.Aborted, "transaction failed (%s) while recovering from %s", err, rbErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// bindPlaceholders replaces the ? placeholders in the query with the
+// placeholders used by the driver.
+func bindPlaceholders(driverName, query string) string {
+	if placeholder(driverName, 1) == "?" {
+		return query
+	}
+	for pqCounter := 1; strings.Contains(query, "?"); pqCounter++ {
+		query = strings.Replace(query, "?", placeholder(driverName, pqCounter), 1)
+	}
+	return query
+}
+
+// placeholder returns the placeholder of the nth argument of a query.
+func placeholder(driverName string, n int) string {
+	switch {
+	case driverName == "postgres":
+		// PostgreSQL uses $n instead of ? for placeholders
+		return fmt.Sprintf("$%v", n)
+	case isMssqlDriver(driverName):
+		// SQLServer uses @p instead of ? for placeholders
+		return fmt.Sprintf("@p%v", n)
+	default:
+		return "?"
+	}
+}
+
+// keyIndexes returns the index of each key column in colNames.
+func keyIndexes(keyColumns, colNames []string) []int {
+	keyIdxs := make([]int, len(keyColumns))
+	for i, key := range keyColumns {
+		for j, name := range colNames {
+			if name == key {
+				keyIdxs[i] = j
+			}
+		}
+	}
+	return keyIdxs
+}
+
+// dedupRows removes the rows of a batch whose key columns are repeated by
+// a later row, and keeps the values of the last of those rows.
+// The rows keep the order in which their keys first appear.
+func dedupRows(keyColumns, colNames []string, valueStrings []string, valueArgs []interface{}) ([]string, []interface{}) {
+	keyIdxs := keyIndexes(keyColumns, colNames)
+	width := len(colNames)
+	rows := make(map[string]int, len(valueStrings))
+	args := make([]interface{}, 0, len(valueArgs))
+	key := make([]interface{}, len(keyIdxs))
+	for i := 0; i < len(valueStrings); i++ {
+		row := valueArgs[i*width : (i+1)*width]
+		for k, j := range keyIdxs {
+			key[k] = row[j]
+		}
+		// The verbose format distinguishes the types and the values
+		// of the key columns.
+		repr := fmt.Sprintf("%#v", key)
+		if n, ok := rows[repr]; ok {
+			copy(args[n*width:(n+1)*width], row)
+			continue
+		}
+		rows[repr] = len(rows)
+		args = append(args, row...)
+	}
+	if len(rows) == len(valueStrings) {
+		return valueStrings, valueArgs
+	}
+	return valueStrings[:len(rows)], args
+}
+
+// createDeleteComponents creates the statement that deletes the rows with the
+// same key columns as the n rows in valueArgs, and the arguments for it.
+func createDeleteComponents(s *ToSQLOpSpec, colNames []string, n int, valueArgs []interface{}, quote quoteIdentFunc) (string, []interface{}) {
+	keyIdxs := keyIndexes(s.KeyColumns, colNames)
+	conditions := make([]string, len(s.KeyColumns))
+	for i, key := range s.KeyColumns {
+		conditions[i] = quote(key) + " = ?"
+	}
+	condition := "(" + strings.Join(conditions, " AND ") + ")"
+
+	rows := make([]string, n)
+	args := make([]interface{}, 0, n*len(keyIdxs))
+	for i := range rows {
+		rows[i] = condition
+		for _, j := range keyIdxs {
+			args = append(args, valueArgs[i*len(colNames)+j])
+		}
+	}
+	where := bindPlaceholders(s.DriverName, strings.Join(rows, " OR "))
+	return fmt.Sprintf("DELETE FROM %s WHERE %s", s.Table, where), args
+}
+
+// upsertFunc returns the statement that inserts the rows in valueStrings into
+// the table and updates the existing rows that have the same key columns.
+type upsertFunc func(table string, colNames, keyColumns []string, valueStrings string, quote quoteIdentFunc) string
+
+func getUpsertFunc(driverName string) (upsertFunc, error) {
+	// simply return the upsertFunc that corresponds to the driver type
+	switch driverName {
+	case "sqlite3", "postgres", "sqlmock":
+		// SQLite supports the same ON CONFLICT clause as PostgreSQL
+		return postgresUpsert, nil
+	case "mysql":
+		return mysqlUpsert, nil
+	case "snowflake":
+		return snowflakeUpsert, nil
+	case "mssql", "sqlserver":
+		return mssqlUpsert, nil
+	default:
+		return nil, errors.Newf(codes.Invalid, "upsert is not supported for %s", driverName)
+	}
+}
+
+// mergeStatement creates a MERGE statement that updates the rows of the table
+// that match the source on the key columns and inserts the other rows.
+func mergeStatement(table, source string, colNames, keyColumns []string, quote quoteIdentFunc) string {
+	conditions := make([]string, len(keyColumns))
+	for i, key := range keyColumns {
+		conditions[i] = fmt.Sprintf("target.%s = source.%s", quote(key), quote(key))
+	}
+	sourceCols := make([]string, len(colNames))
+	for i, col := range colNames {
+		sourceCols[i] = "source." + quote(col)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "MERGE INTO %s AS target USING %s ON %s", table, source, strings.Join(conditions, " AND "))
+	if cols := nonKeyColumns(colNames, keyColumns); len(cols) > 0 {
+		updates := make([]string, len(cols))
+		for i, col := range cols {
+			updates[i] = fmt.Sprintf("%s = source.%s", quote(col), quote(col))
+		}
+		fmt.Fprintf(&sb, " WHEN MATCHED THEN UPDATE SET %s", strings.Join(updates, ","))
+	}
+	fmt.Fprintf(&sb, " WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", quoteIdents(quote, colNames), strings.Join(sourceCols, ","))
+	return sb.String()
+}
+
+// quoteIdents quotes the names and joins them into a list.
+func quoteIdents(quote quoteIdentFunc, names []string) string {
+	idents := make([]string, len(names))
+	for i, name := range names {
+		idents[i] = quote(name)
+	}
+	return strings.Join(idents, ",")
+}
+
+// nonKeyColumns returns the columns that are not key columns.
+func nonKeyColumns(colNames, keyColumns []string) []string {
+	var cols []string
+	for _, name := range colNames {
+		if !isKeyColumn(keyColumns, name) {
+			cols = append(cols, name)
+		}
+	}
+	return cols
+}
+
+func isKeyColumn(keyColumns []string, label string) bool {
+	for _, key := range keyColumns {
+		if key == label {
+			return true
+		}
+	}
+	return false
+}
+
+// stringKeyColumnType returns the type of string key columns for drivers
+// whose string column type cannot be used in a primary key.
+func stringKeyColumnType(driverName string) (string, bool) {
+	switch {
+	case driverName == "mysql", isMssqlDriver(driverName):
+		return "VARCHAR(255)", true
+	default:
+		return "", false
+	}
+}
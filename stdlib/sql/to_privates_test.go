@@ -240,3 +240,121 @@ func TestMssqlTranslation(t *testing.T) {
 		}
 	}
 }
+
+func TestUpsertStatements(t *testing.T) {
+	colNames := []string{"_time", "host", "_value"}
+	keyColumns := []string{"_time", "host"}
+	testCases := []struct {
+		driverName   string
+		valueStrings string
+		want         string
+	}{
+		{
+			driverName:   "postgres",
+			valueStrings: "($1,$2,$3)",
+			want:         `INSERT INTO t ("_time","host","_value") VALUES ($1,$2,$3) ON CONFLICT ("_time","host") DO UPDATE SET "_value" = EXCLUDED."_value"`,
+		},
+		{
+			driverName:   "sqlite3",
+			valueStrings: "(?,?,?)",
+			want:         `INSERT INTO t ("_time","host","_value") VALUES (?,?,?) ON CONFLICT ("_time","host") DO UPDATE SET "_value" = EXCLUDED."_value"`,
+		},
+		{
+			driverName:   "mysql",
+			valueStrings: "(?,?,?)",
+			want:         "INSERT INTO t (`_time`,`host`,`_value`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `_value` = VALUES(`_value`)",
+		},
+		{
+			driverName:   "sqlserver",
+			valueStrings: "(@p1,@p2,@p3)",
+			want: "MERGE INTO t AS target USING (VALUES (@p1,@p2,@p3)) AS source ([_time],[host],[_value]) " +
+				"ON target.[_time] = source.[_time] AND target.[host] = source.[host] " +
+				"WHEN MATCHED THEN UPDATE SET [_value] = source.[_value] " +
+				"WHEN NOT MATCHED THEN INSERT ([_time],[host],[_value]) VALUES (source.[_time],source.[host],source.[_value]);",
+		},
+		{
+			driverName:   "snowflake",
+			valueStrings: "(?,?,?)",
+			want: `MERGE INTO t AS target USING (SELECT * FROM VALUES (?,?,?) AS v ("_time","host","_value")) AS source ` +
+				`ON target."_time" = source."_time" AND target."host" = source."host" ` +
+				`WHEN MATCHED THEN UPDATE SET "_value" = source."_value" ` +
+				`WHEN NOT MATCHED THEN INSERT ("_time","host","_value") VALUES (source."_time",source."host",source."_value")`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.driverName, func(t *testing.T) {
+			upsert, err := getUpsertFunc(tc.driverName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			quote, err := getQuoteIdentFunc(tc.driverName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := upsert("t", colNames, keyColumns, tc.valueStrings, quote); !cmp.Equal(tc.want, got) {
+				t.Fatalf("unexpected statement -want/+got\n\n%s\n\n", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+
+	// Rows are left unchanged when every column is a key column.
+	upsert, _ := getUpsertFunc("postgres")
+	quote, _ := getQuoteIdentFunc("postgres")
+	want := `INSERT INTO t ("_time","host") VALUES ($1,$2) ON CONFLICT ("_time","host") DO NOTHING`
+	if got := upsert("t", keyColumns, keyColumns, "($1,$2)", quote); !cmp.Equal(want, got) {
+		t.Fatalf("unexpected statement -want/+got\n\n%s\n\n", cmp.Diff(want, got))
+	}
+
+	if _, err := getUpsertFunc("awsathena"); err == nil {
+		t.Fatal("expected error for awsathena")
+	}
+}
+
+func TestCreateDeleteComponents(t *testing.T) {
+	spec := &ToSQLOpSpec{
+		DriverName: "postgres",
+		Table:      "t",
+		Mode:       ReplaceMode,
+		KeyColumns: []string{"host", "_time"},
+	}
+	colNames := []string{"_time", "host", "_value"}
+	valueArgs := []interface{}{int64(1), "a", 1.0, int64(2), "b", 2.0}
+
+	query, args := createDeleteComponents(spec, colNames, 2, valueArgs, quoteIdentWith(`"`, `"`))
+	wantQuery := `DELETE FROM t WHERE ("host" = $1 AND "_time" = $2) OR ("host" = $3 AND "_time" = $4)`
+	if !cmp.Equal(wantQuery, query) {
+		t.Fatalf("unexpected statement -want/+got\n\n%s\n\n", cmp.Diff(wantQuery, query))
+	}
+	wantArgs := []interface{}{"a", int64(1), "b", int64(2)}
+	if !cmp.Equal(wantArgs, args) {
+		t.Fatalf("unexpected arguments -want/+got\n\n%s\n\n", cmp.Diff(wantArgs, args))
+	}
+}
+
+func TestDedupRows(t *testing.T) {
+	keyColumns := []string{"host", "_time"}
+	colNames := []string{"_time", "host", "_value"}
+	valueStrings := []string{"(?,?,?)", "(?,?,?)", "(?,?,?)", "(?,?,?)"}
+	valueArgs := []interface{}{
+		int64(1), "a", 1.0,
+		int64(1), "b", 2.0,
+		int64(1), "a", 3.0,
+		int64(2), "a", 4.0,
+	}
+
+	gotStrings, gotArgs := dedupRows(keyColumns, colNames, valueStrings, valueArgs)
+	wantStrings := []string{"(?,?,?)", "(?,?,?)", "(?,?,?)"}
+	if !cmp.Equal(wantStrings, gotStrings) {
+		t.Fatalf("unexpected value strings -want/+got\n\n%s\n\n", cmp.Diff(wantStrings, gotStrings))
+	}
+	// The last row with a key replaces the earlier rows with that key.
+	wantArgs := []interface{}{
+		int64(1), "a", 3.0,
+		int64(1), "b", 2.0,
+		int64(2), "a", 4.0,
+	}
+	if !cmp.Equal(wantArgs, gotArgs) {
+		t.Fatalf("unexpected arguments -want/+got\n\n%s\n\n", cmp.Diff(wantArgs, gotArgs))
+	}
+}
@@ -1,6 +1,10 @@
 package sql_test
 
 import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -40,6 +44,8 @@ func TestSqlTo(t *testing.T) {
 							DataSourceName: "root@/db",
 							Table:          "TestTable",
 							BatchSize:      fsql.DefaultBatchSize,
+							Mode:           fsql.InsertMode,
+							CreateTable:    true,
 						},
 					},
 				},
@@ -48,6 +54,54 @@ func TestSqlTo(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "upsert",
+			Raw:  `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", mode:"upsert", keyColumns:["_time", "host"], createTable:false)`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: influxdb.NameOrID{Name: "mybucket"},
+						},
+					},
+					{
+						ID: "toSQL1",
+						Spec: &fsql.ToSQLOpSpec{
+							DriverName:     "sqlmock",
+							DataSourceName: "root@/db",
+							Table:          "TestTable",
+							BatchSize:      fsql.DefaultBatchSize,
+							Mode:           fsql.UpsertMode,
+							KeyColumns:     []string{"_time", "host"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "toSQL1"},
+				},
+			},
+		},
+		{
+			Name:    "upsert without key columns",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", mode:"upsert")`,
+			WantErr: true,
+		},
+		{
+			Name:    "invalid mode",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"sqlmock", dataSourceName:"root@/db", table:"TestTable", mode:"merge")`,
+			WantErr: true,
+		},
+		{
+			Name:    "upsert to awsathena",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"awsathena", dataSourceName:"s3://bucket", table:"TestTable", mode:"upsert", keyColumns:["_time"])`,
+			WantErr: true,
+		},
+		{
+			Name:    "replace to awsathena",
+			Raw:     `import "sql" from(bucket: "mybucket") |> sql.to(driverName:"awsathena", dataSourceName:"s3://bucket", table:"TestTable", mode:"replace", keyColumns:["_time"])`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -387,6 +441,8 @@ func TestSqlite3To(t *testing.T) {
 							DataSourceName: "file::memory:",
 							Table:          "TestTable",
 							BatchSize:      10000,
+							Mode:           fsql.InsertMode,
+							CreateTable:    true,
 						},
 					},
 				},
@@ -670,3 +726,108 @@ func TestToSQLite3_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestToSQLite3_Modes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dsn := filepath.Join(dir, "test.db")
+
+	write := func(mode string, data [][]interface{}) error {
+		d := executetest.NewDataset(executetest.RandomDatasetID())
+		c := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+		c.SetTriggerSpec(plan.DefaultTriggerSpec)
+
+		transformation, err := fsql.NewToSQLTransformation(d, dependenciestest.Default(), c, &fsql.ToSQLProcedureSpec{
+			Spec: &fsql.ToSQLOpSpec{
+				DriverName:     "sqlite3",
+				DataSourceName: dsn,
+				Table:          "temps_" + mode,
+				BatchSize:      10000,
+				Mode:           mode,
+				KeyColumns:     []string{"_time", "host"},
+				CreateTable:    true,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		tbl := executetest.MustCopyTable(&executetest.Table{
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "host", Type: flux.TString},
+				{Label: "_value", Type: flux.TFloat},
+			},
+			Data: data,
+		})
+		err = transformation.Process(executetest.RandomDatasetID(), tbl)
+		transformation.Finish(executetest.RandomDatasetID(), err)
+		return err
+	}
+	read := func(t *testing.T, mode string) [][]interface{} {
+		t.Helper()
+		db, err := sql.Open("sqlite3", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		rows, err := db.Query("SELECT host, _value FROM temps_" + mode + " ORDER BY host")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+
+		var got [][]interface{}
+		for rows.Next() {
+			var (
+				host  string
+				value float64
+			)
+			if err := rows.Scan(&host, &value); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, []interface{}{host, value})
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	first := [][]interface{}{
+		{execute.Time(10), "a", 1.0},
+		{execute.Time(10), "b", 2.0},
+	}
+	second := [][]interface{}{
+		{execute.Time(10), "b", 3.0},
+		{execute.Time(10), "c", 4.0},
+	}
+	want := [][]interface{}{{"a", 1.0}, {"b", 3.0}, {"c", 4.0}}
+
+	for _, mode := range []string{fsql.UpsertMode, fsql.ReplaceMode} {
+		mode := mode
+		t.Run(mode, func(t *testing.T) {
+			if err := write(mode, first); err != nil {
+				t.Fatal(err)
+			}
+			if err := write(mode, second); err != nil {
+				t.Fatal(err)
+			}
+			if got := read(t, mode); !cmp.Equal(want, got) {
+				t.Fatalf("unexpected rows -want/+got\n\n%s\n\n", cmp.Diff(want, got))
+			}
+		})
+	}
+
+	// Inserting a row with the same key columns violates the primary key.
+	t.Run(fsql.InsertMode, func(t *testing.T) {
+		if err := write(fsql.InsertMode, first); err != nil {
+			t.Fatal(err)
+		}
+		if err := write(fsql.InsertMode, second); err == nil {
+			t.Fatal("expected a unique constraint error")
+		}
+	})
+}
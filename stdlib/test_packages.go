@@ -4,7 +4,9 @@ package stdlib
 
 import (
 	ast "github.com/influxdata/flux/ast"
+	array "github.com/influxdata/flux/stdlib/array"
 	date "github.com/influxdata/flux/stdlib/date"
+	dict "github.com/influxdata/flux/stdlib/dict"
 	experimental "github.com/influxdata/flux/stdlib/experimental"
 	aggregate "github.com/influxdata/flux/stdlib/experimental/aggregate"
 	geo "github.com/influxdata/flux/stdlib/experimental/geo"
@@ -14,6 +16,7 @@ import (
 	secrets "github.com/influxdata/flux/stdlib/influxdata/influxdb/secrets"
 	v1 "github.com/influxdata/flux/stdlib/influxdata/influxdb/v1"
 	promql "github.com/influxdata/flux/stdlib/internal/promql"
+	parquet "github.com/influxdata/flux/stdlib/parquet"
 	planner "github.com/influxdata/flux/stdlib/planner"
 	regexp "github.com/influxdata/flux/stdlib/regexp"
 	strings "github.com/influxdata/flux/stdlib/strings"
@@ -29,7 +32,9 @@ import (
 
 var FluxTestPackages = func() []*ast.Package {
 	var pkgs []*ast.Package
+	pkgs = append(pkgs, array.FluxTestPackages...)
 	pkgs = append(pkgs, date.FluxTestPackages...)
+	pkgs = append(pkgs, dict.FluxTestPackages...)
 	pkgs = append(pkgs, experimental.FluxTestPackages...)
 	pkgs = append(pkgs, aggregate.FluxTestPackages...)
 	pkgs = append(pkgs, geo.FluxTestPackages...)
@@ -39,6 +44,7 @@ var FluxTestPackages = func() []*ast.Package {
 	pkgs = append(pkgs, secrets.FluxTestPackages...)
 	pkgs = append(pkgs, v1.FluxTestPackages...)
 	pkgs = append(pkgs, promql.FluxTestPackages...)
+	pkgs = append(pkgs, parquet.FluxTestPackages...)
 	pkgs = append(pkgs, planner.FluxTestPackages...)
 	pkgs = append(pkgs, regexp.FluxTestPackages...)
 	pkgs = append(pkgs, strings.FluxTestPackages...)
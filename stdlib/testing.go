@@ -17,33 +17,58 @@ func TestingBenchmarkCalls(pkg *ast.Package) *ast.File {
 	return genCalls(pkg, "benchmark")
 }
 
+// TestingRunCall constructs an ast.File that calls testing.run for a single test case.
+func TestingRunCall(tc *ast.TestStatement) *ast.File {
+	return genCall(tc, "run")
+}
+
+// TestingInspectCall constructs an ast.File that calls testing.inspect for a single test case.
+func TestingInspectCall(tc *ast.TestStatement) *ast.File {
+	return genCall(tc, "inspect")
+}
+
 func genCalls(pkg *ast.Package, fn string) *ast.File {
+	callFile := newCallFile()
+	visitor := testStmtVisitor{
+		fn: func(tc *ast.TestStatement) {
+			callFile.Body = append(callFile.Body, callStatement(tc, fn))
+		},
+	}
+	ast.Walk(visitor, pkg)
+	return callFile
+}
+
+func genCall(tc *ast.TestStatement, fn string) *ast.File {
+	callFile := newCallFile()
+	callFile.Body = append(callFile.Body, callStatement(tc, fn))
+	return callFile
+}
+
+func newCallFile() *ast.File {
 	callFile := new(ast.File)
 	callFile.Imports = []*ast.ImportDeclaration{{
 		Path: &ast.StringLiteral{Value: "testing"},
 	}}
-	visitor := testStmtVisitor{
-		fn: func(tc *ast.TestStatement) {
-			callFile.Body = append(callFile.Body, &ast.ExpressionStatement{
-				Expression: &ast.CallExpression{
-					Callee: &ast.MemberExpression{
-						Object:   &ast.Identifier{Name: "testing"},
-						Property: &ast.StringLiteral{Value: fn},
-					},
-					Arguments: []ast.Expression{
-						&ast.ObjectExpression{
-							Properties: []*ast.Property{{
-								Key:   &ast.Identifier{Name: "case"},
-								Value: tc.Assignment.ID,
-							}},
-						},
-					},
+	return callFile
+}
+
+func callStatement(tc *ast.TestStatement, fn string) ast.Statement {
+	return &ast.ExpressionStatement{
+		Expression: &ast.CallExpression{
+			Callee: &ast.MemberExpression{
+				Object:   &ast.Identifier{Name: "testing"},
+				Property: &ast.StringLiteral{Value: fn},
+			},
+			Arguments: []ast.Expression{
+				&ast.ObjectExpression{
+					Properties: []*ast.Property{{
+						Key:   &ast.Identifier{Name: "case"},
+						Value: tc.Assignment.ID,
+					}},
 				},
-			})
+			},
 		},
 	}
-	ast.Walk(visitor, pkg)
-	return callFile
 }
 
 type testStmtVisitor struct {
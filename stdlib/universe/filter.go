@@ -83,7 +83,6 @@ func (s *FilterOpSpec) Kind() flux.OperationKind {
 }
 
 type FilterProcedureSpec struct {
-	plan.DefaultCost
 	Fn              interpreter.ResolvedFunction
 	KeepEmptyTables bool
 }
@@ -115,11 +114,23 @@ func (s *FilterProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// Cost estimates that the filter keeps a fraction of the rows
+// since the selectivity of the predicate is not known.
+func (s *FilterProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, in.Scale(plan.DefaultSelectivity)
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *FilterProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
 }
 
+// IsPartitionable implements plan.PartitionableProcedureSpec
+func (s *FilterProcedureSpec) IsPartitionable() bool {
+	return true
+}
+
 func createFilterTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*FilterProcedureSpec)
 	if !ok {
@@ -303,6 +314,10 @@ func (t *filterTransformation) filterTable(fn *execute.RowPredicatePreparedFn, i
 }
 
 func (t *filterTransformation) filter(fn *execute.RowPredicatePreparedFn, cr flux.ColReader, record values.Object, indices []int) (*arrowmem.Buffer, error) {
+	if fn.IsVectorized() {
+		return t.filterVector(fn, cr)
+	}
+
 	cols, l := cr.Cols(), cr.Len()
 	bitset := arrowmem.NewResizableBuffer(t.alloc)
 	bitset.Resize(l)
@@ -321,6 +336,23 @@ func (t *filterTransformation) filter(fn *execute.RowPredicatePreparedFn, cr flu
 	return bitset, nil
 }
 
+// filterVector evaluates the predicate over the whole columns of cr.
+func (t *filterTransformation) filterVector(fn *execute.RowPredicatePreparedFn, cr flux.ColReader) (*arrowmem.Buffer, error) {
+	vs, err := fn.EvalVector(cr, t.alloc)
+	if err != nil {
+		return nil, errors.Wrap(err, codes.Inherit, "failed to evaluate filter function")
+	}
+	defer vs.Release()
+
+	l := cr.Len()
+	bitset := arrowmem.NewResizableBuffer(t.alloc)
+	bitset.Resize(l)
+	for i := 0; i < l; i++ {
+		bitutil.SetBitTo(bitset.Buf(), i, vs.IsValid(i) && vs.Value(i))
+	}
+	return bitset, nil
+}
+
 func (t *filterTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
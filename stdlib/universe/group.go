@@ -93,7 +93,6 @@ func (s *GroupOpSpec) Kind() flux.OperationKind {
 }
 
 type GroupProcedureSpec struct {
-	plan.DefaultCost
 	GroupMode flux.GroupMode
 	GroupKeys []string
 }
@@ -130,6 +129,14 @@ func (s *GroupProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+func (s *GroupProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	out := plan.SumStatistics(inStats)
+	if s.GroupMode == flux.GroupModeBy && len(s.GroupKeys) == 0 {
+		out.GroupCardinality = 1
+	}
+	return plan.Cost{CPU: out.Cardinality}, out
+}
+
 func createGroupTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*GroupProcedureSpec)
 	if !ok {
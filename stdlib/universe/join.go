@@ -1,6 +1,7 @@
 package universe
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"sort"
@@ -9,6 +10,7 @@ import (
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/spill"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/memory"
@@ -28,11 +30,15 @@ func init() {
 	//TODO(nathanielc): Allow for other types of join implementations
 	plan.RegisterProcedureSpec(MergeJoinKind, newMergeJoinProcedure, JoinKind)
 	execute.RegisterTransformation(MergeJoinKind, createMergeJoinTransformation)
+	plan.RegisterPhysicalRules(JoinReorderRule{})
 }
 
 // All supported join types in Flux
 var methods = map[string]bool{
 	"inner": true,
+	"left":  true,
+	"right": true,
+	"full":  true,
 }
 
 // JoinOpSpec specifies a particular join operation
@@ -160,9 +166,9 @@ func (s *JoinOpSpec) Kind() flux.OperationKind {
 }
 
 type MergeJoinProcedureSpec struct {
-	plan.DefaultCost
 	TableNames []string `json:"table_names"`
 	On         []string `json:"keys"`
+	Method     string   `json:"method"`
 }
 
 func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -189,6 +195,7 @@ func newMergeJoinProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.
 	return &MergeJoinProcedureSpec{
 		On:         on,
 		TableNames: tableNames,
+		Method:     spec.Method,
 	}, nil
 }
 
@@ -201,9 +208,68 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.On = make([]string, len(s.On))
 	copy(ns.On, s.On)
 
+	ns.TableNames = make([]string, len(s.TableNames))
+	copy(ns.TableNames, s.TableNames)
+
+	ns.Method = s.Method
+
 	return ns
 }
 
+// Cost estimates the cost of buffering both sides of the join.
+// The join is assumed to match each row of the larger side once.
+func (s *MergeJoinProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	var out plan.Statistics
+	for _, in := range inStats {
+		if in.Cardinality > out.Cardinality {
+			out.Cardinality = in.Cardinality
+		}
+		if in.GroupCardinality > out.GroupCardinality {
+			out.GroupCardinality = in.GroupCardinality
+		}
+	}
+	if len(inStats) == 2 && len(s.TableNames) == 2 && inStats[0].Columns != nil && inStats[1].Columns != nil {
+		out.Columns = s.joinColumns(inStats[0].Columns, inStats[1].Columns)
+	}
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality, MEM: in.Cardinality}, out
+}
+
+// joinColumns returns the labels of the columns of the joined tables
+// when the tables of the inputs have the given columns.
+func (s *MergeJoinProcedureSpec) joinColumns(left, right []string) []string {
+	on := make(map[string]bool, len(s.On))
+	for _, c := range s.On {
+		on[c] = true
+	}
+	shared := make(map[string]bool)
+	for _, c := range left {
+		for _, rc := range right {
+			if c == rc {
+				shared[c] = true
+				break
+			}
+		}
+	}
+	added := make(map[string]bool, len(left)+len(right))
+	columns := make([]string, 0, len(left)+len(right))
+	for i, cols := range [][]string{left, right} {
+		for _, c := range cols {
+			label := renameColumn(tableCol{table: s.TableNames[i], col: c}, shared, on)
+			if !added[label] {
+				added[label] = true
+				columns = append(columns, label)
+			}
+		}
+	}
+	return columns
+}
+
+// isInner reports whether the join is an inner join.
+func (s *MergeJoinProcedureSpec) isInner() bool {
+	return s.Method == "" || s.Method == "inner"
+}
+
 func createMergeJoinTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*MergeJoinProcedureSpec)
 	if !ok {
@@ -221,6 +287,11 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 	}
 
 	cache := NewMergeJoinCache(a.Allocator(), parents, tableNames, s.On)
+	store, err := spill.NewStore(a.Context(), a.Allocator())
+	if err != nil {
+		return nil, nil, err
+	}
+	cache.SetSpillStore(store)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -255,6 +326,9 @@ func NewMergeJoinTransformation(d execute.Dataset, cache *MergeJoinCache, spec *
 	for _, id := range parents {
 		t.parentState[id] = new(mergeJoinParentState)
 	}
+	if spec.Method != "" {
+		cache.method = spec.Method
+	}
 	return t
 }
 
@@ -275,9 +349,11 @@ func (t *mergeJoinTransformation) Process(id execute.DatasetID, tbl flux.Table)
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	// If a table is missing any of the "on" columns, then it won't be part of the output:
+	// If a table is missing any of the "on" columns, then it won't be joined:
 	//   - A missing column is treated as a null value
 	//   - Null values are not considered as equal to each other in joins
+	// The rows of such a table are only part of the output if it comes from
+	// the preserved side of an outer join, with the missing columns set to null.
 	numOnCols := 0
 	for _, c := range tbl.Cols() {
 		if t.cache.on[c.Label] {
@@ -285,9 +361,12 @@ func (t *mergeJoinTransformation) Process(id execute.DatasetID, tbl flux.Table)
 		}
 	}
 	if numOnCols < len(t.cache.on) {
-		// Discard this table
-		tbl.Done()
-		return nil
+		if !t.cache.isPreserved(id) {
+			// Discard this table
+			tbl.Done()
+			return nil
+		}
+		return t.cache.insertUnjoinable(id, tbl)
 	}
 
 	if err := t.cache.insertIntoBuffer(id, tbl); err != nil {
@@ -350,6 +429,12 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 	}
 
 	if finished {
+		defer t.cache.closeSpillStore()
+		if t.err == nil && t.cache.isOuterJoin() {
+			// Materialize the output before finishing the dataset
+			// so that any error producing it is reported downstream.
+			t.err = t.cache.materialize()
+		}
 		t.d.Finish(t.err)
 	}
 }
@@ -357,20 +442,30 @@ func (t *mergeJoinTransformation) Finish(id execute.DatasetID, err error) {
 // MergeJoinCache implements execute.DataCache
 // This is where the all the tables to be joined are stored.
 //
-// buffers:         Buffers to hold the tables for each incoming stream.
+// The buffers hold the tables for each incoming stream.
+//
+// The postJoinKeys are the post-join group keys for all joined tables.
+// These group keys are constructed and stored as soon as a table is
+// consumed by the join operator, but prior to actually joining the data.
 //
-// postJoinKeys:    The post-join group keys for all joined tables.
-//                  These group keys are constructed and stored as soon
-//                  as a table is consumed by the join operator, but prior
-//                  to actually joining the data.
+// The reverseLookup maps each output group key that is stored to its
+// corresponding pre-join group keys. These pre-join group keys are then
+// used to retrieve their corresponding tables from the buffers.
 //
-// reverseLookup:   Each output group key that is stored is mapped to its
-//                  corresponding pre-join group keys. These pre-join group
-//                  keys are then used to retrieve their corresponding
-//                  tables from the buffers.
+// The tables map stores all output tables once they are materialized
+// and before they are sent to downstream operators.
 //
-// tables:          All output tables are materialized and stored in this
-//                  map before being sent to downstream operators.
+// The method is the join method. For the outer methods (left, right and
+// full) the rows of the preserved input(s) that do not join with any row
+// of the opposing input are emitted with the opposing input's columns set
+// to null. Since those rows are only known once both inputs have finished,
+// outer joins materialize all of their output tables at once when the
+// cache is flushed. The first error that occurs while doing so is kept in
+// err and returned for every table that is requested afterwards.
+//
+// When spilling is enabled for the query, the tables held in the buffers
+// are written to spill files whenever the memory allocation limit is
+// reached and are read back when they are joined.
 type MergeJoinCache struct {
 	leftID  execute.DatasetID
 	rightID execute.DatasetID
@@ -393,50 +488,135 @@ type MergeJoinCache struct {
 	tables      map[flux.GroupKey]flux.Table
 	alloc       *memory.Allocator
 	triggerSpec plan.TriggerSpec
+
+	method       string
+	materialized bool
+	err          error
+
+	spill *spill.Store
 }
 
 type streamBuffer struct {
-	data     map[flux.GroupKey]*execute.ColListTableBuilder
-	consumed map[values.Value]int
-	ready    map[values.Value]bool
-	stale    map[flux.GroupKey]bool
-	last     values.Value
-	alloc    *memory.Allocator
+	data    map[flux.GroupKey]*execute.ColListTableBuilder
+	spilled map[flux.GroupKey]*spill.Segment
+	// unjoinable holds the keys of the tables that are missing one of
+	// the on columns. They are only kept for the unmatched rows of an
+	// outer join and are never joined with the opposing input.
+	unjoinable map[flux.GroupKey]bool
+	matched    map[flux.GroupKey][]bool
+	consumed   map[values.Value]int
+	ready      map[values.Value]bool
+	stale      map[flux.GroupKey]bool
+	last       values.Value
+	alloc      *memory.Allocator
+	store      *spill.Store
 }
 
 func newStreamBuffer(alloc *memory.Allocator) *streamBuffer {
 	return &streamBuffer{
-		data:     make(map[flux.GroupKey]*execute.ColListTableBuilder),
-		consumed: make(map[values.Value]int),
-		ready:    make(map[values.Value]bool),
-		stale:    make(map[flux.GroupKey]bool),
-		alloc:    alloc,
+		data:       make(map[flux.GroupKey]*execute.ColListTableBuilder),
+		spilled:    make(map[flux.GroupKey]*spill.Segment),
+		matched:    make(map[flux.GroupKey][]bool),
+		unjoinable: make(map[flux.GroupKey]bool),
+		consumed:   make(map[values.Value]int),
+		ready:      make(map[values.Value]bool),
+		stale:      make(map[flux.GroupKey]bool),
+		alloc:      alloc,
 	}
 }
 
-func (buf *streamBuffer) table(key flux.GroupKey) *execute.ColListTableBuilder {
-	return buf.data[key]
+// table returns the table builder for the given key. If the table
+// was spilled, it is read back into memory first. This returns nil
+// if the buffer does not contain a table with the key.
+func (buf *streamBuffer) table(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+	if builder, ok := buf.data[key]; ok {
+		return builder, nil
+	}
+	seg, ok := buf.spilled[key]
+	if !ok {
+		return nil, nil
+	}
+
+	tbl, err := buf.store.Read(seg)
+	if err != nil {
+		return nil, err
+	}
+	builder := execute.NewColListTableBuilder(key, buf.alloc)
+	if err := buf.build(builder, func() error {
+		if err := execute.AddTableCols(tbl, builder); err != nil {
+			return err
+		}
+		return execute.AppendTable(tbl, builder)
+	}); err != nil {
+		return nil, err
+	}
+
+	buf.data[key] = builder
+	delete(buf.spilled, key)
+	return builder, buf.store.Remove(seg)
 }
 
-func (buf *streamBuffer) insert(table flux.Table) error {
-	// Construct a new table builder with same schema as input table
-	builder := execute.NewColListTableBuilder(table.Key(), buf.alloc)
-	// this will only error if we try to add a duplicate column to the builder.
-	// since this is a new table, that won't happen.
-	if err := execute.AddTableCols(table, builder); err != nil {
-		return err
+// build calls f to fill the table builder. If f fails, including
+// by reaching the memory allocation limit, the builder is released.
+func (buf *streamBuffer) build(builder *execute.ColListTableBuilder, f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			builder.Release()
+			panic(r)
+		}
+		if err != nil {
+			builder.Release()
+		}
+	}()
+	return f()
+}
+
+// matches returns the set of rows of the table with the given key
+// that have been joined with at least one row of the opposing stream.
+func (buf *streamBuffer) matches(key flux.GroupKey) []bool {
+	m, ok := buf.matched[key]
+	if !ok {
+		m = make([]bool, buf.len(key))
+		buf.matched[key] = m
 	}
+	return m
+}
 
-	// Append the input table to this builder, safe to ignore errors
-	if err := execute.AppendTable(table, builder); err != nil {
+// len returns the number of rows in the table with the given key.
+func (buf *streamBuffer) len(key flux.GroupKey) int {
+	if seg, ok := buf.spilled[key]; ok {
+		return seg.Len()
+	}
+	return buf.data[key].NRows()
+}
+
+// insert copies the buffers of a table into a new table builder and adds it
+// to the buffer. The copy is made by calling guard, which may call it again
+// if it reaches the memory allocation limit.
+func (buf *streamBuffer) insert(key flux.GroupKey, cols []flux.ColMeta, do func(func(flux.ColReader) error) error, guard func(f func() error, inUse ...*execute.ColListTableBuilder) error) error {
+	var builder *execute.ColListTableBuilder
+	if err := guard(func() error {
+		// Construct a new table builder with same schema as input table
+		builder = execute.NewColListTableBuilder(key, buf.alloc)
+		return buf.build(builder, func() error {
+			for _, c := range cols {
+				if _, err := builder.AddCol(c); err != nil {
+					return err
+				}
+			}
+			return do(func(cr flux.ColReader) error {
+				return execute.AppendCols(cr, builder)
+			})
+		})
+	}); err != nil {
 		return err
 	}
 
 	// Insert this table into the buffer
-	buf.data[table.Key()] = builder
+	buf.data[key] = builder
 
-	if len(table.Key().Cols()) > 0 {
-		leftKeyValue := table.Key().Value(0)
+	if len(key.Cols()) > 0 {
+		leftKeyValue := key.Value(0)
 
 		tablesConsumed := buf.consumed[leftKeyValue]
 		buf.consumed[leftKeyValue] = tablesConsumed + 1
@@ -464,9 +644,35 @@ func (buf *streamBuffer) expire(key flux.GroupKey) {
 
 func (buf *streamBuffer) evict(key flux.GroupKey) {
 	if builder, ok := buf.data[key]; ok {
-		builder.ClearData()
+		builder.Release()
 		delete(buf.data, key)
+		delete(buf.matched, key)
+	}
+	if seg, ok := buf.spilled[key]; ok {
+		// Failing to remove the file only leaves it behind in the spill directory.
+		_ = buf.store.Remove(seg)
+		delete(buf.spilled, key)
+		delete(buf.matched, key)
 	}
+	delete(buf.unjoinable, key)
+}
+
+// spill writes the tables of the buffer to spill files and
+// releases their memory. Tables that are in use are kept in memory.
+func (buf *streamBuffer) spill(inUse map[*execute.ColListTableBuilder]bool) error {
+	for key, builder := range buf.data {
+		if inUse[builder] {
+			continue
+		}
+		seg, err := buf.store.WriteBuilder(builder)
+		if err != nil {
+			return err
+		}
+		builder.Release()
+		delete(buf.data, key)
+		buf.spilled[key] = seg
+	}
+	return nil
 }
 
 func (buf *streamBuffer) clear(f func(flux.GroupKey) bool) {
@@ -479,7 +685,16 @@ func (buf *streamBuffer) clear(f func(flux.GroupKey) bool) {
 }
 
 func (buf *streamBuffer) iterate(f func(flux.GroupKey)) {
+	// Tables may move between memory and the spill files
+	// while iterating so the keys are collected first.
+	keys := make([]flux.GroupKey, 0, len(buf.data)+len(buf.spilled))
 	for key := range buf.data {
+		keys = append(keys, key)
+	}
+	for key := range buf.spilled {
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
 		f(key)
 	}
 }
@@ -546,11 +761,19 @@ func NewMergeJoinCache(alloc *memory.Allocator, datasetIDs []execute.DatasetID,
 		postJoinKeys:  execute.NewGroupLookup(),
 		tables:        make(map[flux.GroupKey]flux.Table),
 		alloc:         alloc,
+		method:        "inner",
 	}
 }
 
 // Table joins the two tables associated with a single output group key and returns the resulting table
 func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if table, ok := c.tables[key]; ok {
+		return table, nil
+	}
+
 	preJoinGroupKeys, ok := c.reverseLookup[key]
 
 	if !ok {
@@ -559,17 +782,23 @@ func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
 
 	if _, ok := c.tables[key]; !ok {
 
-		left := c.buffers[c.leftID].table(preJoinGroupKeys.left)
+		left, err := c.table(c.leftID, preJoinGroupKeys.left)
+		if err != nil {
+			return nil, err
+		}
 		if left == nil {
 			return nil, errors.Newf(codes.FailedPrecondition, "no table in left join buffer with key: %v", key)
 		}
 
-		right := c.buffers[c.rightID].table(preJoinGroupKeys.right)
+		right, err := c.table(c.rightID, preJoinGroupKeys.right, left)
+		if err != nil {
+			return nil, err
+		}
 		if right == nil {
 			return nil, errors.Newf(codes.FailedPrecondition, "no table in right join buffer with key: %v", key)
 		}
 
-		table, err := c.join(left, right)
+		table, err := c.joinTables(left, right)
 		if err != nil {
 			return nil, errors.Newf(codes.NotFound, "table with group key (%v) could not be fetched", key)
 		}
@@ -581,6 +810,18 @@ func (c *MergeJoinCache) Table(key flux.GroupKey) (flux.Table, error) {
 
 // ForEach iterates over each table in the output stream
 func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
+	if c.isOuterJoin() {
+		if err := c.materialize(); err != nil {
+			return
+		}
+		c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
+			if _, ok := c.tables[key]; ok {
+				f(key)
+			}
+		})
+		return
+	}
+
 	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
 
 		if _, ok := c.tables[key]; !ok {
@@ -590,10 +831,18 @@ func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
 			leftKey := preJoinGroupKeys.left
 			rightKey := preJoinGroupKeys.right
 
-			leftBuilder := c.buffers[c.leftID].table(leftKey)
-			rightBuilder := c.buffers[c.rightID].table(rightKey)
+			leftBuilder, err := c.table(c.leftID, leftKey)
+			if err != nil {
+				c.DiscardTable(key)
+				return
+			}
+			rightBuilder, err := c.table(c.rightID, rightKey, leftBuilder)
+			if err != nil {
+				c.DiscardTable(key)
+				return
+			}
 
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.joinTables(leftBuilder, rightBuilder)
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
 				return
@@ -607,6 +856,13 @@ func (c *MergeJoinCache) ForEach(f func(flux.GroupKey)) {
 
 // ForEachWithContext iterates over each table in the output stream
 func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigger, execute.TableContext)) {
+	// The unmatched rows of an outer join are not known until both
+	// input streams have finished, so none of its tables can be
+	// triggered early. They are all produced by ForEach instead.
+	if c.isOuterJoin() {
+		return
+	}
+
 	trigger := execute.NewTriggerFromSpec(c.triggerSpec)
 
 	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
@@ -616,12 +872,20 @@ func (c *MergeJoinCache) ForEachWithContext(f func(flux.GroupKey, execute.Trigge
 		leftKey := preJoinGroupKeys.left
 		rightKey := preJoinGroupKeys.right
 
-		leftBuilder := c.buffers[c.leftID].table(leftKey)
-		rightBuilder := c.buffers[c.rightID].table(rightKey)
+		leftBuilder, err := c.table(c.leftID, leftKey)
+		if err != nil {
+			c.DiscardTable(key)
+			return
+		}
+		rightBuilder, err := c.table(c.rightID, rightKey, leftBuilder)
+		if err != nil {
+			c.DiscardTable(key)
+			return
+		}
 
 		if _, ok := c.tables[key]; !ok {
 
-			table, err := c.join(leftBuilder, rightBuilder)
+			table, err := c.joinTables(leftBuilder, rightBuilder)
 
 			if err != nil || table.Empty() {
 				c.DiscardTable(key)
@@ -658,7 +922,12 @@ func (c *MergeJoinCache) ExpireTable(key flux.GroupKey) {
 	delete(c.tables, key)
 
 	// Clear any stale data
-	preJoinGroupKeys := c.reverseLookup[key]
+	preJoinGroupKeys, ok := c.reverseLookup[key]
+	if !ok {
+		// The table only holds the unmatched rows of an outer join
+		// so there is no pre-join data associated with it.
+		return
+	}
 
 	leftBuffer := c.buffers[c.leftID]
 	rightBuffer := c.buffers[c.rightID]
@@ -694,36 +963,59 @@ func (c *MergeJoinCache) canEvictTables() bool {
 		leftKey[0].Label == rightKey[0].Label && c.on[leftKey[0].Label]
 }
 
-// insertIntoBuffer adds the rows of an incoming table to one of the Join's internal buffers
-func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table) error {
-	// Initialize schema if tbl is first from its stream
-	if _, ok := c.schemas[id]; !ok {
-
-		c.schemas[id] = schema{
-			key:     make([]flux.ColMeta, len(tbl.Key().Cols())),
-			columns: make([]flux.ColMeta, len(tbl.Cols())),
-		}
+// initSchema sets the schema of the input stream with the given id
+// from the group key and columns of one of its tables.
+func (c *MergeJoinCache) initSchema(id execute.DatasetID, key flux.GroupKey, cols []flux.ColMeta) {
+	c.schemas[id] = schema{
+		key:     make([]flux.ColMeta, len(key.Cols())),
+		columns: make([]flux.ColMeta, len(cols)),
+	}
 
-		copy(c.schemas[id].columns, tbl.Cols())
+	copy(c.schemas[id].columns, cols)
 
-		intersection := make(map[string]bool, len(c.intersection))
+	intersection := make(map[string]bool, len(c.intersection))
 
-		for j, column := range tbl.Key().Cols() {
-			c.schemas[id].key[j] = column
+	for j, column := range key.Cols() {
+		c.schemas[id].key[j] = column
 
-			if c.intersection[column.Label] {
-				intersection[column.Label] = true
-			}
+		if c.intersection[column.Label] {
+			intersection[column.Label] = true
 		}
+	}
 
-		c.intersection = intersection
+	c.intersection = intersection
+}
+
+// insertUnjoinable adds a table that is missing one of the on columns to
+// the buffer of the preserved input with the given id. Its rows are emitted
+// with the missing columns set to null when the outer join is materialized.
+// The table does not determine the schema of its input since the schema
+// of the tables that do have the on columns is the one the output is built from.
+func (c *MergeJoinCache) insertUnjoinable(id execute.DatasetID, tbl flux.Table) error {
+	buf := c.buffers[id]
+	if err := buf.insert(tbl.Key(), tbl.Cols(), tbl.Do, c.guard); err != nil {
+		return err
+	}
+	buf.unjoinable[tbl.Key()] = true
+	return nil
+}
+
+// insertIntoBuffer adds the rows of an incoming table to one of the Join's internal buffers
+func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table) error {
+	// Initialize schema if tbl is first from its stream
+	if _, ok := c.schemas[id]; !ok {
+		c.initSchema(id, tbl.Key(), tbl.Cols())
 	}
 
 	// Optimization: if any group key columns overlap join key columns,
 	// and there are any nulls in those columns, we can discard this table,
-	// since null != null for joining purposes.
+	// since null != null for joining purposes. The rows of a table from
+	// the preserved side of an outer join must be kept regardless.
 	k := tbl.Key()
 	for j, col := range k.Cols() {
+		if c.isPreserved(id) {
+			break
+		}
 		if c.on[col.Label] {
 			if k.IsNull(j) {
 				// Discard the table and return.  Note: we need to iterate over the
@@ -735,7 +1027,86 @@ func (c *MergeJoinCache) insertIntoBuffer(id execute.DatasetID, tbl flux.Table)
 			}
 		}
 	}
-	return c.buffers[id].insert(tbl)
+
+	if c.spill == nil {
+		return c.buffers[id].insert(tbl.Key(), tbl.Cols(), tbl.Do, c.guard)
+	}
+
+	// Retain the buffers of the table so that copying
+	// them can be retried after spilling other tables.
+	var crs []flux.ColReader
+	defer func() {
+		for _, cr := range crs {
+			cr.Release()
+		}
+	}()
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		cr.Retain()
+		crs = append(crs, cr)
+		return nil
+	}); err != nil {
+		return err
+	}
+	do := func(f func(flux.ColReader) error) error {
+		for _, cr := range crs {
+			if err := f(cr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return c.buffers[id].insert(tbl.Key(), tbl.Cols(), do, c.guard)
+}
+
+// SetSpillStore enables spilling the tables of the buffers to the store.
+func (c *MergeJoinCache) SetSpillStore(store *spill.Store) {
+	c.spill = store
+	for _, buf := range c.buffers {
+		buf.store = store
+	}
+}
+
+// closeSpillStore removes any remaining spill files.
+func (c *MergeJoinCache) closeSpillStore() {
+	if c.spill != nil {
+		_ = c.spill.Close()
+	}
+}
+
+// guard calls f and, if spilling is enabled and f reaches the memory
+// allocation limit, spills the buffered tables that are not in use
+// and calls f again. If f reaches the limit again, it panics as it
+// would have without spilling.
+func (c *MergeJoinCache) guard(f func() error, inUse ...*execute.ColListTableBuilder) error {
+	if c.spill == nil {
+		return f()
+	}
+	err := spill.Guard(f)
+	if !memory.IsLimitExceeded(err) {
+		return err
+	}
+	keep := make(map[*execute.ColListTableBuilder]bool, len(inUse))
+	for _, b := range inUse {
+		keep[b] = true
+	}
+	for _, buf := range c.buffers {
+		if err := buf.spill(keep); err != nil {
+			return err
+		}
+	}
+	return f()
+}
+
+// table returns the table with the given key from the buffer of the
+// input stream with the given id. The tables that are in use are kept
+// in memory if other tables need to be spilled to read this one back.
+func (c *MergeJoinCache) table(id execute.DatasetID, key flux.GroupKey, inUse ...*execute.ColListTableBuilder) (*execute.ColListTableBuilder, error) {
+	var builder *execute.ColListTableBuilder
+	err := c.guard(func() (err error) {
+		builder, err = c.buffers[id].table(key)
+		return err
+	}, inUse...)
+	return builder, err
 }
 
 // registerKey takes a group key from the input stream associated with id and joins
@@ -749,6 +1120,9 @@ func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
 	case c.leftID:
 
 		c.buffers[c.rightID].iterate(func(groupKey flux.GroupKey) {
+			if c.buffers[c.rightID].unjoinable[groupKey] {
+				return
+			}
 
 			keys := map[execute.DatasetID]flux.GroupKey{
 				c.leftID:  key,
@@ -773,6 +1147,9 @@ func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
 	case c.rightID:
 
 		c.buffers[c.leftID].iterate(func(groupKey flux.GroupKey) {
+			if c.buffers[c.leftID].unjoinable[groupKey] {
+				return
+			}
 
 			keys := map[execute.DatasetID]flux.GroupKey{
 				c.leftID:  groupKey,
@@ -797,7 +1174,8 @@ func (c *MergeJoinCache) registerKey(id execute.DatasetID, key flux.GroupKey) {
 }
 
 func (c *MergeJoinCache) isBufferEmpty(id execute.DatasetID) bool {
-	return len(c.buffers[id].data) == 0
+	buf := c.buffers[id]
+	return len(buf.data) == 0 && len(buf.spilled) == 0
 }
 
 func (c *MergeJoinCache) postJoinSchemaBuilt() bool {
@@ -855,17 +1233,18 @@ func equalJoinkeys(left, right flux.GroupKey) bool {
 	return true
 }
 
-func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
-	// Sort input tables
-	left.Sort(c.order, false)
-	right.Sort(c.order, false)
-
-	var leftSet, rightSet subset
-	var leftKey, rightKey flux.GroupKey
-
-	leftSet, leftKey = c.advance(leftSet.Stop, left)
-	rightSet, rightKey = c.advance(rightSet.Stop, right)
+// joinTables joins the left and right tables while keeping them
+// in memory if other tables need to be spilled to do so.
+func (c *MergeJoinCache) joinTables(left, right *execute.ColListTableBuilder) (flux.Table, error) {
+	var table flux.Table
+	err := c.guard(func() (err error) {
+		table, err = c.join(left, right)
+		return err
+	}, left, right)
+	return table, err
+}
 
+func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Table, error) {
 	keys := map[execute.DatasetID]flux.GroupKey{
 		c.leftID:  left.Key(),
 		c.rightID: right.Key(),
@@ -873,14 +1252,50 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 
 	// Instantiate a builder for the output table
 	groupKey := c.postJoinGroupKey(keys)
-	builder := execute.NewColListTableBuilder(groupKey, c.alloc)
+	builder, err := c.newOutputBuilder(groupKey)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, column := range c.schema.columns {
-		_, err := builder.AddCol(column)
-		if err != nil {
-			return nil, err
-		}
+	// The output table is a copy of the builder
+	// so the builder's memory can be released.
+	defer builder.Release()
+
+	if err := c.mergeJoin(left, right, builder, nil, nil); err != nil {
+		return nil, err
+	}
+	return builder.Table()
+}
+
+// mergeJoin performs a sort merge join of the left and right tables and
+// appends the joined rows to builder. If leftMatched or rightMatched are
+// non-nil, the rows of the corresponding (sorted) input that were joined
+// are marked in them.
+func (c *MergeJoinCache) mergeJoin(left, right, builder *execute.ColListTableBuilder, leftMatched, rightMatched []bool) error {
+	// Sort input tables
+	left.Sort(c.order, false)
+	right.Sort(c.order, false)
+
+	// Read the join keys from a single copy of each input
+	// rather than copying the inputs each time they advance.
+	leftTable, err := left.Table()
+	if err != nil {
+		return err
+	}
+	defer leftTable.Done()
+	rightTable, err := right.Table()
+	if err != nil {
+		return err
 	}
+	defer rightTable.Done()
+	leftReader := leftTable.(flux.ColReader)
+	rightReader := rightTable.(flux.ColReader)
+
+	var leftSet, rightSet subset
+	var leftKey, rightKey flux.GroupKey
+
+	leftSet, leftKey = c.advance(leftSet.Stop, leftReader)
+	rightSet, rightKey = c.advance(rightSet.Stop, rightReader)
 
 	// Perform sort merge join
 	for !leftSet.Empty() && !rightSet.Empty() {
@@ -888,49 +1303,259 @@ func (c *MergeJoinCache) join(left, right *execute.ColListTableBuilder) (flux.Ta
 
 			for l := leftSet.Start; l < leftSet.Stop; l++ {
 				for r := rightSet.Start; r < rightSet.Stop; r++ {
-
-					leftRecord := left.GetRow(l)
-					rightRecord := right.GetRow(r)
-
-					leftRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.leftID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-						_ = builder.AppendValue(newColumnIdx, columnVal)
-					})
-
-					rightRecord.Range(func(columnName string, columnVal values.Value) {
-						column := tableCol{
-							table: c.names[c.rightID],
-							col:   columnName,
-						}
-						newColumn := c.schemaMap[column]
-						newColumnIdx := c.colIndex[newColumn]
-
-						// No need to append value if column is part of the join key.
-						// Because value already appended when iterating over left record.
-						if !c.on[newColumn.Label] {
-							_ = builder.AppendValue(newColumnIdx, columnVal)
-						}
-					})
+					if err := c.appendRow(builder, left.GetRow(l), right.GetRow(r)); err != nil {
+						return err
+					}
+				}
+				if leftMatched != nil {
+					leftMatched[l] = true
 				}
 			}
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
+			if rightMatched != nil {
+				for r := rightSet.Start; r < rightSet.Stop; r++ {
+					rightMatched[r] = true
+				}
+			}
+			leftSet, leftKey = c.advance(leftSet.Stop, leftReader)
+			rightSet, rightKey = c.advance(rightSet.Stop, rightReader)
 		} else if leftKey.Less(rightKey) {
-			leftSet, leftKey = c.advance(leftSet.Stop, left)
+			leftSet, leftKey = c.advance(leftSet.Stop, leftReader)
 		} else {
-			rightSet, rightKey = c.advance(rightSet.Stop, right)
+			rightSet, rightKey = c.advance(rightSet.Stop, rightReader)
 		}
 	}
+	return nil
+}
 
-	return builder.Table()
+// newOutputBuilder creates a table builder with the post-join schema.
+func (c *MergeJoinCache) newOutputBuilder(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+	builder := execute.NewColListTableBuilder(key, c.alloc)
+	for _, column := range c.schema.columns {
+		if _, err := builder.AddCol(column); err != nil {
+			return nil, err
+		}
+	}
+	return builder, nil
+}
+
+// appendRow appends a single output row to builder. Either record may be nil,
+// in which case the columns that originate from that side of the join are
+// filled with nulls unless the other side provides them (as is the case for
+// the join columns).
+func (c *MergeJoinCache) appendRow(builder *execute.ColListTableBuilder, leftRecord, rightRecord values.Object) error {
+	row := make([]values.Value, len(c.schema.columns))
+	fill := func(id execute.DatasetID, record values.Object) {
+		if record == nil {
+			return
+		}
+		record.Range(func(columnName string, columnVal values.Value) {
+			column := tableCol{
+				table: c.names[id],
+				col:   columnName,
+			}
+			newColumn, ok := c.schemaMap[column]
+			if !ok {
+				return
+			}
+			// No need to append value if column is part of the join key
+			// and its value was already provided by the left record.
+			if newColumnIdx := c.colIndex[newColumn]; row[newColumnIdx] == nil {
+				row[newColumnIdx] = columnVal
+			}
+		})
+	}
+	fill(c.leftID, leftRecord)
+	fill(c.rightID, rightRecord)
+
+	for j, v := range row {
+		if v == nil {
+			if err := builder.AppendNil(j); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := builder.AppendValue(j, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isOuterJoin reports whether the cache performs one of the outer join methods.
+func (c *MergeJoinCache) isOuterJoin() bool {
+	return c.method != "inner"
+}
+
+// isPreserved reports whether every row of the input stream associated
+// with id must be part of the output, whether it is joined or not.
+func (c *MergeJoinCache) isPreserved(id execute.DatasetID) bool {
+	switch c.method {
+	case "left":
+		return id == c.leftID
+	case "right":
+		return id == c.rightID
+	case "full":
+		return true
+	default:
+		return false
+	}
+}
+
+// materialize produces every output table of an outer join the first time
+// it is called. It returns the first error that occurred while doing so.
+func (c *MergeJoinCache) materialize() error {
+	if !c.materialized {
+		c.materialized = true
+		c.err = c.materializeOuterJoin()
+	}
+	return c.err
+}
+
+// materializeOuterJoin produces every output table of an outer join.
+// The joined rows of each pair of matching input tables are produced as
+// they are for an inner join. The rows of the preserved input(s) that were
+// not joined with any row are then added to the table of the group key they
+// would have had if the opposing input's group key columns were null.
+func (c *MergeJoinCache) materializeOuterJoin() (err error) {
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if _, ok := c.schemas[id]; ok {
+			continue
+		}
+		// The input only produced tables that are missing one of the
+		// on columns, so its schema is taken from one of those.
+		buf := c.buffers[id]
+		for key := range buf.unjoinable {
+			if b, ok := buf.data[key]; ok {
+				c.initSchema(id, key, b.Cols())
+			} else {
+				c.initSchema(id, key, buf.spilled[key].Cols())
+			}
+			break
+		}
+	}
+
+	if !c.postJoinSchemaBuilt() {
+		// One of the inputs never produced a table. The output
+		// schema is made up solely of the other input's columns.
+		c.buildPostJoinSchema()
+	}
+
+	builders := execute.NewGroupLookup()
+	defer func() {
+		// The builders are copied into tables once all of
+		// them have been filled so they are always released.
+		builders.Range(func(key flux.GroupKey, value interface{}) {
+			value.(*execute.ColListTableBuilder).Release()
+		})
+	}()
+	builder := func(key flux.GroupKey) (*execute.ColListTableBuilder, error) {
+		if b, ok := builders.Lookup(key); ok {
+			return b.(*execute.ColListTableBuilder), nil
+		}
+		b, err := c.newOutputBuilder(key)
+		if err != nil {
+			return nil, err
+		}
+		builders.Set(key, b)
+		return b, nil
+	}
+
+	leftBuffer := c.buffers[c.leftID]
+	rightBuffer := c.buffers[c.rightID]
+
+	c.postJoinKeys.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		preJoinGroupKeys := c.reverseLookup[key]
+		var left, right *execute.ColListTableBuilder
+		if left, err = c.table(c.leftID, preJoinGroupKeys.left); err != nil {
+			return
+		}
+		if right, err = c.table(c.rightID, preJoinGroupKeys.right, left); err != nil {
+			return
+		}
+		if left == nil || right == nil {
+			return
+		}
+
+		var b *execute.ColListTableBuilder
+		if b, err = builder(key); err != nil {
+			return
+		}
+		err = c.mergeJoin(left, right, b,
+			leftBuffer.matches(preJoinGroupKeys.left),
+			rightBuffer.matches(preJoinGroupKeys.right),
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if !c.isPreserved(id) {
+			continue
+		}
+		buf := c.buffers[id]
+		buf.iterate(func(key flux.GroupKey) {
+			if err != nil {
+				return
+			}
+			var table *execute.ColListTableBuilder
+			if table, err = c.table(id, key); err != nil {
+				return
+			}
+			matched := buf.matches(key)
+
+			var b *execute.ColListTableBuilder
+			for i := 0; i < table.NRows(); i++ {
+				if matched[i] {
+					continue
+				}
+				if b == nil {
+					outputKey := c.postJoinGroupKey(map[execute.DatasetID]flux.GroupKey{id: key})
+					if b, err = builder(outputKey); err != nil {
+						return
+					}
+				}
+				record := table.GetRow(i)
+				if id == c.leftID {
+					err = c.appendRow(b, record, nil)
+				} else {
+					err = c.appendRow(b, nil, record)
+				}
+				if err != nil {
+					return
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var empty struct{}
+	builders.Range(func(key flux.GroupKey, value interface{}) {
+		if err != nil {
+			return
+		}
+		b := value.(*execute.ColListTableBuilder)
+		if b.NRows() == 0 {
+			return
+		}
+		var table flux.Table
+		if table, err = b.Table(); err != nil {
+			return
+		}
+		c.tables[key] = table
+		c.postJoinKeys.Set(key, empty)
+	})
+	return err
 }
 
-// postJoinGroupKey produces a new group key value from a left and a right group key value
+// postJoinGroupKey produces a new group key value from a left and a right group key value.
+// If the group key of either input is absent, as is the case for the unmatched rows
+// of an outer join, that input's group key columns are included with null values.
 func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupKey) flux.GroupKey {
 	key := groupKey{
 		cols: make([]flux.ColMeta, 0, len(keys)*5),
@@ -947,7 +1572,12 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 				col:   column.Label,
 			}
 
-			colMeta := c.schemaMap[tableAndColumn]
+			colMeta, ok := c.schemaMap[tableAndColumn]
+			if !ok {
+				// The column is not part of the output, as is the case
+				// for tables of an outer join that were never joined.
+				continue
+			}
 
 			if !added[colMeta.Label] {
 				key.cols = append(key.cols, colMeta)
@@ -958,6 +1588,27 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 		}
 	}
 
+	for _, id := range []execute.DatasetID{c.leftID, c.rightID} {
+		if _, ok := keys[id]; ok {
+			continue
+		}
+		for _, column := range c.schemas[id].key {
+			tableAndColumn := tableCol{
+				table: c.names[id],
+				col:   column.Label,
+			}
+
+			colMeta := c.schemaMap[tableAndColumn]
+
+			if !added[colMeta.Label] {
+				key.cols = append(key.cols, colMeta)
+				key.vals = append(key.vals, values.NewNull(flux.SemanticType(colMeta.Type)))
+			}
+
+			added[colMeta.Label] = true
+		}
+	}
+
 	// Table columns are always sorted so need
 	// to sort the group key for consistency
 	sort.Sort(key)
@@ -965,9 +1616,7 @@ func (c *MergeJoinCache) postJoinGroupKey(keys map[execute.DatasetID]flux.GroupK
 }
 
 // advance advances the row pointer of a sorted table that is being joined
-func (c *MergeJoinCache) advance(offset int, table *execute.ColListTableBuilder) (subset, flux.GroupKey) {
-	tbl, _ := table.Table()
-	cr := tbl.(flux.ColReader)
+func (c *MergeJoinCache) advance(offset int, cr flux.ColReader) (subset, flux.GroupKey) {
 	if n := cr.Len(); n == offset {
 		return subset{Start: n, Stop: n}, nil
 	}
@@ -1080,3 +1729,180 @@ func (k groupKey) Swap(i, j int) {
 	k.cols[i], k.cols[j] = k.cols[j], k.cols[i]
 	k.vals[i], k.vals[j] = k.vals[j], k.vals[i]
 }
+
+// JoinReorderRule reorders two inner joins on the same columns so that
+// the pair of their three inputs that is the cheapest to join is joined
+// first. The joins
+//
+//	join(tables: {ab: join(tables: {a: A, b: B}, on: on), c: C}, on: on)
+//
+// are rewritten as one of
+//
+//	join(tables: {ab: join(tables: {a: A, c: C}, on: on), b: B}, on: on)
+//	join(tables: {ab: join(tables: {b: B, c: C}, on: on), a: A}, on: on)
+//
+// A join renames the columns that are part of both of its inputs and that
+// are not joined on, so the labels of the columns depend on the order of the
+// joins when the inputs have columns in common. The joins are only reordered
+// when the columns of the three inputs are known and no two inputs have a
+// column in common other than the columns that they are joined on.
+// The rows that have the same values in the joined columns may be
+// produced in a different order.
+type JoinReorderRule struct{}
+
+func (JoinReorderRule) Name() string {
+	return "JoinReorderRule"
+}
+
+func (JoinReorderRule) Pattern() plan.Pattern {
+	return plan.Pat(MergeJoinKind, plan.Any(), plan.Any())
+}
+
+// CostBased implements plan.CostBasedRule.
+func (JoinReorderRule) CostBased() {}
+
+func (JoinReorderRule) Rewrite(ctx context.Context, node plan.Node) (plan.Node, bool, error) {
+	outer, ok := node.(*plan.PhysicalPlanNode)
+	if !ok {
+		return node, false, nil
+	}
+	outerSpec := outer.Spec.(*MergeJoinProcedureSpec)
+	if !outerSpec.isInner() || len(outerSpec.TableNames) != 2 {
+		return node, false, nil
+	}
+	for i, pred := range outer.Predecessors() {
+		inner, ok := pred.(*plan.PhysicalPlanNode)
+		if !ok || inner.Kind() != MergeJoinKind || len(inner.Successors()) != 1 {
+			continue
+		}
+		innerSpec := inner.Spec.(*MergeJoinProcedureSpec)
+		if !innerSpec.isInner() || len(innerSpec.TableNames) != 2 || !equalStrings(innerSpec.On, outerSpec.On) {
+			continue
+		}
+		if newNode, ok := reorderJoins(outer, inner, i); ok {
+			return newNode, true, nil
+		}
+	}
+	return node, false, nil
+}
+
+// joinInput is an input of two joins that are reordered.
+type joinInput struct {
+	node plan.Node
+	// join is the join that the input is an input of.
+	join  plan.Node
+	name  string
+	stats plan.Statistics
+}
+
+// reorderJoins reorders the inputs of the outer join and the inner join
+// that is the predecessor of the outer join at index i. It returns the new
+// outer join and true if the inputs are joined in a cheaper order.
+func reorderJoins(outer, inner *plan.PhysicalPlanNode, i int) (plan.Node, bool) {
+	outerSpec := outer.Spec.(*MergeJoinProcedureSpec)
+	innerSpec := inner.Spec.(*MergeJoinProcedureSpec)
+
+	inputs := []joinInput{
+		{node: inner.Predecessors()[0], join: inner, name: innerSpec.TableNames[0]},
+		{node: inner.Predecessors()[1], join: inner, name: innerSpec.TableNames[1]},
+		{node: outer.Predecessors()[1-i], join: outer, name: outerSpec.TableNames[1-i]},
+	}
+	for j := range inputs {
+		_, inputs[j].stats = plan.EstimateCost(inputs[j].node)
+		if inputs[j].stats.Columns == nil {
+			return nil, false
+		}
+		for _, in := range inputs[:j] {
+			if in.node == inputs[j].node || sharesColumns(in.stats.Columns, inputs[j].stats.Columns, outerSpec.On) {
+				return nil, false
+			}
+		}
+	}
+
+	// cost returns the cost of joining the first two
+	// inputs and joining their result with the third.
+	cost := func(x, y, z joinInput) plan.Cost {
+		spec := &MergeJoinProcedureSpec{TableNames: []string{x.name, y.name}, On: outerSpec.On}
+		innerCost, stats := spec.Cost([]plan.Statistics{x.stats, y.stats})
+		outerCost, _ := outerSpec.Cost([]plan.Statistics{stats, z.stats})
+		return plan.Add(innerCost, outerCost)
+	}
+	a, b, c := inputs[0], inputs[1], inputs[2]
+	best := cost(a, b, c)
+	var order []joinInput
+	for _, o := range [][]joinInput{{a, c, b}, {b, c, a}} {
+		if oc := cost(o[0], o[1], o[2]); oc.Less(best) {
+			best, order = oc, o
+		}
+	}
+	if order == nil {
+		return nil, false
+	}
+
+	newInner := plan.CreatePhysicalNode(inner.ID(), &MergeJoinProcedureSpec{
+		TableNames: []string{order[0].name, order[1].name},
+		On:         append([]string(nil), innerSpec.On...),
+		Method:     innerSpec.Method,
+	})
+	newInner.TriggerSpec = inner.TriggerSpec
+	names := make([]string, 2)
+	names[i], names[1-i] = outerSpec.TableNames[i], order[2].name
+	newOuter := plan.CreatePhysicalNode(outer.ID(), &MergeJoinProcedureSpec{
+		TableNames: names,
+		On:         append([]string(nil), outerSpec.On...),
+		Method:     outerSpec.Method,
+	})
+	newOuter.TriggerSpec = outer.TriggerSpec
+
+	replaceSuccessor(order[0].node, order[0].join, newInner)
+	replaceSuccessor(order[1].node, order[1].join, newInner)
+	replaceSuccessor(order[2].node, order[2].join, newOuter)
+	newInner.AddPredecessors(order[0].node, order[1].node)
+	newInner.AddSuccessors(newOuter)
+	preds := make([]plan.Node, 2)
+	preds[i], preds[1-i] = newInner, order[2].node
+	newOuter.AddPredecessors(preds...)
+
+	inner.ClearPredecessors()
+	inner.ClearSuccessors()
+	outer.ClearPredecessors()
+	return newOuter, true
+}
+
+// replaceSuccessor replaces the successor old of the node with new.
+func replaceSuccessor(node, old, new plan.Node) {
+	for i, succ := range node.Successors() {
+		if succ == old {
+			node.Successors()[i] = new
+			return
+		}
+	}
+}
+
+// sharesColumns reports whether the columns have a label in common
+// that is not one of the columns that are joined on.
+func sharesColumns(left, right, on []string) bool {
+	for _, l := range left {
+		if execute.ContainsStr(on, l) {
+			continue
+		}
+		for _, r := range right {
+			if l == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,16 +1,24 @@
 package universe_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"sort"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/execute/spill"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
+	"github.com/influxdata/flux/plan/plantest"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
@@ -153,6 +161,83 @@ func TestJoin_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "full outer join",
+			Raw: `
+				a = from(bucket:"flux") |> range(start:-1h)
+				b = from(bucket:"flux") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["_time"], method: "full")
+			`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: influxdb.NameOrID{Name: "flux"},
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -1 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "from2",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: influxdb.NameOrID{Name: "flux"},
+						},
+					},
+					{
+						ID: "range3",
+						Spec: &universe.RangeOpSpec{
+							Start: flux.Time{
+								Relative:   -1 * time.Hour,
+								IsRelative: true,
+							},
+							Stop: flux.Time{
+								IsRelative: true,
+							},
+							TimeColumn:  "_time",
+							StartColumn: "_start",
+							StopColumn:  "_stop",
+						},
+					},
+					{
+						ID: "join4",
+						Spec: &universe.JoinOpSpec{
+							On:         []string{"_time"},
+							TableNames: map[flux.OperationID]string{"range1": "a", "range3": "b"},
+							Method:     "full",
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "range1"},
+					{Parent: "from2", Child: "range3"},
+					{Parent: "range1", Child: "join4"},
+					{Parent: "range3", Child: "join4"},
+				},
+			},
+		},
+		{
+			Name: "invalid method",
+			Raw: `
+				a = from(bucket:"flux") |> range(start:-1h)
+				b = from(bucket:"flux") |> range(start:-1h)
+				join(tables:{a:a,b:b}, on:["_time"], method: "outer")
+			`,
+			WantErr: true,
+		},
 		{
 			Name: "no 'on' parameter",
 			Raw: `
@@ -1473,6 +1558,359 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "simple left outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(1), 1.0, nil},
+					},
+				},
+			},
+		},
+		{
+			name: "simple right outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "right",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(4), nil, 40.0},
+					},
+				},
+			},
+		},
+		{
+			name: "simple full outer",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 20.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(2), 2.0, 20.0},
+						{execute.Time(3), 3.0, 30.0},
+						{execute.Time(1), 1.0, nil},
+						{execute.Time(4), nil, 40.0},
+					},
+				},
+			},
+		},
+		{
+			name: "full outer with unmatched group keys",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "tag"},
+				TableNames: tableNames,
+				Method:     "full",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0, "b"},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 30.0, "c"},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "a"},
+						{execute.Time(2), 2.0, nil, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 3.0, nil, "b"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), nil, 30.0, "c"},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with empty right input",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+					},
+				},
+			},
+		},
+		{
+			name: "left outer with table missing an on column",
+			spec: &universe.MergeJoinProcedureSpec{
+				On:         []string{"_time", "tag"},
+				TableNames: tableNames,
+				Method:     "left",
+			},
+			data0: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, "a"},
+						{execute.Time(2), 2.0, "a"},
+					},
+				},
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0},
+					},
+				},
+			},
+			data1: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0, "a"},
+					},
+				},
+				{
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value", Type: flux.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 50.0},
+					},
+				},
+			},
+			want: []*executetest.Table{
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0, 10.0, "a"},
+						{execute.Time(2), 2.0, nil, "a"},
+					},
+				},
+				{
+					KeyCols: []string{"tag"},
+					ColMeta: []flux.ColMeta{
+						{Label: "_time", Type: flux.TTime},
+						{Label: "_value_a", Type: flux.TFloat},
+						{Label: "_value_b", Type: flux.TFloat},
+						{Label: "tag", Type: flux.TString},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 5.0, nil, nil},
+					},
+				},
+			},
+		},
 		{
 			name: "two failures",
 			spec: &universe.MergeJoinProcedureSpec{
@@ -1561,3 +1999,327 @@ func TestMergeJoin_Process(t *testing.T) {
 		})
 	}
 }
+
+// joinOutput collects the tables that are produced by a join.
+type joinOutput struct {
+	tables []*executetest.Table
+	err    error
+}
+
+func (o *joinOutput) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
+	return nil
+}
+
+func (o *joinOutput) Process(id execute.DatasetID, tbl flux.Table) error {
+	t, err := executetest.ConvertTable(tbl)
+	if err != nil {
+		return err
+	}
+	o.tables = append(o.tables, t)
+	return nil
+}
+
+func (o *joinOutput) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return nil
+}
+
+func (o *joinOutput) UpdateProcessingTime(id execute.DatasetID, t execute.Time) error {
+	return nil
+}
+
+func (o *joinOutput) Finish(id execute.DatasetID, err error) {
+	o.err = err
+}
+
+func TestMergeJoin_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-join-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+
+	// The limit is enough to join any single pair of tables,
+	// but not to buffer all of the tables from both inputs.
+	limit := int64(400000)
+	alloc := &memory.Allocator{Limit: &limit}
+
+	const (
+		numTables = 16
+		numRows   = 1000
+	)
+	var data0, data1, want []*executetest.Table
+	for i := 0; i < numTables; i++ {
+		tag := fmt.Sprintf("t%d", i)
+		a := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+		}
+		b := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+		}
+		w := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value_a", Type: flux.TFloat},
+				{Label: "_value_b", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+		}
+		for j := 0; j < numRows; j++ {
+			a.Data = append(a.Data, []interface{}{execute.Time(j), float64(j), tag})
+			b.Data = append(b.Data, []interface{}{execute.Time(j), float64(-j), tag})
+			w.Data = append(w.Data, []interface{}{execute.Time(j), float64(j), float64(-j), tag})
+		}
+		data0 = append(data0, a)
+		data1 = append(data1, b)
+		want = append(want, w)
+	}
+
+	spec := &universe.MergeJoinProcedureSpec{
+		TableNames: []string{"a", "b"},
+		On:         []string{"_time", "t0"},
+	}
+	parents := []execute.DatasetID{
+		executetest.RandomDatasetID(),
+		executetest.RandomDatasetID(),
+	}
+	tableNames := map[execute.DatasetID]string{
+		parents[0]: "a",
+		parents[1]: "b",
+	}
+
+	store, err := spill.NewStore(ctx, alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := universe.NewMergeJoinCache(alloc, parents, tableNames, spec.On)
+	c.SetSpillStore(store)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+	output := &joinOutput{}
+	d.AddTransformation(output)
+	jt := universe.NewMergeJoinTransformation(d, c, spec, parents, tableNames)
+
+	for _, tbl := range data0 {
+		if err := jt.Process(parents[0], tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tbl := range data1 {
+		if err := jt.Process(parents[1], tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	jt.Finish(parents[0], nil)
+	jt.Finish(parents[1], nil)
+	if output.err != nil {
+		t.Fatal(output.err)
+	}
+
+	got := output.tables
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+
+	sort.Sort(executetest.SortedTables(got))
+	sort.Sort(executetest.SortedTables(want))
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected spill files to be removed, found %d files", len(files))
+	}
+}
+
+func TestMergeJoin_OuterJoinError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-join-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+
+	// The limit is only enough to hold one of the tables so
+	// the left table is spilled when the right one is buffered.
+	limit := int64(50000)
+	alloc := &memory.Allocator{Limit: &limit}
+
+	spec := &universe.MergeJoinProcedureSpec{
+		TableNames: []string{"a", "b"},
+		On:         []string{"_time", "t0"},
+		Method:     "full",
+	}
+	parents := []execute.DatasetID{
+		executetest.RandomDatasetID(),
+		executetest.RandomDatasetID(),
+	}
+	tableNames := map[execute.DatasetID]string{
+		parents[0]: "a",
+		parents[1]: "b",
+	}
+
+	store, err := spill.NewStore(ctx, alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := universe.NewMergeJoinCache(alloc, parents, tableNames, spec.On)
+	c.SetSpillStore(store)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+	output := &joinOutput{}
+	d.AddTransformation(output)
+	jt := universe.NewMergeJoinTransformation(d, c, spec, parents, tableNames)
+
+	for _, id := range parents {
+		tbl := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+		}
+		for j := 0; j < 1000; j++ {
+			tbl.Data = append(tbl.Data, []interface{}{execute.Time(j), float64(j), "a"})
+		}
+		if err := jt.Process(id, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Removing the spill files makes it impossible to produce the
+	// output. The error must be reported instead of dropping tables.
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+	jt.Finish(parents[0], nil)
+	jt.Finish(parents[1], nil)
+	if output.err == nil {
+		t.Fatal("expected an error when the outer join cannot be materialized")
+	}
+	if len(output.tables) != 0 {
+		t.Errorf("expected no tables, got %d", len(output.tables))
+	}
+	if _, err := c.Table(execute.NewGroupKey(nil, nil)); err == nil {
+		t.Error("expected the cache to return the error")
+	}
+}
+
+// statsSourceSpec is a source that produces data with the given statistics.
+type statsSourceSpec struct {
+	Stats plan.Statistics
+}
+
+func (s *statsSourceSpec) Kind() plan.ProcedureKind {
+	return "stats-source"
+}
+
+func (s *statsSourceSpec) Copy() plan.ProcedureSpec {
+	ns := *s
+	return &ns
+}
+
+func (s *statsSourceSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	return plan.Cost{}, s.Stats
+}
+
+func TestJoinReorderRule(t *testing.T) {
+	source := func(rows int64, columns ...string) *statsSourceSpec {
+		return &statsSourceSpec{
+			Stats: plan.Statistics{Cardinality: rows, GroupCardinality: 1, Columns: columns},
+		}
+	}
+	join := func(method string, names ...string) *universe.MergeJoinProcedureSpec {
+		return &universe.MergeJoinProcedureSpec{
+			TableNames: names,
+			On:         []string{"_time"},
+			Method:     method,
+		}
+	}
+	// joins creates a plan that joins the first two sources
+	// and joins the result with the third source.
+	joins := func(inner, outer *universe.MergeJoinProcedureSpec, sources ...*statsSourceSpec) *plantest.PlanSpec {
+		return &plantest.PlanSpec{
+			Nodes: []plan.Node{
+				plan.CreatePhysicalNode("a", sources[0]),
+				plan.CreatePhysicalNode("b", sources[1]),
+				plan.CreatePhysicalNode("join0", inner),
+				plan.CreatePhysicalNode("c", sources[2]),
+				plan.CreatePhysicalNode("join1", outer),
+			},
+			Edges: [][2]int{{0, 2}, {1, 2}, {2, 4}, {3, 4}},
+		}
+	}
+
+	large := source(1000, "_time", "a")
+	b := source(10, "_time", "b")
+	c := source(10, "_time", "c")
+	tests := []plantest.RuleTestCase{
+		{
+			Name:   "join small inputs first",
+			Rules:  []plan.Rule{universe.JoinReorderRule{}},
+			Before: joins(join("inner", "a", "b"), join("inner", "ab", "c"), large, b, c),
+			After: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					plan.CreatePhysicalNode("b", b),
+					plan.CreatePhysicalNode("c", c),
+					plan.CreatePhysicalNode("join0", join("inner", "b", "c")),
+					plan.CreatePhysicalNode("a", large),
+					plan.CreatePhysicalNode("join1", join("inner", "ab", "a")),
+				},
+				Edges: [][2]int{{0, 2}, {1, 2}, {2, 4}, {3, 4}},
+			},
+		},
+		{
+			Name:     "cheapest order",
+			Rules:    []plan.Rule{universe.JoinReorderRule{}},
+			Before:   joins(join("inner", "b", "c"), join("inner", "bc", "a"), b, c, large),
+			NoChange: true,
+		},
+		{
+			Name:     "shared columns",
+			Rules:    []plan.Rule{universe.JoinReorderRule{}},
+			Before:   joins(join("inner", "a", "b"), join("inner", "ab", "c"), source(1000, "_time", "a", "c"), b, c),
+			NoChange: true,
+		},
+		{
+			Name:     "unknown columns",
+			Rules:    []plan.Rule{universe.JoinReorderRule{}},
+			Before:   joins(join("inner", "a", "b"), join("inner", "ab", "c"), source(1000), b, c),
+			NoChange: true,
+		},
+		{
+			Name:     "outer join",
+			Rules:    []plan.Rule{universe.JoinReorderRule{}},
+			Before:   joins(join("left", "a", "b"), join("inner", "ab", "c"), large, b, c),
+			NoChange: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			plantest.PhysicalRuleTestHelper(t, &tc)
+		})
+	}
+}
@@ -63,7 +63,6 @@ func (s *LimitOpSpec) Kind() flux.OperationKind {
 }
 
 type LimitProcedureSpec struct {
-	plan.DefaultCost
 	N      int64 `json:"n"`
 	Offset int64 `json:"offset"`
 }
@@ -88,11 +87,28 @@ func (s *LimitProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+func (s *LimitProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	out := plan.SumStatistics(inStats)
+	groups := out.GroupCardinality
+	if groups == 0 {
+		groups = 1
+	}
+	if n := s.N * groups; n < out.Cardinality {
+		out.Cardinality = n
+	}
+	return plan.Cost{CPU: out.Cardinality}, out
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *LimitProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
 }
 
+// IsPartitionable implements plan.PartitionableProcedureSpec
+func (s *LimitProcedureSpec) IsPartitionable() bool {
+	return true
+}
+
 func createLimitTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*LimitProcedureSpec)
 	if !ok {
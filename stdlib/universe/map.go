@@ -9,7 +9,9 @@ import (
 	"github.com/influxdata/flux/compiler"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/interpreter"
+	"github.com/influxdata/flux/memory"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/runtime"
 	"github.com/influxdata/flux/semantic"
@@ -108,6 +110,7 @@ func createMapTransformation(id execute.DatasetID, mode execute.AccumulationMode
 	if err != nil {
 		return nil, nil, err
 	}
+	t.alloc = a.Allocator()
 	return t, d, nil
 }
 
@@ -117,6 +120,7 @@ type mapTransformation struct {
 	ctx      context.Context
 	fn       *execute.RowMapFn
 	mergeKey bool
+	alloc    *memory.Allocator
 }
 
 func NewMapTransformation(ctx context.Context, spec *MapProcedureSpec, d execute.Dataset, cache execute.TableBuilderCache) (*mapTransformation, error) {
@@ -127,6 +131,7 @@ func NewMapTransformation(ctx context.Context, spec *MapProcedureSpec, d execute
 		fn:       fn,
 		ctx:      ctx,
 		mergeKey: spec.MergeKey,
+		alloc:    &memory.Allocator{},
 	}, nil
 }
 
@@ -149,6 +154,12 @@ func (t *mapTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 
 	var on map[string]bool
 	return tbl.Do(func(cr flux.ColReader) error {
+		if fn.IsVectorized() && cr.Len() > 0 {
+			if ok, err := t.processVector(fn, tbl.Key(), cr, &on); err != nil || ok {
+				return err
+			}
+		}
+
 		l := cr.Len()
 		for i := 0; i < l; i++ {
 			m, err := fn.Eval(t.ctx, i, cr)
@@ -192,6 +203,87 @@ func (t *mapTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	})
 }
 
+// processVector evaluates the map function over the whole columns of cr.
+// The first row is evaluated on its own to determine the group key and
+// the schema of the output table in the same way as the other rows would be.
+// It returns false without processing any rows if the vectorized results
+// do not all belong to that table or do not match its schema.
+func (t *mapTransformation) processVector(fn *execute.RowMapPreparedFn, key flux.GroupKey, cr flux.ColReader, on *map[string]bool) (bool, error) {
+	m, err := fn.Eval(t.ctx, 0, cr)
+	if err != nil {
+		return false, errors.Wrap(err, codes.Inherit, "failed to evaluate map function")
+	}
+	if *on == nil {
+		if *on, err = t.groupOn(key, m.Type()); err != nil {
+			return false, err
+		}
+	}
+
+	vs, err := fn.EvalVector(cr, t.alloc)
+	if err != nil {
+		return false, errors.Wrap(err, codes.Inherit, "failed to evaluate map function")
+	}
+	defer vs.Release()
+
+	// Every row must have the group key of the first row.
+	outKey := groupKeyForObject(0, cr, m, *on)
+	for j, c := range outKey.Cols() {
+		idx := execute.ColIdx(c.Label, vs.Cols())
+		if idx < 0 {
+			continue
+		}
+		if vs.Cols()[idx].Type != c.Type {
+			return false, nil
+		}
+		// A column that is passed through from the input is
+		// part of the group key of the input.
+		if cj := execute.ColIdx(c.Label, cr.Cols()); cj >= 0 && vs.Values[idx] == table.Values(cr, cj) {
+			continue
+		}
+		v := outKey.Value(j)
+		for i, l := 0, vs.Len(); i < l; i++ {
+			if !v.Equal(execute.ValueForRow(vs, i, idx)) {
+				return false, nil
+			}
+		}
+	}
+
+	builder, created := t.cache.TableBuilder(outKey)
+	if created {
+		if err := t.createSchema(fn, builder, m); err != nil {
+			return false, err
+		}
+	}
+
+	colMap := make([]int, len(builder.Cols()))
+	for j, c := range builder.Cols() {
+		idx := execute.ColIdx(c.Label, vs.Cols())
+		if idx >= 0 && vs.Cols()[idx].Type != c.Type {
+			return false, nil
+		}
+		colMap[j] = idx
+	}
+	for j, c := range builder.Cols() {
+		if idx := colMap[j]; idx >= 0 {
+			if err := execute.AppendCol(j, idx, vs, builder); err != nil {
+				return false, err
+			}
+			continue
+		}
+		idx := execute.ColIdx(c.Label, key.Cols())
+		if !t.mergeKey || idx < 0 {
+			// This should be unreachable
+			return false, errors.Newf(codes.Internal, "could not find value for column %q", c.Label)
+		}
+		for i, l := 0, vs.Len(); i < l; i++ {
+			if err := builder.AppendValue(j, key.Value(idx)); err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
 func (t *mapTransformation) groupOn(key flux.GroupKey, m semantic.MonoType) (map[string]bool, error) {
 	on := make(map[string]bool, len(key.Cols()))
 	for _, c := range key.Cols() {
@@ -12,6 +12,7 @@ import (
 	"github.com/influxdata/flux/arrow"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/spill"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/internal/execute/table"
 	"github.com/influxdata/flux/interpreter"
@@ -173,6 +174,17 @@ func createPivotTransformation(id execute.DatasetID, mode execute.AccumulationMo
 		return t, d, err
 	}
 
+	store, err := spill.NewStore(a.Context(), a.Allocator())
+	if err != nil {
+		return nil, nil, err
+	}
+	if store != nil {
+		cache := spill.NewCache(store, a.Allocator())
+		d := execute.NewDataset(id, mode, cache)
+		t := NewPivotTransformation(d, cache, s)
+		return t, d, nil
+	}
+
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewPivotTransformation(d, cache, s)
@@ -188,6 +200,9 @@ type pivotTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
 	spec  PivotProcedureSpec
+	// spill is set when the cache spills the pivoted
+	// tables to disk when the memory limit is reached.
+	spill *spill.Cache
 	// for each table, we need to store a map to keep track of which rows/columns have already been created.
 	colKeyMaps map[string]map[string]int
 	rowKeyMaps map[string]map[string]int
@@ -203,6 +218,7 @@ func NewPivotTransformation(d execute.Dataset, cache execute.TableBuilderCache,
 		rowKeyMaps: make(map[string]map[string]int),
 		nextRowCol: make(map[string]rowCol),
 	}
+	t.spill, _ = cache.(*spill.Cache)
 	return t
 }
 
@@ -265,7 +281,20 @@ func (t *pivotTransformation) Process(id execute.DatasetID, tbl flux.Table) erro
 	}
 
 	newGroupKey := execute.NewGroupKey(keyCols, keyValues)
+	if t.spill != nil {
+		if err := t.spill.Restore(newGroupKey); err != nil {
+			return err
+		}
+	}
 	builder, created := t.cache.TableBuilder(newGroupKey)
+	if t.spill != nil && t.spill.NearLimit() {
+		// Rows of the builder are updated in place so processing the
+		// table cannot be retried if it reaches the memory limit part
+		// way through. Spill the other tables ahead of time instead.
+		if err := t.spill.Spill(builder); err != nil {
+			return err
+		}
+	}
 	groupKeyString := newGroupKey.String()
 	if created {
 		for _, c := range cols {
@@ -417,18 +446,28 @@ func (t *pivotTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 func (t *pivotTransformation) Finish(id execute.DatasetID, err error) {
 
 	t.d.Finish(err)
+	if t.spill != nil {
+		_ = t.spill.Close()
+	}
 }
 
 // pivotTransformation2 is an optimized version of pivot.
 // It can only be used when there is a single row and column key
 // and it can only be used if the row key is sorted without
 // null values.
+//
+// The buffers of the input tables are retained until the transformation
+// finishes. When spilling is enabled, they are written to spill files
+// once the memory in use passes the spill threshold and each group is
+// read back when it is pivoted.
 type pivotTransformation2 struct {
 	d      *execute.PassthroughDataset
 	ctx    context.Context
 	alloc  *memory.Allocator
 	spec   PivotProcedureSpec
 	groups *execute.GroupLookup
+	// store is set when spilling is enabled for the query.
+	store *spill.Store
 
 	watermark  execute.Time
 	processing execute.Time
@@ -445,12 +484,17 @@ func newPivotTransformation2(ctx context.Context, spec PivotProcedureSpec, id ex
 	} else if !spec.isKeyColumn(spec.ColumnKey[0]) {
 		return nil, nil, errors.New(codes.Unimplemented, "column key must be part of the group key")
 	}
+	store, err := spill.NewStore(ctx, alloc)
+	if err != nil {
+		return nil, nil, err
+	}
 	t := &pivotTransformation2{
 		d:      execute.NewPassthroughDataset(id),
 		ctx:    ctx,
 		alloc:  alloc,
 		spec:   spec,
 		groups: execute.NewGroupLookup(),
+		store:  store,
 	}
 	return t, t.d, nil
 }
@@ -465,6 +509,14 @@ func (t *pivotTransformation2) Process(id execute.DatasetID, tbl flux.Table) err
 		return err
 	}
 
+	// The buffers of the table are retained so
+	// make room for them ahead of time.
+	if t.store != nil && t.store.NearLimit() {
+		if err := t.spill(); err != nil {
+			return err
+		}
+	}
+
 	// Compute the group key that this table belongs part of.
 	// This is calculated by taking the current group key and removing
 	// the column keys and the value column.
@@ -605,6 +657,38 @@ func (t *pivotTransformation2) getColumn(cr flux.ColReader, j int) array.Interfa
 	}
 }
 
+// spill writes the buffers of every group to spill files
+// and releases their memory.
+func (t *pivotTransformation2) spill() (err error) {
+	t.groups.Range(func(key flux.GroupKey, value interface{}) {
+		gr := value.(*pivotTableGroup)
+		for _, buf := range gr.buffers {
+			if err != nil {
+				return
+			}
+			err = buf.spill(t.store, key, gr.rowCol, t.spec.ValueColumn)
+		}
+	})
+	return err
+}
+
+// restore reads the spilled buffers of the group back into memory.
+// The buffers of the other groups are spilled first if the memory
+// in use has passed the spill threshold.
+func (t *pivotTransformation2) restore(gr *pivotTableGroup) error {
+	if t.store.NearLimit() {
+		if err := t.spill(); err != nil {
+			return err
+		}
+	}
+	for _, buf := range gr.buffers {
+		if err := buf.restore(t.store); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t *pivotTransformation2) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	t.watermark = mark
 	return nil
@@ -620,6 +704,9 @@ func (t *pivotTransformation2) Finish(id execute.DatasetID, err error) {
 	// Wrap this in a function so that we do not capture the err variable.
 	// https://play.golang.org/p/QXns3c8s76f
 	defer func() { t.d.Finish(err) }()
+	if t.store != nil {
+		defer func() { _ = t.store.Close() }()
+	}
 
 	t.groups.Range(func(key flux.GroupKey, value interface{}) {
 		if err != nil {
@@ -628,6 +715,11 @@ func (t *pivotTransformation2) Finish(id execute.DatasetID, err error) {
 
 		var tbl flux.Table
 		gr := value.(*pivotTableGroup)
+		if t.store != nil {
+			if err = t.restore(gr); err != nil {
+				return
+			}
+		}
 		tbl, err = gr.doPivot(key, t.alloc)
 		if err != nil {
 			return
@@ -650,6 +742,9 @@ type pivotTableBuffer struct {
 	keys      []array.Interface
 	valueType flux.ColType
 	values    []array.Interface
+	// segments hold the keys and values that were spilled.
+	// They precede the keys and values that are in memory.
+	segments []*spill.Segment
 }
 
 func (b *pivotTableBuffer) Insert(k, v array.Interface) {
@@ -666,6 +761,73 @@ func (b *pivotTableBuffer) Release() {
 	for _, v := range b.values {
 		v.Release()
 	}
+	b.keys, b.values = nil, nil
+}
+
+// spill writes the keys and values that are in memory to a spill file
+// as a table with the row key and value columns and releases them.
+func (b *pivotTableBuffer) spill(store *spill.Store, key flux.GroupKey, rowCol flux.ColMeta, valueColumn string) error {
+	if len(b.keys) == 0 {
+		return nil
+	}
+	cols := []flux.ColMeta{
+		rowCol,
+		{Label: valueColumn, Type: b.valueType},
+	}
+	buffers := make([]flux.ColReader, len(b.keys))
+	for i := range b.keys {
+		buffers[i] = &arrow.TableBuffer{
+			GroupKey: key,
+			Columns:  cols,
+			Values:   []array.Interface{b.keys[i], b.values[i]},
+		}
+	}
+	b.keys, b.values = nil, nil
+
+	// The table releases the keys and values once they are written.
+	seg, err := store.Write(&table.BufferedTable{
+		GroupKey: key,
+		Columns:  cols,
+		Buffers:  buffers,
+	})
+	if err != nil {
+		return err
+	}
+	b.segments = append(b.segments, seg)
+	return nil
+}
+
+// restore reads the spilled keys and values back in front
+// of the keys and values that are in memory.
+func (b *pivotTableBuffer) restore(store *spill.Store) error {
+	if len(b.segments) == 0 {
+		return nil
+	}
+	keys, values := b.keys, b.values
+	b.keys, b.values = nil, nil
+	defer func() {
+		b.keys = append(b.keys, keys...)
+		b.values = append(b.values, values...)
+	}()
+
+	for len(b.segments) > 0 {
+		seg := b.segments[0]
+		tbl, err := store.Read(seg)
+		if err != nil {
+			return err
+		}
+		if err := tbl.Do(func(cr flux.ColReader) error {
+			b.Insert(table.Values(cr, 0), table.Values(cr, 1))
+			return nil
+		}); err != nil {
+			return err
+		}
+		b.segments = b.segments[1:]
+		if err := store.Remove(seg); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type pivotTableGroup struct {
@@ -2,14 +2,22 @@ package universe_test
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/execute/spill"
 	"github.com/influxdata/flux/internal/gen"
 	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/influxdata/influxdb"
 	"github.com/influxdata/flux/stdlib/universe"
@@ -1443,3 +1451,238 @@ func benchmarkPivot(b *testing.B, n int) {
 		},
 	)
 }
+
+func TestPivot_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-pivot-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+
+	// The limit is enough to pivot any single table,
+	// but not to hold all of the pivoted tables at once.
+	limit := int64(300000)
+	alloc := &memory.Allocator{Limit: &limit}
+
+	const (
+		numTables = 16
+		numRows   = 1000
+	)
+	var as, bs, want []*executetest.Table
+	for i := 0; i < numTables; i++ {
+		tag := fmt.Sprintf("t%d", i)
+		newTable := func(field string, sign float64) *executetest.Table {
+			tbl := &executetest.Table{
+				KeyCols: []string{"_field", "t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_field", Type: flux.TString},
+					{Label: "t0", Type: flux.TString},
+				},
+			}
+			for j := 0; j < numRows; j++ {
+				tbl.Data = append(tbl.Data, []interface{}{execute.Time(j), sign * float64(j), field, tag})
+			}
+			return tbl
+		}
+		as = append(as, newTable("a", 1))
+		bs = append(bs, newTable("b", -1))
+
+		w := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "t0", Type: flux.TString},
+				{Label: "a", Type: flux.TFloat},
+				{Label: "b", Type: flux.TFloat},
+			},
+		}
+		for j := 0; j < numRows; j++ {
+			w.Data = append(w.Data, []interface{}{execute.Time(j), tag, float64(j), float64(-j)})
+		}
+		want = append(want, w)
+	}
+
+	store, err := spill.NewStore(ctx, alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := spill.NewCache(store, alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+	output := &joinOutput{}
+	d.AddTransformation(output)
+	pt := universe.NewPivotTransformation(d, c, &universe.PivotProcedureSpec{
+		RowKey:      []string{"_time"},
+		ColumnKey:   []string{"_field"},
+		ValueColumn: "_value",
+	})
+
+	// All of the tables are visited twice so the
+	// spilled tables must be read back to be updated.
+	parentID := executetest.RandomDatasetID()
+	for _, tbl := range append(as, bs...) {
+		if err := pt.Process(parentID, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pt.Finish(parentID, nil)
+	if output.err != nil {
+		t.Fatal(output.err)
+	}
+
+	got := output.tables
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+
+	sort.Sort(executetest.SortedTables(got))
+	sort.Sort(executetest.SortedTables(want))
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected spill files to be removed, found %d files", len(files))
+	}
+}
+
+func TestPivot2_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-pivot-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+
+	// The limit is enough to pivot any single group,
+	// but not to hold all of the input buffers at once.
+	limit := int64(300000)
+	alloc := &memory.Allocator{Limit: &limit}
+
+	const (
+		numTables = 16
+		numRows   = 1000
+	)
+	var as, bs, want []*executetest.Table
+	for i := 0; i < numTables; i++ {
+		tag := fmt.Sprintf("t%d", i)
+		newTable := func(field string, sign float64) *executetest.Table {
+			tbl := &executetest.Table{
+				KeyCols: []string{"_field", "t0"},
+				ColMeta: []flux.ColMeta{
+					{Label: "_time", Type: flux.TTime},
+					{Label: "_value", Type: flux.TFloat},
+					{Label: "_field", Type: flux.TString},
+					{Label: "t0", Type: flux.TString},
+				},
+			}
+			for j := 0; j < numRows; j++ {
+				tbl.Data = append(tbl.Data, []interface{}{execute.Time(j), sign * float64(j), field, tag})
+			}
+			tbl.Normalize()
+			return tbl
+		}
+		as = append(as, newTable("a", 1))
+		bs = append(bs, newTable("b", -1))
+
+		w := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "t0", Type: flux.TString},
+				{Label: "a", Type: flux.TFloat},
+				{Label: "b", Type: flux.TFloat},
+			},
+		}
+		for j := 0; j < numRows; j++ {
+			w.Data = append(w.Data, []interface{}{execute.Time(j), tag, float64(j), float64(-j)})
+		}
+		want = append(want, w)
+	}
+
+	spec := universe.PivotProcedureSpec{
+		RowKey:      []string{"_time"},
+		ColumnKey:   []string{"_field"},
+		ValueColumn: "_value",
+		IsSortedByFunc: func(cols []string, desc bool) bool {
+			return !desc && len(cols) == 1 && cols[0] == "_time"
+		},
+		IsKeyColumnFunc: func(label string) bool {
+			return label == "_field" || label == "t0"
+		},
+	}
+	pt, d, err := universe.NewPivotTransformation2(ctx, spec, executetest.RandomDatasetID(), alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := &joinOutput{}
+	d.AddTransformation(output)
+
+	// The input tables are copied with the allocator of the
+	// transformation so that the retained buffers count
+	// against the limit.
+	parentID := executetest.RandomDatasetID()
+	for _, in := range append(as, bs...) {
+		builder := execute.NewColListTableBuilder(in.Key(), alloc)
+		if err := execute.AddTableCols(in, builder); err != nil {
+			t.Fatal(err)
+		}
+		if err := execute.AppendTable(in, builder); err != nil {
+			t.Fatal(err)
+		}
+		tbl, err := builder.Table()
+		builder.Release()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := pt.Process(parentID, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("expected the input buffers to be spilled")
+	}
+
+	pt.Finish(parentID, nil)
+	if output.err != nil {
+		t.Fatal(output.err)
+	}
+	if got := alloc.Allocated(); got != 0 {
+		t.Errorf("expected all memory to be released, got %d bytes", got)
+	}
+
+	got := output.tables
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+
+	sort.Sort(executetest.SortedTables(got))
+	sort.Sort(executetest.SortedTables(want))
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	files, err = ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected spill files to be removed, found %d files", len(files))
+	}
+}
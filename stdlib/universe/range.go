@@ -1,6 +1,8 @@
 package universe
 
 import (
+	"time"
+
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
@@ -87,7 +89,6 @@ func (s *RangeOpSpec) Kind() flux.OperationKind {
 }
 
 type RangeProcedureSpec struct {
-	plan.DefaultCost
 	Bounds      flux.Bounds
 	TimeColumn  string
 	StartColumn string
@@ -151,6 +152,18 @@ func (s *RangeProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// SetNow implements plan.NowAwareProcedureSpec
+func (s *RangeProcedureSpec) SetNow(now time.Time) {
+	s.Bounds.Now = now
+}
+
+// Cost estimates that range keeps all of the rows. Sources report
+// the statistics of the data within the bounds that they read.
+func (s *RangeProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	return plan.Cost{CPU: in.Cardinality}, in.AddColumns(s.StartColumn, s.StopColumn)
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *RangeProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
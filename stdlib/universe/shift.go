@@ -112,6 +112,11 @@ func (s *ShiftProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// SetNow implements plan.NowAwareProcedureSpec
+func (s *ShiftProcedureSpec) SetNow(now time.Time) {
+	s.Now = now
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *ShiftProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
@@ -1,9 +1,12 @@
 package universe
 
 import (
+	"math"
+
 	"github.com/influxdata/flux"
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
+	"github.com/influxdata/flux/execute/spill"
 	"github.com/influxdata/flux/internal/errors"
 	"github.com/influxdata/flux/interpreter"
 	"github.com/influxdata/flux/plan"
@@ -65,7 +68,6 @@ func (s *SortOpSpec) Kind() flux.OperationKind {
 }
 
 type SortProcedureSpec struct {
-	plan.DefaultCost
 	Columns []string
 	Desc    bool
 }
@@ -95,16 +97,41 @@ func (s *SortProcedureSpec) Copy() plan.ProcedureSpec {
 	return ns
 }
 
+// Cost estimates the cost of sorting all of the rows in memory.
+func (s *SortProcedureSpec) Cost(inStats []plan.Statistics) (plan.Cost, plan.Statistics) {
+	in := plan.SumStatistics(inStats)
+	n := in.Cardinality
+	return plan.Cost{
+		CPU: n * int64(math.Ceil(math.Log2(float64(n+1)))),
+		MEM: n,
+	}, in
+}
+
 // TriggerSpec implements plan.TriggerAwareProcedureSpec
 func (s *SortProcedureSpec) TriggerSpec() plan.TriggerSpec {
 	return plan.NarrowTransformationTriggerSpec{}
 }
 
+// IsPartitionable implements plan.PartitionableProcedureSpec
+func (s *SortProcedureSpec) IsPartitionable() bool {
+	return true
+}
+
 func createSortTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*SortProcedureSpec)
 	if !ok {
 		return nil, nil, errors.Newf(codes.Internal, "invalid spec type %T", spec)
 	}
+	store, err := spill.NewStore(a.Context(), a.Allocator())
+	if err != nil {
+		return nil, nil, err
+	}
+	if store != nil {
+		cache := spill.NewCache(store, a.Allocator())
+		d := execute.NewDataset(id, mode, cache)
+		t := NewSortTransformation(d, cache, s)
+		return t, d, nil
+	}
 	cache := execute.NewTableBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
 	t := NewSortTransformation(d, cache, s)
@@ -115,17 +142,23 @@ type sortTransformation struct {
 	d     execute.Dataset
 	cache execute.TableBuilderCache
 
+	// spill is set when the cache spills the sorted
+	// tables to disk when the memory limit is reached.
+	spill *spill.Cache
+
 	cols []string
 	desc bool
 }
 
 func NewSortTransformation(d execute.Dataset, cache execute.TableBuilderCache, spec *SortProcedureSpec) *sortTransformation {
-	return &sortTransformation{
+	t := &sortTransformation{
 		d:     d,
 		cache: cache,
 		cols:  spec.Columns,
 		desc:  spec.Desc,
 	}
+	t.spill, _ = cache.(*spill.Cache)
+	return t
 }
 
 func (t *sortTransformation) RetractTable(id execute.DatasetID, key flux.GroupKey) error {
@@ -148,6 +181,9 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	if err := execute.AddTableCols(tbl, builder); err != nil {
 		return err
 	}
+	if t.spill != nil {
+		return t.appendSpillable(tbl, builder)
+	}
 	if err := execute.AppendTable(tbl, builder); err != nil {
 		return err
 	}
@@ -156,6 +192,35 @@ func (t *sortTransformation) Process(id execute.DatasetID, tbl flux.Table) error
 	return nil
 }
 
+// appendSpillable appends the table to the builder and sorts it.
+// The buffers of the table are retained so the append can be retried
+// after the other sorted tables have been spilled.
+func (t *sortTransformation) appendSpillable(tbl flux.Table, builder execute.TableBuilder) error {
+	var crs []flux.ColReader
+	defer func() {
+		for _, cr := range crs {
+			cr.Release()
+		}
+	}()
+	if err := tbl.Do(func(cr flux.ColReader) error {
+		cr.Retain()
+		crs = append(crs, cr)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return t.spill.Guard(func() error {
+		builder.ClearData()
+		for _, cr := range crs {
+			if err := execute.AppendCols(cr, builder); err != nil {
+				return err
+			}
+		}
+		builder.Sort(t.cols, t.desc)
+		return nil
+	}, builder)
+}
+
 func (t *sortTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
@@ -164,6 +229,9 @@ func (t *sortTransformation) UpdateProcessingTime(id execute.DatasetID, pt execu
 }
 func (t *sortTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
+	if t.spill != nil {
+		_ = t.spill.Close()
+	}
 }
 
 func (t *sortTransformation) sortedKey(key flux.GroupKey) flux.GroupKey {
@@ -1,11 +1,21 @@
 package universe_test
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/flux"
+	"github.com/influxdata/flux/dependencies/dependenciestest"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/execute/executetest"
+	"github.com/influxdata/flux/execute/spill"
+	"github.com/influxdata/flux/memory"
+	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/querytest"
 	"github.com/influxdata/flux/stdlib/universe"
 )
@@ -406,3 +416,90 @@ func TestSort_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestSort_Spill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flux-sort-spill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	ctx := dependenciestest.Default().Inject(context.Background())
+	ctx = spill.Dependency{Dir: dir}.Inject(ctx)
+
+	// The limit is enough to sort any single table,
+	// but not to hold all of the sorted tables at once.
+	limit := int64(200000)
+	alloc := &memory.Allocator{Limit: &limit}
+
+	const (
+		numTables = 16
+		numRows   = 1000
+	)
+	var data, want []*executetest.Table
+	for i := 0; i < numTables; i++ {
+		tag := fmt.Sprintf("t%d", i)
+		in := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: []flux.ColMeta{
+				{Label: "_time", Type: flux.TTime},
+				{Label: "_value", Type: flux.TFloat},
+				{Label: "t0", Type: flux.TString},
+			},
+		}
+		out := &executetest.Table{
+			KeyCols: []string{"t0"},
+			ColMeta: in.ColMeta,
+		}
+		for j := 0; j < numRows; j++ {
+			in.Data = append(in.Data, []interface{}{execute.Time(j), float64(j), tag})
+			out.Data = append(out.Data, []interface{}{execute.Time(numRows - j - 1), float64(numRows - j - 1), tag})
+		}
+		data = append(data, in)
+		want = append(want, out)
+	}
+
+	store, err := spill.NewStore(ctx, alloc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := spill.NewCache(store, alloc)
+	c.SetTriggerSpec(plan.DefaultTriggerSpec)
+	d := execute.NewDataset(executetest.RandomDatasetID(), execute.DiscardingMode, c)
+	output := &joinOutput{}
+	d.AddTransformation(output)
+	st := universe.NewSortTransformation(d, c, &universe.SortProcedureSpec{
+		Columns: []string{"_value"},
+		Desc:    true,
+	})
+
+	parentID := executetest.RandomDatasetID()
+	for _, tbl := range data {
+		if err := st.Process(parentID, tbl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st.Finish(parentID, nil)
+	if output.err != nil {
+		t.Fatal(output.err)
+	}
+
+	got := output.tables
+	executetest.NormalizeTables(got)
+	executetest.NormalizeTables(want)
+
+	sort.Sort(executetest.SortedTables(got))
+	sort.Sort(executetest.SortedTables(want))
+
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected spill files to be removed, found %d files", len(files))
+	}
+}
@@ -85,7 +85,7 @@ func tableFind(ctx context.Context, to *flux.TableObject, fn *execute.TablePredi
 
 	c := lang.TableObjectCompiler{
 		Tables: to,
-		Now:    *deps.Now,
+		Now:    deps.GetNow(),
 	}
 
 	p, err := c.Compile(ctx)
@@ -8,6 +8,7 @@ import (
 	"github.com/influxdata/flux/codes"
 	"github.com/influxdata/flux/execute"
 	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/lang/execdeps"
 	"github.com/influxdata/flux/plan"
 	"github.com/influxdata/flux/runtime"
 	"github.com/influxdata/flux/values"
@@ -16,13 +17,14 @@ import (
 const WindowKind = "window"
 
 type WindowOpSpec struct {
-	Every       flux.Duration `json:"every"`
-	Period      flux.Duration `json:"period"`
-	Offset      flux.Duration `json:"offset"`
-	TimeColumn  string        `json:"timeColumn"`
-	StopColumn  string        `json:"stopColumn"`
-	StartColumn string        `json:"startColumn"`
-	CreateEmpty bool          `json:"createEmpty"`
+	Every       flux.Duration     `json:"every"`
+	Period      flux.Duration     `json:"period"`
+	Offset      flux.Duration     `json:"offset"`
+	TimeColumn  string            `json:"timeColumn"`
+	StopColumn  string            `json:"stopColumn"`
+	StartColumn string            `json:"startColumn"`
+	CreateEmpty bool              `json:"createEmpty"`
+	Location    *execute.Location `json:"location,omitempty"`
 }
 
 var infinityVar = values.NewDuration(values.ConvertDuration(math.MaxInt64))
@@ -99,6 +101,15 @@ func createWindowOpSpec(args flux.Arguments, a *flux.Administration) (flux.Opera
 	} else {
 		spec.CreateEmpty = false
 	}
+	if location, ok, err := args.GetObject("location"); err != nil {
+		return nil, err
+	} else if ok {
+		loc, err := execute.LocationFromObject(location)
+		if err != nil {
+			return nil, err
+		}
+		spec.Location = &loc
+	}
 
 	// Apply defaults
 	if !everySet {
@@ -125,6 +136,14 @@ type WindowProcedureSpec struct {
 	StartColumn,
 	StopColumn string
 	CreateEmpty bool
+	// Location is the time zone that aligns the windows.
+	// When it is nil, the location option is used.
+	Location *execute.Location
+	// Streaming is set when the window reads from a stream.
+	// The windows of a stream have no end, so empty windows are
+	// created as the watermark advances and an infinite window
+	// keeps the window of each table instead of merging them.
+	Streaming bool
 }
 
 func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -142,6 +161,7 @@ func newWindowProcedure(qs flux.OperationSpec, pa plan.Administration) (plan.Pro
 		StartColumn: s.StartColumn,
 		StopColumn:  s.StopColumn,
 		CreateEmpty: s.CreateEmpty,
+		Location:    s.Location,
 	}
 	return p, nil
 }
@@ -156,6 +176,11 @@ func (s *WindowProcedureSpec) Copy() plan.ProcedureSpec {
 	ns.StartColumn = s.StartColumn
 	ns.StopColumn = s.StopColumn
 	ns.CreateEmpty = s.CreateEmpty
+	ns.Streaming = s.Streaming
+	if s.Location != nil {
+		loc := *s.Location
+		ns.Location = &loc
+	}
 	return ns
 }
 
@@ -172,15 +197,20 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		return nil, nil, errors.New(codes.Invalid, "nil bounds passed to window")
 	}
 
-	w, err := execute.NewWindow(
+	loc, err := windowLocation(a.Context(), s.Location)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := execute.NewWindowInLocation(
 		s.Window.Every,
 		s.Window.Period,
 		s.Window.Offset,
+		loc,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
-	t := NewFixedWindowTransformation(
+	t := newFixedWindowTransformation(
 		d,
 		cache,
 		*bounds,
@@ -189,10 +219,26 @@ func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationM
 		s.StartColumn,
 		s.StopColumn,
 		s.CreateEmpty,
+		s.Streaming,
 	)
 	return t, d, nil
 }
 
+// windowLocation returns the location of the window. If the window
+// does not have one, the location option is used and the default is UTC.
+func windowLocation(ctx context.Context, loc *execute.Location) (execute.Location, error) {
+	if loc != nil {
+		return *loc, nil
+	}
+	if execdeps.HaveExecutionDependencies(ctx) {
+		deps := execdeps.GetExecutionDependencies(ctx)
+		if deps.Location != nil && *deps.Location != nil {
+			return execute.LocationFromObject((*deps.Location).Object())
+		}
+	}
+	return execute.UTC, nil
+}
+
 type fixedWindowTransformation struct {
 	d         execute.Dataset
 	cache     execute.TableBuilderCache
@@ -204,6 +250,25 @@ type fixedWindowTransformation struct {
 	startCol,
 	stopCol string
 	createEmpty bool
+
+	// streaming is set when the input is a stream.
+	streaming bool
+	watermark execute.Time
+	// series holds the schema of each input group key. On a stream,
+	// the empty windows of every series are created once the
+	// watermark passes them. The windows that end at or before
+	// emptyUntil have been created.
+	series     *execute.GroupLookup
+	emptyUntil execute.Time
+	emptySet   bool
+}
+
+// windowSchema describes the tables that a window creates for an input group key.
+type windowSchema struct {
+	key       flux.GroupKey
+	newCols   []flux.ColMeta
+	keyCols   []flux.ColMeta
+	keyColMap []int
 }
 
 func NewFixedWindowTransformation(
@@ -216,6 +281,20 @@ func NewFixedWindowTransformation(
 	stopCol string,
 	createEmpty bool,
 ) execute.Transformation {
+	return newFixedWindowTransformation(d, cache, bounds, w, timeCol, startCol, stopCol, createEmpty, false)
+}
+
+func newFixedWindowTransformation(
+	d execute.Dataset,
+	cache execute.TableBuilderCache,
+	bounds execute.Bounds,
+	w execute.Window,
+	timeCol,
+	startCol,
+	stopCol string,
+	createEmpty bool,
+	streaming bool,
+) *fixedWindowTransformation {
 	t := &fixedWindowTransformation{
 		d:           d,
 		cache:       cache,
@@ -225,10 +304,18 @@ func NewFixedWindowTransformation(
 		startCol:    startCol,
 		stopCol:     stopCol,
 		createEmpty: createEmpty,
+		streaming:   streaming,
+		watermark:   execute.MinTime,
 	}
 
 	if createEmpty {
-		t.generateWindowsWithinBounds()
+		if streaming {
+			// The bounds of a stream span all time, so the empty
+			// windows are created as the watermark advances.
+			t.series = execute.NewGroupLookup()
+		} else {
+			t.generateWindowsWithinBounds()
+		}
 	}
 
 	return t
@@ -244,93 +331,55 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 		return errors.Newf(codes.FailedPrecondition, "missing time column %q", t.timeCol)
 	}
 
-	newCols := make([]flux.ColMeta, 0, len(tbl.Cols())+2)
-	keyCols := make([]flux.ColMeta, 0, len(tbl.Cols())+2)
-	keyColMap := make([]int, 0, len(tbl.Cols())+2)
-	startColIdx := -1
-	stopColIdx := -1
-	for j, c := range tbl.Cols() {
-		keyIdx := execute.ColIdx(c.Label, tbl.Key().Cols())
-		keyed := keyIdx >= 0
-		if c.Label == t.startCol {
-			startColIdx = j
-			keyed = true
-		}
-		if c.Label == t.stopCol {
-			stopColIdx = j
-			keyed = true
-		}
-		newCols = append(newCols, c)
-		if keyed {
-			keyCols = append(keyCols, c)
-			keyColMap = append(keyColMap, keyIdx)
-		}
-	}
-	if startColIdx == -1 {
-		startColIdx = len(newCols)
-		c := flux.ColMeta{
-			Label: t.startCol,
-			Type:  flux.TTime,
-		}
-		newCols = append(newCols, c)
-		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, len(keyColMap))
-	}
-	if stopColIdx == -1 {
-		stopColIdx = len(newCols)
-		c := flux.ColMeta{
-			Label: t.stopCol,
-			Type:  flux.TTime,
-		}
-		newCols = append(newCols, c)
-		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, len(keyColMap))
-	}
+	schema := t.schema(tbl)
 
 	// Abort processing if no data will match bounds
 	if t.bounds.IsEmpty() {
 		return nil
 	}
 
+	if t.series != nil {
+		t.series.Set(tbl.Key(), schema)
+	}
 	for _, bnds := range t.allBounds {
-		key := t.newWindowGroupKey(tbl, keyCols, bnds, keyColMap)
-		builder, created := t.cache.TableBuilder(key)
-		if created {
-			for _, c := range newCols {
-				_, err := builder.AddCol(c)
-				if err != nil {
-					return err
-				}
-			}
+		if _, err := t.tableBuilder(schema, bnds); err != nil {
+			return err
 		}
 	}
 
+	// On a stream, an infinite window keeps the window of each
+	// table so the tables pass through as they arrive.
+	var tableBounds []execute.Bounds
+	if t.streaming && t.w.Every == infinityVar.Duration() {
+		tableBounds = []execute.Bounds{t.keyBounds(tbl.Key())}
+	}
+
 	return tbl.Do(func(cr flux.ColReader) error {
 		l := cr.Len()
 		for i := 0; i < l; i++ {
 			tm := values.Time(cr.Times(timeIdx).Value(i))
-			bounds := t.getWindowBounds(tm)
+			bounds := tableBounds
+			if bounds == nil {
+				bounds = t.getWindowBounds(tm)
+			}
+			if t.series != nil && !t.emptySet && len(bounds) > 0 {
+				t.startEmptyWindows(bounds)
+			}
 
 			for _, bnds := range bounds {
-				key := t.newWindowGroupKey(tbl, keyCols, bnds, keyColMap)
-				builder, created := t.cache.TableBuilder(key)
-				if created {
-					for _, c := range newCols {
-						_, err := builder.AddCol(c)
-						if err != nil {
-							return err
-						}
-					}
+				builder, err := t.tableBuilder(schema, bnds)
+				if err != nil {
+					return err
 				}
 
 				for j, c := range builder.Cols() {
 					switch c.Label {
 					case t.startCol:
-						if err := builder.AppendTime(startColIdx, bnds.Start); err != nil {
+						if err := builder.AppendTime(j, bnds.Start); err != nil {
 							return err
 						}
 					case t.stopCol:
-						if err := builder.AppendTime(stopColIdx, bnds.Stop); err != nil {
+						if err := builder.AppendTime(j, bnds.Stop); err != nil {
 							return err
 						}
 					default:
@@ -345,7 +394,70 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, tbl flux.Table
 	})
 }
 
-func (t *fixedWindowTransformation) newWindowGroupKey(tbl flux.Table, keyCols []flux.ColMeta, bnds execute.Bounds, keyColMap []int) flux.GroupKey {
+// schema returns the columns and the group key columns
+// of the windows that are created for the table.
+func (t *fixedWindowTransformation) schema(tbl flux.Table) *windowSchema {
+	s := &windowSchema{
+		key:       tbl.Key(),
+		newCols:   make([]flux.ColMeta, 0, len(tbl.Cols())+2),
+		keyCols:   make([]flux.ColMeta, 0, len(tbl.Cols())+2),
+		keyColMap: make([]int, 0, len(tbl.Cols())+2),
+	}
+	hasStart, hasStop := false, false
+	for _, c := range tbl.Cols() {
+		keyIdx := execute.ColIdx(c.Label, tbl.Key().Cols())
+		keyed := keyIdx >= 0
+		if c.Label == t.startCol {
+			hasStart = true
+			keyed = true
+		}
+		if c.Label == t.stopCol {
+			hasStop = true
+			keyed = true
+		}
+		s.newCols = append(s.newCols, c)
+		if keyed {
+			s.keyCols = append(s.keyCols, c)
+			s.keyColMap = append(s.keyColMap, keyIdx)
+		}
+	}
+	if !hasStart {
+		c := flux.ColMeta{
+			Label: t.startCol,
+			Type:  flux.TTime,
+		}
+		s.newCols = append(s.newCols, c)
+		s.keyCols = append(s.keyCols, c)
+		s.keyColMap = append(s.keyColMap, len(s.keyColMap))
+	}
+	if !hasStop {
+		c := flux.ColMeta{
+			Label: t.stopCol,
+			Type:  flux.TTime,
+		}
+		s.newCols = append(s.newCols, c)
+		s.keyCols = append(s.keyCols, c)
+		s.keyColMap = append(s.keyColMap, len(s.keyColMap))
+	}
+	return s
+}
+
+// tableBuilder returns the builder for the window of the schema,
+// creating it with the columns of the schema if it does not exist.
+func (t *fixedWindowTransformation) tableBuilder(s *windowSchema, bnds execute.Bounds) (execute.TableBuilder, error) {
+	key := t.newWindowGroupKey(s.key, s.keyCols, bnds, s.keyColMap)
+	builder, created := t.cache.TableBuilder(key)
+	if created {
+		for _, c := range s.newCols {
+			if _, err := builder.AddCol(c); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return builder, nil
+}
+
+func (t *fixedWindowTransformation) newWindowGroupKey(tblKey flux.GroupKey, keyCols []flux.ColMeta, bnds execute.Bounds, keyColMap []int) flux.GroupKey {
 	cols := make([]flux.ColMeta, len(keyCols))
 	vs := make([]values.Value, len(keyCols))
 	for j, c := range keyCols {
@@ -356,7 +468,7 @@ func (t *fixedWindowTransformation) newWindowGroupKey(tbl flux.Table, keyCols []
 		case t.stopCol:
 			vs[j] = values.NewTime(bnds.Stop)
 		default:
-			vs[j] = tbl.Key().Value(keyColMap[j])
+			vs[j] = tblKey.Value(keyColMap[j])
 		}
 	}
 	return execute.NewGroupKey(cols, vs)
@@ -389,7 +501,71 @@ func (t *fixedWindowTransformation) generateWindowsWithinBounds() {
 	t.allBounds = bs
 }
 
+// keyBounds returns the window of a table from its group key.
+// If the group key does not contain the window, the bounds are used.
+func (t *fixedWindowTransformation) keyBounds(key flux.GroupKey) execute.Bounds {
+	startIdx := execute.ColIdx(t.startCol, key.Cols())
+	stopIdx := execute.ColIdx(t.stopCol, key.Cols())
+	if startIdx < 0 || stopIdx < 0 ||
+		key.Cols()[startIdx].Type != flux.TTime ||
+		key.Cols()[stopIdx].Type != flux.TTime {
+		return t.bounds
+	}
+	return execute.Bounds{
+		Start: key.ValueTime(startIdx),
+		Stop:  key.ValueTime(stopIdx),
+	}
+}
+
+// startEmptyWindows starts creating the empty windows of a stream
+// from the earliest of the windows of the first row. The windows
+// that the watermark has already passed are not created.
+func (t *fixedWindowTransformation) startEmptyWindows(bounds []execute.Bounds) {
+	start := bounds[0].Start
+	for _, bnds := range bounds[1:] {
+		if bnds.Start < start {
+			start = bnds.Start
+		}
+	}
+	if start < t.watermark {
+		start = t.watermark
+	}
+	t.emptyUntil = start
+	t.emptySet = true
+}
+
+// createEmptyWindows creates the windows of each series that end
+// after the previous watermark and at or before the new watermark,
+// so the watermark triggers them even if they did not receive any rows.
+func (t *fixedWindowTransformation) createEmptyWindows(mark execute.Time) error {
+	if !t.emptySet || mark <= t.emptyUntil {
+		return nil
+	}
+	bs := t.w.GetOverlappingBounds(execute.Bounds{Start: t.emptyUntil, Stop: mark})
+	t.clipBounds(bs)
+	var err error
+	t.series.Range(func(key flux.GroupKey, value interface{}) {
+		for _, bnds := range bs {
+			if err != nil {
+				return
+			}
+			if bnds.Stop <= t.emptyUntil || bnds.Stop > mark || bnds.IsEmpty() {
+				continue
+			}
+			_, err = t.tableBuilder(value.(*windowSchema), bnds)
+		}
+	})
+	t.emptyUntil = mark
+	return err
+}
+
 func (t *fixedWindowTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	t.watermark = mark
+	if t.series != nil {
+		if err := t.createEmptyWindows(mark); err != nil {
+			return err
+		}
+	}
 	return t.d.UpdateWatermark(mark)
 }
 func (t *fixedWindowTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
@@ -417,6 +593,13 @@ func (WindowTriggerPhysicalRule) Pattern() plan.Pattern {
 // window descendents that occur earlier in the plan and as long as none
 // of its descendents merge multiple streams together like union and join.
 func (WindowTriggerPhysicalRule) Rewrite(ctx context.Context, window plan.Node) (plan.Node, bool, error) {
+	// The windows of a stream are only complete once the
+	// watermark has passed them so keep the default trigger.
+	// An infinite window never completes on a stream, so it
+	// passes each table through as soon as it is processed.
+	if plan.IsStreaming(window) {
+		return rewriteStreamingWindow(window.(*plan.PhysicalPlanNode))
+	}
 	// This rule's pattern ensures us only one predecessor
 	if !hasValidPredecessors(window.Predecessors()[0]) {
 		return window, false, nil
@@ -430,6 +613,20 @@ func (WindowTriggerPhysicalRule) Rewrite(ctx context.Context, window plan.Node)
 	return ppn, true, nil
 }
 
+func rewriteStreamingWindow(ppn *plan.PhysicalPlanNode) (plan.Node, bool, error) {
+	spec := ppn.ProcedureSpec().(*WindowProcedureSpec)
+	changed := false
+	if !spec.Streaming {
+		spec.Streaming = true
+		changed = true
+	}
+	if spec.Window.Every == infinityVar.Duration() && ppn.TriggerSpec == nil {
+		ppn.TriggerSpec = plan.NarrowTransformationTriggerSpec{}
+		changed = true
+	}
+	return ppn, changed, nil
+}
+
 func hasValidPredecessors(node plan.Node) bool {
 	pred := node.Predecessors()
 	// Source nodes might not produce uniform time bounds for all
@@ -0,0 +1,28 @@
+package universe
+
+import (
+	"github.com/influxdata/flux/execute"
+)
+
+// NewStreamingWindowTransformation is exposed so the tests have access
+// to a window transformation that reads from a stream. The window of
+// a stream is only created by the planner when its source is streaming.
+func NewStreamingWindowTransformation(
+	d execute.Dataset,
+	cache execute.TableBuilderCache,
+	bounds execute.Bounds,
+	w execute.Window,
+	createEmpty bool,
+) execute.Transformation {
+	return newFixedWindowTransformation(
+		d,
+		cache,
+		bounds,
+		w,
+		execute.DefaultTimeColLabel,
+		execute.DefaultStartColLabel,
+		execute.DefaultStopColLabel,
+		createEmpty,
+		true,
+	)
+}
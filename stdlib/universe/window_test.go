@@ -2,6 +2,7 @@ package universe_test
 
 import (
 	"context"
+	"math"
 	"sort"
 	"strconv"
 	"testing"
@@ -49,6 +50,39 @@ func TestWindow_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "from with window in location",
+			Raw:  `from(bucket:"mybucket") |> window(every:1d, location: {zone: "America/New_York", offset: 0h})`,
+			Want: &flux.Spec{
+				Operations: []*flux.Operation{
+					{
+						ID: "from0",
+						Spec: &influxdb.FromOpSpec{
+							Bucket: influxdb.NameOrID{Name: "mybucket"},
+						},
+					},
+					{
+						ID: "window1",
+						Spec: &universe.WindowOpSpec{
+							Every:       flux.ConvertDuration(24 * time.Hour),
+							Period:      flux.ConvertDuration(24 * time.Hour),
+							TimeColumn:  execute.DefaultTimeColLabel,
+							StartColumn: execute.DefaultStartColLabel,
+							StopColumn:  execute.DefaultStopColLabel,
+							Location:    &execute.Location{Name: "America/New_York"},
+						},
+					},
+				},
+				Edges: []flux.Edge{
+					{Parent: "from0", Child: "window1"},
+				},
+			},
+		},
+		{
+			Name:    "window without every or period",
+			Raw:     `from(bucket:"mybucket") |> window(offset: 5m)`,
+			WantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -911,6 +945,15 @@ func windowOp(id string) plan.Node {
 	return plan.CreatePhysicalNode(plan.NodeID(id), &universe.WindowProcedureSpec{})
 }
 
+// infinityDuration is the every of an infinite window.
+var infinityDuration = values.ConvertDuration(math.MaxInt64)
+
+func infWindowOp(id string) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &universe.WindowProcedureSpec{
+		Window: plan.WindowSpec{Every: infinityDuration, Period: infinityDuration},
+	})
+}
+
 func boundsOp(id string) plan.Node {
 	return plan.CreatePhysicalNode(plan.NodeID(id), &universe.RangeProcedureSpec{})
 }
@@ -926,6 +969,173 @@ func mockPred(id string) plan.Node {
 	return plan.CreatePhysicalNode(plan.NodeID(id), &universe.FilterProcedureSpec{})
 }
 
+// streamingProcedureSpec is a bounded source that produces a stream of tables.
+type streamingProcedureSpec struct {
+	plan.DefaultCost
+}
+
+func (s *streamingProcedureSpec) Kind() plan.ProcedureKind {
+	return "streaming"
+}
+
+func (s *streamingProcedureSpec) Copy() plan.ProcedureSpec {
+	return s
+}
+
+func (s *streamingProcedureSpec) IsStreaming() bool {
+	return true
+}
+
+func (s *streamingProcedureSpec) TimeBounds(*plan.Bounds) *plan.Bounds {
+	return &plan.Bounds{Start: execute.MinTime, Stop: execute.MaxTime}
+}
+
+func streamOp(id string) plan.Node {
+	return plan.CreatePhysicalNode(plan.NodeID(id), &streamingProcedureSpec{})
+}
+
+func TestFixedWindow_Stream(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_time", Type: flux.TTime},
+		{Label: "host", Type: flux.TString},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	outCols := append(cols[:len(cols):len(cols)],
+		flux.ColMeta{Label: "_start", Type: flux.TTime},
+		flux.ColMeta{Label: "_stop", Type: flux.TTime},
+	)
+	window := func(start, stop execute.Time, data ...[]interface{}) *executetest.Table {
+		return &executetest.Table{
+			KeyCols:   []string{"host", "_start", "_stop"},
+			KeyValues: []interface{}{"a", start, stop},
+			ColMeta:   outCols,
+			Data:      data,
+		}
+	}
+
+	w, err := execute.NewWindow(values.ConvertDuration(10), values.ConvertDuration(10), values.ConvertDuration(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := executetest.RandomDatasetID()
+	cache := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	d := execute.NewDataset(id, execute.DiscardingMode, cache)
+	d.SetTriggerSpec(plan.DefaultTriggerSpec)
+	out := &joinOutput{}
+	d.AddTransformation(out)
+
+	// The bounds of a stream span all time.
+	bounds := execute.Bounds{Start: execute.MinTime, Stop: execute.MaxTime}
+	tr := universe.NewStreamingWindowTransformation(d, cache, bounds, w, true)
+
+	in := &executetest.Table{
+		KeyCols: []string{"host"},
+		ColMeta: cols,
+		Data: [][]interface{}{
+			{execute.Time(12), "a", 1.0},
+			{execute.Time(15), "a", 2.0},
+		},
+	}
+	parentID := executetest.RandomDatasetID()
+	if err := tr.Process(parentID, in); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.tables) != 0 {
+		t.Fatalf("expected no tables before the watermark passes the window, got %d", len(out.tables))
+	}
+
+	// Each watermark outputs the windows that it has passed.
+	// The empty windows are created up to the watermark.
+	for _, step := range []struct {
+		mark execute.Time
+		want []*executetest.Table
+	}{
+		{
+			mark: 20,
+			want: []*executetest.Table{
+				window(10, 20,
+					[]interface{}{execute.Time(12), "a", 1.0, execute.Time(10), execute.Time(20)},
+					[]interface{}{execute.Time(15), "a", 2.0, execute.Time(10), execute.Time(20)},
+				),
+			},
+		},
+		{
+			mark: 45,
+			want: []*executetest.Table{
+				window(20, 30),
+				window(30, 40),
+			},
+		},
+		{
+			mark: 50,
+			want: []*executetest.Table{
+				window(40, 50),
+			},
+		},
+	} {
+		out.tables = nil
+		if err := tr.UpdateWatermark(parentID, step.mark); err != nil {
+			t.Fatal(err)
+		}
+		got := out.tables
+		executetest.NormalizeTables(got)
+		executetest.NormalizeTables(step.want)
+		if !cmp.Equal(step.want, got) {
+			t.Fatalf("unexpected tables at watermark %d -want/+got\n%s", step.mark, cmp.Diff(step.want, got))
+		}
+	}
+}
+
+func TestFixedWindow_StreamInfinite(t *testing.T) {
+	cols := []flux.ColMeta{
+		{Label: "_start", Type: flux.TTime},
+		{Label: "_stop", Type: flux.TTime},
+		{Label: "_time", Type: flux.TTime},
+		{Label: "_value", Type: flux.TFloat},
+	}
+	id := executetest.RandomDatasetID()
+	cache := execute.NewTableBuilderCache(executetest.UnlimitedAllocator)
+	d := execute.NewDataset(id, execute.DiscardingMode, cache)
+	d.SetTriggerSpec(plan.NarrowTransformationTriggerSpec{})
+	out := &joinOutput{}
+	d.AddTransformation(out)
+
+	w, err := execute.NewWindow(infinityDuration, infinityDuration, values.ConvertDuration(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bounds := execute.Bounds{Start: execute.MinTime, Stop: execute.MaxTime}
+	tr := universe.NewStreamingWindowTransformation(d, cache, bounds, w, false)
+
+	// Each table keeps its own window and is output before Finish.
+	parentID := executetest.RandomDatasetID()
+	for i, start := range []execute.Time{0, 10} {
+		table := func() *executetest.Table {
+			return &executetest.Table{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: cols,
+				Data: [][]interface{}{
+					{start, start + 10, start + 10, float64(i)},
+				},
+			}
+		}
+		out.tables = nil
+		if err := tr.Process(parentID, table()); err != nil {
+			t.Fatal(err)
+		}
+		if err := tr.UpdateWatermark(parentID, start+10); err != nil {
+			t.Fatal(err)
+		}
+		want := []*executetest.Table{table()}
+		got := out.tables
+		executetest.NormalizeTables(got)
+		executetest.NormalizeTables(want)
+		if !cmp.Equal(want, got) {
+			t.Fatalf("unexpected tables -want/+got\n%s", cmp.Diff(want, got))
+		}
+	}
+}
+
 func TestWindowRewriteRule(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -937,6 +1147,9 @@ func TestWindowRewriteRule(t *testing.T) {
 		//    w: window transformation
 		//    r: range transformation
 		//    b: bounded source
+		//    s: streaming source
+		//    i: infinite window transformation
+		//    I: infinite window transformation with narrow trigger spec
 		//    W: window transformation with narrow trigger spec
 		{
 			name: "bounded source",
@@ -958,6 +1171,45 @@ func TestWindowRewriteRule(t *testing.T) {
 			},
 			want: []plan.NodeID{"2"},
 		},
+		{
+			name: "streaming source",
+			// w       w
+			// |       |
+			// r  ==>  r
+			// |       |
+			// s       s
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					streamOp("0"),
+					rangeOp("1"),
+					windowOp("2"),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+		},
+		{
+			name: "streaming source with infinite window",
+			// i       I
+			// |       |
+			// w       w
+			// |       |
+			// s       s
+			spec: &plantest.PlanSpec{
+				Nodes: []plan.Node{
+					streamOp("0"),
+					windowOp("1"),
+					infWindowOp("2"),
+				},
+				Edges: [][2]int{
+					{0, 1},
+					{1, 2},
+				},
+			},
+			want: []plan.NodeID{"2"},
+		},
 		{
 			name: "unbounded source",
 			// w       w
@@ -0,0 +1,319 @@
+package values
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/flux/codes"
+	"github.com/influxdata/flux/internal/errors"
+	"github.com/influxdata/flux/semantic"
+)
+
+// Dictionary represents a mapping of keys to values.
+// All keys must be the same type and all values must be the same type.
+//
+// A Dictionary is immutable. The methods that modify the contents
+// of a dictionary return a new Dictionary and leave the original unchanged.
+type Dictionary interface {
+	Value
+
+	// Get will retrieve the value for the key.
+	// If the key is not present, the default value is returned.
+	Get(key, def Value) Value
+
+	// Lookup will retrieve the value for the key and
+	// report whether the key was present.
+	Lookup(key Value) (Value, bool)
+
+	// Insert returns a dictionary that contains the given key and value.
+	// If the key is already present, its value is replaced.
+	Insert(key, value Value) (Dictionary, error)
+
+	// Remove returns a dictionary without the given key.
+	Remove(key Value) Dictionary
+
+	// Range will iterate over each key and value in the dictionary
+	// in the order of the keys.
+	Range(f func(key, value Value))
+
+	// Len returns the number of entries in the dictionary.
+	Len() int
+}
+
+type dictEntry struct {
+	key   Value
+	value Value
+}
+
+type dict struct {
+	t semantic.MonoType
+	// entries is sorted by key and is never modified
+	// once the dictionary has been constructed.
+	entries []dictEntry
+}
+
+// NewDict constructs an empty dictionary with the given dictionary type.
+func NewDict(dictType semantic.MonoType) Dictionary {
+	if dictType.Nature() != semantic.Dictionary {
+		panic(UnexpectedKind(dictType.Nature(), semantic.Dictionary))
+	}
+	return &dict{t: dictType}
+}
+
+func (d *dict) IsNull() bool {
+	return false
+}
+func (d *dict) String() string {
+	b := new(strings.Builder)
+	b.WriteString("[")
+	if len(d.entries) == 0 {
+		b.WriteString(":")
+	}
+	for i, e := range d.entries {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(b, "%v: %v", e.key, e.value)
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+func (d *dict) Type() semantic.MonoType {
+	return d.t
+}
+
+// search returns the index where the key is or would be inserted
+// and whether the key is present at that index.
+func (d *dict) search(key Value) (int, bool) {
+	i := sort.Search(len(d.entries), func(i int) bool {
+		return compareDictKeys(d.entries[i].key, key) >= 0
+	})
+	return i, i < len(d.entries) && compareDictKeys(d.entries[i].key, key) == 0
+}
+
+func (d *dict) Get(key, def Value) Value {
+	if v, ok := d.Lookup(key); ok {
+		return v
+	}
+	return def
+}
+
+func (d *dict) Lookup(key Value) (Value, bool) {
+	if key.IsNull() {
+		return nil, false
+	}
+	i, ok := d.search(key)
+	if !ok {
+		return nil, false
+	}
+	return d.entries[i].value, true
+}
+
+func (d *dict) Insert(key, value Value) (Dictionary, error) {
+	if err := checkDictKey(key); err != nil {
+		return nil, err
+	}
+	i, ok := d.search(key)
+	if ok {
+		entries := make([]dictEntry, len(d.entries))
+		copy(entries, d.entries)
+		entries[i].value = value
+		return &dict{t: d.t, entries: entries}, nil
+	}
+	entries := make([]dictEntry, 0, len(d.entries)+1)
+	entries = append(entries, d.entries[:i]...)
+	entries = append(entries, dictEntry{key: key, value: value})
+	entries = append(entries, d.entries[i:]...)
+	return &dict{t: d.t, entries: entries}, nil
+}
+
+func (d *dict) Remove(key Value) Dictionary {
+	if key.IsNull() {
+		return d
+	}
+	i, ok := d.search(key)
+	if !ok {
+		return d
+	}
+	entries := make([]dictEntry, 0, len(d.entries)-1)
+	entries = append(entries, d.entries[:i]...)
+	entries = append(entries, d.entries[i+1:]...)
+	return &dict{t: d.t, entries: entries}
+}
+
+func (d *dict) Range(f func(key, value Value)) {
+	for _, e := range d.entries {
+		f(e.key, e.value)
+	}
+}
+
+func (d *dict) Len() int {
+	return len(d.entries)
+}
+
+func (d *dict) Str() string {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.String))
+}
+func (d *dict) Bytes() []byte {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Bytes))
+}
+func (d *dict) Int() int64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Int))
+}
+func (d *dict) UInt() uint64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.UInt))
+}
+func (d *dict) Float() float64 {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Float))
+}
+func (d *dict) Bool() bool {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Bool))
+}
+func (d *dict) Time() Time {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Time))
+}
+func (d *dict) Duration() Duration {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Duration))
+}
+func (d *dict) Regexp() *regexp.Regexp {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Regexp))
+}
+func (d *dict) Array() Array {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Array))
+}
+func (d *dict) Object() Object {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Object))
+}
+func (d *dict) Function() Function {
+	panic(UnexpectedKind(semantic.Dictionary, semantic.Function))
+}
+func (d *dict) Equal(rhs Value) bool {
+	if !d.Type().Equal(rhs.Type()) {
+		return false
+	}
+	r, ok := rhs.(Dictionary)
+	if !ok {
+		return false
+	}
+	if d.Len() != r.Len() {
+		return false
+	}
+	for _, e := range d.entries {
+		v, ok := r.Lookup(e.key)
+		if !ok {
+			return false
+		}
+		// Null values are equal to each other within a dictionary
+		// so that two dictionaries with the same contents are equal.
+		if e.value.IsNull() || v.IsNull() {
+			if e.value.IsNull() != v.IsNull() {
+				return false
+			}
+			continue
+		}
+		if !e.value.Equal(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// DictionaryBuilder can be used to construct a Dictionary
+// with many entries without copying the contents for each insert.
+type DictionaryBuilder struct {
+	t       semantic.MonoType
+	entries map[interface{}]dictEntry
+}
+
+// NewDictBuilder constructs a DictionaryBuilder for the given dictionary type.
+func NewDictBuilder(dictType semantic.MonoType) DictionaryBuilder {
+	if dictType.Nature() != semantic.Dictionary {
+		panic(UnexpectedKind(dictType.Nature(), semantic.Dictionary))
+	}
+	return DictionaryBuilder{
+		t:       dictType,
+		entries: make(map[interface{}]dictEntry),
+	}
+}
+
+// Insert will add the key and value to the dictionary.
+// If the key has already been inserted, its value is replaced.
+func (b *DictionaryBuilder) Insert(key, value Value) error {
+	if err := checkDictKey(key); err != nil {
+		return err
+	}
+	b.entries[Unwrap(key)] = dictEntry{key: key, value: value}
+	return nil
+}
+
+// Dict will construct a Dictionary from the inserted entries.
+func (b *DictionaryBuilder) Dict() Dictionary {
+	entries := make([]dictEntry, 0, len(b.entries))
+	for _, e := range b.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return compareDictKeys(entries[i].key, entries[j].key) < 0
+	})
+	return &dict{t: b.t, entries: entries}
+}
+
+// checkDictKey verifies that the value can be used as the key of a dictionary.
+func checkDictKey(key Value) error {
+	if key.IsNull() {
+		return errors.New(codes.Invalid, "dictionary key cannot be null")
+	}
+	switch n := key.Type().Nature(); n {
+	case semantic.Int, semantic.UInt, semantic.Float, semantic.String, semantic.Time, semantic.Duration:
+		return nil
+	default:
+		return errors.Newf(codes.Invalid, "dictionary key must be a comparable type, got %v", n)
+	}
+}
+
+// compareDictKeys compares two dictionary keys of the same type.
+// It returns a negative number if l < r, zero if l == r and a positive
+// number if l > r.
+func compareDictKeys(l, r Value) int {
+	switch n := l.Type().Nature(); n {
+	case semantic.Int:
+		return compareOrdered(l.Int() < r.Int(), l.Int() > r.Int())
+	case semantic.UInt:
+		return compareOrdered(l.UInt() < r.UInt(), l.UInt() > r.UInt())
+	case semantic.Float:
+		return compareOrdered(l.Float() < r.Float(), l.Float() > r.Float())
+	case semantic.String:
+		return strings.Compare(l.Str(), r.Str())
+	case semantic.Time:
+		return compareOrdered(l.Time() < r.Time(), l.Time() > r.Time())
+	case semantic.Duration:
+		lv, rv := l.Duration(), r.Duration()
+		if lv.Equal(rv) {
+			return 0
+		}
+		// Durations with months are ordered by their approximate
+		// length and then by their components so that distinct
+		// durations are never considered to be the same key.
+		if ld, rd := lv.Duration(), rv.Duration(); ld != rd {
+			return compareOrdered(ld < rd, ld > rd)
+		}
+		if lv.Months() != rv.Months() {
+			return compareOrdered(lv.Months() < rv.Months(), lv.Months() > rv.Months())
+		}
+		return compareOrdered(lv.Nanoseconds() < rv.Nanoseconds(), lv.Nanoseconds() > rv.Nanoseconds())
+	default:
+		panic(errors.Newf(codes.Internal, "invalid dictionary key type %v", n))
+	}
+}
+
+func compareOrdered(less, greater bool) int {
+	if less {
+		return -1
+	} else if greater {
+		return 1
+	}
+	return 0
+}
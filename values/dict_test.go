@@ -0,0 +1,107 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/flux/semantic"
+	"github.com/influxdata/flux/values"
+)
+
+func TestDict_Insert(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.BasicString, semantic.BasicInt)
+	empty := values.NewDict(dictType)
+
+	a, err := empty.Insert(values.NewString("b"), values.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := a.Insert(values.NewString("a"), values.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := b.Insert(values.NewString("b"), values.NewInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want, got := 0, empty.Len(); want != got {
+		t.Fatalf("unexpected length of original dictionary -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := "[a: 1, b: 2]", b.(values.ValueStringer).String(); want != got {
+		t.Fatalf("unexpected dictionary -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+	if want, got := "[a: 1, b: 3]", c.(values.ValueStringer).String(); want != got {
+		t.Fatalf("unexpected dictionary -want/+got:\n\t- %s\n\t+ %s", want, got)
+	}
+
+	if _, err := empty.Insert(values.NewNull(semantic.BasicString), values.NewInt(1)); err == nil {
+		t.Fatal("expected error when inserting a null key")
+	}
+}
+
+func TestDict_Get(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.BasicInt, semantic.BasicString)
+	b := values.NewDictBuilder(dictType)
+	for i, s := range []string{"zero", "one", "two"} {
+		if err := b.Insert(values.NewInt(int64(i)), values.NewString(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := b.Dict()
+
+	def := values.NewString("missing")
+	if want, got := values.NewString("one"), d.Get(values.NewInt(1), def); !want.Equal(got) {
+		t.Fatalf("unexpected value -want/+got:\n\t- %v\n\t+ %v", want, got)
+	}
+	if want, got := def, d.Get(values.NewInt(3), def); !want.Equal(got) {
+		t.Fatalf("unexpected value -want/+got:\n\t- %v\n\t+ %v", want, got)
+	}
+	if want, got := def, d.Get(values.NewNull(semantic.BasicInt), def); !want.Equal(got) {
+		t.Fatalf("unexpected value -want/+got:\n\t- %v\n\t+ %v", want, got)
+	}
+}
+
+func TestDict_Remove(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.BasicInt, semantic.BasicInt)
+	b := values.NewDictBuilder(dictType)
+	for i := int64(0); i < 3; i++ {
+		if err := b.Insert(values.NewInt(i), values.NewInt(i*i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := b.Dict()
+
+	r := d.Remove(values.NewInt(1))
+	if want, got := 2, r.Len(); want != got {
+		t.Fatalf("unexpected length -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if want, got := 3, d.Len(); want != got {
+		t.Fatalf("unexpected length of original dictionary -want/+got:\n\t- %d\n\t+ %d", want, got)
+	}
+	if _, ok := r.Lookup(values.NewInt(1)); ok {
+		t.Fatal("expected key to be removed")
+	}
+	if r := r.Remove(values.NewInt(1)); r.Len() != 2 {
+		t.Fatal("expected removing a missing key to leave the dictionary unchanged")
+	}
+}
+
+func TestDict_Equal(t *testing.T) {
+	dictType := semantic.NewDictType(semantic.BasicString, semantic.BasicFloat)
+	l, _ := values.NewDict(dictType).Insert(values.NewString("a"), values.NewFloat(1))
+	r, _ := values.NewDict(dictType).Insert(values.NewString("a"), values.NewFloat(1))
+	if !l.Equal(r) {
+		t.Fatal("expected dictionaries to be equal")
+	}
+
+	r, _ = r.Insert(values.NewString("a"), values.NewFloat(2))
+	if l.Equal(r) {
+		t.Fatal("expected dictionaries to be unequal")
+	}
+
+	l, _ = l.Insert(values.NewString("b"), values.NewFloat(2))
+	r, _ = r.Insert(values.NewString("b"), values.NewFloat(2))
+	if l.Equal(r) {
+		t.Fatal("expected dictionaries to be unequal")
+	}
+}
@@ -188,6 +188,13 @@ func Unwrap(v Value) interface{} {
 			o[k] = val
 		})
 		return o
+	case semantic.Dictionary:
+		dict := v.(Dictionary)
+		d := make(map[interface{}]interface{}, dict.Len())
+		dict.Range(func(key, value Value) {
+			d[Unwrap(key)] = Unwrap(value)
+		})
+		return d
 	case semantic.Function:
 		// there is no primitive value for a Function object, just return itself.
 		return v